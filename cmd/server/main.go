@@ -2,6 +2,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"os"
@@ -12,23 +13,37 @@ import (
 	"github.com/erinchen11/hr-system/internal/api" // 路由註冊
 	"github.com/gin-contrib/cors"
 
-	"github.com/erinchen11/hr-system/internal/api/handlers"                            // 頂層 handlers (如果 CheckLive 在這裡)
-	acchandler "github.com/erinchen11/hr-system/internal/api/handlers/account"         // 使用別名 account handler
-	authhandler "github.com/erinchen11/hr-system/internal/api/handlers/auth"           // 使用別名 auth handler
-	jobgradehandler "github.com/erinchen11/hr-system/internal/api/handlers/job_grade"  // 導入 jobgrade
-	leavehandler "github.com/erinchen11/hr-system/internal/api/handlers/leave_request" // 使用別名 leave handler
+	"github.com/erinchen11/hr-system/internal/api/handlers"                              // 頂層 handlers (如果 CheckLive 在這裡)
+	acchandler "github.com/erinchen11/hr-system/internal/api/handlers/account"           // 使用別名 account handler
+	analyticshandler "github.com/erinchen11/hr-system/internal/api/handlers/analytics"   // 假期統計 handler
+	authhandler "github.com/erinchen11/hr-system/internal/api/handlers/auth"             // 使用別名 auth handler
+	calendarhandler "github.com/erinchen11/hr-system/internal/api/handlers/calendar"     // 團隊請假行事曆 / iCalendar 訂閱 handler
+	delegationhandler "github.com/erinchen11/hr-system/internal/api/handlers/delegation" // 審核代理 handler
+	employmenthandler "github.com/erinchen11/hr-system/internal/api/handlers/employment" // 僱傭 / 回報關係 handler
+	holidayhandler "github.com/erinchen11/hr-system/internal/api/handlers/holiday"       // 假日行事曆 handler
+	jobgradehandler "github.com/erinchen11/hr-system/internal/api/handlers/job_grade"    // 導入 jobgrade
+	leavehandler "github.com/erinchen11/hr-system/internal/api/handlers/leave_request"   // 使用別名 leave handler
+	leaverulehandler "github.com/erinchen11/hr-system/internal/api/handlers/leave_rule"  // 封鎖期間 / 最少在班人數規則 handler
+	leavetypehandler "github.com/erinchen11/hr-system/internal/api/handlers/leave_type"  // 假別管理 handler
+	overtimehandler "github.com/erinchen11/hr-system/internal/api/handlers/overtime"     // 加班申請 / 補休 handler
 
-	"github.com/erinchen11/hr-system/internal/api/middleware" // Middleware 實現
-	"github.com/erinchen11/hr-system/internal/config"         // 調用 LoadConfig
-	"github.com/erinchen11/hr-system/internal/infra/cache"    // Cache 初始化和 Repository
-	"github.com/erinchen11/hr-system/internal/infra/database" // DB 初始化和 Repository
+	"github.com/erinchen11/hr-system/internal/api/middleware"     // Middleware 實現
+	"github.com/erinchen11/hr-system/internal/config"             // 調用 LoadConfig
+	"github.com/erinchen11/hr-system/internal/infra/cache"        // Cache 初始化和 Repository
+	"github.com/erinchen11/hr-system/internal/infra/database"     // DB 初始化和 Repository
+	"github.com/erinchen11/hr-system/internal/infra/notification" // 通知 (目前寫入 log)
+	"github.com/erinchen11/hr-system/internal/infra/storage"      // 附件檔案儲存
 
 	// 導入 interfaces
-	"github.com/erinchen11/hr-system/internal/seeds"    // Seeds
-	"github.com/erinchen11/hr-system/internal/services" // Service 實現
-	"github.com/erinchen11/hr-system/internal/utils"    // Utilities 實現
-	"github.com/gin-gonic/gin"                          // 導入 Gin
-	"github.com/redis/go-redis/v9"                      // 導入 Redis Client
+	"github.com/erinchen11/hr-system/internal/interfaces" // Email 審核連結 (可停用)
+	"github.com/erinchen11/hr-system/internal/models"     // 附件大小預設值
+	"github.com/erinchen11/hr-system/internal/scheduler"  // 背景排程 (Redis 鎖)
+	"github.com/erinchen11/hr-system/internal/seeds"      // Seeds
+	"github.com/erinchen11/hr-system/internal/services"   // Service 實現
+	"github.com/erinchen11/hr-system/internal/utils"      // Utilities 實現
+	"github.com/gin-gonic/gin"                            // 導入 Gin
+	"github.com/redis/go-redis/v9"                        // 導入 Redis Client
+	"github.com/shopspring/decimal"                       // 自動核准病假的天數上限
 
 	"gorm.io/gorm" // 導入 GORM
 )
@@ -37,6 +52,7 @@ func main() {
 	// --- flag 參數解析 ---
 	migrate := flag.Bool("migrate", false, "Run database migrations")
 	seed := flag.Bool("seed", false, "Seed the database with initial data")
+	yearEnd := flag.Int("year-end", 0, "Run year-end leave carry-over and expiry for the given year (e.g. 2025)")
 	flag.Parse()
 
 	// --- 1. 加載配置 ---
@@ -66,6 +82,17 @@ func main() {
 		log.Println("Seeding completed.")
 		os.Exit(0)
 	}
+	if *yearEnd != 0 {
+		log.Printf("Running year-end leave processing for %d...", *yearEnd)
+		balanceService := services.NewLeaveBalanceServiceImpl(database.NewGormLeaveBalanceRepository(db), database.NewGormEmploymentRepository(db))
+		summary, err := balanceService.RunYearEnd(context.Background(), *yearEnd)
+		if err != nil {
+			log.Fatalf("Year-end processing failed: %v", err)
+		}
+		printYearEndSummary(summary)
+		log.Println("Year-end processing completed.")
+		os.Exit(0)
+	}
 
 	// --- 3. 依賴注入設置 ---
 	log.Println("Initializing dependencies...")
@@ -76,6 +103,18 @@ func main() {
 	employmentRepo := database.NewGormEmploymentRepository(db)
 	leaveRequestRepo := database.NewGormLeaveRequestRepository(db)
 	jobGradeRepo := database.NewGormJobGradeRepository(db)
+	leaveBalanceRepo := database.NewGormLeaveBalanceRepository(db)
+	holidayRepo := database.NewGormHolidayRepository(db)
+	leaveApprovalRepo := database.NewGormLeaveApprovalRepository(db)
+	approvalDelegationRepo := database.NewGormApprovalDelegationRepository(db)
+	leaveRequestEventRepo := database.NewGormLeaveRequestEventRepository(db)
+	leaveAttachmentRepo := database.NewGormLeaveAttachmentRepository(db)
+	leaveRuleRepo := database.NewGormLeaveRuleRepository(db)
+	leaveTypeRepo := database.NewGormLeaveTypeRepository(db)
+	employmentStatusChangeRepo := database.NewGormEmploymentStatusChangeRepository(db)
+	overtimeClaimRepo := database.NewGormOvertimeClaimRepository(db)
+	leaveAnalyticsRepo := database.NewGormLeaveAnalyticsRepository(db)
+	transactionManager := database.NewGormTransactionManager(db)
 	cacheRepo := cache.NewRedisCacheRepository(redisClient)
 	log.Println("Repositories initialized.")
 
@@ -96,6 +135,15 @@ func main() {
 		log.Fatalf("Failed to initialize JWT Utils: %v", err)
 	}
 	defaultPassword := environment.DefaultPassword
+	fileStorage, err := storage.NewLocalFileStorage(environment.AttachmentStorageDir)
+	if err != nil {
+		log.Fatalf("Failed to initialize attachment storage: %v", err)
+	}
+	attachmentMaxSizeMB, err := strconv.Atoi(environment.AttachmentMaxSizeMB)
+	if err != nil {
+		log.Printf("Warning: Invalid ATTACHMENT_MAX_SIZE_MB '%s', using default %d MB. Error: %v", environment.AttachmentMaxSizeMB, models.DefaultMaxAttachmentSizeMB, err)
+		attachmentMaxSizeMB = models.DefaultMaxAttachmentSizeMB
+	}
 	log.Println("Utilities initialized.")
 
 	// 3.3 實例化 Services
@@ -105,13 +153,49 @@ func main() {
 	accountService := services.NewAccountServiceImpl(
 		accountRepo, employmentRepo, pwChecker, pwHasher, cacheRepo, defaultPassword, db,
 	)
-	employmentService := services.NewEmploymentServiceImpl(
-		employmentRepo, accountRepo,
+	leaveBalanceService := services.NewLeaveBalanceServiceImpl(leaveBalanceRepo, employmentRepo)
+	holidayService := services.NewHolidayServiceImpl(holidayRepo)
+	leaveAttachmentService := services.NewLeaveAttachmentServiceImpl(
+		leaveAttachmentRepo, leaveRequestRepo, accountRepo, employmentRepo, leaveApprovalRepo, approvalDelegationRepo, fileStorage, attachmentMaxSizeMB,
 	)
+	leaveRuleService := services.NewLeaveRuleServiceImpl(leaveRuleRepo, employmentRepo, leaveRequestRepo, holidayService)
+	leaveTypeService := services.NewLeaveTypeServiceImpl(leaveTypeRepo)
+	// Email 審核連結 (簽章金鑰由 JWT 密鑰衍生); 有效時數為 0 或 notifier 不是 Private (例如寫入 log) 時通知不附連結
+	leaveActionLinkTTLHours := envInt(environment.LeaveActionLinkTTLHours, "LEAVE_ACTION_LINK_TTL_HOURS", models.DefaultLeaveActionLinkTTLHours)
+	leaveActionLinkBaseURL := environment.LeaveActionLinkBaseURL
+	if leaveActionLinkBaseURL == "" {
+		leaveActionLinkBaseURL = "http://" + environment.Domain + ":" + environment.ServerPort + environment.BasePath + "/v1/leave-actions"
+	}
+	var leaveActionLinkGenerator interfaces.LeaveActionLinkGenerator
+	if leaveActionLinkTTLHours > 0 {
+		leaveActionLinkGenerator = services.NewLeaveActionLinkGeneratorImpl(jwtHelper, leaveActionLinkBaseURL, leaveActionLinkTTLHours)
+	}
+	notifier := notification.NewLogNotifier()
+	leaveApprovalNotifier := services.NewLeaveApprovalNotifierImpl(accountRepo, employmentRepo, approvalDelegationRepo, notifier, leaveActionLinkGenerator)
 	leaveRequestService := services.NewLeaveRequestServiceImpl(
-		leaveRequestRepo, accountRepo,
+		leaveRequestRepo, accountRepo, employmentRepo, leaveApprovalRepo, approvalDelegationRepo, leaveRequestEventRepo, leaveBalanceService, holidayService, leaveAttachmentService,
+		leaveRuleService, leaveTypeService, leaveApprovalNotifier, transactionManager,
+	)
+	employmentService := services.NewEmploymentServiceImpl(
+		employmentRepo, accountRepo, employmentStatusChangeRepo, leaveRequestService, leaveBalanceService, transactionManager,
 	)
 	jobGradeService := services.NewJobGradeServiceImpl(jobGradeRepo, employmentRepo) // 實例化 JobGradeService
+	approvalDelegationService := services.NewApprovalDelegationServiceImpl(approvalDelegationRepo, accountRepo)
+	leaveCalendarFeedTTLDays := envInt(environment.LeaveCalendarFeedTTLDays, "LEAVE_CALENDAR_FEED_TTL_DAYS", models.DefaultCalendarFeedTTLDays)
+	if leaveCalendarFeedTTLDays <= 0 {
+		leaveCalendarFeedTTLDays = models.DefaultCalendarFeedTTLDays
+	}
+	leaveCalendarService := services.NewLeaveCalendarServiceImpl(leaveRequestRepo, accountRepo, employmentRepo, jwtHelper, leaveCalendarFeedTTLDays)
+	leaveActionLinkService := services.NewLeaveActionLinkServiceImpl(jwtHelper, cacheRepo, leaveRequestService, leaveActionLinkBaseURL, leaveActionLinkTTLHours)
+	leaveFollowUpService := services.NewLeaveFollowUpServiceImpl(
+		leaveRequestRepo, accountRepo, leaveApprovalRepo, leaveRequestEventRepo, leaveRequestService,
+		notifier, leaveApprovalNotifier, loadLeaveFollowUpSettings(),
+	)
+	longLeaveThresholdDays := envInt(environment.LongLeaveThresholdDays, "LEAVE_LONG_LEAVE_THRESHOLD_DAYS", models.DefaultLongLeaveThresholdDays)
+	employmentStatusService := services.NewEmploymentStatusServiceImpl(employmentRepo, leaveRequestRepo, employmentStatusChangeRepo, transactionManager, longLeaveThresholdDays)
+	overtimeService := services.NewOvertimeServiceImpl(overtimeClaimRepo, accountRepo, leaveBalanceService, transactionManager,
+		envInt(environment.CompOffExpiryDays, "COMP_OFF_EXPIRY_DAYS", models.DefaultCompOffExpiryDays))
+	leaveAnalyticsService := services.NewLeaveAnalyticsServiceImpl(leaveAnalyticsRepo)
 
 	log.Println("Services initialized.")
 
@@ -125,13 +209,65 @@ func main() {
 	listLeaveRequestsHandler := leavehandler.NewListLeaveRequestsHandler(leaveRequestService)
 	approveLeaveRequestHandler := leavehandler.NewApproveLeaveRequestHandler(leaveRequestService)
 	rejectLeaveRequestHandler := leavehandler.NewRejectLeaveRequestHandler(leaveRequestService)
-	applyLeaveHandler := leavehandler.NewApplyLeaveHandler(leaveRequestService)
+	bulkProcessLeaveRequestsHandler := leavehandler.NewBulkProcessLeaveRequestsHandler(leaveRequestService)
+	applyLeaveHandler := leavehandler.NewApplyLeaveHandler(leaveRequestService, attachmentMaxSizeMB)
 	viewLeaveStatusHandler := leavehandler.NewViewLeaveStatusHandler(leaveRequestService)
+	viewLeaveBalanceHandler := leavehandler.NewViewLeaveBalanceHandler(leaveBalanceService)
+	leaveYearEndSummaryHandler := leavehandler.NewLeaveYearEndSummaryHandler(leaveBalanceService)
+	cancelLeaveRequestHandler := leavehandler.NewCancelLeaveRequestHandler(leaveRequestService)
+	updateLeaveRequestHandler := leavehandler.NewUpdateLeaveRequestHandler(leaveRequestService)
+	applyLeaveOnBehalfHandler := leavehandler.NewApplyLeaveOnBehalfHandler(leaveRequestService, attachmentMaxSizeMB)
+	listEmploymentStatusChangesHandler := employmenthandler.NewListEmploymentStatusChangesHandler(employmentStatusService)
+	confirmLeaveCancellationHandler := leavehandler.NewConfirmLeaveCancellationHandler(leaveRequestService)
+	declineLeaveCancellationHandler := leavehandler.NewDeclineLeaveCancellationHandler(leaveRequestService)
+	listManagedLeaveRequestsHandler := leavehandler.NewListManagedLeaveRequestsHandler(leaveRequestService)
+	managerApproveLeaveHandler := leavehandler.NewManagerApproveLeaveHandler(leaveRequestService)
+	managerRejectLeaveHandler := leavehandler.NewManagerRejectLeaveHandler(leaveRequestService)
+	leaveRequestHistoryHandler := leavehandler.NewLeaveRequestHistoryHandler(leaveRequestService)
+	getLeaveRequestHandler := leavehandler.NewGetLeaveRequestHandler(leaveRequestService)
+	uploadLeaveAttachmentHandler := leavehandler.NewUploadLeaveAttachmentHandler(leaveAttachmentService, attachmentMaxSizeMB)
+	listLeaveAttachmentsHandler := leavehandler.NewListLeaveAttachmentsHandler(leaveAttachmentService)
+	downloadLeaveAttachmentHandler := leavehandler.NewDownloadLeaveAttachmentHandler(leaveAttachmentService)
+	setManagerHandler := employmenthandler.NewSetManagerHandler(employmentService)
+	getManagerHandler := employmenthandler.NewGetManagerHandler(employmentService)
+	terminateEmploymentHandler := employmenthandler.NewTerminateEmploymentHandler(employmentService)
+	leaveActionLinkHandler := leavehandler.NewLeaveActionLinkHandler(leaveActionLinkService)
+	createDelegationHandler := delegationhandler.NewCreateDelegationHandler(approvalDelegationService)
+	listDelegationsHandler := delegationhandler.NewListDelegationsHandler(approvalDelegationService)
+	revokeDelegationHandler := delegationhandler.NewRevokeDelegationHandler(approvalDelegationService)
+	teamLeaveCalendarHandler := calendarhandler.NewTeamLeaveCalendarHandler(leaveCalendarService)
+	leaveFeedHandler := calendarhandler.NewLeaveFeedHandler(leaveCalendarService)
+	leaveFeedTokenHandler := calendarhandler.NewLeaveFeedTokenHandler(leaveCalendarService)
 	listJobGradesHandler := jobgradehandler.NewListJobGradesHandler(jobGradeService) // 新增: 創建 ListJobGradesHandler
+	updateJobGradeHandler := jobgradehandler.NewUpdateJobGradeHandler(jobGradeService)
+	getJobGradeHandler := jobgradehandler.NewGetJobGradeHandler(jobGradeService)
+	listHolidaysHandler := holidayhandler.NewListHolidaysHandler(holidayService)
+	createHolidayHandler := holidayhandler.NewCreateHolidayHandler(holidayService)
+	deleteHolidayHandler := holidayhandler.NewDeleteHolidayHandler(holidayService)
+	updateWeekendDaysHandler := holidayhandler.NewUpdateWeekendDaysHandler(holidayService)
+	listLeaveBlackoutsHandler := leaverulehandler.NewListLeaveBlackoutsHandler(leaveRuleService)
+	createLeaveBlackoutHandler := leaverulehandler.NewCreateLeaveBlackoutHandler(leaveRuleService)
+	deleteLeaveBlackoutHandler := leaverulehandler.NewDeleteLeaveBlackoutHandler(leaveRuleService)
+	listStaffingRulesHandler := leaverulehandler.NewListStaffingRulesHandler(leaveRuleService)
+	createStaffingRuleHandler := leaverulehandler.NewCreateStaffingRuleHandler(leaveRuleService)
+	deleteStaffingRuleHandler := leaverulehandler.NewDeleteStaffingRuleHandler(leaveRuleService)
+	listLeaveTypesHandler := leavetypehandler.NewListLeaveTypesHandler(leaveTypeService)
+	createLeaveTypeHandler := leavetypehandler.NewCreateLeaveTypeHandler(leaveTypeService)
+	updateLeaveTypeHandler := leavetypehandler.NewUpdateLeaveTypeHandler(leaveTypeService)
+	deleteLeaveTypeHandler := leavetypehandler.NewDeleteLeaveTypeHandler(leaveTypeService)
+	submitOvertimeClaimHandler := overtimehandler.NewSubmitOvertimeClaimHandler(overtimeService)
+	listMyOvertimeClaimsHandler := overtimehandler.NewListMyOvertimeClaimsHandler(overtimeService)
+	listOvertimeClaimsHandler := overtimehandler.NewListOvertimeClaimsHandler(overtimeService)
+	approveOvertimeClaimHandler := overtimehandler.NewApproveOvertimeClaimHandler(overtimeService)
+	rejectOvertimeClaimHandler := overtimehandler.NewRejectOvertimeClaimHandler(overtimeService)
+	absenceTotalsHandler := analyticshandler.NewAbsenceTotalsHandler(leaveAnalyticsService)
+	bradfordFactorHandler := analyticshandler.NewBradfordFactorHandler(leaveAnalyticsService)
+	approvalTurnaroundHandler := analyticshandler.NewApprovalTurnaroundHandler(leaveAnalyticsService)
 	log.Println("Handlers initialized.")
 
 	// 3.5 實例化 Middleware
 	authMiddleware := middleware.NewAuthMiddleware(tokenService)
+	feedAuthMiddleware := middleware.NewFeedAuthMiddleware(leaveCalendarService)
 	log.Println("Middleware initialized.")
 
 	log.Println("Dependencies initialized.")
@@ -146,6 +282,7 @@ func main() {
 		checkLiveHandler,
 		loginHandler,               // auth.LoginHandler
 		authMiddleware,             // middleware
+		feedAuthMiddleware,         // middleware.FeedAuthMiddleware
 		accountPasswordHandler,     // account.AccountPasswordHandler
 		userCreationHandler,        // account.UserCreationHandler
 		listLeaveRequestsHandler,   // leave_request.ListLeaveRequestsHandler
@@ -155,10 +292,96 @@ func main() {
 		applyLeaveHandler,          // leave_request.ApplyLeaveHandler
 		viewLeaveStatusHandler,     // leave_request.ViewLeaveStatusHandler
 		listJobGradesHandler,
+		updateJobGradeHandler,
+		viewLeaveBalanceHandler,            // leave_request.ViewLeaveBalanceHandler
+		listHolidaysHandler,                // holiday.ListHolidaysHandler
+		createHolidayHandler,               // holiday.CreateHolidayHandler
+		deleteHolidayHandler,               // holiday.DeleteHolidayHandler
+		updateWeekendDaysHandler,           // holiday.UpdateWeekendDaysHandler
+		cancelLeaveRequestHandler,          // leave_request.CancelLeaveRequestHandler
+		confirmLeaveCancellationHandler,    // leave_request.ConfirmLeaveCancellationHandler
+		declineLeaveCancellationHandler,    // leave_request.DeclineLeaveCancellationHandler
+		setManagerHandler,                  // employment.SetManagerHandler
+		listManagedLeaveRequestsHandler,    // leave_request.ListManagedLeaveRequestsHandler
+		managerApproveLeaveHandler,         // leave_request.ManagerApproveLeaveHandler
+		managerRejectLeaveHandler,          // leave_request.ManagerRejectLeaveHandler
+		createDelegationHandler,            // delegation.CreateDelegationHandler
+		listDelegationsHandler,             // delegation.ListDelegationsHandler
+		revokeDelegationHandler,            // delegation.RevokeDelegationHandler
+		leaveRequestHistoryHandler,         // leave_request.LeaveRequestHistoryHandler
+		teamLeaveCalendarHandler,           // calendar.TeamLeaveCalendarHandler
+		leaveFeedHandler,                   // calendar.LeaveFeedHandler
+		leaveFeedTokenHandler,              // calendar.LeaveFeedTokenHandler
+		uploadLeaveAttachmentHandler,       // leave_request.UploadLeaveAttachmentHandler
+		listLeaveAttachmentsHandler,        // leave_request.ListLeaveAttachmentsHandler
+		downloadLeaveAttachmentHandler,     // leave_request.DownloadLeaveAttachmentHandler
+		listLeaveBlackoutsHandler,          // leave_rule.ListLeaveBlackoutsHandler
+		createLeaveBlackoutHandler,         // leave_rule.CreateLeaveBlackoutHandler
+		deleteLeaveBlackoutHandler,         // leave_rule.DeleteLeaveBlackoutHandler
+		listStaffingRulesHandler,           // leave_rule.ListStaffingRulesHandler
+		createStaffingRuleHandler,          // leave_rule.CreateStaffingRuleHandler
+		deleteStaffingRuleHandler,          // leave_rule.DeleteStaffingRuleHandler
+		leaveYearEndSummaryHandler,         // leave_request.LeaveYearEndSummaryHandler
+		listLeaveTypesHandler,              // leave_type.ListLeaveTypesHandler
+		createLeaveTypeHandler,             // leave_type.CreateLeaveTypeHandler
+		updateLeaveTypeHandler,             // leave_type.UpdateLeaveTypeHandler
+		deleteLeaveTypeHandler,             // leave_type.DeleteLeaveTypeHandler
+		bulkProcessLeaveRequestsHandler,    // leave_request.BulkProcessLeaveRequestsHandler
+		updateLeaveRequestHandler,          // leave_request.UpdateLeaveRequestHandler
+		applyLeaveOnBehalfHandler,          // leave_request.ApplyLeaveOnBehalfHandler
+		listEmploymentStatusChangesHandler, // employment.ListEmploymentStatusChangesHandler
+		submitOvertimeClaimHandler,         // overtime.SubmitOvertimeClaimHandler
+		listMyOvertimeClaimsHandler,        // overtime.ListMyOvertimeClaimsHandler
+		listOvertimeClaimsHandler,          // overtime.ListOvertimeClaimsHandler
+		approveOvertimeClaimHandler,        // overtime.ApproveOvertimeClaimHandler
+		rejectOvertimeClaimHandler,         // overtime.RejectOvertimeClaimHandler
+		absenceTotalsHandler,               // analytics.AbsenceTotalsHandler
+		bradfordFactorHandler,              // analytics.BradfordFactorHandler
+		approvalTurnaroundHandler,          // analytics.ApprovalTurnaroundHandler
+		terminateEmploymentHandler,         // employment.TerminateEmploymentHandler
+		leaveActionLinkHandler,             // leave_request.LeaveActionLinkHandler
+		getLeaveRequestHandler,             // leave_request.GetLeaveRequestHandler
+		getJobGradeHandler,                 // job_grade.GetJobGradeHandler
+		getManagerHandler,                  // employment.GetManagerHandler
 	)
 	log.Println("Routes registered.")
 
-	// --- 5. 啟動 HTTP Server ---
+	// --- 5. 啟動背景排程 (多副本時以 Redis 鎖確保每個間隔只有一個副本執行) ---
+	schedulerCtx, stopScheduler := context.WithCancel(context.Background())
+	defer stopScheduler()
+	jobScheduler := scheduler.NewScheduler(cacheRepo)
+	jobScheduler.Register(scheduler.Job{
+		Name:     "leave-follow-up",
+		Interval: time.Duration(envInt(environment.LeaveSchedulerIntervalMinutes, "LEAVE_SCHEDULER_INTERVAL_MINUTES", 15)) * time.Minute,
+		Run: func(ctx context.Context) error {
+			_, err := leaveFollowUpService.ProcessStaleRequests(ctx, time.Now())
+			return err
+		},
+	})
+	longLeaveInterval := 24 * time.Hour
+	if longLeaveThresholdDays == 0 {
+		longLeaveInterval = 0 // 停用
+	}
+	jobScheduler.Register(scheduler.Job{
+		Name:       "long-leave-status",
+		Interval:   longLeaveInterval,
+		RunOnStart: true,
+		Run: func(ctx context.Context) error {
+			_, err := employmentStatusService.SyncLongLeaveStatuses(ctx, time.Now())
+			return err
+		},
+	})
+	jobScheduler.Register(scheduler.Job{
+		Name:       "leave-accruals",
+		Interval:   time.Hour,
+		RunOnStart: true,
+		Run: func(ctx context.Context) error {
+			return leaveBalanceService.PostAccruals(ctx, time.Now())
+		},
+	})
+	jobScheduler.Start(schedulerCtx)
+
+	// --- 6. 啟動 HTTP Server ---
 	serverPort := environment.ServerPort
 	log.Printf("🚀 Starting server on port %s...", serverPort)
 	if err := engine.Run(":" + serverPort); err != nil {
@@ -213,11 +436,57 @@ func initializeGin() *gin.Engine { /* ... */
 	engine.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"}, // 允許所有來源, 也可以改成 []string{"http://localhost:3000"} 指定
 		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", "If-Match"}, // If-Match: 樂觀鎖版本號
+		ExposeHeaders:    []string{"Content-Length", "ETag"},                              // 讓前端讀取記錄版本號
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
 	log.Printf("Gin engine initialized in %s mode.", gin.Mode())
 	return engine
 }
+
+// printYearEndSummary 將年底結算報表輸出給 HR (依假別合計 + 每位員工明細)
+func printYearEndSummary(summary *models.LeaveYearEndSummary) {
+	log.Printf("===== Leave year-end summary %d =====", summary.Year)
+	for _, total := range summary.Totals {
+		log.Printf("[%s] accounts: %d, closing balance: %s, carried over: %s, forfeited: %s",
+			total.LeaveType, total.Accounts, total.ClosingBalance, total.CarriedOver, total.Forfeited)
+	}
+	for _, record := range summary.Records {
+		name := record.AccountID.String()
+		if record.Account != nil {
+			name = record.Account.FirstName + " " + record.Account.LastName + " <" + record.Account.Email + ">"
+		}
+		expires := "-"
+		if record.ExpiresOn != nil {
+			expires = record.ExpiresOn.Format("2006-01-02")
+		}
+		log.Printf("  %s [%s] closing: %s, carried over: %s (expires %s), forfeited: %s",
+			name, record.LeaveType, record.ClosingBalance, record.CarriedOver, expires, record.Forfeited)
+	}
+}
+
+// loadLeaveFollowUpSettings 讀取逾時未審假單的提醒、升級與自動核准設定
+func loadLeaveFollowUpSettings() models.LeaveFollowUpSettings {
+	settings := models.LeaveFollowUpSettings{
+		ReminderAfter: time.Duration(envInt(environment.LeaveReminderAfterHours, "LEAVE_REMINDER_AFTER_HOURS", models.DefaultLeaveReminderAfterHours)) * time.Hour,
+		EscalateAfter: time.Duration(envInt(environment.LeaveEscalateAfterHours, "LEAVE_ESCALATE_AFTER_HOURS", models.DefaultLeaveEscalateAfterHours)) * time.Hour,
+	}
+	maxDays, err := decimal.NewFromString(environment.LeaveAutoApproveSickMaxDays)
+	if err != nil || maxDays.IsNegative() {
+		log.Printf("Warning: Invalid LEAVE_AUTO_APPROVE_SICK_MAX_DAYS '%s', sick leave auto-approval disabled.", environment.LeaveAutoApproveSickMaxDays)
+		maxDays = decimal.Zero
+	}
+	settings.AutoApproveSickMaxDays = maxDays
+	return settings
+}
+
+// envInt 將設定值解析為非負整數，格式錯誤時使用預設值
+func envInt(value, name string, fallback int) int {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		log.Printf("Warning: Invalid %s '%s', using default %d.", name, value, fallback)
+		return fallback
+	}
+	return n
+}
@@ -29,6 +29,29 @@ var (
 	JwtSecret       string
 	JwtExpireHours  string // 小時 (保持為 string)
 	DefaultPassword string // 新用戶的預設密碼
+
+	// 假單附件
+	AttachmentStorageDir string // 本機檔案儲存的根目錄
+	AttachmentMaxSizeMB  string // 單一附件大小上限 (MB)
+
+	// 逾時未審假單排程
+	LeaveSchedulerIntervalMinutes string // 排程執行間隔 (分鐘), 0 表示停用
+	LeaveReminderAfterHours       string // 待審超過此時數提醒審核人
+	LeaveEscalateAfterHours       string // 待審超過此時數升級給 Super Admin
+	LeaveAutoApproveSickMaxDays   string // 自動核准病假的天數上限, 0 表示停用
+
+	// 長假僱傭狀態排程
+	LongLeaveThresholdDays string // 假期日曆天超過此值時將僱傭狀態設為 on_leave, 0 表示停用
+
+	// 加班補休
+	CompOffExpiryDays string // 補休自加班日起的有效天數
+
+	// Email 審核連結
+	LeaveActionLinkBaseURL  string // 連結前綴 (對外網址), 空值時依 Domain 與 ServerPort 組成
+	LeaveActionLinkTTLHours string // 連結有效時數, 0 表示停用
+
+	// iCalendar 訂閱網址
+	LeaveCalendarFeedTTLDays string // 訂閱網址有效天數, 到期後需重新簽發
 )
 
 // API 的基礎路徑
@@ -54,4 +77,20 @@ const (
 	DefaultJwtSecret      = "change-this-in-production-env-file"
 	DefaultJwtExpireHours = "24"
 	DefaultPasswordValue  = ""
+
+	DefaultAttachmentStorageDir = "./uploads"
+	DefaultAttachmentMaxSizeMB  = "5"
+
+	DefaultLeaveSchedulerIntervalMinutes = "15"
+	DefaultLeaveReminderAfterHours       = "48"
+	DefaultLeaveEscalateAfterHours       = "120"
+	DefaultLeaveAutoApproveSickMaxDays   = "0"
+
+	DefaultLongLeaveThresholdDays = "30"
+
+	DefaultCompOffExpiryDays = "90"
+
+	DefaultLeaveActionLinkTTLHours = "72"
+
+	DefaultLeaveCalendarFeedTTLDays = "180"
 )
@@ -41,6 +41,7 @@ type UserProfileResponse struct {
 	Role          uint8            `json:"role"`                     // From Account
 	PhoneNumber   string           `json:"phone_number,omitempty"`   // From Account
 	PositionTitle string           `json:"position_title,omitempty"` // From Employment
+	Department    string           `json:"department,omitempty"`     // From Employment
 	Salary        *decimal.Decimal `json:"salary,omitempty"`         // From Employment (是否返回需謹慎)
 	HireDate      *time.Time       `json:"hire_date,omitempty"`      // From Employment
 	Status        string           `json:"status,omitempty"`         // From Employment
@@ -100,6 +101,7 @@ func (h *UserProfileHandler) GetProfile(c *gin.Context) {
 
 	if employment != nil { // 只有在找到僱傭記錄時才填充相關欄位
 		responseDTO.PositionTitle = employment.PositionTitle
+		responseDTO.Department = employment.Department
 		responseDTO.Salary = employment.Salary // 再次考慮是否要在 Profile API 中返回薪資
 		responseDTO.HireDate = employment.HireDate
 		responseDTO.Status = employment.Status
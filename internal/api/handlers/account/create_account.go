@@ -36,6 +36,7 @@ type CreateUserRequest struct {
 	Role          uint8   `json:"role" binding:"omitempty,oneof=1 2" example:"2" default:"2"`
 	JobGradeCode  string  `json:"job_grade_code,omitempty" binding:"omitempty"`
 	PositionTitle string  `json:"position_title,omitempty"`
+	Department    string  `json:"department,omitempty"`
 	Salary        *string `json:"salary,omitempty" binding:"omitempty,numeric"`
 	HireDate      *string `json:"hire_date,omitempty" binding:"omitempty,datetime=2006-01-02"`
 }
@@ -96,6 +97,7 @@ func (h *AccountCreationHandler) CreateUser(c *gin.Context) {
 	employment := &models.Employment{
 		Status:        models.EmploymentStatusActive,
 		PositionTitle: req.PositionTitle,
+		Department:    req.Department,
 	}
 
 	if req.JobGradeCode != "" {
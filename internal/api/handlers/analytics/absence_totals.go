@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// AbsenceTotalsHandler 包含依賴
+type AbsenceTotalsHandler struct {
+	analyticsSvc interfaces.LeaveAnalyticsService
+}
+
+// NewAbsenceTotalsHandler 構造函數
+func NewAbsenceTotalsHandler(analyticsSvc interfaces.LeaveAnalyticsService) *AbsenceTotalsHandler {
+	return &AbsenceTotalsHandler{analyticsSvc: analyticsSvc}
+}
+
+// AbsenceTotalDTO 缺勤統計中的一個分組
+type AbsenceTotalDTO struct {
+	Key      string `json:"key"`
+	Label    string `json:"label,omitempty"`
+	Requests int64  `json:"requests"`
+	Days     string `json:"days"`
+}
+
+// GetAbsenceTotals 處理 HR 查詢缺勤統計的 HTTP 請求
+// 支援的查詢參數:
+//   - group_by: month (預設) / leave_type / job_grade / department / status
+//   - start_date / end_date: YYYY-MM-DD, 依假期開始日篩選 (預設為截至今天的一年)
+//   - department / leave_type: 只統計指定部門 / 假別
+//   - status: 以逗號分隔的狀態 (預設只計入已核准的假單, 依狀態分組時預設為全部狀態)
+func (h *AbsenceTotalsHandler) GetAbsenceTotals(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view leave analytics"})
+		return
+	}
+
+	// 2. 解析查詢參數
+	filter, err := parseAnalyticsFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+		return
+	}
+	groupBy := c.DefaultQuery("group_by", models.LeaveAnalyticsByMonth)
+
+	// 3. 調用 Service 層
+	totals, err := h.analyticsSvc.GetAbsenceTotals(c.Request.Context(), groupBy, filter)
+	if err != nil {
+		writeAnalyticsError(c, err)
+		return
+	}
+
+	response := make([]AbsenceTotalDTO, 0, len(totals))
+	for _, total := range totals {
+		response = append(response, AbsenceTotalDTO{Key: total.Key, Label: total.Label, Requests: total.Requests, Days: total.Days.String()})
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: response})
+}
+
+// parseAnalyticsFilter 將查詢參數轉換為 LeaveAnalyticsFilter; 狀態是否合法交由 Service 層檢查
+func parseAnalyticsFilter(c *gin.Context) (models.LeaveAnalyticsFilter, error) {
+	today := time.Now()
+	filter := models.LeaveAnalyticsFilter{
+		To:         time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC),
+		Department: strings.TrimSpace(c.Query("department")),
+		LeaveType:  strings.TrimSpace(c.Query("leave_type")),
+	}
+	if dateStr := c.Query("end_date"); dateStr != "" {
+		to, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return filter, errors.New("end_date must be YYYY-MM-DD")
+		}
+		filter.To = to
+	}
+	filter.From = filter.To.AddDate(-1, 0, 1)
+	if dateStr := c.Query("start_date"); dateStr != "" {
+		from, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return filter, errors.New("start_date must be YYYY-MM-DD")
+		}
+		filter.From = from
+	}
+	if statusStr := c.Query("status"); statusStr != "" {
+		for _, status := range strings.Split(statusStr, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filter.Statuses = append(filter.Statuses, status)
+			}
+		}
+	}
+	return filter, nil
+}
+
+// writeAnalyticsError 將假期統計 Service 返回的錯誤對應到 HTTP 回應
+func writeAnalyticsError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrInvalidDateRange):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: end_date cannot be before start_date"})
+	case errors.Is(err, services.ErrInvalidAnalyticsQuery):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+	default:
+		log.Printf("Error calculating leave analytics: %v", err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to calculate leave analytics"})
+	}
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAbsenceTotalsHandler_GetAbsenceTotals(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		query              string
+		setupMocks         func(mockSvc *mocks.MockLeaveAnalyticsService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedData       []AbsenceTotalDTO
+	}{
+		{
+			name:         "Success - Sick Days By Leave Type For Engineering",
+			callerClaims: hrClaims,
+			query:        "?group_by=leave_type&start_date=2025-01-01&end_date=2025-03-31&department=Engineering&leave_type=sick",
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetAbsenceTotals(gomock.Any(), models.LeaveAnalyticsByLeaveType, models.LeaveAnalyticsFilter{
+					From:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+					To:         time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+					Department: "Engineering",
+					LeaveType:  models.LeaveTypeSick,
+				}).Return([]models.LeaveAbsenceTotal{{Key: models.LeaveTypeSick, Requests: 5, Days: decimal.RequireFromString("7.5")}}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedData:       []AbsenceTotalDTO{{Key: models.LeaveTypeSick, Requests: 5, Days: "7.5"}},
+		},
+		{
+			name:         "Success - Defaults To Month Over The Last Year",
+			callerClaims: hrClaims,
+			query:        "?end_date=2025-03-31&status=approved,rejected",
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetAbsenceTotals(gomock.Any(), models.LeaveAnalyticsByMonth, models.LeaveAnalyticsFilter{
+					From:     time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+					To:       time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+					Statuses: []string{models.LeaveStatusApproved, models.LeaveStatusRejected},
+				}).Return(nil, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedData:       []AbsenceTotalDTO{},
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view leave analytics",
+		},
+		{
+			name:               "Bad Request - Invalid Date",
+			callerClaims:       hrClaims,
+			query:              "?start_date=2025/01/01",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: start_date must be YYYY-MM-DD",
+		},
+		{
+			name:         "Bad Request - Unknown Dimension",
+			callerClaims: hrClaims,
+			query:        "?group_by=weekday",
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetAbsenceTotals(gomock.Any(), "weekday", gomock.Any()).
+					Return(nil, fmt.Errorf("%w: unknown group_by %q", services.ErrInvalidAnalyticsQuery, "weekday")).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    `Invalid query parameter: invalid leave analytics query: unknown group_by "weekday"`,
+		},
+		{
+			name:         "Bad Request - Reversed Date Range",
+			callerClaims: hrClaims,
+			query:        "?start_date=2025-03-31&end_date=2025-01-01",
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetAbsenceTotals(gomock.Any(), models.LeaveAnalyticsByMonth, gomock.Any()).Return(nil, services.ErrInvalidDateRange).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: end_date cannot be before start_date",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetAbsenceTotals(gomock.Any(), models.LeaveAnalyticsByMonth, gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to calculate leave analytics",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveAnalyticsService(ctrl)
+			handler := NewAbsenceTotalsHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/analytics/absences"+tc.query, nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.GetAbsenceTotals(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				common.Response
+				Data []AbsenceTotalDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedData != nil {
+				assert.Equal(t, tc.expectedData, resp.Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/shopspring/decimal"
+)
+
+// ApprovalTurnaroundHandler 包含依賴
+type ApprovalTurnaroundHandler struct {
+	analyticsSvc interfaces.LeaveAnalyticsService
+}
+
+// NewApprovalTurnaroundHandler 構造函數
+func NewApprovalTurnaroundHandler(analyticsSvc interfaces.LeaveAnalyticsService) *ApprovalTurnaroundHandler {
+	return &ApprovalTurnaroundHandler{analyticsSvc: analyticsSvc}
+}
+
+// TurnaroundStatDTO 核准時效統計, 時間以小時表示 (小數兩位)
+type TurnaroundStatDTO struct {
+	LeaveType string `json:"leave_type,omitempty"`
+	Decisions int64  `json:"decisions"`
+	AvgHours  string `json:"avg_hours"`
+	MinHours  string `json:"min_hours"`
+	MaxHours  string `json:"max_hours"`
+}
+
+// ApprovalTurnaroundDTO 核准時效報表
+type ApprovalTurnaroundDTO struct {
+	Overall     TurnaroundStatDTO   `json:"overall"`
+	ByLeaveType []TurnaroundStatDTO `json:"by_leave_type"`
+}
+
+// GetApprovalTurnaround 處理 HR 查詢假單核准時效 (從提交到審核人核准) 的 HTTP 請求
+// 拒絕與排程自動核准的假單不計入
+// 查詢參數同缺勤統計 (不含 group_by)，但 start_date / end_date 依提交時間篩選
+func (h *ApprovalTurnaroundHandler) GetApprovalTurnaround(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view leave analytics"})
+		return
+	}
+
+	// 2. 解析查詢參數
+	filter, err := parseAnalyticsFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+		return
+	}
+
+	// 3. 調用 Service 層
+	report, err := h.analyticsSvc.GetApprovalTurnaround(c.Request.Context(), filter)
+	if err != nil {
+		writeAnalyticsError(c, err)
+		return
+	}
+
+	response := ApprovalTurnaroundDTO{
+		Overall:     toTurnaroundStatDTO(report.Overall),
+		ByLeaveType: make([]TurnaroundStatDTO, 0, len(report.ByLeaveType)),
+	}
+	for _, stat := range report.ByLeaveType {
+		response.ByLeaveType = append(response.ByLeaveType, toTurnaroundStatDTO(stat))
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: response})
+}
+
+// toTurnaroundStatDTO 將秒數換算為小時
+func toTurnaroundStatDTO(stat models.LeaveTurnaroundStat) TurnaroundStatDTO {
+	return TurnaroundStatDTO{
+		LeaveType: stat.LeaveType,
+		Decisions: stat.Decisions,
+		AvgHours:  decimal.NewFromFloat(stat.AvgSeconds / 3600).Round(2).String(),
+		MinHours:  decimal.New(stat.MinSeconds, 0).Div(decimal.NewFromInt(3600)).Round(2).String(),
+		MaxHours:  decimal.New(stat.MaxSeconds, 0).Div(decimal.NewFromInt(3600)).Round(2).String(),
+	}
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovalTurnaroundHandler_GetApprovalTurnaround(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		query              string
+		setupMocks         func(mockSvc *mocks.MockLeaveAnalyticsService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedData       *ApprovalTurnaroundDTO
+	}{
+		{
+			name:         "Success - Seconds Converted To Hours",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetApprovalTurnaround(gomock.Any(), gomock.Any()).Return(&models.LeaveTurnaroundReport{
+					Overall: models.LeaveTurnaroundStat{Decisions: 4, AvgSeconds: 6300, MinSeconds: 600, MaxSeconds: 18000},
+					ByLeaveType: []models.LeaveTurnaroundStat{
+						{LeaveType: models.LeaveTypeAnnual, Decisions: 3, AvgSeconds: 7200, MinSeconds: 600, MaxSeconds: 18000},
+						{LeaveType: models.LeaveTypeSick, Decisions: 1, AvgSeconds: 3600, MinSeconds: 3600, MaxSeconds: 3600},
+					},
+				}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedData: &ApprovalTurnaroundDTO{
+				Overall: TurnaroundStatDTO{Decisions: 4, AvgHours: "1.75", MinHours: "0.17", MaxHours: "5"},
+				ByLeaveType: []TurnaroundStatDTO{
+					{LeaveType: models.LeaveTypeAnnual, Decisions: 3, AvgHours: "2", MinHours: "0.17", MaxHours: "5"},
+					{LeaveType: models.LeaveTypeSick, Decisions: 1, AvgHours: "1", MinHours: "1", MaxHours: "1"},
+				},
+			},
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view leave analytics",
+		},
+		{
+			name:               "Bad Request - Invalid End Date",
+			callerClaims:       hrClaims,
+			query:              "?end_date=tomorrow",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: end_date must be YYYY-MM-DD",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetApprovalTurnaround(gomock.Any(), gomock.Any()).Return(nil, services.ErrAnalyticsFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to calculate leave analytics",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveAnalyticsService(ctrl)
+			handler := NewApprovalTurnaroundHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/analytics/approval-turnaround"+tc.query, nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.GetApprovalTurnaround(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				common.Response
+				Data *ApprovalTurnaroundDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			assert.Equal(t, tc.expectedData, resp.Data)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+)
+
+// BradfordFactorHandler 包含依賴
+type BradfordFactorHandler struct {
+	analyticsSvc interfaces.LeaveAnalyticsService
+}
+
+// NewBradfordFactorHandler 構造函數
+func NewBradfordFactorHandler(analyticsSvc interfaces.LeaveAnalyticsService) *BradfordFactorHandler {
+	return &BradfordFactorHandler{analyticsSvc: analyticsSvc}
+}
+
+// BradfordFactorDTO 單一員工的 Bradford factor
+type BradfordFactorDTO struct {
+	AccountID  string `json:"account_id"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	Department string `json:"department,omitempty"`
+	Spells     int64  `json:"spells"`
+	Days       string `json:"days"`
+	Score      string `json:"score"`
+}
+
+// GetBradfordFactors 處理 HR 查詢員工 Bradford factor (S² × D) 排名的 HTTP 請求
+// 查詢參數同缺勤統計 (不含 group_by)，未指定 leave_type 時只計入病假; limit 為返回筆數 (預設 20, 最多 200)
+func (h *BradfordFactorHandler) GetBradfordFactors(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view leave analytics"})
+		return
+	}
+
+	// 2. 解析查詢參數
+	filter, err := parseAnalyticsFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+		return
+	}
+	limit := 0 // 由 Service 層套用預設值
+	if limitStr := c.Query("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit < 1 {
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: limit must be a positive integer"})
+			return
+		}
+	}
+
+	// 3. 調用 Service 層
+	scores, err := h.analyticsSvc.GetBradfordFactors(c.Request.Context(), filter, limit)
+	if err != nil {
+		writeAnalyticsError(c, err)
+		return
+	}
+
+	response := make([]BradfordFactorDTO, 0, len(scores))
+	for _, s := range scores {
+		response = append(response, BradfordFactorDTO{
+			AccountID:  s.AccountID.String(),
+			Name:       s.FirstName + " " + s.LastName,
+			Email:      s.Email,
+			Department: s.Department,
+			Spells:     s.Spells,
+			Days:       s.Days.String(),
+			Score:      s.Score.String(),
+		})
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: response})
+}
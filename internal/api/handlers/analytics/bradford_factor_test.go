@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBradfordFactorHandler_GetBradfordFactors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleSuperAdmin}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	accountID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		query              string
+		setupMocks         func(mockSvc *mocks.MockLeaveAnalyticsService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedData       []BradfordFactorDTO
+	}{
+		{
+			name:         "Success",
+			callerClaims: hrClaims,
+			query:        "?limit=5&department=Engineering",
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetBradfordFactors(gomock.Any(), gomock.Any(), 5).
+					DoAndReturn(func(_ interface{}, filter models.LeaveAnalyticsFilter, _ int) ([]models.BradfordFactorScore, error) {
+						assert.Equal(t, "Engineering", filter.Department)
+						return []models.BradfordFactorScore{{
+							AccountID: accountID, FirstName: "Amy", LastName: "Lin", Email: "amy@example.com", Department: "Engineering",
+							Spells: 4, Days: decimal.NewFromInt(5), Score: decimal.NewFromInt(80),
+						}}, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedData: []BradfordFactorDTO{{
+				AccountID: accountID.String(), Name: "Amy Lin", Email: "amy@example.com", Department: "Engineering", Spells: 4, Days: "5", Score: "80",
+			}},
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view leave analytics",
+		},
+		{
+			name:               "Bad Request - Invalid Limit",
+			callerClaims:       hrClaims,
+			query:              "?limit=0",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: limit must be a positive integer",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveAnalyticsService) {
+				mockSvc.EXPECT().GetBradfordFactors(gomock.Any(), gomock.Any(), 0).Return(nil, services.ErrAnalyticsFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to calculate leave analytics",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveAnalyticsService(ctrl)
+			handler := NewBradfordFactorHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/analytics/bradford-factor"+tc.query, nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.GetBradfordFactors(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				common.Response
+				Data []BradfordFactorDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedData != nil {
+				assert.Equal(t, tc.expectedData, resp.Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// LeaveFeedTokenHandler 包含依賴, 簽發與撤銷 iCalendar 訂閱網址
+type LeaveFeedTokenHandler struct {
+	calendarSvc interfaces.LeaveCalendarService
+}
+
+// NewLeaveFeedTokenHandler 構造函數
+func NewLeaveFeedTokenHandler(calendarSvc interfaces.LeaveCalendarService) *LeaveFeedTokenHandler {
+	return &LeaveFeedTokenHandler{calendarSvc: calendarSvc}
+}
+
+// IssueFeedTokenRequest 定義簽發訂閱網址的請求體
+type IssueFeedTokenRequest struct {
+	Feed      string `json:"feed" binding:"required,oneof=account team"`
+	SubjectID string `json:"subject_id" binding:"required,uuid"` // account: 帳戶 ID, team: 主管 ID
+}
+
+// FeedTokenDTO 定義返回給客戶端的訂閱網址
+type FeedTokenDTO struct {
+	URL string `json:"url"`
+}
+
+// feedPaths 訂閱種類對應的 .ics 路徑格式 (相對於 API 版本前綴)
+var feedPaths = map[string]string{
+	models.CalendarFeedAccount: "/calendar/accounts/%s/leave.ics",
+	models.CalendarFeedTeam:    "/calendar/teams/%s/leave.ics",
+}
+
+// IssueFeedToken 處理簽發訂閱網址的 HTTP 請求 (POST /leave-calendar/feeds)
+// 網址中的 token 僅可讀取該訂閱, 不能作為登入憑證
+func (h *LeaveFeedTokenHandler) IssueFeedToken(c *gin.Context) {
+	claims, ok := feedClaims(c)
+	if !ok {
+		return
+	}
+
+	var req IssueFeedTokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	// binding 已驗證格式
+	subjectID := uuid.MustParse(req.SubjectID)
+
+	token, err := h.calendarSvc.IssueFeedToken(c.Request.Context(), claims.UserID, req.Feed, subjectID.String())
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrCalendarFeedInvalid):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrCalendarAccessDenied):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only subscribe to your own, your direct reports' or your own team's leave calendar"})
+		case errors.Is(err, services.ErrAccountNotFound):
+			c.JSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Account not found"})
+		default:
+			log.Printf("Error issuing %s calendar feed token for account %s via service: %v", req.Feed, claims.UserID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to issue calendar feed URL"})
+		}
+		return
+	}
+
+	// 以本路由的版本前綴組出訂閱路徑
+	basePath := strings.TrimSuffix(c.FullPath(), "/leave-calendar/feeds")
+	feedURL := basePath + fmt.Sprintf(feedPaths[req.Feed], subjectID) + "?token=" + url.QueryEscape(token)
+
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Calendar feed URL issued successfully",
+		Data:    FeedTokenDTO{URL: feedURL},
+	})
+}
+
+// RevokeFeedTokens 處理撤銷訂閱網址的 HTTP 請求 (DELETE /leave-calendar/feeds)
+// 呼叫者先前簽發的所有訂閱網址立即失效 (例如網址外流時), 需要時再重新簽發
+func (h *LeaveFeedTokenHandler) RevokeFeedTokens(c *gin.Context) {
+	claims, ok := feedClaims(c)
+	if !ok {
+		return
+	}
+
+	if err := h.calendarSvc.RevokeFeedTokens(c.Request.Context(), claims.UserID); err != nil {
+		if errors.Is(err, services.ErrAccountNotFound) {
+			c.JSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Account not found"})
+			return
+		}
+		log.Printf("Error revoking calendar feed tokens for account %s via service: %v", claims.UserID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to revoke calendar feed URLs"})
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Calendar feed URLs revoked successfully"})
+}
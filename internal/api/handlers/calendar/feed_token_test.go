@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaveFeedTokenHandler_IssueFeedToken(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	claims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	subjectID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		body               string
+		setupMocks         func(mockSvc *mocks.MockLeaveCalendarService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedURL        string // 僅成功時檢查
+	}{
+		{
+			name: "Success - Account Feed URL",
+			body: `{"feed":"account","subject_id":"` + subjectID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().IssueFeedToken(gomock.Any(), claims.UserID, models.CalendarFeedAccount, subjectID.String()).Return("payload.sig", nil).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Calendar feed URL issued successfully",
+			expectedURL:        "/api/v1/calendar/accounts/" + subjectID.String() + "/leave.ics?token=payload.sig",
+		},
+		{
+			name: "Success - Team Feed URL",
+			body: `{"feed":"team","subject_id":"` + subjectID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().IssueFeedToken(gomock.Any(), claims.UserID, models.CalendarFeedTeam, subjectID.String()).Return("payload.sig", nil).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Calendar feed URL issued successfully",
+			expectedURL:        "/api/v1/calendar/teams/" + subjectID.String() + "/leave.ics?token=payload.sig",
+		},
+		{
+			name:               "Bad Request - Unknown Feed",
+			body:               `{"feed":"company","subject_id":"` + subjectID.String() + `"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Forbidden - Not Own Or Report",
+			body: `{"feed":"account","subject_id":"` + subjectID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().IssueFeedToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", services.ErrCalendarAccessDenied).Times(1)
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: You can only subscribe to your own, your direct reports' or your own team's leave calendar",
+		},
+		{
+			name: "Internal Server Error - Service Error",
+			body: `{"feed":"account","subject_id":"` + subjectID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().IssueFeedToken(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("", errors.New("db down")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to issue calendar feed URL",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveCalendarService(ctrl)
+			handler := NewLeaveFeedTokenHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			// 經由路由執行, 以取得 FullPath 的版本前綴
+			engine := gin.New()
+			engine.POST("/api/v1/leave-calendar/feeds", func(c *gin.Context) {
+				c.Set("claims", claims)
+				handler.IssueFeedToken(c)
+			})
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/api/v1/leave-calendar/feeds", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			engine.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var actualResponse struct {
+				common.Response
+				Data FeedTokenDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, actualResponse.Message)
+			}
+			if tc.expectedURL != "" {
+				assert.Equal(t, tc.expectedURL, actualResponse.Data.URL)
+			}
+		})
+	}
+}
+
+func TestLeaveFeedTokenHandler_RevokeFeedTokens(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	claims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+
+	testCases := []struct {
+		name               string
+		setupMocks         func(mockSvc *mocks.MockLeaveCalendarService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name: "Success - Feed URLs Revoked",
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().RevokeFeedTokens(gomock.Any(), claims.UserID).Return(nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Calendar feed URLs revoked successfully",
+		},
+		{
+			name: "Unauthorized - Account Not Found",
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().RevokeFeedTokens(gomock.Any(), claims.UserID).Return(services.ErrAccountNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Account not found",
+		},
+		{
+			name: "Internal Server Error - Service Error",
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().RevokeFeedTokens(gomock.Any(), claims.UserID).Return(errors.New("db down")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to revoke calendar feed URLs",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveCalendarService(ctrl)
+			handler := NewLeaveFeedTokenHandler(mockSvc)
+			tc.setupMocks(mockSvc)
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodDelete, "/leave-calendar/feeds", nil)
+			c.Set("claims", claims)
+
+			handler.RevokeFeedTokens(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var actualResponse common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+			assert.Equal(t, tc.expectedMessage, actualResponse.Message)
+		})
+	}
+}
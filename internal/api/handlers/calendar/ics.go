@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// icsUIDDomain 組成事件 UID 的網域部分; UID 由假單 ID 產生, 同一張假單在每次匯出時都相同,
+// 行事曆軟體才能在假單修改或取消時更新原本的事件, 而不是新增一筆
+const icsUIDDomain = "hr-system"
+
+const (
+	icsDateFormat     = "20060102"
+	icsDateTimeFormat = "20060102T150405"
+	icsMaxLineOctets  = 75
+)
+
+// leaveEventSequence 以假單的樂觀鎖版本號作為 SEQUENCE (RFC 5545 自 0 起算, 版本號自 1 起算)
+// 版本號僅在假單內容或狀態變更時遞增, 提醒與升級的更新不會讓行事曆軟體誤判為新版本
+func leaveEventSequence(request models.LeaveRequest) int {
+	if request.Version < 1 {
+		return 0
+	}
+	return request.Version - 1
+}
+
+// leaveEventUID 返回假單對應的 iCalendar 事件 UID
+func leaveEventUID(request models.LeaveRequest) string {
+	return request.ID.String() + "@" + icsUIDDomain
+}
+
+// renderLeaveICS 將假單轉換為 iCalendar (RFC 5545) 文件
+func renderLeaveICS(calendarName string, requests []models.LeaveRequest, now time.Time) []byte {
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//hr-system//Leave Calendar//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "X-WR-CALNAME:"+escapeICSText(calendarName))
+
+	dtStamp := now.UTC().Format(icsDateTimeFormat) + "Z"
+	for _, request := range requests {
+		modified := request.UpdatedAt
+		if modified.IsZero() {
+			modified = request.RequestedAt
+		}
+
+		writeICSLine(&b, "BEGIN:VEVENT")
+		writeICSLine(&b, "UID:"+leaveEventUID(request))
+		writeICSLine(&b, "DTSTAMP:"+dtStamp)
+		if !modified.IsZero() {
+			writeICSLine(&b, "LAST-MODIFIED:"+modified.UTC().Format(icsDateTimeFormat)+"Z")
+		}
+		writeICSLine(&b, fmt.Sprintf("SEQUENCE:%d", leaveEventSequence(request)))
+		if request.DurationUnit == models.LeaveDurationHours && request.StartTime != "" && request.EndTime != "" {
+			// 小時假以當地時間 (floating time) 表示起訖時間
+			day := request.StartDate.Format(icsDateFormat)
+			writeICSLine(&b, "DTSTART:"+day+"T"+strings.ReplaceAll(request.StartTime, ":", "")+"00")
+			writeICSLine(&b, "DTEND:"+day+"T"+strings.ReplaceAll(request.EndTime, ":", "")+"00")
+		} else {
+			// 整天事件的 DTEND 不包含在事件內, 因此為結束日的隔天
+			writeICSLine(&b, "DTSTART;VALUE=DATE:"+request.StartDate.Format(icsDateFormat))
+			writeICSLine(&b, "DTEND;VALUE=DATE:"+request.EndDate.AddDate(0, 0, 1).Format(icsDateFormat))
+		}
+		writeICSLine(&b, "SUMMARY:"+escapeICSText(leaveEventSummary(request)))
+		writeICSLine(&b, "STATUS:"+leaveEventStatus(request.Status))
+		writeICSLine(&b, "TRANSP:TRANSPARENT")
+		writeICSLine(&b, "END:VEVENT")
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// leaveEventSummary 事件標題: 申請人姓名與假別, 半天假另外標示上午 / 下午
+func leaveEventSummary(request models.LeaveRequest) string {
+	name := strings.TrimSpace(request.Account.FirstName + " " + request.Account.LastName)
+	if name == "" {
+		name = request.Account.Email
+	}
+	summary := fmt.Sprintf("%s - %s leave", name, request.LeaveType)
+	switch request.DurationUnit {
+	case models.LeaveDurationHalfDayAM:
+		summary += " (AM)"
+	case models.LeaveDurationHalfDayPM:
+		summary += " (PM)"
+	}
+	if request.Status == models.LeaveStatusPending {
+		summary += " [pending]"
+	}
+	return summary
+}
+
+// leaveEventStatus 將假單狀態對應到 iCalendar 的事件狀態
+func leaveEventStatus(status string) string {
+	switch status {
+	case models.LeaveStatusApproved, models.LeaveStatusCancellationRequested:
+		return "CONFIRMED"
+	case models.LeaveStatusPending:
+		return "TENTATIVE"
+	default:
+		return "CANCELLED"
+	}
+}
+
+// escapeICSText 依 RFC 5545 跳脫文字欄位中的特殊字元
+func escapeICSText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeICSLine 寫入一行內容, 超過 75 個位元組時折行 (續行以空白開頭), 行尾為 CRLF
+func writeICSLine(b *strings.Builder, line string) {
+	octets := 0
+	for _, r := range line {
+		size := len(string(r))
+		if octets+size > icsMaxLineOctets {
+			b.WriteString("\r\n ")
+			octets = 1
+		}
+		b.WriteRune(r)
+		octets += size
+	}
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// icsContentType iCalendar 回應的 Content-Type
+const icsContentType = "text/calendar; charset=utf-8"
+
+// LeaveFeedHandler 包含依賴, 提供可由行事曆軟體訂閱的 .ics 假單
+type LeaveFeedHandler struct {
+	calendarSvc interfaces.LeaveCalendarService
+}
+
+// NewLeaveFeedHandler 構造函數
+func NewLeaveFeedHandler(calendarSvc interfaces.LeaveCalendarService) *LeaveFeedHandler {
+	return &LeaveFeedHandler{calendarSvc: calendarSvc}
+}
+
+// AccountFeed 處理個人假單訂閱 (GET /calendar/accounts/:account_id/leave.ics)
+func (h *LeaveFeedHandler) AccountFeed(c *gin.Context) {
+	claims, ok := feedClaims(c)
+	if !ok {
+		return
+	}
+	accountID, err := uuid.Parse(c.Param("account_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid account ID in URL path"})
+		return
+	}
+	requests, err := h.calendarSvc.ListAccountFeed(c.Request.Context(), claims.UserID, accountID.String())
+	if err != nil {
+		respondFeedError(c, err, "Permission denied: You can only subscribe to your own or your direct reports' leave calendar")
+		return
+	}
+	c.Data(http.StatusOK, icsContentType, renderLeaveICS("Leave - "+accountID.String(), requests, time.Now()))
+}
+
+// TeamFeed 處理團隊假單訂閱 (GET /calendar/teams/:manager_id/leave.ics)
+func (h *LeaveFeedHandler) TeamFeed(c *gin.Context) {
+	claims, ok := feedClaims(c)
+	if !ok {
+		return
+	}
+	managerID, err := uuid.Parse(c.Param("manager_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid account ID in URL path"})
+		return
+	}
+	requests, err := h.calendarSvc.ListTeamFeed(c.Request.Context(), claims.UserID, managerID.String())
+	if err != nil {
+		respondFeedError(c, err, "Permission denied: You can only subscribe to the calendar of your own team")
+		return
+	}
+	c.Data(http.StatusOK, icsContentType, renderLeaveICS("Team Leave - "+managerID.String(), requests, time.Now()))
+}
+
+// feedClaims 取得登入者 Claims, 失敗時已寫入錯誤回應
+func feedClaims(c *gin.Context) (*models.Claims, bool) {
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return nil, false
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return nil, false
+	}
+	return claims, true
+}
+
+// respondFeedError 將 Service 錯誤對應到 HTTP 回應
+func respondFeedError(c *gin.Context, err error, forbiddenMessage string) {
+	switch {
+	case errors.Is(err, services.ErrCalendarAccessDenied):
+		c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: forbiddenMessage})
+	case errors.Is(err, services.ErrAccountNotFound):
+		c.JSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Account not found"})
+	default:
+		log.Printf("Error fetching leave calendar feed: %v", err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve leave calendar feed"})
+	}
+}
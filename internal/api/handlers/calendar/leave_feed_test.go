@@ -0,0 +1,206 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaveFeedHandler_AccountFeed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	claims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	accountID := uuid.New()
+	approvedID := uuid.New()
+	cancelledID := uuid.New()
+	updatedAt := time.Date(2025, 5, 2, 8, 30, 0, 0, time.UTC)
+	feedRequests := []models.LeaveRequest{
+		{
+			ID: approvedID, AccountID: accountID, LeaveType: models.LeaveTypeAnnual, Status: models.LeaveStatusApproved,
+			DurationUnit: models.LeaveDurationFullDay, UpdatedAt: updatedAt, Version: 3,
+			StartDate: time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 5, 7, 0, 0, 0, 0, time.UTC),
+			Account: models.Account{FirstName: "Amy", LastName: "Lin, Jr."},
+		},
+		{
+			ID: cancelledID, AccountID: accountID, LeaveType: models.LeaveTypeSick, Status: models.LeaveStatusCancelled,
+			DurationUnit: models.LeaveDurationHours, StartTime: "09:00", EndTime: "12:00", UpdatedAt: updatedAt, Version: 1,
+			StartDate: time.Date(2025, 5, 9, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 5, 9, 0, 0, 0, 0, time.UTC),
+			Account: models.Account{FirstName: "Amy", LastName: "Lin"},
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		accountIDParam     string
+		setupMocks         func(mockSvc *mocks.MockLeaveCalendarService)
+		expectedStatusCode int
+		expectedMessage    string // 僅錯誤時檢查
+		expectedContains   []string
+	}{
+		{
+			name:           "Success - Renders ICS",
+			accountIDParam: accountID.String(),
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().ListAccountFeed(gomock.Any(), claims.UserID, accountID.String()).Return(feedRequests, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedContains: []string{
+				"BEGIN:VCALENDAR\r\n",
+				"UID:" + approvedID.String() + "@hr-system\r\n",
+				"DTSTART;VALUE=DATE:20250505\r\n",
+				"DTEND;VALUE=DATE:20250508\r\n", // 整天事件的 DTEND 為結束日隔天
+				"SUMMARY:Amy Lin\\, Jr. - annual leave\r\n",
+				"STATUS:CONFIRMED\r\n",
+				"LAST-MODIFIED:20250502T083000Z\r\n",
+				"SEQUENCE:2\r\n", // 版本 3 為第三版, SEQUENCE 自 0 起算
+				"UID:" + cancelledID.String() + "@hr-system\r\n",
+				"DTSTART:20250509T090000\r\n",
+				"DTEND:20250509T120000\r\n",
+				"SEQUENCE:0\r\n",
+				"STATUS:CANCELLED\r\n",
+				"END:VCALENDAR\r\n",
+			},
+		},
+		{
+			name:               "Bad Request - Invalid Account ID",
+			accountIDParam:     "not-a-uuid",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid account ID in URL path",
+		},
+		{
+			name:           "Forbidden - Not Own Or Report",
+			accountIDParam: accountID.String(),
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().ListAccountFeed(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrCalendarAccessDenied).Times(1)
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: You can only subscribe to your own or your direct reports' leave calendar",
+		},
+		{
+			name:           "Internal Server Error - Service Error",
+			accountIDParam: accountID.String(),
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().ListAccountFeed(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve leave calendar feed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveCalendarService(ctrl)
+			handler := NewLeaveFeedHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/calendar/accounts/"+tc.accountIDParam+"/leave.ics", nil)
+			c.Params = gin.Params{{Key: "account_id", Value: tc.accountIDParam}}
+			c.Set("claims", claims)
+
+			handler.AccountFeed(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			if tc.expectedStatusCode == http.StatusOK {
+				assert.Equal(t, icsContentType, recorder.Header().Get("Content-Type"))
+				body := recorder.Body.String()
+				for _, fragment := range tc.expectedContains {
+					assert.Contains(t, body, fragment)
+				}
+				for _, line := range strings.Split(body, "\r\n") {
+					assert.LessOrEqual(t, len(line), icsMaxLineOctets, "ICS lines must be folded at 75 octets")
+				}
+				return
+			}
+			var actualResponse common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+			assert.Equal(t, tc.expectedMessage, actualResponse.Message)
+		})
+	}
+}
+
+func TestLeaveFeedHandler_TeamFeed(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	claims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	managerID := uuid.New()
+
+	t.Run("Success - Stable UID Across Renders", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := mocks.NewMockLeaveCalendarService(ctrl)
+		request := models.LeaveRequest{
+			ID: uuid.New(), Status: models.LeaveStatusPending, LeaveType: models.LeaveTypePersonal,
+			StartDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		}
+		mockSvc.EXPECT().ListTeamFeed(gomock.Any(), claims.UserID, managerID.String()).Return([]models.LeaveRequest{request}, nil).Times(2)
+
+		var bodies []string
+		for i := 0; i < 2; i++ {
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/calendar/teams/"+managerID.String()+"/leave.ics", nil)
+			c.Params = gin.Params{{Key: "manager_id", Value: managerID.String()}}
+			c.Set("claims", claims)
+
+			NewLeaveFeedHandler(mockSvc).TeamFeed(c)
+
+			require.Equal(t, http.StatusOK, recorder.Code)
+			bodies = append(bodies, recorder.Body.String())
+		}
+		uid := "UID:" + request.ID.String() + "@hr-system\r\n"
+		assert.Contains(t, bodies[0], uid)
+		assert.Contains(t, bodies[1], uid)
+		assert.Contains(t, bodies[0], "STATUS:TENTATIVE\r\n")
+	})
+
+	t.Run("Forbidden - Not Team Member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockSvc := mocks.NewMockLeaveCalendarService(ctrl)
+		mockSvc.EXPECT().ListTeamFeed(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrCalendarAccessDenied).Times(1)
+
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/calendar/teams/"+managerID.String()+"/leave.ics", nil)
+		c.Params = gin.Params{{Key: "manager_id", Value: managerID.String()}}
+		c.Set("claims", claims)
+
+		NewLeaveFeedHandler(mockSvc).TeamFeed(c)
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("Unauthorized - Missing Claims", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		recorder := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(recorder)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/calendar/teams/"+managerID.String()+"/leave.ics", nil)
+
+		NewLeaveFeedHandler(mocks.NewMockLeaveCalendarService(ctrl)).TeamFeed(c)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// TeamLeaveCalendarHandler 包含依賴
+type TeamLeaveCalendarHandler struct {
+	calendarSvc interfaces.LeaveCalendarService
+}
+
+// NewTeamLeaveCalendarHandler 構造函數
+func NewTeamLeaveCalendarHandler(calendarSvc interfaces.LeaveCalendarService) *TeamLeaveCalendarHandler {
+	return &TeamLeaveCalendarHandler{calendarSvc: calendarSvc}
+}
+
+// CalendarEntryDTO 行事曆中某一天的一筆請假
+type CalendarEntryDTO struct {
+	LeaveRequestID string `json:"leave_request_id"`
+	AccountID      string `json:"account_id"`
+	Name           string `json:"name"`
+	Email          string `json:"email"`
+	LeaveType      string `json:"leave_type"`
+	Status         string `json:"status"`
+	DurationUnit   string `json:"duration_unit"`
+	StartTime      string `json:"start_time,omitempty"`
+	EndTime        string `json:"end_time,omitempty"`
+	StartDate      string `json:"start_date"`
+	EndDate        string `json:"end_date"`
+}
+
+// CalendarDayDTO 行事曆中的一天
+type CalendarDayDTO struct {
+	Date    string             `json:"date"`
+	Entries []CalendarEntryDTO `json:"entries"`
+}
+
+// GetTeamCalendar 處理查看團隊請假行事曆的 HTTP 請求
+// 查詢參數: start_date / end_date (YYYY-MM-DD, 預設為今天起 7 天), include_pending (true / false),
+// manager_id (指定團隊的主管帳戶 ID, 省略時 HR 查看全公司, 其他人查看自己所屬的團隊)
+func (h *TeamLeaveCalendarHandler) GetTeamCalendar(c *gin.Context) {
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	query, err := parseCalendarQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+		return
+	}
+
+	days, err := h.calendarSvc.GetTeamCalendar(c.Request.Context(), claims.UserID, query)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrCalendarAccessDenied):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only view the calendar of your own team"})
+		case errors.Is(err, services.ErrInvalidDateRange):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: end_date cannot be before start_date"})
+		case errors.Is(err, services.ErrCalendarRangeTooLong):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+		case errors.Is(err, services.ErrAccountNotFound):
+			c.JSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Account not found"})
+		default:
+			log.Printf("Error fetching team leave calendar for %s: %v", claims.UserID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve leave calendar"})
+		}
+		return
+	}
+
+	response := make([]CalendarDayDTO, 0, len(days))
+	for _, day := range days {
+		entries := make([]CalendarEntryDTO, 0, len(day.Requests))
+		for _, r := range day.Requests {
+			entries = append(entries, toCalendarEntryDTO(r))
+		}
+		response = append(response, CalendarDayDTO{Date: day.Date.Format("2006-01-02"), Entries: entries})
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: response})
+}
+
+// parseCalendarQuery 將查詢參數轉換為 LeaveCalendarQuery
+func parseCalendarQuery(c *gin.Context) (models.LeaveCalendarQuery, error) {
+	today := time.Now()
+	query := models.LeaveCalendarQuery{
+		From: time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC),
+	}
+	if dateStr := c.Query("start_date"); dateStr != "" {
+		from, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return query, errors.New("start_date must be YYYY-MM-DD")
+		}
+		query.From = from
+	}
+	query.To = query.From.AddDate(0, 0, 6)
+	if dateStr := c.Query("end_date"); dateStr != "" {
+		to, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return query, errors.New("end_date must be YYYY-MM-DD")
+		}
+		query.To = to
+	}
+	if pendingStr := c.Query("include_pending"); pendingStr != "" {
+		includePending, err := strconv.ParseBool(pendingStr)
+		if err != nil {
+			return query, errors.New("include_pending must be true or false")
+		}
+		query.IncludePending = includePending
+	}
+	if managerStr := c.Query("manager_id"); managerStr != "" {
+		managerID, err := uuid.Parse(managerStr)
+		if err != nil {
+			return query, errors.New("manager_id must be a valid account ID")
+		}
+		query.ManagerID = &managerID
+	}
+	return query, nil
+}
+
+// toCalendarEntryDTO 將假單轉換為行事曆項目
+func toCalendarEntryDTO(r models.LeaveRequest) CalendarEntryDTO {
+	return CalendarEntryDTO{
+		LeaveRequestID: r.ID.String(),
+		AccountID:      r.AccountID.String(),
+		Name:           r.Account.FirstName + " " + r.Account.LastName,
+		Email:          r.Account.Email,
+		LeaveType:      r.LeaveType,
+		Status:         r.Status,
+		DurationUnit:   r.DurationUnit,
+		StartTime:      r.StartTime,
+		EndTime:        r.EndTime,
+		StartDate:      r.StartDate.Format("2006-01-02"),
+		EndDate:        r.EndDate.Format("2006-01-02"),
+	}
+}
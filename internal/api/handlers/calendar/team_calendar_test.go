@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamLeaveCalendarHandler_GetTeamCalendar(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	managerID := uuid.New()
+	day := time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC)
+	calendarDays := []models.LeaveCalendarDay{{
+		Date: day,
+		Requests: []models.LeaveRequest{{
+			ID: uuid.New(), LeaveType: models.LeaveTypeAnnual, Status: models.LeaveStatusApproved, DurationUnit: models.LeaveDurationFullDay,
+			StartDate: day, EndDate: day, Account: models.Account{FirstName: "Amy", LastName: "Lin", Email: "amy@co.co"},
+		}},
+	}}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		query              string
+		setupMocks         func(mockSvc *mocks.MockLeaveCalendarService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedDays       int // -1 表示不檢查 Data
+	}{
+		{
+			name:         "Success - Parses Query",
+			callerClaims: employeeClaims,
+			query:        "?start_date=2025-05-05&end_date=2025-05-09&include_pending=true&manager_id=" + managerID.String(),
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().GetTeamCalendar(gomock.Any(), employeeClaims.UserID, gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ string, query models.LeaveCalendarQuery) ([]models.LeaveCalendarDay, error) {
+						assert.Equal(t, "2025-05-05", query.From.Format("2006-01-02"))
+						assert.Equal(t, "2025-05-09", query.To.Format("2006-01-02"))
+						assert.True(t, query.IncludePending)
+						require.NotNil(t, query.ManagerID)
+						assert.Equal(t, managerID, *query.ManagerID)
+						return calendarDays, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedDays:       1,
+		},
+		{
+			name:         "Success - Defaults To One Week",
+			callerClaims: employeeClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().GetTeamCalendar(gomock.Any(), employeeClaims.UserID, gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ string, query models.LeaveCalendarQuery) ([]models.LeaveCalendarDay, error) {
+						assert.Equal(t, query.From.AddDate(0, 0, 6), query.To)
+						assert.False(t, query.IncludePending)
+						assert.Nil(t, query.ManagerID)
+						return []models.LeaveCalendarDay{}, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedDays:       0,
+		},
+		{
+			name:               "Bad Request - Invalid Manager ID",
+			callerClaims:       employeeClaims,
+			query:              "?manager_id=abc",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: manager_id must be a valid account ID",
+			expectedDays:       -1,
+		},
+		{
+			name:               "Bad Request - Invalid include_pending",
+			callerClaims:       employeeClaims,
+			query:              "?include_pending=maybe",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: include_pending must be true or false",
+			expectedDays:       -1,
+		},
+		{
+			name:         "Bad Request - Range Too Long",
+			callerClaims: employeeClaims,
+			query:        "?start_date=2025-01-01&end_date=2025-12-31",
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().GetTeamCalendar(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrCalendarRangeTooLong).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: " + services.ErrCalendarRangeTooLong.Error(),
+			expectedDays:       -1,
+		},
+		{
+			name:         "Forbidden - Other Team",
+			callerClaims: employeeClaims,
+			query:        "?manager_id=" + managerID.String(),
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().GetTeamCalendar(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrCalendarAccessDenied).Times(1)
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: You can only view the calendar of your own team",
+			expectedDays:       -1,
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: employeeClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveCalendarService) {
+				mockSvc.EXPECT().GetTeamCalendar(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve leave calendar",
+			expectedDays:       -1,
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			callerClaims:       nil,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+			expectedDays:       -1,
+		},
+		{
+			name:               "Internal Server Error - Invalid Claims Type",
+			callerClaims:       "not a claims struct",
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Internal error processing user identity",
+			expectedDays:       -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveCalendarService(ctrl)
+			handler := NewTeamLeaveCalendarHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/leave-calendar"+tc.query, nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.GetTeamCalendar(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var actualResponse common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+			assert.Equal(t, tc.expectedStatusCode, actualResponse.Code)
+			assert.Equal(t, tc.expectedMessage, actualResponse.Message)
+
+			if tc.expectedDays >= 0 {
+				dataBytes, _ := json.Marshal(actualResponse.Data)
+				var days []CalendarDayDTO
+				require.NoError(t, json.Unmarshal(dataBytes, &days))
+				require.Len(t, days, tc.expectedDays)
+				if tc.expectedDays > 0 {
+					assert.Equal(t, "2025-05-06", days[0].Date)
+					require.Len(t, days[0].Entries, 1)
+					assert.Equal(t, "Amy Lin", days[0].Entries[0].Name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CreateDelegationHandler 包含依賴
+type CreateDelegationHandler struct {
+	delegationSvc interfaces.ApprovalDelegationService
+}
+
+// NewCreateDelegationHandler 構造函數
+func NewCreateDelegationHandler(delegationSvc interfaces.ApprovalDelegationService) *CreateDelegationHandler {
+	return &CreateDelegationHandler{delegationSvc: delegationSvc}
+}
+
+// CreateDelegationRequest 定義建立審核代理的請求體
+type CreateDelegationRequest struct {
+	DelegateID string `json:"delegate_id" binding:"required,uuid"`
+	StartDate  string `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate    string `json:"end_date" binding:"required,datetime=2006-01-02"`
+	Scope      string `json:"scope" binding:"omitempty,oneof=all manager hr"` // 預設 all
+}
+
+// DelegationDTO 定義返回給客戶端的審核代理資料
+type DelegationDTO struct {
+	ID          uuid.UUID `json:"id"`
+	DelegatorID uuid.UUID `json:"delegator_id"`
+	DelegateID  uuid.UUID `json:"delegate_id"`
+	StartDate   string    `json:"start_date"` // YYYY-MM-DD
+	EndDate     string    `json:"end_date"`   // YYYY-MM-DD
+	Scope       string    `json:"scope"`
+}
+
+// CreateDelegation 方法處理審核人建立代理的 HTTP 請求 (委託人為目前登入者)
+func (h *CreateDelegationHandler) CreateDelegation(c *gin.Context) {
+	// 1. 獲取登入者資訊
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 綁定並驗證請求體
+	var req CreateDelegationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	// binding 已驗證格式
+	startDate, _ := time.Parse("2006-01-02", req.StartDate)
+	endDate, _ := time.Parse("2006-01-02", req.EndDate)
+	input := models.ApprovalDelegationInput{
+		DelegateID: uuid.MustParse(req.DelegateID),
+		StartDate:  startDate,
+		EndDate:    endDate,
+		Scope:      req.Scope,
+	}
+
+	// 3. 調用 Service 層
+	delegation, err := h.delegationSvc.CreateDelegation(c.Request.Context(), claims.UserID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidDelegation), errors.Is(err, services.ErrInvalidDateRange):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrAccountNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Delegate account not found"})
+		default:
+			log.Printf("Error creating delegation for account %s via service: %v", claims.UserID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to create approval delegation"})
+		}
+		return
+	}
+
+	// 4. 返回成功響應
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Approval delegation created successfully",
+		Data:    toDelegationDTO(*delegation),
+	})
+}
+
+// toDelegationDTO 將代理模型轉換為 DTO
+func toDelegationDTO(d models.ApprovalDelegation) DelegationDTO {
+	return DelegationDTO{
+		ID:          d.ID,
+		DelegatorID: d.DelegatorID,
+		DelegateID:  d.DelegateID,
+		StartDate:   d.StartDate.Format("2006-01-02"),
+		EndDate:     d.EndDate.Format("2006-01-02"),
+		Scope:       d.Scope,
+	}
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDelegationHandler_CreateDelegation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	delegatorID := uuid.New()
+	delegateID := uuid.New()
+	callerClaims := &models.Claims{UserID: delegatorID.String(), Role: models.RoleHR}
+	validBody := `{"delegate_id":"` + delegateID.String() + `","start_date":"2025-08-01","end_date":"2025-08-10","scope":"hr"}`
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		body               string
+		setupMocks         func(mockSvc *mocks.MockApprovalDelegationService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success",
+			callerClaims: callerClaims,
+			body:         validBody,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().CreateDelegation(gomock.Any(), delegatorID.String(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ string, input models.ApprovalDelegationInput) (*models.ApprovalDelegation, error) {
+						assert.Equal(t, delegateID, input.DelegateID)
+						assert.Equal(t, models.DelegationScopeHR, input.Scope)
+						return &models.ApprovalDelegation{ID: uuid.New(), DelegatorID: delegatorID, DelegateID: delegateID,
+							StartDate: input.StartDate, EndDate: input.EndDate, Scope: input.Scope}, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Approval delegation created successfully",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			body:               validBody,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Bad Request - Unknown Scope",
+			callerClaims:       callerClaims,
+			body:               `{"delegate_id":"` + delegateID.String() + `","start_date":"2025-08-01","end_date":"2025-08-10","scope":"payroll"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Bad Request - Invalid Delegation",
+			callerClaims: callerClaims,
+			body:         validBody,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().CreateDelegation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrInvalidDelegation).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInvalidDelegation.Error(),
+		},
+		{
+			name:         "Not Found - Delegate",
+			callerClaims: callerClaims,
+			body:         validBody,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().CreateDelegation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrAccountNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Delegate account not found",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: callerClaims,
+			body:         validBody,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().CreateDelegation(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to create approval delegation",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockApprovalDelegationService(ctrl)
+			handler := NewCreateDelegationHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/delegations", strings.NewReader(tc.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.CreateDelegation(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, resp.Message)
+			}
+		})
+	}
+}
+
+func TestToDelegationDTO(t *testing.T) {
+	d := models.ApprovalDelegation{
+		ID:        uuid.New(),
+		StartDate: time.Date(2025, time.August, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, time.August, 10, 0, 0, 0, 0, time.UTC),
+		Scope:     models.DelegationScopeAll,
+	}
+	dto := toDelegationDTO(d)
+	assert.Equal(t, "2025-08-01", dto.StartDate)
+	assert.Equal(t, "2025-08-10", dto.EndDate)
+	assert.Equal(t, models.DelegationScopeAll, dto.Scope)
+}
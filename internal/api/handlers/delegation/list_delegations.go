@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+)
+
+// ListDelegationsHandler 包含依賴
+type ListDelegationsHandler struct {
+	delegationSvc interfaces.ApprovalDelegationService
+}
+
+// NewListDelegationsHandler 構造函數
+func NewListDelegationsHandler(delegationSvc interfaces.ApprovalDelegationService) *ListDelegationsHandler {
+	return &ListDelegationsHandler{delegationSvc: delegationSvc}
+}
+
+// DelegationListDTO 將登入者委託出去與被委託的代理分開返回
+type DelegationListDTO struct {
+	Given    []DelegationDTO `json:"given"`    // 登入者為委託人
+	Received []DelegationDTO `json:"received"` // 登入者為代理人
+}
+
+// ListDelegations 方法處理查詢登入者審核代理的 HTTP 請求
+func (h *ListDelegationsHandler) ListDelegations(c *gin.Context) {
+	// 1. 獲取登入者資訊
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 調用 Service 層
+	delegations, err := h.delegationSvc.ListDelegations(c.Request.Context(), claims.UserID)
+	if err != nil {
+		log.Printf("Error listing delegations for account %s via service: %v", claims.UserID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve approval delegations"})
+		return
+	}
+
+	// 3. 依登入者角色分組
+	result := DelegationListDTO{Given: []DelegationDTO{}, Received: []DelegationDTO{}}
+	for _, d := range delegations {
+		if d.DelegatorID.String() == claims.UserID {
+			result.Given = append(result.Given, toDelegationDTO(d))
+		} else {
+			result.Received = append(result.Received, toDelegationDTO(d))
+		}
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    result,
+	})
+}
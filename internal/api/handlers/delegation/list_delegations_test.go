@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDelegationsHandler_ListDelegations(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountID := uuid.New()
+	otherID := uuid.New()
+	callerClaims := &models.Claims{UserID: accountID.String(), Role: models.RoleEmployee}
+	delegations := []models.ApprovalDelegation{
+		{ID: uuid.New(), DelegatorID: accountID, DelegateID: otherID, Scope: models.DelegationScopeAll},
+		{ID: uuid.New(), DelegatorID: otherID, DelegateID: accountID, Scope: models.DelegationScopeManager},
+		{ID: uuid.New(), DelegatorID: otherID, DelegateID: accountID, Scope: models.DelegationScopeHR},
+	}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		setupMocks         func(mockSvc *mocks.MockApprovalDelegationService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedGiven      int
+		expectedReceived   int
+	}{
+		{
+			name:         "Success - Groups Given And Received",
+			callerClaims: callerClaims,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().ListDelegations(gomock.Any(), accountID.String()).Return(delegations, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedGiven:      1,
+			expectedReceived:   2,
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Internal Server Error - Invalid Claims Type",
+			callerClaims:       "not-claims",
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Internal error processing user identity",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: callerClaims,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().ListDelegations(gomock.Any(), accountID.String()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve approval delegations",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockApprovalDelegationService(ctrl)
+			handler := NewListDelegationsHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/delegations", nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ListDelegations(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int               `json:"code"`
+				Message string            `json:"message"`
+				Data    DelegationListDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatusCode == http.StatusOK {
+				assert.Len(t, resp.Data.Given, tc.expectedGiven)
+				assert.Len(t, resp.Data.Received, tc.expectedReceived)
+			}
+		})
+	}
+}
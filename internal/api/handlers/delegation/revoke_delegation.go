@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RevokeDelegationHandler 包含依賴
+type RevokeDelegationHandler struct {
+	delegationSvc interfaces.ApprovalDelegationService
+}
+
+// NewRevokeDelegationHandler 構造函數
+func NewRevokeDelegationHandler(delegationSvc interfaces.ApprovalDelegationService) *RevokeDelegationHandler {
+	return &RevokeDelegationHandler{delegationSvc: delegationSvc}
+}
+
+// RevokeDelegation 方法處理撤銷審核代理的 HTTP 請求 (委託人本人或 HR / Super Admin)
+func (h *RevokeDelegationHandler) RevokeDelegation(c *gin.Context) {
+	// 1. 獲取登入者資訊
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取代理 ID
+	delegationID := c.Param("id")
+	if _, err := uuid.Parse(delegationID); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid delegation ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	if err := h.delegationSvc.RevokeDelegation(c.Request.Context(), delegationID, claims.UserID); err != nil {
+		switch {
+		case errors.Is(err, services.ErrDelegationNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Approval delegation not found"})
+		case errors.Is(err, services.ErrInvalidProcessor):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only the delegator or HR can revoke this delegation"})
+		default:
+			log.Printf("Error revoking delegation %s via service: %v", delegationID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to revoke approval delegation"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Approval delegation revoked successfully"})
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeDelegationHandler_RevokeDelegation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountID := uuid.New()
+	delegationID := uuid.New().String()
+	callerClaims := &models.Claims{UserID: accountID.String(), Role: models.RoleEmployee}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		setupMocks         func(mockSvc *mocks.MockApprovalDelegationService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success",
+			callerClaims: callerClaims,
+			idParam:      delegationID,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().RevokeDelegation(gomock.Any(), delegationID, accountID.String()).Return(nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Approval delegation revoked successfully",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			idParam:            delegationID,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Bad Request - Invalid ID",
+			callerClaims:       callerClaims,
+			idParam:            "not-a-uuid",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid delegation ID in URL path",
+		},
+		{
+			name:         "Forbidden - Not Delegator",
+			callerClaims: callerClaims,
+			idParam:      delegationID,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().RevokeDelegation(gomock.Any(), delegationID, accountID.String()).Return(services.ErrInvalidProcessor).Times(1)
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only the delegator or HR can revoke this delegation",
+		},
+		{
+			name:         "Not Found",
+			callerClaims: callerClaims,
+			idParam:      delegationID,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().RevokeDelegation(gomock.Any(), delegationID, accountID.String()).Return(services.ErrDelegationNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Approval delegation not found",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: callerClaims,
+			idParam:      delegationID,
+			setupMocks: func(mockSvc *mocks.MockApprovalDelegationService) {
+				mockSvc.EXPECT().RevokeDelegation(gomock.Any(), delegationID, accountID.String()).Return(errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to revoke approval delegation",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockApprovalDelegationService(ctrl)
+			handler := NewRevokeDelegationHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodDelete, "/delegations/"+tc.idParam, nil)
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tc.idParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.RevokeDelegation(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+		})
+	}
+}
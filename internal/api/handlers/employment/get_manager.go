@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetManagerHandler 包含依賴
+type GetManagerHandler struct {
+	employmentSvc interfaces.EmploymentService
+}
+
+// NewGetManagerHandler 構造函數
+func NewGetManagerHandler(employmentSvc interfaces.EmploymentService) *GetManagerHandler {
+	return &GetManagerHandler{employmentSvc: employmentSvc}
+}
+
+// GetManager 方法處理 HR 查詢員工回報關係的 HTTP 請求 (以 ETag 標頭返回僱傭記錄版本號, 設定主管或離職時以 If-Match 帶回)
+func (h *GetManagerHandler) GetManager(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view reporting lines"})
+		return
+	}
+
+	// 2. 解析員工 ID
+	accountID, err := uuid.Parse(c.Param("account_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid account ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	employment, err := h.employmentSvc.GetEmploymentByAccountID(c.Request.Context(), accountID)
+	if err != nil {
+		if errors.Is(err, services.ErrEmploymentNotFound) {
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Employment record not found"})
+			return
+		}
+		log.Printf("Error fetching employment for account %s via service: %v", accountID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve reporting line"})
+		return
+	}
+
+	c.Header("ETag", middleware.ETag(employment.Version))
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    ReportingLineDTO{AccountID: employment.AccountID, ManagerID: employment.ManagerID, Version: employment.Version},
+	})
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetManagerHandler_GetManager(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	accountID := uuid.New()
+	managerID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		setupMocks         func(mockSvc *mocks.MockEmploymentService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedETag       string
+	}{
+		{
+			name:         "Success - Version Returned As ETag",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).
+					Return(&models.Employment{AccountID: accountID, ManagerID: &managerID, Version: 7}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedETag:       `"7"`,
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            accountID.String(),
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view reporting lines",
+		},
+		{
+			name:               "Bad Request - Invalid Account ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid account ID in URL path",
+		},
+		{
+			name:         "Not Found - Employment",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(nil, services.ErrEmploymentNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Employment record not found",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve reporting line",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockEmploymentService(ctrl)
+			handler := NewGetManagerHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/employees/"+tc.idParam+"/manager", nil)
+			c.Params = gin.Params{gin.Param{Key: "account_id", Value: tc.idParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.GetManager(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			assert.Equal(t, tc.expectedETag, recorder.Header().Get("ETag"))
+		})
+	}
+}
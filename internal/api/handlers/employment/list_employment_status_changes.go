@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListEmploymentStatusChangesHandler 包含依賴
+type ListEmploymentStatusChangesHandler struct {
+	statusSvc interfaces.EmploymentStatusService
+}
+
+// NewListEmploymentStatusChangesHandler 構造函數
+func NewListEmploymentStatusChangesHandler(statusSvc interfaces.EmploymentStatusService) *ListEmploymentStatusChangesHandler {
+	return &ListEmploymentStatusChangesHandler{statusSvc: statusSvc}
+}
+
+// EmploymentStatusChangeDTO 定義返回給客戶端的僱傭狀態變更記錄
+type EmploymentStatusChangeDTO struct {
+	ID             uuid.UUID  `json:"id"`
+	FromStatus     string     `json:"from_status"`
+	ToStatus       string     `json:"to_status"`
+	Reason         string     `json:"reason"`
+	LeaveRequestID *uuid.UUID `json:"leave_request_id,omitempty"`
+	ChangedByID    *uuid.UUID `json:"changed_by_id,omitempty"` // 為空表示由系統排程變更
+	ChangedAt      time.Time  `json:"changed_at"`
+}
+
+// ListEmploymentStatusChanges 方法處理 HR 查詢員工僱傭狀態變更記錄的 HTTP 請求
+func (h *ListEmploymentStatusChangesHandler) ListEmploymentStatusChanges(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view employment status changes"})
+		return
+	}
+
+	// 2. 解析員工 ID
+	accountID, err := uuid.Parse(c.Param("account_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid account ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	changes, err := h.statusSvc.ListStatusChanges(c.Request.Context(), accountID)
+	if err != nil {
+		log.Printf("Error listing employment status changes for account %s via service: %v", accountID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve employment status changes"})
+		return
+	}
+
+	// 4. 轉換為 DTO
+	dtos := make([]EmploymentStatusChangeDTO, 0, len(changes))
+	for _, change := range changes {
+		dtos = append(dtos, EmploymentStatusChangeDTO{
+			ID:             change.ID,
+			FromStatus:     change.FromStatus,
+			ToStatus:       change.ToStatus,
+			Reason:         change.Reason,
+			LeaveRequestID: change.LeaveRequestID,
+			ChangedByID:    change.ChangedByID,
+			ChangedAt:      change.ChangedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: dtos})
+}
@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListEmploymentStatusChangesHandler_ListEmploymentStatusChanges(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	accountID := uuid.New()
+	leaveID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		setupMocks         func(mockSvc *mocks.MockEmploymentStatusService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedCount      int
+	}{
+		{
+			name:         "Success - HR views status changes",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			setupMocks: func(mockSvc *mocks.MockEmploymentStatusService) {
+				mockSvc.EXPECT().ListStatusChanges(gomock.Any(), accountID).Return([]models.EmploymentStatusChange{
+					{AccountID: accountID, FromStatus: models.EmploymentStatusActive, ToStatus: models.EmploymentStatusOnLeave, LeaveRequestID: &leaveID},
+					{AccountID: accountID, FromStatus: models.EmploymentStatusOnLeave, ToStatus: models.EmploymentStatusActive, LeaveRequestID: &leaveID},
+				}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedCount:      2,
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			idParam:            accountID.String(),
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing claims",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            accountID.String(),
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view employment status changes",
+		},
+		{
+			name:               "Bad Request - Invalid Account ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid account ID in URL path",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			setupMocks: func(mockSvc *mocks.MockEmploymentStatusService) {
+				mockSvc.EXPECT().ListStatusChanges(gomock.Any(), accountID).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve employment status changes",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockEmploymentStatusService(ctrl)
+			handler := NewListEmploymentStatusChangesHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/employees/"+tc.idParam+"/status-changes", nil)
+			c.Params = gin.Params{gin.Param{Key: "account_id", Value: tc.idParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ListEmploymentStatusChanges(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				common.Response
+				Data []EmploymentStatusChangeDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.Len(t, resp.Data, tc.expectedCount)
+				assert.Equal(t, models.EmploymentStatusOnLeave, resp.Data[0].ToStatus)
+				assert.Equal(t, &leaveID, resp.Data[0].LeaveRequestID)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// SetManagerHandler 包含依賴
+type SetManagerHandler struct {
+	employmentSvc interfaces.EmploymentService
+}
+
+// NewSetManagerHandler 構造函數
+func NewSetManagerHandler(employmentSvc interfaces.EmploymentService) *SetManagerHandler {
+	return &SetManagerHandler{employmentSvc: employmentSvc}
+}
+
+// SetManagerRequest 定義設定直屬主管的請求體; manager_id 為 null 表示清除主管
+type SetManagerRequest struct {
+	ManagerID *string `json:"manager_id" binding:"omitempty,uuid"`
+}
+
+// ReportingLineDTO 定義返回給客戶端的回報關係
+type ReportingLineDTO struct {
+	AccountID uuid.UUID  `json:"account_id"`
+	ManagerID *uuid.UUID `json:"manager_id"`
+	Version   int        `json:"version"`
+}
+
+// SetManager 方法處理 HR 設定員工直屬主管的 HTTP 請求
+func (h *SetManagerHandler) SetManager(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage reporting lines"})
+		return
+	}
+
+	// 2. 解析員工 ID 與請求體
+	accountID, err := uuid.Parse(c.Param("account_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid account ID in URL path"})
+		return
+	}
+	var req SetManagerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	var managerID *uuid.UUID
+	if req.ManagerID != nil {
+		parsed := uuid.MustParse(*req.ManagerID) // binding 已驗證格式
+		managerID = &parsed
+	}
+
+	// 3. 調用 Service 層
+	employment, err := h.employmentSvc.SetManager(c.Request.Context(), accountID, managerID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrEmploymentNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Employment record not found"})
+		case errors.Is(err, services.ErrInvalidManager), errors.Is(err, services.ErrManagerCycle):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Employment record was modified by another request; reload it and retry"})
+		default:
+			log.Printf("Error setting manager for account %s via service: %v", accountID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update reporting line"})
+		}
+		return
+	}
+
+	c.Header("ETag", middleware.ETag(employment.Version))
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Reporting line updated successfully",
+		Data:    ReportingLineDTO{AccountID: employment.AccountID, ManagerID: employment.ManagerID, Version: employment.Version},
+	})
+}
@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetManagerHandler_SetManager(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	accountID := uuid.New()
+	managerID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		body               string
+		setupMocks         func(mockSvc *mocks.MockEmploymentService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedETag       string
+	}{
+		{
+			name:         "Success - HR assigns manager",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         `{"manager_id":"` + managerID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().SetManager(gomock.Any(), accountID, &managerID).
+					Return(&models.Employment{AccountID: accountID, ManagerID: &managerID, Version: 2}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Reporting line updated successfully",
+			expectedETag:       `"2"`,
+		},
+		{
+			name:         "Success - HR clears manager",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         `{"manager_id":null}`,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().SetManager(gomock.Any(), accountID, nil).
+					Return(&models.Employment{AccountID: accountID}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Reporting line updated successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            accountID.String(),
+			body:               `{"manager_id":null}`,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage reporting lines",
+		},
+		{
+			name:               "Bad Request - Invalid Account ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			body:               `{"manager_id":null}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid account ID in URL path",
+		},
+		{
+			name:         "Bad Request - Reporting Cycle",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         `{"manager_id":"` + managerID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().SetManager(gomock.Any(), accountID, &managerID).Return(nil, services.ErrManagerCycle).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrManagerCycle.Error(),
+		},
+		{
+			name:         "Not Found - Employment",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         `{"manager_id":"` + managerID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().SetManager(gomock.Any(), accountID, &managerID).Return(nil, services.ErrEmploymentNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Employment record not found",
+		},
+		{
+			name:         "Conflict - Modified By Another Request",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         `{"manager_id":"` + managerID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().SetManager(gomock.Any(), accountID, &managerID).Return(nil, services.ErrConcurrentModification).Times(1)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    "Employment record was modified by another request; reload it and retry",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         `{"manager_id":"` + managerID.String() + `"}`,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().SetManager(gomock.Any(), accountID, &managerID).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to update reporting line",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockEmploymentService(ctrl)
+			handler := NewSetManagerHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodPut, "/hr/employees/"+tc.idParam+"/manager", strings.NewReader(tc.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{gin.Param{Key: "account_id", Value: tc.idParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.SetManager(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedETag != "" {
+				assert.Equal(t, tc.expectedETag, recorder.Header().Get("ETag"))
+			}
+		})
+	}
+}
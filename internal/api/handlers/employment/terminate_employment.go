@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TerminateEmploymentHandler 包含依賴
+type TerminateEmploymentHandler struct {
+	employmentSvc interfaces.EmploymentService
+}
+
+// NewTerminateEmploymentHandler 構造函數
+func NewTerminateEmploymentHandler(employmentSvc interfaces.EmploymentService) *TerminateEmploymentHandler {
+	return &TerminateEmploymentHandler{employmentSvc: employmentSvc}
+}
+
+// TerminateEmploymentRequest 定義辦理離職的請求體
+type TerminateEmploymentRequest struct {
+	TerminationDate string `json:"termination_date" binding:"required,datetime=2006-01-02"`
+}
+
+// TerminationLeaveDTO 定義因離職而自動取消或截短的假單 (截短的假單為截短後的日期與天數)
+type TerminationLeaveDTO struct {
+	ID        uuid.UUID `json:"id"`
+	LeaveType string    `json:"leave_type"`
+	StartDate string    `json:"start_date"` // YYYY-MM-DD
+	EndDate   string    `json:"end_date"`   // YYYY-MM-DD
+	Days      string    `json:"days"`
+}
+
+// TerminationSettlementDTO 定義返回給客戶端的離職結算摘要 (金額固定兩位小數, 方便列印)
+type TerminationSettlementDTO struct {
+	EmploymentID    uuid.UUID `json:"employment_id"`
+	AccountID       uuid.UUID `json:"account_id"`
+	FirstName       string    `json:"first_name"`
+	LastName        string    `json:"last_name"`
+	Email           string    `json:"email"`
+	Department      string    `json:"department,omitempty"`
+	PositionTitle   string    `json:"position_title,omitempty"`
+	HireDate        *string   `json:"hire_date,omitempty"` // YYYY-MM-DD
+	TerminationDate string    `json:"termination_date"`    // YYYY-MM-DD
+
+	AnnualLeave struct {
+		LeaveType          string `json:"leave_type"`
+		ProRatedAdjustment string `json:"pro_rated_adjustment"` // 離職日後尚未賺得而扣回的天數
+		RemainingDays      string `json:"remaining_days"`
+	} `json:"annual_leave"`
+
+	MonthlySalary    *string `json:"monthly_salary"` // 未設定薪資時為 null, 不折算金額
+	DailyRate        *string `json:"daily_rate"`
+	EncashmentAmount *string `json:"encashment_amount"`
+
+	CancelledLeaveRequests []TerminationLeaveDTO `json:"cancelled_leave_requests"`
+	ShortenedLeaveRequests []TerminationLeaveDTO `json:"shortened_leave_requests"`
+}
+
+// TerminateEmployment 方法處理 HR 辦理員工離職並取得結算摘要的 HTTP 請求
+// 以相同離職日重複呼叫只會重新計算結算 (補印或重試)
+func (h *TerminateEmploymentHandler) TerminateEmployment(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can terminate employment"})
+		return
+	}
+	processorID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		log.Printf("Error parsing account ID '%s' from claims: %v", claims.UserID, err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity format"})
+		return
+	}
+
+	// 2. 解析員工 ID 與請求體
+	accountID, err := uuid.Parse(c.Param("account_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid account ID in URL path"})
+		return
+	}
+	var req TerminateEmploymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	terminationDate, _ := time.Parse("2006-01-02", req.TerminationDate) // binding 已驗證格式
+
+	// 3. 調用 Service 層
+	employment, err := h.employmentSvc.GetEmploymentByAccountID(c.Request.Context(), accountID)
+	if err != nil {
+		if errors.Is(err, services.ErrEmploymentNotFound) {
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Employment record not found"})
+			return
+		}
+		log.Printf("Error fetching employment for account %s via service: %v", accountID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to terminate employment"})
+		return
+	}
+	settlement, err := h.employmentSvc.TerminateEmployment(c.Request.Context(), employment.ID, terminationDate, processorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrEmploymentNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Employment record not found"})
+		case errors.Is(err, services.ErrAlreadyTerminated):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Employment is already terminated with a different termination date"})
+		case errors.Is(err, services.ErrInvalidTerminationDate):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrTerminationSettlementFailed):
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Employment terminated but final settlement failed; retry with the same termination date"})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Employment record was modified by another request; reload it and retry"})
+		default:
+			log.Printf("Error terminating employment for account %s via service: %v", accountID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to terminate employment"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Employment terminated successfully",
+		Data:    toTerminationSettlementDTO(settlement),
+	})
+}
+
+// toTerminationSettlementDTO 將結算摘要轉換為 DTO
+func toTerminationSettlementDTO(settlement *models.TerminationSettlement) TerminationSettlementDTO {
+	money := func(amount *decimal.Decimal) *string {
+		if amount == nil {
+			return nil
+		}
+		s := amount.StringFixed(2)
+		return &s
+	}
+
+	dto := TerminationSettlementDTO{
+		EmploymentID:     settlement.EmploymentID,
+		AccountID:        settlement.AccountID,
+		FirstName:        settlement.FirstName,
+		LastName:         settlement.LastName,
+		Email:            settlement.Email,
+		Department:       settlement.Department,
+		PositionTitle:    settlement.PositionTitle,
+		TerminationDate:  settlement.TerminationDate.Format("2006-01-02"),
+		MonthlySalary:    money(settlement.MonthlySalary),
+		DailyRate:        money(settlement.DailyRate),
+		EncashmentAmount: money(settlement.EncashmentAmount),
+	}
+	if settlement.HireDate != nil {
+		hireDate := settlement.HireDate.Format("2006-01-02")
+		dto.HireDate = &hireDate
+	}
+	dto.AnnualLeave.LeaveType = settlement.AnnualLeave.LeaveType
+	dto.AnnualLeave.ProRatedAdjustment = settlement.AnnualLeave.ProRatedAdjustment.String()
+	dto.AnnualLeave.RemainingDays = settlement.AnnualLeave.Remaining.String()
+
+	dto.CancelledLeaveRequests = toTerminationLeaveDTOs(settlement.CancelledLeaveRequests)
+	dto.ShortenedLeaveRequests = toTerminationLeaveDTOs(settlement.ShortenedLeaveRequests)
+	return dto
+}
+
+// toTerminationLeaveDTOs 將因離職而調整的假單轉換為 DTO (沒有假單時返回空陣列)
+func toTerminationLeaveDTOs(requests []models.LeaveRequest) []TerminationLeaveDTO {
+	dtos := make([]TerminationLeaveDTO, 0, len(requests))
+	for _, request := range requests {
+		dtos = append(dtos, TerminationLeaveDTO{
+			ID:        request.ID,
+			LeaveType: request.LeaveType,
+			StartDate: request.StartDate.Format("2006-01-02"),
+			EndDate:   request.EndDate.Format("2006-01-02"),
+			Days:      request.Days.String(),
+		})
+	}
+	return dtos
+}
@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerminateEmploymentHandler_TerminateEmployment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrID := uuid.New()
+	hrClaims := &models.Claims{UserID: hrID.String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	accountID := uuid.New()
+	employmentID := uuid.New()
+	terminationDate := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	body := `{"termination_date":"2025-03-14"}`
+
+	salary := decimal.NewFromInt(45000)
+	dailyRate := decimal.NewFromInt(1500)
+	encashment := decimal.RequireFromString("9780")
+	settlement := &models.TerminationSettlement{
+		EmploymentID: employmentID, AccountID: accountID, FirstName: "Amy", LastName: "Lin", Email: "amy@example.com",
+		TerminationDate: terminationDate,
+		AnnualLeave: models.LeaveBalanceSettlement{LeaveType: models.LeaveTypeAnnual,
+			ProRatedAdjustment: decimal.RequireFromString("-0.69"), Remaining: decimal.RequireFromString("6.52")},
+		MonthlySalary: &salary, DailyRate: &dailyRate, EncashmentAmount: &encashment,
+		CancelledLeaveRequests: []models.LeaveRequest{{ID: uuid.New(), LeaveType: models.LeaveTypeAnnual,
+			StartDate: terminationDate.AddDate(0, 0, 3), EndDate: terminationDate.AddDate(0, 0, 4), Days: decimal.NewFromInt(2)}},
+		ShortenedLeaveRequests: []models.LeaveRequest{{ID: uuid.New(), LeaveType: models.LeaveTypeAnnual,
+			StartDate: terminationDate.AddDate(0, 0, -2), EndDate: terminationDate, Days: decimal.NewFromInt(3)}},
+	}
+	expectEmployment := func(mockSvc *mocks.MockEmploymentService) {
+		mockSvc.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).
+			Return(&models.Employment{ID: employmentID, AccountID: accountID}, nil).Times(1)
+	}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		body               string
+		setupMocks         func(mockSvc *mocks.MockEmploymentService)
+		expectedStatusCode int
+		expectedMessage    string
+		checkData          func(t *testing.T, data TerminationSettlementDTO)
+	}{
+		{
+			name:         "Success - Returns Settlement",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         body,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				expectEmployment(mockSvc)
+				mockSvc.EXPECT().TerminateEmployment(gomock.Any(), employmentID, terminationDate, hrID).Return(settlement, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Employment terminated successfully",
+			checkData: func(t *testing.T, data TerminationSettlementDTO) {
+				assert.Equal(t, "2025-03-14", data.TerminationDate)
+				assert.Equal(t, "-0.69", data.AnnualLeave.ProRatedAdjustment)
+				assert.Equal(t, "6.52", data.AnnualLeave.RemainingDays)
+				require.NotNil(t, data.DailyRate)
+				assert.Equal(t, "1500.00", *data.DailyRate)
+				require.NotNil(t, data.EncashmentAmount)
+				assert.Equal(t, "9780.00", *data.EncashmentAmount)
+				require.Len(t, data.CancelledLeaveRequests, 1)
+				assert.Equal(t, "2025-03-17", data.CancelledLeaveRequests[0].StartDate)
+				require.Len(t, data.ShortenedLeaveRequests, 1)
+				assert.Equal(t, "2025-03-14", data.ShortenedLeaveRequests[0].EndDate)
+				assert.Equal(t, "3", data.ShortenedLeaveRequests[0].Days)
+			},
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            accountID.String(),
+			body:               body,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can terminate employment",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			idParam:            accountID.String(),
+			body:               body,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Bad Request - Invalid Account ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			body:               body,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid account ID in URL path",
+		},
+		{
+			name:               "Bad Request - Invalid Date",
+			callerClaims:       hrClaims,
+			idParam:            accountID.String(),
+			body:               `{"termination_date":"14/03/2025"}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Not Found - Employment",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         body,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				mockSvc.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(nil, services.ErrEmploymentNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Employment record not found",
+		},
+		{
+			name:         "Conflict - Already Terminated",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         body,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				expectEmployment(mockSvc)
+				mockSvc.EXPECT().TerminateEmployment(gomock.Any(), employmentID, terminationDate, hrID).Return(nil, services.ErrAlreadyTerminated).Times(1)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    "Employment is already terminated with a different termination date",
+		},
+		{
+			name:         "Bad Request - Before Hire Date",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         body,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				expectEmployment(mockSvc)
+				mockSvc.EXPECT().TerminateEmployment(gomock.Any(), employmentID, terminationDate, hrID).Return(nil, services.ErrInvalidTerminationDate).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInvalidTerminationDate.Error(),
+		},
+		{
+			name:         "Internal Server Error - Settlement Failed",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         body,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				expectEmployment(mockSvc)
+				mockSvc.EXPECT().TerminateEmployment(gomock.Any(), employmentID, terminationDate, hrID).Return(nil, services.ErrTerminationSettlementFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Employment terminated but final settlement failed; retry with the same termination date",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			idParam:      accountID.String(),
+			body:         body,
+			setupMocks: func(mockSvc *mocks.MockEmploymentService) {
+				expectEmployment(mockSvc)
+				mockSvc.EXPECT().TerminateEmployment(gomock.Any(), employmentID, terminationDate, hrID).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to terminate employment",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockEmploymentService(ctrl)
+			handler := NewTerminateEmploymentHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/hr/employees/"+tc.idParam+"/terminate", strings.NewReader(tc.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{gin.Param{Key: "account_id", Value: tc.idParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.TerminateEmployment(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				common.Response
+				Data TerminationSettlementDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, resp.Message)
+			}
+			if tc.checkData != nil {
+				tc.checkData(t, resp.Data)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateHolidayHandler 包含依賴
+type CreateHolidayHandler struct {
+	holidaySvc interfaces.HolidayService
+}
+
+// NewCreateHolidayHandler 構造函數
+func NewCreateHolidayHandler(holidaySvc interfaces.HolidayService) *CreateHolidayHandler {
+	return &CreateHolidayHandler{holidaySvc: holidaySvc}
+}
+
+// CreateHolidayRequest 定義新增假日的請求體
+type CreateHolidayRequest struct {
+	Date string `json:"date" binding:"required,datetime=2006-01-02"`
+	Name string `json:"name" binding:"required"`
+}
+
+// CreateHoliday 方法處理 HR 新增假日的 HTTP 請求
+func (h *CreateHolidayHandler) CreateHoliday(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage holidays"})
+		return
+	}
+
+	// 2. 綁定並驗證請求體
+	var req CreateHolidayRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	date, _ := time.Parse("2006-01-02", req.Date) // binding 已驗證格式
+
+	// 3. 調用 Service 層
+	holiday, err := h.holidaySvc.AddHoliday(c.Request.Context(), date, req.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrHolidayExists):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "A holiday already exists on this date"})
+		case errors.Is(err, services.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		default:
+			log.Printf("Error creating holiday on %s via service: %v", req.Date, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to create holiday"})
+		}
+		return
+	}
+
+	// 4. 返回成功響應
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Holiday created successfully",
+		Data:    HolidayDTO{ID: holiday.ID, Date: holiday.Date.Format("2006-01-02"), Name: holiday.Name},
+	})
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateHolidayHandler_CreateHoliday(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	holidayDate := time.Date(2025, time.October, 10, 0, 0, 0, 0, time.UTC)
+	validBody := `{"date": "2025-10-10", "name": "National Day"}`
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockHolidayService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR creates holiday",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().AddHoliday(gomock.Any(), holidayDate, "National Day").
+					Return(&models.Holiday{ID: uuid.New(), Date: holidayDate, Year: 2025, Name: "National Day"}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Holiday created successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage holidays",
+		},
+		{
+			name:               "Bad Request - Invalid Date",
+			callerClaims:       hrClaims,
+			requestBody:        `{"date": "10/10/2025", "name": "National Day"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format",
+		},
+		{
+			name:         "Conflict - Holiday Exists",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().AddHoliday(gomock.Any(), holidayDate, "National Day").
+					Return(nil, fmt.Errorf("%w: 2025-10-10", services.ErrHolidayExists)).Times(1)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    "A holiday already exists on this date",
+		},
+		{
+			name:         "Internal Server Error - Create Failed",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().AddHoliday(gomock.Any(), holidayDate, "National Day").Return(nil, services.ErrHolidayCreateFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to create holiday",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockHolidayService(ctrl)
+			handler := NewCreateHolidayHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/hr/holidays", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.CreateHoliday(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+		})
+	}
+}
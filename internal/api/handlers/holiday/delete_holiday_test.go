@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteHolidayHandler_DeleteHoliday(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	holidayID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		setupMocks         func(mockSvc *mocks.MockHolidayService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR deletes holiday",
+			callerClaims: hrClaims,
+			idParam:      holidayID.String(),
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().DeleteHoliday(gomock.Any(), holidayID).Return(nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Holiday deleted successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            holidayID.String(),
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage holidays",
+		},
+		{
+			name:               "Bad Request - Invalid ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid holiday ID in URL path",
+		},
+		{
+			name:         "Not Found",
+			callerClaims: hrClaims,
+			idParam:      holidayID.String(),
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().DeleteHoliday(gomock.Any(), holidayID).Return(services.ErrHolidayNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Holiday not found",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			idParam:      holidayID.String(),
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().DeleteHoliday(gomock.Any(), holidayID).Return(errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to delete holiday",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockHolidayService(ctrl)
+			handler := NewDeleteHolidayHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodDelete, "/hr/holidays/"+tc.idParam, nil)
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tc.idParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.DeleteHoliday(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+		})
+	}
+}
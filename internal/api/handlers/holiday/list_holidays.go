@@ -0,0 +1,107 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListHolidaysHandler 包含依賴
+type ListHolidaysHandler struct {
+	holidaySvc interfaces.HolidayService
+}
+
+// NewListHolidaysHandler 構造函數
+func NewListHolidaysHandler(holidaySvc interfaces.HolidayService) *ListHolidaysHandler {
+	return &ListHolidaysHandler{holidaySvc: holidaySvc}
+}
+
+// HolidayDTO 定義返回給客戶端的假日資料
+type HolidayDTO struct {
+	ID   uuid.UUID `json:"id"`
+	Date string    `json:"date"` // YYYY-MM-DD
+	Name string    `json:"name"`
+}
+
+// HolidayCalendarDTO 定義返回給客戶端的年度行事曆 (工作週設定 + 假日)
+type HolidayCalendarDTO struct {
+	Year        int          `json:"year"`
+	WeekendDays []int        `json:"weekend_days"` // 0=週日 ... 6=週六
+	Holidays    []HolidayDTO `json:"holidays"`
+}
+
+// ListHolidays 方法處理查詢年度行事曆的 HTTP 請求 (?year=YYYY, 預設今年)
+func (h *ListHolidaysHandler) ListHolidays(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view the holiday calendar"})
+		return
+	}
+
+	// 2. 解析年度參數
+	year := time.Now().Year()
+	if yearStr := c.Query("year"); yearStr != "" {
+		parsed, err := strconv.Atoi(yearStr)
+		if err != nil || parsed < 1 {
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid year parameter"})
+			return
+		}
+		year = parsed
+	}
+
+	// 3. 調用 Service 層
+	calendar, err := h.holidaySvc.GetCalendar(c.Request.Context(), year)
+	if err != nil {
+		log.Printf("Error fetching holiday calendar for %d via service: %v", year, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve holiday calendar"})
+		return
+	}
+	holidays, err := h.holidaySvc.ListHolidays(c.Request.Context(), year)
+	if err != nil {
+		log.Printf("Error fetching holidays for %d via service: %v", year, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve holiday calendar"})
+		return
+	}
+
+	// 4. 轉換為 DTO 並返回
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    toHolidayCalendarDTO(calendar, holidays),
+	})
+}
+
+// toHolidayCalendarDTO 將行事曆與假日轉換為 DTO
+func toHolidayCalendarDTO(calendar *models.HolidayCalendar, holidays []models.Holiday) HolidayCalendarDTO {
+	weekendDays := make([]int, 0, 2)
+	for _, d := range calendar.Weekends() {
+		weekendDays = append(weekendDays, int(d))
+	}
+	holidayDTOs := make([]HolidayDTO, 0, len(holidays))
+	for _, holiday := range holidays {
+		holidayDTOs = append(holidayDTOs, HolidayDTO{
+			ID:   holiday.ID,
+			Date: holiday.Date.Format("2006-01-02"),
+			Name: holiday.Name,
+		})
+	}
+	return HolidayCalendarDTO{Year: calendar.Year, WeekendDays: weekendDays, Holidays: holidayDTOs}
+}
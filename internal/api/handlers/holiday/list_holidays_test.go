@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListHolidaysHandler_ListHolidays(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+
+	calendar := &models.HolidayCalendar{Year: 2025, WeekendDays: "0,6"}
+	holidays := []models.Holiday{
+		{ID: uuid.New(), Date: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Year: 2025, Name: "New Year"},
+	}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		query              string
+		setupMocks         func(mockSvc *mocks.MockHolidayService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectData         bool
+	}{
+		{
+			name:         "Success - HR lists holidays for a year",
+			callerClaims: hrClaims,
+			query:        "?year=2025",
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().GetCalendar(gomock.Any(), 2025).Return(calendar, nil).Times(1)
+				mockSvc.EXPECT().ListHolidays(gomock.Any(), 2025).Return(holidays, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectData:         true,
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view the holiday calendar",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing claims",
+		},
+		{
+			name:               "Bad Request - Invalid Year",
+			callerClaims:       hrClaims,
+			query:              "?year=abc",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid year parameter",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			query:        "?year=2025",
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().GetCalendar(gomock.Any(), 2025).Return(calendar, nil).Times(1)
+				mockSvc.EXPECT().ListHolidays(gomock.Any(), 2025).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve holiday calendar",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockHolidayService(ctrl)
+			handler := NewListHolidaysHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/holidays"+tc.query, nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ListHolidays(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int                `json:"code"`
+				Message string             `json:"message"`
+				Data    HolidayCalendarDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectData {
+				assert.Equal(t, 2025, resp.Data.Year)
+				assert.Equal(t, []int{0, 6}, resp.Data.WeekendDays)
+				require.Len(t, resp.Data.Holidays, 1)
+				assert.Equal(t, "2025-01-01", resp.Data.Holidays[0].Date)
+			}
+		})
+	}
+}
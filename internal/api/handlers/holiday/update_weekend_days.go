@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateWeekendDaysHandler 包含依賴
+type UpdateWeekendDaysHandler struct {
+	holidaySvc interfaces.HolidayService
+}
+
+// NewUpdateWeekendDaysHandler 構造函數
+func NewUpdateWeekendDaysHandler(holidaySvc interfaces.HolidayService) *UpdateWeekendDaysHandler {
+	return &UpdateWeekendDaysHandler{holidaySvc: holidaySvc}
+}
+
+// UpdateWeekendDaysRequest 定義設定年度週末的請求體
+type UpdateWeekendDaysRequest struct {
+	WeekendDays []int `json:"weekend_days" binding:"required,dive,min=0,max=6"` // 0=週日 ... 6=週六
+}
+
+// UpdateWeekendDays 方法處理 HR 設定某年度週末的 HTTP 請求
+func (h *UpdateWeekendDaysHandler) UpdateWeekendDays(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage holidays"})
+		return
+	}
+
+	// 2. 解析年度與請求體
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil || year < 1 {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid year in URL path"})
+		return
+	}
+	var req UpdateWeekendDaysRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	weekendDays := make([]time.Weekday, 0, len(req.WeekendDays))
+	for _, d := range req.WeekendDays {
+		weekendDays = append(weekendDays, time.Weekday(d))
+	}
+
+	// 3. 調用 Service 層
+	calendar, err := h.holidaySvc.SetWeekendDays(c.Request.Context(), year, weekendDays)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidWeekendDays), errors.Is(err, services.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		default:
+			log.Printf("Error updating weekend days for %d via service: %v", year, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update holiday calendar"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Holiday calendar updated successfully",
+		Data:    toHolidayCalendarDTO(calendar, nil),
+	})
+}
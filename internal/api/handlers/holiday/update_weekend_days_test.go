@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateWeekendDaysHandler_UpdateWeekendDays(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		yearParam          string
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockHolidayService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR sets Friday/Saturday weekend",
+			callerClaims: hrClaims,
+			yearParam:    "2026",
+			requestBody:  `{"weekend_days": [5, 6]}`,
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().SetWeekendDays(gomock.Any(), 2026, []time.Weekday{time.Friday, time.Saturday}).
+					Return(&models.HolidayCalendar{Year: 2026, WeekendDays: "5,6"}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Holiday calendar updated successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			yearParam:          "2026",
+			requestBody:        `{"weekend_days": [0, 6]}`,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage holidays",
+		},
+		{
+			name:               "Bad Request - Invalid Year",
+			callerClaims:       hrClaims,
+			yearParam:          "abc",
+			requestBody:        `{"weekend_days": [0, 6]}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid year in URL path",
+		},
+		{
+			name:               "Bad Request - Weekday Out Of Range",
+			callerClaims:       hrClaims,
+			yearParam:          "2026",
+			requestBody:        `{"weekend_days": [7]}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format",
+		},
+		{
+			name:         "Bad Request - Service Rejects Weekend Days",
+			callerClaims: hrClaims,
+			yearParam:    "2026",
+			requestBody:  `{"weekend_days": [0, 1, 2, 3, 4, 5, 6]}`,
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().SetWeekendDays(gomock.Any(), 2026, gomock.Any()).Return(nil, services.ErrInvalidWeekendDays).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInvalidWeekendDays.Error(),
+		},
+		{
+			name:         "Internal Server Error - Update Failed",
+			callerClaims: hrClaims,
+			yearParam:    "2026",
+			requestBody:  `{"weekend_days": [0, 6]}`,
+			setupMocks: func(mockSvc *mocks.MockHolidayService) {
+				mockSvc.EXPECT().SetWeekendDays(gomock.Any(), 2026, gomock.Any()).Return(nil, services.ErrHolidayCalendarUpdateFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to update holiday calendar",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockHolidayService(ctrl)
+			handler := NewUpdateWeekendDaysHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPut, "/hr/holiday-calendars/"+tc.yearParam, bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "year", Value: tc.yearParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.UpdateWeekendDays(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+		})
+	}
+}
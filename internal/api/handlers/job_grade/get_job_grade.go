@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetJobGradeHandler 包含依賴
+type GetJobGradeHandler struct {
+	jobGradeSvc interfaces.JobGradeService
+}
+
+// NewGetJobGradeHandler 構造函數
+func NewGetJobGradeHandler(jobGradeSvc interfaces.JobGradeService) *GetJobGradeHandler {
+	return &GetJobGradeHandler{jobGradeSvc: jobGradeSvc}
+}
+
+// GetJobGrade 方法處理 HR 查詢單一職等的 HTTP 請求 (以 ETag 標頭返回版本號, 更新時以 If-Match 帶回)
+func (h *GetJobGradeHandler) GetJobGrade(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view job grades"})
+		return
+	}
+
+	// 2. 解析職等 ID
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid job grade ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	jobGrade, err := h.jobGradeSvc.GetJobGradeByID(c.Request.Context(), id)
+	if err != nil {
+		if errors.Is(err, services.ErrJobGradeNotFound) {
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Job grade not found"})
+			return
+		}
+		log.Printf("Error fetching job grade %s via service: %v", id, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve job grade"})
+		return
+	}
+
+	c.Header("ETag", middleware.ETag(jobGrade.Version))
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    toJobGradeDTO(*jobGrade),
+	})
+}
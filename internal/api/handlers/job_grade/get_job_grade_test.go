@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetJobGradeHandler_GetJobGrade(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	jobGradeID := uuid.New()
+	grade := &models.JobGrade{ID: jobGradeID, Code: "P2", Name: "Engineer", Version: 5}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		setupMocks         func(mockSvc *mocks.MockJobGradeService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedETag       string
+	}{
+		{
+			name:         "Success - Version Returned As ETag",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().GetJobGradeByID(gomock.Any(), jobGradeID).Return(grade, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedETag:       `"5"`,
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            jobGradeID.String(),
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view job grades",
+		},
+		{
+			name:               "Bad Request - Invalid Job Grade ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid job grade ID in URL path",
+		},
+		{
+			name:         "Not Found - Job Grade",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().GetJobGradeByID(gomock.Any(), jobGradeID).Return(nil, services.ErrJobGradeNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Job grade not found",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().GetJobGradeByID(gomock.Any(), jobGradeID).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve job grade",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockJobGradeService(ctrl)
+			handler := NewGetJobGradeHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			router := gin.New()
+			router.GET("/hr/job-grades/:id", func(c *gin.Context) {
+				if tc.callerClaims != nil {
+					c.Set("claims", tc.callerClaims)
+				}
+			}, handler.GetJobGrade)
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/hr/job-grades/"+tc.idParam, nil)
+			router.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			assert.Equal(t, tc.expectedETag, recorder.Header().Get("ETag"))
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/erinchen11/hr-system/internal/models"
 	common "github.com/erinchen11/hr-system/internal/models/common"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
 
@@ -22,11 +23,26 @@ func NewListJobGradesHandler(jobGradeSvc interfaces.JobGradeService) *ListJobGra
 
 // JobGradeDTO 定義返回給客戶端的職等資料結構
 type JobGradeDTO struct {
+	ID          uuid.UUID       `json:"id"`
 	Code        string          `json:"code"`
 	Name        string          `json:"name"`
 	Description string          `json:"description,omitempty"`
 	MinSalary   decimal.Decimal `json:"min_salary"`
 	MaxSalary   decimal.Decimal `json:"max_salary"`
+	Version     int             `json:"version"` // 更新時以 If-Match 帶回
+}
+
+// toJobGradeDTO 將 models.JobGrade 轉換為 JobGradeDTO
+func toJobGradeDTO(grade models.JobGrade) JobGradeDTO {
+	return JobGradeDTO{
+		ID:          grade.ID,
+		Code:        grade.Code,
+		Name:        grade.Name,
+		Description: grade.Description,
+		MinSalary:   grade.MinSalary,
+		MaxSalary:   grade.MaxSalary,
+		Version:     grade.Version,
+	}
 }
 
 // ListJobGrades 方法處理獲取所有職等列表的 HTTP 請求
@@ -65,14 +81,7 @@ func (h *ListJobGradesHandler) ListJobGrades(c *gin.Context) {
 	// 3. 將 []models.JobGrade 轉換為 []JobGradeDTO
 	responseDTOs := make([]JobGradeDTO, 0, len(jobGrades))
 	for _, grade := range jobGrades {
-		dto := JobGradeDTO{
-			Code:        grade.Code,
-			Name:        grade.Name,
-			Description: grade.Description,
-			MinSalary:   grade.MinSalary, 
-			MaxSalary:   grade.MaxSalary, 
-		}
-		responseDTOs = append(responseDTOs, dto)
+		responseDTOs = append(responseDTOs, toJobGradeDTO(grade))
 	}
 
 	// 4. 返回成功響應
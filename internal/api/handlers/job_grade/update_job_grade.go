@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// UpdateJobGradeHandler 包含依賴
+type UpdateJobGradeHandler struct {
+	jobGradeSvc interfaces.JobGradeService
+}
+
+// NewUpdateJobGradeHandler 構造函數
+func NewUpdateJobGradeHandler(jobGradeSvc interfaces.JobGradeService) *UpdateJobGradeHandler {
+	return &UpdateJobGradeHandler{jobGradeSvc: jobGradeSvc}
+}
+
+// UpdateJobGradeRequest 定義更新職等的請求體; 未提供 (空字串或 0) 的欄位維持原值
+type UpdateJobGradeRequest struct {
+	Code        string          `json:"code"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	MinSalary   decimal.Decimal `json:"min_salary"`
+	MaxSalary   decimal.Decimal `json:"max_salary"`
+}
+
+// UpdateJobGrade 方法處理 HR 更新職等的 HTTP 請求 (可帶 If-Match 標頭, 版本不符時返回 409)
+func (h *UpdateJobGradeHandler) UpdateJobGrade(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can update job grades"})
+		return
+	}
+
+	// 2. 解析職等 ID 與請求體
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid job grade ID in URL path"})
+		return
+	}
+	var req UpdateJobGradeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+
+	// 3. 調用 Service 層
+	updates := &models.JobGrade{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+		MinSalary:   req.MinSalary,
+		MaxSalary:   req.MaxSalary,
+	}
+	jobGrade, err := h.jobGradeSvc.UpdateJobGrade(c.Request.Context(), id, updates)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrJobGradeNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Job grade not found"})
+		case errors.Is(err, services.ErrJobGradeCodeExists):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Job grade code already exists"})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Job grade was modified by another request; reload it and retry"})
+		default:
+			log.Printf("Error updating job grade %s via service: %v", id, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update job grade"})
+		}
+		return
+	}
+
+	c.Header("ETag", middleware.ETag(jobGrade.Version))
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Job grade updated successfully",
+		Data:    toJobGradeDTO(*jobGrade),
+	})
+}
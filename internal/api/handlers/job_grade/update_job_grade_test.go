@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateJobGradeHandler_UpdateJobGrade(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	jobGradeID := uuid.New()
+	updatedGrade := &models.JobGrade{ID: jobGradeID, Code: "P2", Name: "Engineer", MinSalary: decimal.NewFromInt(40000), MaxSalary: decimal.NewFromInt(60000), Version: 4}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		ifMatch            string
+		body               string
+		setupMocks         func(mockSvc *mocks.MockJobGradeService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedETag       string
+	}{
+		{
+			name:         "Success - If-Match Version Passed To Service",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			ifMatch:      `"3"`,
+			body:         `{"name":"Engineer","max_salary":"60000"}`,
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().UpdateJobGrade(gomock.Any(), jobGradeID, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id uuid.UUID, updates *models.JobGrade) (*models.JobGrade, error) {
+						version, ok := models.ExpectedVersionFromContext(ctx)
+						assert.True(t, ok, "If-Match version should be in the context")
+						assert.Equal(t, 3, version)
+						assert.Equal(t, "Engineer", updates.Name)
+						assert.True(t, decimal.NewFromInt(60000).Equal(updates.MaxSalary))
+						return updatedGrade, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Job grade updated successfully",
+			expectedETag:       `"4"`,
+		},
+		{
+			name:         "Success - Without If-Match",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			body:         `{"name":"Engineer"}`,
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().UpdateJobGrade(gomock.Any(), jobGradeID, gomock.Any()).Return(updatedGrade, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Job grade updated successfully",
+			expectedETag:       `"4"`,
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            jobGradeID.String(),
+			body:               `{"name":"Engineer"}`,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can update job grades",
+		},
+		{
+			name:               "Bad Request - Invalid Job Grade ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			body:               `{"name":"Engineer"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid job grade ID in URL path",
+		},
+		{
+			name:               "Bad Request - Malformed If-Match",
+			callerClaims:       hrClaims,
+			idParam:            jobGradeID.String(),
+			ifMatch:            `"abc"`,
+			body:               `{"name":"Engineer"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid If-Match header: expected a single version ETag such as \"3\"",
+		},
+		{
+			name:         "Not Found - Job Grade",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			body:         `{"name":"Engineer"}`,
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().UpdateJobGrade(gomock.Any(), jobGradeID, gomock.Any()).Return(nil, services.ErrJobGradeNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Job grade not found",
+		},
+		{
+			name:         "Conflict - Code Already Exists",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			body:         `{"code":"M1"}`,
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().UpdateJobGrade(gomock.Any(), jobGradeID, gomock.Any()).Return(nil, services.ErrJobGradeCodeExists).Times(1)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    "Job grade code already exists",
+		},
+		{
+			name:         "Conflict - Stale If-Match",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			ifMatch:      `"2"`,
+			body:         `{"name":"Engineer"}`,
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().UpdateJobGrade(gomock.Any(), jobGradeID, gomock.Any()).Return(nil, services.ErrConcurrentModification).Times(1)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    "Job grade was modified by another request; reload it and retry",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			idParam:      jobGradeID.String(),
+			body:         `{"name":"Engineer"}`,
+			setupMocks: func(mockSvc *mocks.MockJobGradeService) {
+				mockSvc.EXPECT().UpdateJobGrade(gomock.Any(), jobGradeID, gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to update job grade",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockJobGradeService(ctrl)
+			handler := NewUpdateJobGradeHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			// 與路由相同: If-Match 中介層在 handler 之前
+			router := gin.New()
+			router.PUT("/hr/job-grades/:id", func(c *gin.Context) {
+				if tc.callerClaims != nil {
+					c.Set("claims", tc.callerClaims)
+				}
+			}, middleware.IfMatch(), handler.UpdateJobGrade)
+
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPut, "/hr/job-grades/"+tc.idParam, strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+			router.ServeHTTP(recorder, req)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedETag != "" {
+				assert.Equal(t, tc.expectedETag, recorder.Header().Get("ETag"))
+			}
+		})
+	}
+}
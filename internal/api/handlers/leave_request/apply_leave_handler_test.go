@@ -3,8 +3,10 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -12,6 +14,7 @@ import (
 	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
 	"github.com/erinchen11/hr-system/internal/models"
 	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -49,7 +52,15 @@ func TestApplyLeaveHandler_ApplyLeave(t *testing.T) {
 			callerClaims: employeeClaims,
 			requestBody:  validRequestBody,
 			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
-				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, testLeaveType, testReason, gomock.Any(), gomock.Any()).Return(&models.LeaveRequest{ID: uuid.New()}, nil)
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, accountID string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
+						assert.Equal(t, testLeaveType, input.LeaveType)
+						assert.Equal(t, testReason, input.Reason)
+						assert.Equal(t, testStartDateStr, input.StartDate.Format("2006-01-02"))
+						assert.Equal(t, testEndDateStr, input.EndDate.Format("2006-01-02"))
+						assert.Empty(t, input.DurationUnit)
+						return &models.LeaveRequest{ID: uuid.New()}, nil
+					})
 			},
 			expectedStatusCode:   http.StatusCreated,
 			expectedResponseCode: http.StatusCreated,
@@ -60,19 +71,145 @@ func TestApplyLeaveHandler_ApplyLeave(t *testing.T) {
 			callerClaims: employeeClaims,
 			requestBody:  validRequestBodyNoReason,
 			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
-				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, testLeaveType, "", gomock.Any(), gomock.Any()).Return(&models.LeaveRequest{ID: uuid.New()}, nil)
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, accountID string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
+						assert.Equal(t, testLeaveType, input.LeaveType)
+						assert.Empty(t, input.Reason)
+						return &models.LeaveRequest{ID: uuid.New()}, nil
+					})
 			},
 			expectedStatusCode:   http.StatusCreated,
 			expectedResponseCode: http.StatusCreated,
 			expectedMessage:      "Leave application submitted successfully",
 		},
 		{
-			name:                 "Forbidden - HR tries to apply",
-			callerClaims:         hrClaims,
-			requestBody:          validRequestBody,
+			name:         "Success - Apply hourly leave",
+			callerClaims: employeeClaims,
+			requestBody:  fmt.Sprintf(`{"start_date": "%s", "end_date": "%s", "leave_type": "%s", "duration_unit": "hours", "start_time": "09:00", "end_time": "11:30"}`, testStartDateStr, testStartDateStr, testLeaveType),
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, accountID string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
+						assert.Equal(t, models.LeaveDurationHours, input.DurationUnit)
+						assert.Equal(t, "09:00", input.StartTime)
+						assert.Equal(t, "11:30", input.EndTime)
+						return &models.LeaveRequest{ID: uuid.New()}, nil
+					})
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseCode: http.StatusCreated,
+			expectedMessage:      "Leave application submitted successfully",
+		},
+		{
+			name:                 "Bad Request - Unknown Duration Unit",
+			callerClaims:         employeeClaims,
+			requestBody:          fmt.Sprintf(`{"start_date": "%s", "end_date": "%s", "leave_type": "%s", "duration_unit": "quarter_day"}`, testStartDateStr, testStartDateStr, testLeaveType),
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseCode: http.StatusBadRequest,
+			expectedMessage:      "Invalid request format",
+		},
+		{
+			name:         "Bad Request - Service Rejects Duration",
+			callerClaims: employeeClaims,
+			requestBody:  fmt.Sprintf(`{"start_date": "%s", "end_date": "%s", "leave_type": "%s", "duration_unit": "half_day_am"}`, testStartDateStr, testEndDateStr, testLeaveType),
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).Return(nil, services.ErrInvalidLeaveDuration)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseCode: http.StatusBadRequest,
+			expectedMessage:      services.ErrInvalidLeaveDuration.Error(),
+		},
+		{
+			name:         "Bad Request - Insufficient Leave Balance",
+			callerClaims: employeeClaims,
+			requestBody:  validRequestBody,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).Return(nil, services.ErrInsufficientLeaveBalance)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseCode: http.StatusBadRequest,
+			expectedMessage:      services.ErrInsufficientLeaveBalance.Error(),
+		},
+		{
+			name:         "Bad Request - Unknown Leave Type",
+			callerClaims: employeeClaims,
+			requestBody:  validRequestBody,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).Return(nil, fmt.Errorf("%w: 'sabbatical'", services.ErrUnknownLeaveType))
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseCode: http.StatusBadRequest,
+			expectedMessage:      services.ErrUnknownLeaveType.Error() + ": 'sabbatical'",
+		},
+		{
+			name:         "Forbidden - Leave Type Not Available For Role",
+			callerClaims: employeeClaims,
+			requestBody:  validRequestBody,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).Return(nil, services.ErrLeaveTypeNotEligible)
+			},
+			expectedStatusCode:   http.StatusForbidden,
+			expectedResponseCode: http.StatusForbidden,
+			expectedMessage:      services.ErrLeaveTypeNotEligible.Error(),
+		},
+		{
+			name:         "Conflict - Overlapping Leave",
+			callerClaims: employeeClaims,
+			requestBody:  validRequestBody,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).Return(nil, services.ErrOverlappingLeave)
+			},
+			expectedStatusCode:   http.StatusConflict,
+			expectedResponseCode: http.StatusConflict,
+			expectedMessage:      services.ErrOverlappingLeave.Error(),
+		},
+		{
+			name:         "Conflict - Blackout Period",
+			callerClaims: employeeClaims,
+			requestBody:  validRequestBody,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				violation := models.LeaveRuleViolation{Rule: models.LeaveRuleBlackout, RuleName: "Q2 close", Message: "leave is not allowed during blackout period Q2 close"}
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).Return(nil, &services.LeaveRuleViolationError{Violation: violation})
+			},
+			expectedStatusCode:   http.StatusConflict,
+			expectedResponseCode: http.StatusConflict,
+			expectedMessage:      services.ErrLeaveRuleViolation.Error() + ": leave is not allowed during blackout period Q2 close",
+		},
+		{
+			name:         "Forbidden - Employee Cannot Override Leave Rule",
+			callerClaims: employeeClaims,
+			requestBody:  `{"start_date": "2025-03-03", "end_date": "2025-03-04", "leave_type": "annual", "override_justification": "manager agreed"}`,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, accountID string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
+						assert.Equal(t, "manager agreed", input.OverrideJustification)
+						return nil, services.ErrRuleOverrideNotAllowed
+					}).Times(1)
+			},
 			expectedStatusCode:   http.StatusForbidden,
 			expectedResponseCode: http.StatusForbidden,
-			expectedMessage:      "Permission denied: Only employees can apply for leave",
+			expectedMessage:      services.ErrRuleOverrideNotAllowed.Error(),
+		},
+		{
+			name:         "Bad Request - No Working Days In Range",
+			callerClaims: employeeClaims,
+			requestBody:  validRequestBody,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeIDStr, gomock.Any()).Return(nil, services.ErrNoWorkingDaysInRange)
+			},
+			expectedStatusCode:   http.StatusBadRequest,
+			expectedResponseCode: http.StatusBadRequest,
+			expectedMessage:      services.ErrNoWorkingDaysInRange.Error(),
+		},
+		{
+			name:         "Success - HR applies for own leave",
+			callerClaims: hrClaims,
+			requestBody:  validRequestBody,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), hrClaims.UserID, gomock.Any()).Return(&models.LeaveRequest{}, nil).Times(1)
+			},
+			expectedStatusCode:   http.StatusCreated,
+			expectedResponseCode: http.StatusCreated,
+			expectedMessage:      "Leave application submitted successfully",
 		},
 		{
 			name:                 "Unauthorized - Missing Claims",
@@ -114,7 +251,7 @@ func TestApplyLeaveHandler_ApplyLeave(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockLeaveSvc := mocks.NewMockLeaveRequestService(ctrl)
-			handler := NewApplyLeaveHandler(mockLeaveSvc)
+			handler := NewApplyLeaveHandler(mockLeaveSvc, 1)
 
 			if tc.setupMocks != nil {
 				tc.setupMocks(mockLeaveSvc)
@@ -143,3 +280,122 @@ func TestApplyLeaveHandler_ApplyLeave(t *testing.T) {
 		})
 	}
 }
+
+// newMultipartApplyRequest 建立附帶佐證文件的 multipart 請假申請
+func newMultipartApplyRequest(t *testing.T, fields map[string]string, files map[string][]byte) *http.Request {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for k, v := range fields {
+		require.NoError(t, writer.WriteField(k, v))
+	}
+	for name, data := range files {
+		part, err := writer.CreateFormFile("attachments", name)
+		require.NoError(t, err)
+		_, err = part.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/employee/apply-leave", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestApplyLeaveHandler_ApplyLeave_Multipart(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	employeeID := uuid.New().String()
+	employeeClaims := &models.Claims{UserID: employeeID, Role: models.RoleEmployee}
+	fields := map[string]string{"start_date": "2025-07-10", "end_date": "2025-07-14", "leave_type": models.LeaveTypeSick, "reason": "Flu"}
+	certificate := []byte("%PDF-1.4 medical certificate")
+	createdID := uuid.New()
+
+	testCases := []struct {
+		name            string
+		fields          map[string]string
+		files           map[string][]byte
+		setupMocks      func(mockLeaveSvc *mocks.MockLeaveRequestService)
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{
+			name:   "Success - Form Fields With Attachment",
+			fields: fields,
+			files:  map[string][]byte{"certificate.pdf": certificate},
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeID, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, accountID string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
+						assert.Equal(t, models.LeaveTypeSick, input.LeaveType)
+						assert.Equal(t, "Flu", input.Reason)
+						assert.Equal(t, "2025-07-14", input.EndDate.Format("2006-01-02"))
+						require.Len(t, input.Attachments, 1)
+						assert.Equal(t, "certificate.pdf", input.Attachments[0].FileName)
+						assert.Equal(t, certificate, input.Attachments[0].Data)
+						return &models.LeaveRequest{ID: createdID}, nil
+					})
+			},
+			expectedStatus:  http.StatusCreated,
+			expectedMessage: "Leave application submitted successfully",
+		},
+		{
+			name:   "Bad Request - Attachment Required",
+			fields: fields,
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeID, gomock.Any()).Return(nil, services.ErrAttachmentRequired)
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: services.ErrAttachmentRequired.Error(),
+		},
+		{
+			name:   "Bad Request - Unsupported Attachment Type",
+			fields: fields,
+			files:  map[string][]byte{"script.sh": []byte("#!/bin/sh")},
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeID, gomock.Any()).Return(nil, services.ErrUnsupportedAttachmentType)
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: services.ErrUnsupportedAttachmentType.Error(),
+		},
+		{
+			name:   "Internal Server Error - Submitted But Attachment Not Saved",
+			fields: fields,
+			files:  map[string][]byte{"certificate.pdf": certificate},
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ApplyForLeave(gomock.Any(), employeeID, gomock.Any()).
+					Return(&models.LeaveRequest{ID: createdID}, services.ErrAttachmentSaveFailed)
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "Leave application submitted but the attachment could not be saved",
+		},
+		{
+			name:            "Bad Request - Missing Form Field",
+			fields:          map[string]string{"start_date": "2025-07-10", "end_date": "2025-07-14"},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "Invalid request format",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLeaveSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewApplyLeaveHandler(mockLeaveSvc, 1)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request = newMultipartApplyRequest(t, tc.fields, tc.files)
+			c.Set("claims", employeeClaims)
+
+			handler.ApplyLeave(c)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+		})
+	}
+}
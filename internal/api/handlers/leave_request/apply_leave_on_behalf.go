@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ApplyLeaveOnBehalfHandler 包含依賴
+type ApplyLeaveOnBehalfHandler struct {
+	leaveRequestSvc    interfaces.LeaveRequestService
+	maxAttachmentBytes int64 // 單一附件的大小上限, 在讀入記憶體前檢查
+}
+
+// NewApplyLeaveOnBehalfHandler 構造函數, maxAttachmentSizeMB 非正數時使用預設上限
+func NewApplyLeaveOnBehalfHandler(leaveRequestSvc interfaces.LeaveRequestService, maxAttachmentSizeMB int) *ApplyLeaveOnBehalfHandler {
+	return &ApplyLeaveOnBehalfHandler{leaveRequestSvc: leaveRequestSvc, maxAttachmentBytes: attachmentSizeLimit(maxAttachmentSizeMB)}
+}
+
+// ApplyLeaveOnBehalfRequest 請求體結構: 與員工申請相同，另可要求建立後直接核准
+type ApplyLeaveOnBehalfRequest struct {
+	ApplyLeaveRequest
+	PreApproved bool `json:"pre_approved" form:"pre_approved"`
+}
+
+// ApplyLeaveOnBehalf 方法處理 HR 代員工提交假單的 HTTP 請求 (例如員工來電請病假)
+func (h *ApplyLeaveOnBehalfHandler) ApplyLeaveOnBehalf(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can submit leave on behalf of employees"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取申請人 ID 並解析請求體
+	accountIDStr := c.Param("account_id")
+	var req ApplyLeaveOnBehalfRequest
+	attachments, err := bindLeaveApplication(c, &req, h.maxAttachmentBytes)
+	if err != nil {
+		if isUploadTooLarge(err) {
+			writeUploadTooLarge(c, h.maxAttachmentBytes)
+			return
+		}
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+
+	// 3. 調用 Service 層
+	leaveRequest, err := h.leaveRequestSvc.ApplyForLeaveOnBehalf(c.Request.Context(), claims.UserID, accountIDStr, req.toInput(attachments), req.PreApproved)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidProcessor):
+			message := "Permission denied: Only HR or Super Admin can submit leave on behalf of employees"
+			if req.PreApproved && accountIDStr == claims.UserID {
+				message = "Permission denied: You cannot pre-approve your own leave request"
+			}
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: message})
+		case errors.Is(err, services.ErrLeavePreApprovalFailed) && leaveRequest != nil:
+			// 假單已提交但未能核准，維持待審; 返回假單 ID 讓 HR 改走一般審核
+			c.JSON(http.StatusInternalServerError, common.Response{
+				Code:    http.StatusInternalServerError,
+				Message: "Leave request submitted but could not be pre-approved, please approve it separately",
+				Data:    gin.H{"leave_request_id": leaveRequest.ID},
+			})
+		default:
+			writeApplyLeaveError(c, err, leaveRequest, accountIDStr)
+		}
+		return
+	}
+
+	// 4. 返回建立的假單
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Leave request submitted on behalf of the employee",
+		Data:    toLeaveRequestStatusDTO(*leaveRequest),
+	})
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyLeaveOnBehalfHandler_ApplyLeaveOnBehalf(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	employeeID := uuid.New().String()
+	leaveID := uuid.New()
+	validBody := `{"start_date": "2025-03-04", "end_date": "2025-03-04", "leave_type": "sick", "reason": "Called in sick", "pre_approved": true}`
+	createdBy := uuid.MustParse(hrClaims.UserID)
+	created := &models.LeaveRequest{ID: leaveID, LeaveType: models.LeaveTypeSick, Status: models.LeaveStatusApproved,
+		StartDate: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC),
+		Days: decimal.NewFromInt(1), DurationUnit: models.LeaveDurationFullDay, CreatedByID: &createdBy}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		accountIDParam     string
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockLeaveRequestService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:           "Success - Pre-approved On Behalf Of Employee",
+			callerClaims:   hrClaims,
+			accountIDParam: employeeID,
+			requestBody:    validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().ApplyForLeaveOnBehalf(gomock.Any(), hrClaims.UserID, employeeID, gomock.Any(), true).
+					DoAndReturn(func(ctx context.Context, creatorID, accountID string, input models.LeaveRequestInput, preApproved bool) (*models.LeaveRequest, error) {
+						assert.Equal(t, models.LeaveTypeSick, input.LeaveType)
+						assert.Equal(t, "2025-03-04", input.StartDate.Format("2006-01-02"))
+						assert.Equal(t, "Called in sick", input.Reason)
+						return created, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Leave request submitted on behalf of the employee",
+		},
+		{
+			name:           "Success - Override Justification Passed To Service",
+			callerClaims:   hrClaims,
+			accountIDParam: employeeID,
+			requestBody:    `{"start_date": "2025-03-04", "end_date": "2025-03-04", "leave_type": "sick", "override_justification": "contractor covers the shift"}`,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().ApplyForLeaveOnBehalf(gomock.Any(), hrClaims.UserID, employeeID, gomock.Any(), false).
+					DoAndReturn(func(ctx context.Context, creatorID, accountID string, input models.LeaveRequestInput, preApproved bool) (*models.LeaveRequest, error) {
+						assert.Equal(t, "contractor covers the shift", input.OverrideJustification)
+						return created, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Leave request submitted on behalf of the employee",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			accountIDParam:     employeeID,
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			accountIDParam:     employeeID,
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can submit leave on behalf of employees",
+		},
+		{
+			name:               "Bad Request - Missing Leave Type",
+			callerClaims:       hrClaims,
+			accountIDParam:     employeeID,
+			requestBody:        `{"start_date": "2025-03-04", "end_date": "2025-03-04"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format: ",
+		},
+		{
+			name:           "Forbidden - Pre-approving Own Leave",
+			callerClaims:   hrClaims,
+			accountIDParam: hrClaims.UserID,
+			requestBody:    validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().ApplyForLeaveOnBehalf(gomock.Any(), hrClaims.UserID, hrClaims.UserID, gomock.Any(), true).
+					Return(nil, services.ErrInvalidProcessor).Times(1)
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: You cannot pre-approve your own leave request",
+		},
+		{
+			name:           "Not Found - Unknown Employee",
+			callerClaims:   hrClaims,
+			accountIDParam: employeeID,
+			requestBody:    validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().ApplyForLeaveOnBehalf(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrAccountNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Applicant account not found",
+		},
+		{
+			name:           "Bad Request - Insufficient Balance",
+			callerClaims:   hrClaims,
+			accountIDParam: employeeID,
+			requestBody:    validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().ApplyForLeaveOnBehalf(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrInsufficientLeaveBalance).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInsufficientLeaveBalance.Error(),
+		},
+		{
+			name:           "Internal Server Error - Submitted But Not Pre-approved",
+			callerClaims:   hrClaims,
+			accountIDParam: employeeID,
+			requestBody:    validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				pending := *created
+				pending.Status = models.LeaveStatusPending
+				mockSvc.EXPECT().ApplyForLeaveOnBehalf(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(&pending, fmt.Errorf("%w: db error", services.ErrLeavePreApprovalFailed)).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Leave request submitted but could not be pre-approved",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewApplyLeaveOnBehalfHandler(mockSvc, 1)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/hr/employees/"+tc.accountIDParam+"/leave-requests", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "account_id", Value: tc.accountIDParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ApplyLeaveOnBehalf(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int             `json:"code"`
+				Message string          `json:"message"`
+				Data    json.RawMessage `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+			switch tc.expectedStatusCode {
+			case http.StatusCreated:
+				var dto LeaveRequestStatusDTO
+				require.NoError(t, json.Unmarshal(resp.Data, &dto))
+				assert.Equal(t, leaveID, dto.ID)
+				assert.Equal(t, models.LeaveStatusApproved, dto.Status)
+				require.NotNil(t, dto.CreatedByID)
+				assert.Equal(t, createdBy, *dto.CreatedByID)
+			case http.StatusInternalServerError:
+				assert.Contains(t, string(resp.Data), leaveID.String(), "the submitted request ID is returned")
+			}
+		})
+	}
+}
@@ -1,40 +1,54 @@
 package handlers
 
 import (
-	"errors" 
+	"errors"
+	"fmt"
 	"log"
 	"net/http"
-	"time" 
+	"time"
 
 	"github.com/erinchen11/hr-system/internal/interfaces"
-	"github.com/erinchen11/hr-system/internal/models" 
+	"github.com/erinchen11/hr-system/internal/models"
 	common "github.com/erinchen11/hr-system/internal/models/common"
-	"github.com/erinchen11/hr-system/internal/services" 
+	"github.com/erinchen11/hr-system/internal/services"
 	"github.com/gin-gonic/gin"
-	
+	"github.com/gin-gonic/gin/binding"
 )
 
 // ApplyLeaveHandler 包含依賴
 type ApplyLeaveHandler struct {
-	leaveRequestSvc interfaces.LeaveRequestService 
+	leaveRequestSvc    interfaces.LeaveRequestService
+	maxAttachmentBytes int64 // 單一附件的大小上限, 在讀入記憶體前檢查
 }
 
-// NewApplyLeaveHandler 構造函數
-func NewApplyLeaveHandler(leaveRequestSvc interfaces.LeaveRequestService) *ApplyLeaveHandler {
-	return &ApplyLeaveHandler{leaveRequestSvc: leaveRequestSvc}
+// NewApplyLeaveHandler 構造函數, maxAttachmentSizeMB 非正數時使用預設上限
+func NewApplyLeaveHandler(leaveRequestSvc interfaces.LeaveRequestService, maxAttachmentSizeMB int) *ApplyLeaveHandler {
+	return &ApplyLeaveHandler{leaveRequestSvc: leaveRequestSvc, maxAttachmentBytes: attachmentSizeLimit(maxAttachmentSizeMB)}
 }
 
-// ApplyLeaveRequest 請求體結構
+// ApplyLeaveRequest 請求體結構 (JSON，或附帶佐證文件時使用 multipart/form-data)
 type ApplyLeaveRequest struct {
-	StartDate string `json:"start_date" binding:"required,datetime=2006-01-02"` 
-	EndDate   string `json:"end_date" binding:"required,datetime=2006-01-02"`
-	LeaveType string `json:"leave_type" binding:"required"`
-	Reason    string `json:"reason"` // 允許 reason 為空
+	StartDate string `json:"start_date" form:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate   string `json:"end_date" form:"end_date" binding:"required,datetime=2006-01-02"`
+	LeaveType string `json:"leave_type" form:"leave_type" binding:"required"`
+	Reason    string `json:"reason" form:"reason"` // 允許 reason 為空
+
+	// 時長單位, 未提供時為整天; 半天與小時假需起訖同一天
+	DurationUnit string `json:"duration_unit" form:"duration_unit" binding:"omitempty,oneof=full_day half_day_am half_day_pm hours"`
+	StartTime    string `json:"start_time" form:"start_time" binding:"omitempty,datetime=15:04"` // 僅 hours 單位使用
+	EndTime      string `json:"end_time" form:"end_time" binding:"omitempty,datetime=15:04"`     // 僅 hours 單位使用
+
+	// 違反封鎖期間或人力規則時的覆寫理由, 僅 HR / Super Admin 可填寫
+	OverrideJustification string `json:"override_justification" form:"override_justification"`
 }
 
+// applyLeaveAttachmentField multipart 請求中佐證文件的欄位名稱 (可重複)
+const applyLeaveAttachmentField = "attachments"
+
 // ApplyLeave 方法處理員工提交請假申請的 HTTP 請求
+// 任何已登入的帳戶 (含 HR / Super Admin) 都可以替自己請假，審核一律由其他人進行
 func (h *ApplyLeaveHandler) ApplyLeave(c *gin.Context) {
-	// 1. 授權檢查: 確保是普通員工 (Role 2)
+	// 1. 授權檢查: 確保已登入
 	claimsRaw, exists := c.Get("claims")
 	if !exists {
 		log.Println("ApplyLeave: Claims not found in context")
@@ -47,59 +61,30 @@ func (h *ApplyLeaveHandler) ApplyLeave(c *gin.Context) {
 		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
 		return
 	}
-
-	// 檢查角色是否為 Employee (Role 2)
-	// 注意：如果 HR 或 SuperAdmin 也被允許 "替自己" 請假，這裡的邏輯需要調整
-	if claims.Role != models.RoleEmployee {
-		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only employees can apply for leave"})
-		return
-	}
-	// 從 Context 獲取申請人 (員工) 的 Account ID String
+	// 從 Context 獲取申請人的 Account ID String
 	accountIDStr := claims.UserID
 
-	// 2. 解析請求體
+	// 2. 解析請求體; multipart 請求另讀取佐證文件
 	var req ApplyLeaveRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
+	attachments, err := bindLeaveApplication(c, &req, h.maxAttachmentBytes)
+	if err != nil {
+		if isUploadTooLarge(err) {
+			writeUploadTooLarge(c, h.maxAttachmentBytes)
+			return
+		}
 		c.JSON(http.StatusBadRequest, common.Response{
 			Code:    http.StatusBadRequest,
-			Message: "Invalid request format: " + err.Error(), 
+			Message: "Invalid request format: " + err.Error(),
 		})
 		return
 	}
 
-	// 3. 將日期字串轉換為 time.Time
-	startDate, _ := time.Parse("2006-01-02", req.StartDate)
-	endDate, _ := time.Parse("2006-01-02", req.EndDate)
+	// 3. 調用 Service 層處理請假申請邏輯
+	leaveRequest, err := h.leaveRequestSvc.ApplyForLeave(c.Request.Context(), accountIDStr, req.toInput(attachments))
 
-	// 4. 調用 Service 層處理請假申請邏輯
-	_, err := h.leaveRequestSvc.ApplyForLeave(
-		c.Request.Context(),
-		accountIDStr,
-		req.LeaveType,
-		req.Reason,
-		startDate,
-		endDate,
-	)
-
-	// 5. 處理 Service 層返回的錯誤
+	// 4. 處理 Service 層返回的錯誤
 	if err != nil {
-		switch {
-		case errors.Is(err, services.ErrInvalidDateRange):
-			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
-		// *** 新增: 處理帳戶未找到的錯誤 ***
-		case errors.Is(err, services.ErrAccountNotFound):
-			// 這表示 context 中的 UserID 無效或對應的帳戶不存在
-			log.Printf("Error applying leave: Applicant account %s not found: %v", accountIDStr, err)
-			// 可以返回 404 或 400，這裡用 404
-			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Applicant account not found"})
-		case errors.Is(err, services.ErrLeaveApplyFailed):
-			log.Printf("Internal error applying leave for user %s: %v", accountIDStr, err)
-			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to submit leave application"})
-		default:
-			// 處理其他可能的 Service 層錯誤或 Repository 層錯誤
-			log.Printf("Unexpected error applying leave for user %s: %v", accountIDStr, err)
-			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
-		}
+		writeApplyLeaveError(c, err, leaveRequest, accountIDStr)
 		return
 	}
 
@@ -108,3 +93,101 @@ func (h *ApplyLeaveHandler) ApplyLeave(c *gin.Context) {
 		Message: "Leave application submitted successfully",
 	})
 }
+
+// bindLeaveApplication 解析請假申請的請求體 (JSON 或 multipart/form-data)，multipart 請求另讀取佐證文件
+// 請求體以附件數量與單一附件上限限制大小，超過時返回的錯誤可由 isUploadTooLarge 判斷
+func bindLeaveApplication(c *gin.Context, req interface{}, maxAttachmentBytes int64) ([]models.AttachmentUpload, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxAttachmentBytes*models.MaxAttachmentsPerApplication+attachmentFormOverhead)
+	if c.ContentType() != binding.MIMEMultipartPOSTForm {
+		return nil, c.ShouldBindJSON(req)
+	}
+	if err := c.ShouldBindWith(req, binding.FormMultipart); err != nil {
+		return nil, err
+	}
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil, err
+	}
+	fileHeaders := form.File[applyLeaveAttachmentField]
+	if len(fileHeaders) > models.MaxAttachmentsPerApplication {
+		return nil, fmt.Errorf("at most %d attachments can be submitted with an application", models.MaxAttachmentsPerApplication)
+	}
+	var attachments []models.AttachmentUpload
+	for _, fileHeader := range fileHeaders {
+		upload, err := readAttachmentUpload(fileHeader, maxAttachmentBytes)
+		if errors.Is(err, errUploadTooLarge) {
+			return nil, err
+		}
+		if err != nil {
+			log.Printf("Error reading uploaded attachment %s: %v", fileHeader.Filename, err)
+			return nil, errors.New("unable to read uploaded file")
+		}
+		attachments = append(attachments, upload)
+	}
+	return attachments, nil
+}
+
+// toInput 將請求體轉換為 Service 層的申請內容 (日期格式已由 binding 驗證)
+func (req ApplyLeaveRequest) toInput(attachments []models.AttachmentUpload) models.LeaveRequestInput {
+	startDate, _ := time.Parse("2006-01-02", req.StartDate)
+	endDate, _ := time.Parse("2006-01-02", req.EndDate)
+	return models.LeaveRequestInput{
+		LeaveType:    req.LeaveType,
+		Reason:       req.Reason,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		DurationUnit: req.DurationUnit,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Attachments:  attachments,
+
+		OverrideJustification: req.OverrideJustification,
+	}
+}
+
+// writeApplyLeaveError 將提交假單時 Service 層返回的錯誤轉換為 HTTP 響應
+// leaveRequest 不為 nil 表示假單已建立，只有後續步驟 (例如儲存附件) 失敗
+func writeApplyLeaveError(c *gin.Context, err error, leaveRequest *models.LeaveRequest, accountIDStr string) {
+	var violationErr *services.LeaveRuleViolationError
+	switch {
+	case errors.As(err, &violationErr):
+		// 封鎖期間或最少在班人數規則: 返回違反的規則讓客戶端顯示
+		c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: err.Error(), Data: violationErr.Violation})
+	case errors.Is(err, services.ErrRuleOverrideNotAllowed):
+		c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: err.Error()})
+	case errors.Is(err, services.ErrInvalidDateRange):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+	case errors.Is(err, services.ErrUnknownLeaveType), errors.Is(err, services.ErrLeaveTypeInactive),
+		errors.Is(err, services.ErrLeaveTypeMaxDaysExceeded):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+	case errors.Is(err, services.ErrLeaveTypeNotEligible):
+		c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: err.Error()})
+	case errors.Is(err, services.ErrInsufficientLeaveBalance), errors.Is(err, services.ErrNoWorkingDaysInRange),
+		errors.Is(err, services.ErrInvalidLeaveDuration):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+	case errors.Is(err, services.ErrAttachmentRequired), errors.Is(err, services.ErrAttachmentTooLarge),
+		errors.Is(err, services.ErrUnsupportedAttachmentType):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+	case errors.Is(err, services.ErrAttachmentSaveFailed) && leaveRequest != nil:
+		// 假單已提交，僅附件儲存失敗; 返回假單 ID 讓客戶端改用附件上傳 API 重試
+		log.Printf("Leave request %s submitted but attachment could not be saved: %v", leaveRequest.ID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{
+			Code:    http.StatusInternalServerError,
+			Message: "Leave application submitted but the attachment could not be saved, please upload it again",
+			Data:    gin.H{"leave_request_id": leaveRequest.ID},
+		})
+	case errors.Is(err, services.ErrOverlappingLeave):
+		c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: err.Error()})
+	case errors.Is(err, services.ErrAccountNotFound):
+		// 這表示申請人帳戶不存在
+		log.Printf("Error applying leave: Applicant account %s not found: %v", accountIDStr, err)
+		c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Applicant account not found"})
+	case errors.Is(err, services.ErrLeaveApplyFailed):
+		log.Printf("Internal error applying leave for user %s: %v", accountIDStr, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to submit leave application"})
+	default:
+		// 處理其他可能的 Service 層錯誤或 Repository 層錯誤
+		log.Printf("Unexpected error applying leave for user %s: %v", accountIDStr, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
+	}
+}
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"errors"
+	"io"
 	"log"
 	"net/http"
 
@@ -16,6 +17,20 @@ type ApproveLeaveRequestHandler struct {
 	leaveRequestSvc interfaces.LeaveRequestService 
 }
 
+// ApproveLeaveRequestRequest 請求體 (可選)
+// 假單違反封鎖期間或人力規則時，HR 可附上覆寫理由強制核准
+type ApproveLeaveRequestRequest struct {
+	OverrideJustification string `json:"override_justification"`
+}
+
+// errOverrideForbidden 是非 HR 審核人附上覆寫理由時返回的訊息
+const errOverrideForbidden = "Permission denied: Only HR or Super Admin can override leave rules"
+
+// canOverrideLeaveRules 只有 HR / Super Admin 可附上覆寫理由核准違反規則的假單 (Service 層仍會再以帳戶角色確認)
+func canOverrideLeaveRules(claims *models.Claims) bool {
+	return claims.Role == models.RoleHR || claims.Role == models.RoleSuperAdmin
+}
+
 // NewApproveLeaveRequestHandler 構造函數
 func NewApproveLeaveRequestHandler(leaveRequestSvc interfaces.LeaveRequestService) *ApproveLeaveRequestHandler {
 	return &ApproveLeaveRequestHandler{leaveRequestSvc: leaveRequestSvc}
@@ -50,16 +65,46 @@ func (h *ApproveLeaveRequestHandler) ApproveLeaveRequest(c *gin.Context) {
 		return
 	}
 
-	// 3. 調用 Service 層處理批准邏輯
-	err := h.leaveRequestSvc.ApproveRequest(c.Request.Context(), leaveRequestIDStr, approverIDStr)
+	// 3. 解析請求體獲取覆寫理由 (可選)
+	var req ApproveLeaveRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request body format: " + err.Error()})
+		return
+	}
+	if req.OverrideJustification != "" && !canOverrideLeaveRules(claims) {
+		c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: errOverrideForbidden})
+		return
+	}
+
+	// 4. 調用 Service 層處理批准邏輯
+	var err error
+	if req.OverrideJustification != "" {
+		err = h.leaveRequestSvc.ApproveRequestWithOverride(c.Request.Context(), leaveRequestIDStr, approverIDStr, req.OverrideJustification)
+	} else {
+		err = h.leaveRequestSvc.ApproveRequest(c.Request.Context(), leaveRequestIDStr, approverIDStr)
+	}
 
-	// 4. 處理 Service 層返回的錯誤
+	// 5. 處理 Service 層返回的錯誤
 	if err != nil {
+		var violationErr *services.LeaveRuleViolationError
 		switch {
+		case errors.As(err, &violationErr):
+			// 返回違反的規則; HR 可附上 override_justification 重新送出
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (" + violationErr.Violation.Message + ")", Data: violationErr.Violation})
+		case errors.Is(err, services.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrRuleOverrideNotAllowed):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: err.Error()})
 		case errors.Is(err, services.ErrLeaveRequestNotFound):
 			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
 		case errors.Is(err, services.ErrInvalidLeaveRequestState):
 			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be approved (state is not pending)"})
+		case errors.Is(err, services.ErrInsufficientLeaveBalance):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be approved (insufficient leave balance)"})
+		case errors.Is(err, services.ErrOverlappingLeave):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (overlaps with an approved leave)"})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"})
 		case errors.Is(err, services.ErrLeaveRequestUpdateFailed):
 			log.Printf("Internal error approving leave request %s: %v", leaveRequestIDStr, err)
 			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request status"})
@@ -70,7 +115,7 @@ func (h *ApproveLeaveRequestHandler) ApproveLeaveRequest(c *gin.Context) {
 		return
 	}
 
-	// 5. 返回成功響應
+	// 6. 返回成功響應
 	c.JSON(http.StatusOK, common.Response{
 		Code:    http.StatusOK, // 成功用 200 OK
 		Message: "Leave request approved successfully",
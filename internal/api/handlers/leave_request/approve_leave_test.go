@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing" 
 
 	"github.com/erinchen11/hr-system/internal/interfaces/mocks" 
@@ -33,9 +34,11 @@ func TestApproveLeaveRequestHandler_ApproveLeaveRequest(t *testing.T) {
 		name             string
 		claimsToSet      interface{} // 模擬 Context 中的 claims
 		leaveIDParam     string      // 模擬 URL 中的 id 參數
+		body             string      // 可選的請求體
 		setupMocks       func(leaveSvc *mocks.MockLeaveRequestService)
 		expectedStatus   int
 		expectedResponse common.Response
+		expectViolation  bool // Data 是否應包含違反的規則
 	}{
 		{
 			name:         "Success - HR Approves Request",
@@ -94,6 +97,68 @@ func TestApproveLeaveRequestHandler_ApproveLeaveRequest(t *testing.T) {
 			expectedStatus:   http.StatusBadRequest,
 			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be approved (state is not pending)"},
 		},
+		{
+			name:         "Bad Request - Service Returns Insufficient Balance Error",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, hrUserID).Return(services.ErrInsufficientLeaveBalance).Times(1)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be approved (insufficient leave balance)"},
+		},
+		{
+			name:         "Conflict - Service Returns Overlapping Leave Error",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, hrUserID).Return(services.ErrOverlappingLeave).Times(1)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (overlaps with an approved leave)"},
+		},
+		{
+			name:         "Conflict - Service Returns Leave Rule Violation",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				violation := models.LeaveRuleViolation{Rule: models.LeaveRuleBlackout, RuleName: "Q2 close", Message: "leave is not allowed during blackout period Q2 close"}
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, hrUserID).Return(&services.LeaveRuleViolationError{Violation: violation}).Times(1)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (leave is not allowed during blackout period Q2 close)"},
+			expectViolation:  true,
+		},
+		{
+			name:         "Success - HR Overrides Leave Rule",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			body:         `{"override_justification":"cover arranged with the other team"}`,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequestWithOverride(gomock.Any(), testLeaveID, hrUserID, "cover arranged with the other team").Return(nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request approved successfully"},
+		},
+		{
+			name:         "Forbidden - Service Rejects Override",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			body:         `{"override_justification":"cover arranged"}`,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequestWithOverride(gomock.Any(), testLeaveID, hrUserID, "cover arranged").Return(services.ErrRuleOverrideNotAllowed).Times(1)
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: common.Response{Code: http.StatusForbidden, Message: services.ErrRuleOverrideNotAllowed.Error()},
+		},
+		{
+			name:             "Bad Request - Malformed Body",
+			claimsToSet:      hrClaims,
+			leaveIDParam:     testLeaveID,
+			body:             `{"override_justification":`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Invalid request body format: unexpected EOF"},
+		},
 		{
 			name:         "Internal Server Error - Service Update Failed",
 			claimsToSet:  hrClaims,
@@ -140,7 +205,10 @@ func TestApproveLeaveRequestHandler_ApproveLeaveRequest(t *testing.T) {
 
 			// 模擬請求 (方法 POST，URL 包含參數，無 Body)
 			// 注意: URL 需要匹配你在 router.go 中定義的實際路徑格式
-			req, _ := http.NewRequest(http.MethodPost, "/hr/leave-requests/"+tc.leaveIDParam+"/approve", nil)
+			req, _ := http.NewRequest(http.MethodPost, "/hr/leave-requests/"+tc.leaveIDParam+"/approve", strings.NewReader(tc.body))
+			if tc.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
 			c.Request = req
 
 			// *** 模擬 Context (設置 claims 和 user_id) ***
@@ -172,8 +240,14 @@ func TestApproveLeaveRequestHandler_ApproveLeaveRequest(t *testing.T) {
 
 			assert.Equal(t, tc.expectedResponse.Code, actualResponse.Code, "Response code mismatch")
 			assert.Equal(t, tc.expectedResponse.Message, actualResponse.Message, "Response message mismatch")
-			// 這個 API 通常不返回 Data，可以確認一下
-			assert.Nil(t, actualResponse.Data)
+			// 這個 API 通常不返回 Data，只有違反請假規則時返回規則內容
+			if tc.expectViolation {
+				data, ok := actualResponse.Data.(map[string]interface{})
+				require.True(t, ok, "violation should be returned in data")
+				assert.Equal(t, models.LeaveRuleBlackout, data["rule"])
+			} else {
+				assert.Nil(t, actualResponse.Data)
+			}
 		})
 	}
 }
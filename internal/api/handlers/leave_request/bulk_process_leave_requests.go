@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// BulkProcessLeaveRequestsHandler 包含依賴
+type BulkProcessLeaveRequestsHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewBulkProcessLeaveRequestsHandler 構造函數
+func NewBulkProcessLeaveRequestsHandler(leaveRequestSvc interfaces.LeaveRequestService) *BulkProcessLeaveRequestsHandler {
+	return &BulkProcessLeaveRequestsHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// BulkProcessLeaveRequestsRequest 請求體
+type BulkProcessLeaveRequestsRequest struct {
+	LeaveRequestIDs []string `json:"leave_request_ids" binding:"required,min=1,max=100"`
+	Decision        string   `json:"decision" binding:"required,oneof=approve reject"`
+	Reason          string   `json:"reason"` // 僅在 reject 時使用
+}
+
+// BulkProcessLeaveRequestsResponse 批次審核的彙總結果
+type BulkProcessLeaveRequestsResponse struct {
+	Succeeded int                              `json:"succeeded"`
+	Failed    int                              `json:"failed"`
+	Results   []models.BulkLeaveDecisionResult `json:"results"`
+}
+
+// BulkProcessLeaveRequests 方法處理 HR 一次核准或拒絕多張假單的 HTTP 請求
+// 每張假單各自套用單張審核的規則，部分失敗仍返回 200 並附上每張假單的結果
+func (h *BulkProcessLeaveRequestsHandler) BulkProcessLeaveRequests(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can process leave requests"})
+		return
+	}
+
+	// 2. 綁定並驗證請求體
+	var req BulkProcessLeaveRequestsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request body: " + err.Error()})
+		return
+	}
+
+	// 3. 調用 Service 層
+	results, err := h.leaveRequestSvc.BulkProcessRequests(c.Request.Context(), req.LeaveRequestIDs, claims.UserID, req.Decision, req.Reason)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+			return
+		}
+		log.Printf("Error bulk processing leave requests via service: %v", err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to process leave requests"})
+		return
+	}
+
+	// 4. 彙總結果
+	resp := BulkProcessLeaveRequestsResponse{Results: results}
+	for _, r := range results {
+		if r.Result == models.BulkLeaveResultSucceeded {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Bulk processing completed", Data: resp})
+}
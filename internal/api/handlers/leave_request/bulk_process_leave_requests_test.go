@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkProcessLeaveRequestsHandler_BulkProcessLeaveRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	firstID := uuid.New().String()
+	secondID := uuid.New().String()
+	validBody := `{"leave_request_ids": ["` + firstID + `", "` + secondID + `"], "decision": "reject", "reason": "Year-end freeze"}`
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockLeaveRequestService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedSucceeded  int
+		expectedFailed     int
+	}{
+		{
+			name:         "Success - Partial failure still returns per-item results",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().BulkProcessRequests(gomock.Any(), []string{firstID, secondID}, hrClaims.UserID, models.LeaveDecisionReject, "Year-end freeze").
+					Return([]models.BulkLeaveDecisionResult{
+						{LeaveRequestID: firstID, Result: models.BulkLeaveResultSucceeded},
+						{LeaveRequestID: secondID, Result: models.BulkLeaveResultInvalidState, Message: "invalid state"},
+					}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Bulk processing completed",
+			expectedSucceeded:  1,
+			expectedFailed:     1,
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can process leave requests",
+		},
+		{
+			name:               "Bad Request - Unknown Decision",
+			callerClaims:       hrClaims,
+			requestBody:        `{"leave_request_ids": ["` + firstID + `"], "decision": "defer"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request body: ",
+		},
+		{
+			name:               "Bad Request - Empty ID List",
+			callerClaims:       hrClaims,
+			requestBody:        `{"leave_request_ids": [], "decision": "approve"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request body: ",
+		},
+		{
+			name:         "Bad Request - Service Rejects Input",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().BulkProcessRequests(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, services.ErrInvalidInput).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInvalidInput.Error(),
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().BulkProcessRequests(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("unexpected")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to process leave requests",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewBulkProcessLeaveRequestsHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/hr/leave-requests/bulk", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.BulkProcessLeaveRequests(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int                               `json:"code"`
+				Message string                            `json:"message"`
+				Data    *BulkProcessLeaveRequestsResponse `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.NotNil(t, resp.Data)
+				assert.Equal(t, tc.expectedSucceeded, resp.Data.Succeeded)
+				assert.Equal(t, tc.expectedFailed, resp.Data.Failed)
+				assert.Len(t, resp.Data.Results, tc.expectedSucceeded+tc.expectedFailed)
+			}
+		})
+	}
+}
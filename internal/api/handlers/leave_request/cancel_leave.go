@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// CancelLeaveRequestHandler 包含依賴
+type CancelLeaveRequestHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewCancelLeaveRequestHandler 構造函數
+func NewCancelLeaveRequestHandler(leaveRequestSvc interfaces.LeaveRequestService) *CancelLeaveRequestHandler {
+	return &CancelLeaveRequestHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// CancelLeaveResponse 定義取消後返回的假單狀態
+type CancelLeaveResponse struct {
+	ID      uuid.UUID `json:"id"`
+	Status  string    `json:"status"`
+	Version int       `json:"version"`
+}
+
+// CancelLeaveRequest 方法處理員工取消自己假單的 HTTP 請求
+func (h *CancelLeaveRequestHandler) CancelLeaveRequest(c *gin.Context) {
+	// 1. 授權檢查: 確保已登入 (HR / Super Admin 也可以管理自己的假單)
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		log.Println("CancelLeaveRequest: Claims not found in context")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假單 ID
+	leaveRequestIDStr := c.Param("id")
+	if leaveRequestIDStr == "" {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層處理取消邏輯
+	request, err := h.leaveRequestSvc.CancelRequest(c.Request.Context(), leaveRequestIDStr, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrNotLeaveRequestOwner):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only cancel your own leave requests"})
+		case errors.Is(err, services.ErrLeaveAlreadyStarted):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrInvalidLeaveRequestState):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be cancelled in its current state"})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"})
+		case errors.Is(err, services.ErrLeaveRequestUpdateFailed):
+			log.Printf("Internal error cancelling leave request %s: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request status"})
+		default:
+			log.Printf("Unexpected error cancelling leave request %s: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
+		}
+		return
+	}
+
+	// 4. 返回成功響應 (依結果狀態給出不同訊息)
+	message := "Leave request cancelled successfully"
+	if request.Status == models.LeaveStatusCancellationRequested {
+		message = "Cancellation requested, awaiting HR confirmation"
+	}
+	c.Header("ETag", middleware.ETag(request.Version))
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: message,
+		Data:    CancelLeaveResponse{ID: request.ID, Status: request.Status, Version: request.Version},
+	})
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelLeaveRequestHandler_CancelLeaveRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	employeeUserID := uuid.New().String()
+	employeeClaims := &models.Claims{UserID: employeeUserID, Email: "emp@example.com", Role: models.RoleEmployee}
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Email: "hr@example.com", Role: models.RoleHR}
+
+	leaveID := uuid.New()
+	testLeaveID := leaveID.String()
+
+	testCases := []struct {
+		name             string
+		claimsToSet      interface{}
+		leaveIDParam     string
+		setupMocks       func(leaveSvc *mocks.MockLeaveRequestService)
+		expectedStatus   int
+		expectedResponse common.Response
+		expectedData     *CancelLeaveResponse
+	}{
+		{
+			name:         "Success - Pending Request Cancelled",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, employeeUserID).
+					Return(&models.LeaveRequest{ID: leaveID, Status: models.LeaveStatusCancelled}, nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request cancelled successfully"},
+			expectedData:     &CancelLeaveResponse{ID: leaveID, Status: models.LeaveStatusCancelled},
+		},
+		{
+			name:         "Success - Approved Request Awaits HR Confirmation",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, employeeUserID).
+					Return(&models.LeaveRequest{ID: leaveID, Status: models.LeaveStatusCancellationRequested}, nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Cancellation requested, awaiting HR confirmation"},
+			expectedData:     &CancelLeaveResponse{ID: leaveID, Status: models.LeaveStatusCancellationRequested},
+		},
+		{
+			name:             "Unauthorized - Missing Claims",
+			claimsToSet:      nil,
+			leaveIDParam:     testLeaveID,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"},
+		},
+		{
+			name:         "Success - HR Cancels Own Request",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, hrClaims.UserID).
+					Return(&models.LeaveRequest{ID: leaveID, Status: models.LeaveStatusCancelled}, nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request cancelled successfully"},
+			expectedData:     &CancelLeaveResponse{ID: leaveID, Status: models.LeaveStatusCancelled},
+		},
+		{
+			name:             "Bad Request - Missing Leave ID Param",
+			claimsToSet:      employeeClaims,
+			leaveIDParam:     "",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"},
+		},
+		{
+			name:         "Not Found - Service Returns Not Found Error",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, employeeUserID).Return(nil, services.ErrLeaveRequestNotFound).Times(1)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: common.Response{Code: http.StatusNotFound, Message: "Leave request not found"},
+		},
+		{
+			name:         "Forbidden - Not Owner",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, employeeUserID).Return(nil, services.ErrNotLeaveRequestOwner).Times(1)
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only cancel your own leave requests"},
+		},
+		{
+			name:         "Bad Request - Leave Already Started",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, employeeUserID).Return(nil, services.ErrLeaveAlreadyStarted).Times(1)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: services.ErrLeaveAlreadyStarted.Error()},
+		},
+		{
+			name:         "Bad Request - Invalid State",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, employeeUserID).Return(nil, services.ErrInvalidLeaveRequestState).Times(1)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be cancelled in its current state"},
+		},
+		{
+			name:         "Internal Server Error - Update Failed",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, employeeUserID).Return(nil, services.ErrLeaveRequestUpdateFailed).Times(1)
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request status"},
+		},
+		{
+			name:         "Internal Server Error - Unexpected Service Error",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().CancelRequest(gomock.Any(), testLeaveID, employeeUserID).Return(nil, errors.New("boom")).Times(1)
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockLeaveReqSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewCancelLeaveRequestHandler(mockLeaveReqSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveReqSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/employee/leave-requests/"+tc.leaveIDParam+"/cancel", nil)
+			c.Request = req
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+			if tc.leaveIDParam != "" {
+				c.Params = gin.Params{gin.Param{Key: "id", Value: tc.leaveIDParam}}
+			}
+
+			handler.CancelLeaveRequest(c)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			var actualResponse struct {
+				Code    int                  `json:"code"`
+				Message string               `json:"message"`
+				Data    *CancelLeaveResponse `json:"data"`
+			}
+			err := json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+			require.NoError(t, err, "Response body should be valid JSON")
+
+			assert.Equal(t, tc.expectedResponse.Code, actualResponse.Code, "Response code mismatch")
+			assert.Equal(t, tc.expectedResponse.Message, actualResponse.Message, "Response message mismatch")
+			assert.Equal(t, tc.expectedData, actualResponse.Data)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ConfirmLeaveCancellationHandler 包含依賴
+type ConfirmLeaveCancellationHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewConfirmLeaveCancellationHandler 構造函數
+func NewConfirmLeaveCancellationHandler(leaveRequestSvc interfaces.LeaveRequestService) *ConfirmLeaveCancellationHandler {
+	return &ConfirmLeaveCancellationHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// ConfirmLeaveCancellation 方法處理HR 確認取消已核准假單的 HTTP 請求
+func (h *ConfirmLeaveCancellationHandler) ConfirmLeaveCancellation(c *gin.Context) {
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 檢查角色是否為 HR 或 Super Admin
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR can process leave cancellations"})
+		return
+	}
+
+	// 從 URL 路徑參數獲取假單 ID
+	leaveRequestIDStr := c.Param("id")
+	if leaveRequestIDStr == "" {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"})
+		return
+	}
+
+	err := h.leaveRequestSvc.ConfirmCancellation(c.Request.Context(), leaveRequestIDStr, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrInvalidLeaveRequestState):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request has no pending cancellation"})
+		case errors.Is(err, services.ErrInvalidProcessor):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR can process leave cancellations"})
+		case errors.Is(err, services.ErrLeaveBalanceUpdateFailed):
+			log.Printf("Leave request %s cancelled but balance was not restored: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Leave request cancelled but failed to restore leave balance"})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"})
+		case errors.Is(err, services.ErrLeaveRequestUpdateFailed):
+			log.Printf("Internal error processing cancellation of leave request %s: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request status"})
+		default:
+			log.Printf("Unexpected error processing cancellation of leave request %s: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Leave cancellation confirmed successfully",
+	})
+}
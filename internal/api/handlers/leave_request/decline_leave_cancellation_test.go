@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeclineLeaveCancellationHandler_DeclineLeaveCancellation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrUserID := uuid.New().String()
+	hrClaims := &models.Claims{UserID: hrUserID, Email: "hr@example.com", Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Email: "emp@example.com", Role: models.RoleEmployee}
+
+	testLeaveID := uuid.New().String()
+
+	testCases := []struct {
+		name             string
+		claimsToSet      interface{}
+		leaveIDParam     string
+		setupMocks       func(leaveSvc *mocks.MockLeaveRequestService)
+		expectedStatus   int
+		expectedResponse common.Response
+	}{
+		{
+			name:         "Success",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().DeclineCancellation(gomock.Any(), testLeaveID, hrUserID).Return(nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave cancellation declined, request remains approved"},
+		},
+		{
+			name:             "Unauthorized - Missing Claims",
+			claimsToSet:      nil,
+			leaveIDParam:     testLeaveID,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"},
+		},
+		{
+			name:             "Forbidden - Employee Role",
+			claimsToSet:      employeeClaims,
+			leaveIDParam:     testLeaveID,
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR can process leave cancellations"},
+		},
+		{
+			name:             "Bad Request - Missing Leave ID Param",
+			claimsToSet:      hrClaims,
+			leaveIDParam:     "",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"},
+		},
+		{
+			name:         "Not Found - Service Returns Not Found Error",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().DeclineCancellation(gomock.Any(), testLeaveID, hrUserID).Return(services.ErrLeaveRequestNotFound).Times(1)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: common.Response{Code: http.StatusNotFound, Message: "Leave request not found"},
+		},
+		{
+			name:         "Bad Request - No Pending Cancellation",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().DeclineCancellation(gomock.Any(), testLeaveID, hrUserID).Return(services.ErrInvalidLeaveRequestState).Times(1)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Leave request has no pending cancellation"},
+		},
+		{
+			name:         "Internal Server Error - Update Failed",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().DeclineCancellation(gomock.Any(), testLeaveID, hrUserID).Return(services.ErrLeaveRequestUpdateFailed).Times(1)
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request status"},
+		},
+		{
+			name:         "Internal Server Error - Unexpected Service Error",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().DeclineCancellation(gomock.Any(), testLeaveID, hrUserID).Return(errors.New("boom")).Times(1)
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockLeaveReqSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewDeclineLeaveCancellationHandler(mockLeaveReqSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveReqSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/hr/leave-requests/"+tc.leaveIDParam+"/cancellation/decline", nil)
+			c.Request = req
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+			if tc.leaveIDParam != "" {
+				c.Params = gin.Params{gin.Param{Key: "id", Value: tc.leaveIDParam}}
+			}
+
+			handler.DeclineLeaveCancellation(c)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			var actualResponse common.Response
+			err := json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+			require.NoError(t, err, "Response body should be valid JSON")
+
+			assert.Equal(t, tc.expectedResponse.Code, actualResponse.Code, "Response code mismatch")
+			assert.Equal(t, tc.expectedResponse.Message, actualResponse.Message, "Response message mismatch")
+			assert.Nil(t, actualResponse.Data)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"mime"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DownloadLeaveAttachmentHandler 包含依賴
+type DownloadLeaveAttachmentHandler struct {
+	attachmentSvc interfaces.LeaveAttachmentService
+}
+
+// NewDownloadLeaveAttachmentHandler 構造函數
+func NewDownloadLeaveAttachmentHandler(attachmentSvc interfaces.LeaveAttachmentService) *DownloadLeaveAttachmentHandler {
+	return &DownloadLeaveAttachmentHandler{attachmentSvc: attachmentSvc}
+}
+
+// DownloadAttachment 方法處理下載假單附件的 HTTP 請求 (申請人本人或 HR / Super Admin)
+func (h *DownloadLeaveAttachmentHandler) DownloadAttachment(c *gin.Context) {
+	// 1. 獲取登入者資訊 (是否可下載由 Service 層判斷)
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假單 ID 與附件 ID
+	leaveRequestIDStr := c.Param("id")
+	if _, err := uuid.Parse(leaveRequestIDStr); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid leave request ID in URL path"})
+		return
+	}
+	attachmentIDStr := c.Param("attachment_id")
+	if _, err := uuid.Parse(attachmentIDStr); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid attachment ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	attachment, content, err := h.attachmentSvc.OpenAttachment(c.Request.Context(), leaveRequestIDStr, attachmentIDStr, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrAttachmentNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Attachment not found"})
+		case errors.Is(err, services.ErrNotLeaveRequestOwner):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only download attachments of your own leave requests or requests awaiting your approval"})
+		default:
+			log.Printf("Error opening attachment %s of leave request %s via service: %v", attachmentIDStr, leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve attachment"})
+		}
+		return
+	}
+	defer content.Close()
+
+	// 4. 以串流方式返回檔案內容
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadLeaveAttachmentHandler_DownloadAttachment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	employeeID := uuid.New().String()
+	employeeClaims := &models.Claims{UserID: employeeID, Role: models.RoleEmployee}
+	leaveID := uuid.New().String()
+	attachmentID := uuid.New().String()
+	content := "%PDF-1.4 medical certificate"
+	attachment := &models.LeaveAttachment{FileName: "醫生證明.pdf", ContentType: "application/pdf", Size: int64(len(content))}
+
+	testCases := []struct {
+		name              string
+		claimsToSet       interface{}
+		leaveIDParam      string
+		attachmentIDParam string
+		setupMocks        func(attachmentSvc *mocks.MockLeaveAttachmentService)
+		expectedStatus    int
+		expectedMessage   string
+	}{
+		{
+			name:              "Success",
+			claimsToSet:       employeeClaims,
+			leaveIDParam:      leaveID,
+			attachmentIDParam: attachmentID,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().OpenAttachment(gomock.Any(), leaveID, attachmentID, employeeID).
+					Return(attachment, io.NopCloser(strings.NewReader(content)), nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:              "Unauthorized - Missing Claims",
+			leaveIDParam:      leaveID,
+			attachmentIDParam: attachmentID,
+			expectedStatus:    http.StatusUnauthorized,
+			expectedMessage:   "Unauthorized: Missing user claims",
+		},
+		{
+			name:              "Bad Request - Invalid Attachment ID",
+			claimsToSet:       employeeClaims,
+			leaveIDParam:      leaveID,
+			attachmentIDParam: "not-a-uuid",
+			expectedStatus:    http.StatusBadRequest,
+			expectedMessage:   "Invalid attachment ID in URL path",
+		},
+		{
+			name:              "Forbidden - Not Owner",
+			claimsToSet:       employeeClaims,
+			leaveIDParam:      leaveID,
+			attachmentIDParam: attachmentID,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().OpenAttachment(gomock.Any(), leaveID, attachmentID, employeeID).Return(nil, nil, services.ErrNotLeaveRequestOwner).Times(1)
+			},
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "Permission denied: You can only download attachments of your own leave requests or requests awaiting your approval",
+		},
+		{
+			name:              "Not Found - Attachment",
+			claimsToSet:       employeeClaims,
+			leaveIDParam:      leaveID,
+			attachmentIDParam: attachmentID,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().OpenAttachment(gomock.Any(), leaveID, attachmentID, employeeID).Return(nil, nil, services.ErrAttachmentNotFound).Times(1)
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "Attachment not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAttachmentSvc := mocks.NewMockLeaveAttachmentService(ctrl)
+			handler := NewDownloadLeaveAttachmentHandler(mockAttachmentSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockAttachmentSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/leave-requests/"+tc.leaveIDParam+"/attachments/"+tc.attachmentIDParam, nil)
+			c.Params = gin.Params{{Key: "id", Value: tc.leaveIDParam}, {Key: "attachment_id", Value: tc.attachmentIDParam}}
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+
+			handler.DownloadAttachment(c)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, content, recorder.Body.String())
+				assert.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+				assert.Contains(t, recorder.Header().Get("Content-Disposition"), "attachment;")
+				assert.Equal(t, "nosniff", recorder.Header().Get("X-Content-Type-Options"))
+				return
+			}
+			var resp struct {
+				Message string `json:"message"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+		})
+	}
+}
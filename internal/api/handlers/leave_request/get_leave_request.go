@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// GetLeaveRequestHandler 包含依賴
+type GetLeaveRequestHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewGetLeaveRequestHandler 構造函數
+func NewGetLeaveRequestHandler(leaveRequestSvc interfaces.LeaveRequestService) *GetLeaveRequestHandler {
+	return &GetLeaveRequestHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// GetLeaveRequest 方法處理查詢單一假單的 HTTP 請求 (申請人本人或 HR / Super Admin)
+// 以 ETag 標頭返回假單版本號, 修改、取消或審核時以 If-Match 帶回
+func (h *GetLeaveRequestHandler) GetLeaveRequest(c *gin.Context) {
+	// 1. 獲取登入者資訊
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假單 ID
+	leaveRequestIDStr := c.Param("id")
+	if _, err := uuid.Parse(leaveRequestIDStr); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid leave request ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	request, err := h.leaveRequestSvc.GetLeaveRequestByID(c.Request.Context(), leaveRequestIDStr)
+	if err != nil {
+		if errors.Is(err, services.ErrLeaveRequestNotFound) {
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+			return
+		}
+		log.Printf("Error fetching leave request %s via service: %v", leaveRequestIDStr, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve leave request"})
+		return
+	}
+
+	// 4. 申請人本人可直接查看，其他人需為 HR / Super Admin
+	if request.AccountID.String() != claims.UserID && claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only view your own leave requests"})
+		return
+	}
+
+	c.Header("ETag", middleware.ETag(request.Version))
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    toLeaveRequestStatusDTO(*request),
+	})
+}
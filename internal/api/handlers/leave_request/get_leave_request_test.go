@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLeaveRequestHandler_GetLeaveRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	ownerID := uuid.New()
+	ownerClaims := &models.Claims{UserID: ownerID.String(), Role: models.RoleEmployee}
+	otherClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	leaveID := uuid.New()
+	request := &models.LeaveRequest{ID: leaveID, AccountID: ownerID, LeaveType: "annual", Status: models.LeaveStatusPending, Version: 3}
+
+	testCases := []struct {
+		name            string
+		claimsToSet     interface{}
+		leaveIDParam    string
+		setupMocks      func(leaveSvc *mocks.MockLeaveRequestService)
+		expectedStatus  int
+		expectedMessage string
+		expectedETag    string
+	}{
+		{
+			name:         "Success - Owner",
+			claimsToSet:  ownerClaims,
+			leaveIDParam: leaveID.String(),
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetLeaveRequestByID(gomock.Any(), leaveID.String()).Return(request, nil).Times(1)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Success",
+			expectedETag:    `"3"`,
+		},
+		{
+			name:         "Success - HR Views Another Employee's Request",
+			claimsToSet:  hrClaims,
+			leaveIDParam: leaveID.String(),
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetLeaveRequestByID(gomock.Any(), leaveID.String()).Return(request, nil).Times(1)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Success",
+			expectedETag:    `"3"`,
+		},
+		{
+			name:            "Unauthorized - Missing Claims",
+			leaveIDParam:    leaveID.String(),
+			expectedStatus:  http.StatusUnauthorized,
+			expectedMessage: "Unauthorized: Missing user claims",
+		},
+		{
+			name:            "Bad Request - Invalid ID",
+			claimsToSet:     ownerClaims,
+			leaveIDParam:    "not-a-uuid",
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "Invalid leave request ID in URL path",
+		},
+		{
+			name:         "Forbidden - Not Owner",
+			claimsToSet:  otherClaims,
+			leaveIDParam: leaveID.String(),
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetLeaveRequestByID(gomock.Any(), leaveID.String()).Return(request, nil).Times(1)
+			},
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "Permission denied: You can only view your own leave requests",
+		},
+		{
+			name:         "Not Found",
+			claimsToSet:  ownerClaims,
+			leaveIDParam: leaveID.String(),
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetLeaveRequestByID(gomock.Any(), leaveID.String()).Return(nil, services.ErrLeaveRequestNotFound).Times(1)
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "Leave request not found",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			claimsToSet:  ownerClaims,
+			leaveIDParam: leaveID.String(),
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetLeaveRequestByID(gomock.Any(), leaveID.String()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "Failed to retrieve leave request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLeaveSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewGetLeaveRequestHandler(mockLeaveSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/leave-requests/"+tc.leaveIDParam, nil)
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tc.leaveIDParam}}
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+
+			handler.GetLeaveRequest(c)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			assert.Equal(t, tc.expectedETag, recorder.Header().Get("ETag"))
+		})
+	}
+}
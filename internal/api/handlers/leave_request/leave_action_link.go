@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// LeaveActionLinkHandler 包含依賴
+// 路由不經過 AuthMiddleware: 連結本身經 HMAC 簽署, 並帶有審核人身分與有效期限
+type LeaveActionLinkHandler struct {
+	actionLinkSvc interfaces.LeaveActionLinkService
+}
+
+// NewLeaveActionLinkHandler 構造函數
+func NewLeaveActionLinkHandler(actionLinkSvc interfaces.LeaveActionLinkService) *LeaveActionLinkHandler {
+	return &LeaveActionLinkHandler{actionLinkSvc: actionLinkSvc}
+}
+
+// ExecuteLeaveActionRequest 定義執行審核連結時的請求體 (可省略)
+type ExecuteLeaveActionRequest struct {
+	Reason string `json:"reason"` // 拒絕原因, 核准時忽略
+}
+
+// LeaveActionLinkDTO 定義返回給客戶端的連結內容
+type LeaveActionLinkDTO struct {
+	LeaveRequestID uuid.UUID `json:"leave_request_id"`
+	Action         string    `json:"action"` // approve 或 reject
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// PreviewLeaveAction 方法處理 GET 請求: 驗證連結並返回內容, 不會核准或拒絕假單
+// Email 安全掃描常會預先開啟信中連結, 因此實際動作只在 POST 時執行
+func (h *LeaveActionLinkHandler) PreviewLeaveAction(c *gin.Context) {
+	token, err := h.actionLinkSvc.InspectLink(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		respondLeaveActionLinkError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Action link is valid; submit a POST request to the same URL to " + token.Action + " the leave request",
+		Data:    toLeaveActionLinkDTO(token),
+	})
+}
+
+// ExecuteLeaveAction 方法處理 POST 請求: 消耗連結並以連結中的審核人身分核准或拒絕假單
+func (h *LeaveActionLinkHandler) ExecuteLeaveAction(c *gin.Context) {
+	// 1. 解析可選的請求體
+	var req ExecuteLeaveActionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+			return
+		}
+	}
+
+	// 2. 調用 Service 層
+	token, err := h.actionLinkSvc.ExecuteLink(c.Request.Context(), c.Param("token"), req.Reason)
+	if err != nil {
+		respondLeaveActionLinkError(c, err)
+		return
+	}
+
+	// 3. 返回成功響應
+	message := "Leave request approved successfully"
+	if token.Action == models.LeaveActionReject {
+		message = "Leave request rejected successfully"
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: message, Data: toLeaveActionLinkDTO(token)})
+}
+
+// respondLeaveActionLinkError 將連結驗證與審核的錯誤轉換為 HTTP 響應
+func respondLeaveActionLinkError(c *gin.Context, err error) {
+	var violationErr *services.LeaveRuleViolationError
+	switch {
+	case errors.Is(err, services.ErrActionLinkInvalid):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid action link"})
+	case errors.Is(err, services.ErrActionLinkExpired):
+		c.JSON(http.StatusGone, common.Response{Code: http.StatusGone, Message: "Action link has expired; please log in to process the leave request"})
+	case errors.Is(err, services.ErrActionLinkUsed):
+		c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Action link has already been used"})
+	case errors.As(err, &violationErr):
+		c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (" + violationErr.Violation.Message + ")", Data: violationErr.Violation})
+	case errors.Is(err, services.ErrLeaveRequestNotFound):
+		c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+	case errors.Is(err, services.ErrInvalidProcessor):
+		c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can no longer process this leave request"})
+	case errors.Is(err, services.ErrInvalidLeaveRequestState):
+		c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request has already been processed"})
+	case errors.Is(err, services.ErrInsufficientLeaveBalance):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be approved (insufficient leave balance)"})
+	case errors.Is(err, services.ErrOverlappingLeave):
+		c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (overlaps with an approved leave)"})
+	case errors.Is(err, services.ErrConcurrentModification):
+		c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"})
+	default:
+		log.Printf("Unexpected error processing leave action link: %v", err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
+	}
+}
+
+// toLeaveActionLinkDTO 將連結內容轉換為 DTO (不返回審核人 ID 與 nonce)
+func toLeaveActionLinkDTO(token *models.LeaveActionToken) LeaveActionLinkDTO {
+	return LeaveActionLinkDTO{
+		LeaveRequestID: token.LeaveRequestID,
+		Action:         token.Action,
+		ExpiresAt:      token.ExpiresAt,
+	}
+}
@@ -0,0 +1,171 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaveActionLinkHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testToken := "payload.signature"
+	approveToken := &models.LeaveActionToken{
+		LeaveRequestID: uuid.New(), ApproverID: uuid.New(), Action: models.LeaveActionApprove,
+		Nonce: uuid.NewString(), ExpiresAt: time.Now().Add(time.Hour),
+	}
+	rejectToken := *approveToken
+	rejectToken.Action = models.LeaveActionReject
+
+	testCases := []struct {
+		name             string
+		method           string
+		requestBody      string
+		setupMocks       func(linkSvc *mocks.MockLeaveActionLinkService)
+		expectedStatus   int
+		expectedResponse common.Response
+		expectData       bool
+	}{
+		{
+			name:   "Success - Preview Does Not Consume Link",
+			method: http.MethodGet,
+			setupMocks: func(linkSvc *mocks.MockLeaveActionLinkService) {
+				linkSvc.EXPECT().InspectLink(gomock.Any(), testToken).Return(approveToken, nil).Times(1)
+				// ExecuteLink should NOT be called
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Action link is valid; submit a POST request to the same URL to approve the leave request"},
+			expectData:       true,
+		},
+		{
+			name:   "Gone - Preview Expired Link",
+			method: http.MethodGet,
+			setupMocks: func(linkSvc *mocks.MockLeaveActionLinkService) {
+				linkSvc.EXPECT().InspectLink(gomock.Any(), testToken).Return(nil, services.ErrActionLinkExpired).Times(1)
+			},
+			expectedStatus:   http.StatusGone,
+			expectedResponse: common.Response{Code: http.StatusGone, Message: "Action link has expired; please log in to process the leave request"},
+		},
+		{
+			name:   "Success - Approve Without Body",
+			method: http.MethodPost,
+			setupMocks: func(linkSvc *mocks.MockLeaveActionLinkService) {
+				linkSvc.EXPECT().ExecuteLink(gomock.Any(), testToken, "").Return(approveToken, nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request approved successfully"},
+			expectData:       true,
+		},
+		{
+			name:        "Success - Reject With Reason",
+			method:      http.MethodPost,
+			requestBody: `{"reason": "Team is short-staffed"}`,
+			setupMocks: func(linkSvc *mocks.MockLeaveActionLinkService) {
+				linkSvc.EXPECT().ExecuteLink(gomock.Any(), testToken, "Team is short-staffed").Return(&rejectToken, nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request rejected successfully"},
+			expectData:       true,
+		},
+		{
+			name:             "Bad Request - Invalid JSON Body",
+			method:           http.MethodPost,
+			requestBody:      `{"reason":`,
+			setupMocks:       func(linkSvc *mocks.MockLeaveActionLinkService) {},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Invalid request format"},
+		},
+		{
+			name:   "Bad Request - Tampered Link",
+			method: http.MethodPost,
+			setupMocks: func(linkSvc *mocks.MockLeaveActionLinkService) {
+				linkSvc.EXPECT().ExecuteLink(gomock.Any(), testToken, "").Return(nil, services.ErrActionLinkInvalid).Times(1)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Invalid action link"},
+		},
+		{
+			name:   "Conflict - Link Already Used",
+			method: http.MethodPost,
+			setupMocks: func(linkSvc *mocks.MockLeaveActionLinkService) {
+				linkSvc.EXPECT().ExecuteLink(gomock.Any(), testToken, "").Return(nil, services.ErrActionLinkUsed).Times(1)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: common.Response{Code: http.StatusConflict, Message: "Action link has already been used"},
+		},
+		{
+			name:   "Conflict - Leave Request Already Processed",
+			method: http.MethodPost,
+			setupMocks: func(linkSvc *mocks.MockLeaveActionLinkService) {
+				linkSvc.EXPECT().ExecuteLink(gomock.Any(), testToken, "").Return(nil, services.ErrInvalidLeaveRequestState).Times(1)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: common.Response{Code: http.StatusConflict, Message: "Leave request has already been processed"},
+		},
+		{
+			name:   "Forbidden - Approver No Longer Allowed",
+			method: http.MethodPost,
+			setupMocks: func(linkSvc *mocks.MockLeaveActionLinkService) {
+				linkSvc.EXPECT().ExecuteLink(gomock.Any(), testToken, "").Return(nil, services.ErrInvalidProcessor).Times(1)
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can no longer process this leave request"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLinkSvc := mocks.NewMockLeaveActionLinkService(ctrl)
+			handler := NewLeaveActionLinkHandler(mockLinkSvc)
+			tc.setupMocks(mockLinkSvc)
+
+			// 模擬 HTTP 環境 (不設置 claims, 此路由不需登入)
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(tc.method, "/leave-actions/"+testToken, bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "token", Value: testToken}}
+
+			if tc.method == http.MethodGet {
+				handler.PreviewLeaveAction(c)
+			} else {
+				handler.ExecuteLeaveAction(c)
+			}
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			var actualResponse common.Response
+			err := json.Unmarshal(recorder.Body.Bytes(), &actualResponse)
+			require.NoError(t, err, "Response body should be valid JSON")
+
+			assert.Equal(t, tc.expectedResponse.Code, actualResponse.Code, "Response code mismatch")
+			assert.Contains(t, actualResponse.Message, tc.expectedResponse.Message, "Response message mismatch")
+			if tc.expectData {
+				data, ok := actualResponse.Data.(map[string]interface{})
+				require.True(t, ok, "Response data should be an object")
+				assert.Equal(t, approveToken.LeaveRequestID.String(), data["leave_request_id"])
+				assert.NotContains(t, data, "approver_id")
+				assert.NotContains(t, data, "nonce")
+			} else {
+				assert.Nil(t, actualResponse.Data)
+			}
+		})
+	}
+}
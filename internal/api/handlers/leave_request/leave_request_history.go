@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// LeaveRequestHistoryHandler 包含依賴
+type LeaveRequestHistoryHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewLeaveRequestHistoryHandler 構造函數
+func NewLeaveRequestHistoryHandler(leaveRequestSvc interfaces.LeaveRequestService) *LeaveRequestHistoryHandler {
+	return &LeaveRequestHistoryHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// LeaveRequestEventDTO 定義返回給客戶端的假單歷程
+type LeaveRequestEventDTO struct {
+	EventType    string     `json:"event_type"`
+	FromStatus   string     `json:"from_status,omitempty"`
+	ToStatus     string     `json:"to_status"`
+	ActorID      *uuid.UUID `json:"actor_id,omitempty"`
+	ActorName    string     `json:"actor_name,omitempty"`
+	OnBehalfOfID *uuid.UUID `json:"on_behalf_of_id,omitempty"` // 代理審核時的原審核人
+	Comment      string     `json:"comment,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// GetHistory 方法處理查詢假單狀態歷程的 HTTP 請求 (申請人本人或 HR / Super Admin)
+func (h *LeaveRequestHistoryHandler) GetHistory(c *gin.Context) {
+	// 1. 獲取登入者資訊 (是否可查看由 Service 層判斷)
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假單 ID
+	leaveRequestIDStr := c.Param("id")
+	if _, err := uuid.Parse(leaveRequestIDStr); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid leave request ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	events, err := h.leaveRequestSvc.GetRequestHistory(c.Request.Context(), leaveRequestIDStr, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrNotLeaveRequestOwner):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only view the history of your own leave requests"})
+		default:
+			log.Printf("Error fetching history of leave request %s via service: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve leave request history"})
+		}
+		return
+	}
+
+	// 4. 轉換為 DTO 並返回
+	response := make([]LeaveRequestEventDTO, 0, len(events))
+	for _, e := range events {
+		dto := LeaveRequestEventDTO{
+			EventType:    e.EventType,
+			FromStatus:   e.FromStatus,
+			ToStatus:     e.ToStatus,
+			ActorID:      e.ActorID,
+			OnBehalfOfID: e.OnBehalfOfID,
+			Comment:      e.Comment,
+			CreatedAt:    e.CreatedAt,
+		}
+		if e.Actor != nil {
+			dto.ActorName = e.Actor.FirstName + " " + e.Actor.LastName
+		}
+		response = append(response, dto)
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    response,
+	})
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaveRequestHistoryHandler_GetHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	employeeID := uuid.New()
+	employeeClaims := &models.Claims{UserID: employeeID.String(), Role: models.RoleEmployee}
+	leaveID := uuid.New().String()
+	hrID := uuid.New()
+	events := []models.LeaveRequestEvent{
+		{EventType: models.LeaveEventSubmitted, ToStatus: models.LeaveStatusPending, ActorID: &employeeID, Comment: "Family matter",
+			Actor: &models.Account{FirstName: "Amy", LastName: "Lin"}},
+		{EventType: models.LeaveEventRejected, FromStatus: models.LeaveStatusPending, ToStatus: models.LeaveStatusRejected, ActorID: &hrID, Comment: "Peak season"},
+	}
+
+	testCases := []struct {
+		name            string
+		claimsToSet     interface{}
+		leaveIDParam    string
+		setupMocks      func(leaveSvc *mocks.MockLeaveRequestService)
+		expectedStatus  int
+		expectedMessage string
+		expectedEvents  int
+	}{
+		{
+			name:         "Success",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetRequestHistory(gomock.Any(), leaveID, employeeID.String()).Return(events, nil).Times(1)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Success",
+			expectedEvents:  2,
+		},
+		{
+			name:            "Unauthorized - Missing Claims",
+			leaveIDParam:    leaveID,
+			expectedStatus:  http.StatusUnauthorized,
+			expectedMessage: "Unauthorized: Missing user claims",
+		},
+		{
+			name:            "Bad Request - Invalid ID",
+			claimsToSet:     employeeClaims,
+			leaveIDParam:    "not-a-uuid",
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "Invalid leave request ID in URL path",
+		},
+		{
+			name:         "Forbidden - Not Owner",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetRequestHistory(gomock.Any(), leaveID, employeeID.String()).Return(nil, services.ErrNotLeaveRequestOwner).Times(1)
+			},
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "Permission denied: You can only view the history of your own leave requests",
+		},
+		{
+			name:         "Not Found",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetRequestHistory(gomock.Any(), leaveID, employeeID.String()).Return(nil, services.ErrLeaveRequestNotFound).Times(1)
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "Leave request not found",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().GetRequestHistory(gomock.Any(), leaveID, employeeID.String()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "Failed to retrieve leave request history",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLeaveSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewLeaveRequestHistoryHandler(mockLeaveSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/leave-requests/"+tc.leaveIDParam+"/history", nil)
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tc.leaveIDParam}}
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+
+			handler.GetHistory(c)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+			var resp struct {
+				Code    int                    `json:"code"`
+				Message string                 `json:"message"`
+				Data    []LeaveRequestEventDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatus == http.StatusOK {
+				require.Len(t, resp.Data, tc.expectedEvents)
+				assert.Equal(t, "Amy Lin", resp.Data[0].ActorName)
+				assert.Equal(t, "Peak season", resp.Data[1].Comment)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// LeaveYearEndSummaryHandler 包含依賴
+type LeaveYearEndSummaryHandler struct {
+	leaveBalanceSvc interfaces.LeaveBalanceService
+}
+
+// NewLeaveYearEndSummaryHandler 構造函數
+func NewLeaveYearEndSummaryHandler(leaveBalanceSvc interfaces.LeaveBalanceService) *LeaveYearEndSummaryHandler {
+	return &LeaveYearEndSummaryHandler{leaveBalanceSvc: leaveBalanceSvc}
+}
+
+// GetYearEndSummary 方法處理 HR 查詢年底結算報表 (結轉/失效天數) 的 HTTP 請求
+// 結算本身由 CLI (-year-end) 執行
+func (h *LeaveYearEndSummaryHandler) GetYearEndSummary(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view the year-end summary"})
+		return
+	}
+
+	// 2. 解析年度參數
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil || year < 1 {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid year in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	summary, err := h.leaveBalanceSvc.GetYearEndSummary(c.Request.Context(), year)
+	if err != nil {
+		if errors.Is(err, services.ErrYearEndNotProcessed) {
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: err.Error()})
+			return
+		}
+		log.Printf("Error fetching %d year-end summary via service: %v", year, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve year-end summary"})
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: summary})
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaveYearEndSummaryHandler_GetYearEndSummary(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	summary := &models.LeaveYearEndSummary{
+		Year: 2025,
+		Totals: []models.LeaveYearEndTotal{{LeaveType: models.LeaveTypeAnnual, Accounts: 1,
+			ClosingBalance: decimal.NewFromInt(8), CarriedOver: decimal.NewFromInt(5), Forfeited: decimal.NewFromInt(3)}},
+		Records: []models.LeaveCarryOverRecord{{Year: 2025, AccountID: uuid.New(), LeaveType: models.LeaveTypeAnnual,
+			ClosingBalance: decimal.NewFromInt(8), CarriedOver: decimal.NewFromInt(5), Forfeited: decimal.NewFromInt(3)}},
+	}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		yearParam          string
+		setupMocks         func(mockSvc *mocks.MockLeaveBalanceService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR views summary",
+			callerClaims: hrClaims,
+			yearParam:    "2025",
+			setupMocks: func(mockSvc *mocks.MockLeaveBalanceService) {
+				mockSvc.EXPECT().GetYearEndSummary(gomock.Any(), 2025).Return(summary, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			yearParam:          "2025",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			yearParam:          "2025",
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view the year-end summary",
+		},
+		{
+			name:               "Bad Request - Invalid Year",
+			callerClaims:       hrClaims,
+			yearParam:          "last-year",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid year in URL path",
+		},
+		{
+			name:         "Not Found - Year Not Processed",
+			callerClaims: hrClaims,
+			yearParam:    "2025",
+			setupMocks: func(mockSvc *mocks.MockLeaveBalanceService) {
+				mockSvc.EXPECT().GetYearEndSummary(gomock.Any(), 2025).Return(nil, services.ErrYearEndNotProcessed).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    services.ErrYearEndNotProcessed.Error(),
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			yearParam:    "2025",
+			setupMocks: func(mockSvc *mocks.MockLeaveBalanceService) {
+				mockSvc.EXPECT().GetYearEndSummary(gomock.Any(), 2025).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve year-end summary",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveBalanceService(ctrl)
+			handler := NewLeaveYearEndSummaryHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/leave-year-end/"+tc.yearParam, nil)
+			c.Params = gin.Params{gin.Param{Key: "year", Value: tc.yearParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.GetYearEndSummary(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int                         `json:"code"`
+				Message string                      `json:"message"`
+				Data    *models.LeaveYearEndSummary `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.NotNil(t, resp.Data)
+				require.Len(t, resp.Data.Totals, 1)
+				assert.True(t, decimal.NewFromInt(5).Equal(resp.Data.Totals[0].CarriedOver))
+				assert.Len(t, resp.Data.Records, 1)
+			}
+		})
+	}
+}
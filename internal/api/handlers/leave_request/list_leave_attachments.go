@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListLeaveAttachmentsHandler 包含依賴
+type ListLeaveAttachmentsHandler struct {
+	attachmentSvc interfaces.LeaveAttachmentService
+}
+
+// NewListLeaveAttachmentsHandler 構造函數
+func NewListLeaveAttachmentsHandler(attachmentSvc interfaces.LeaveAttachmentService) *ListLeaveAttachmentsHandler {
+	return &ListLeaveAttachmentsHandler{attachmentSvc: attachmentSvc}
+}
+
+// ListAttachments 方法處理查詢假單附件清單的 HTTP 請求 (申請人本人或 HR / Super Admin)
+func (h *ListLeaveAttachmentsHandler) ListAttachments(c *gin.Context) {
+	// 1. 獲取登入者資訊 (是否可查看由 Service 層判斷)
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假單 ID
+	leaveRequestIDStr := c.Param("id")
+	if _, err := uuid.Parse(leaveRequestIDStr); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid leave request ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	attachments, err := h.attachmentSvc.ListAttachments(c.Request.Context(), leaveRequestIDStr, claims.UserID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrNotLeaveRequestOwner):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only view attachments of your own leave requests or requests awaiting your approval"})
+		default:
+			log.Printf("Error listing attachments of leave request %s via service: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve attachments"})
+		}
+		return
+	}
+	if attachments == nil {
+		attachments = []models.LeaveAttachment{}
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    attachments,
+	})
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListLeaveAttachmentsHandler_ListAttachments(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrID := uuid.New().String()
+	hrClaims := &models.Claims{UserID: hrID, Role: models.RoleHR}
+	leaveID := uuid.New().String()
+	attachments := []models.LeaveAttachment{
+		{ID: uuid.New(), FileName: "certificate.pdf", ContentType: "application/pdf", Size: 1024, StorageKey: "leave-requests/a/b"},
+	}
+
+	testCases := []struct {
+		name            string
+		claimsToSet     interface{}
+		leaveIDParam    string
+		setupMocks      func(attachmentSvc *mocks.MockLeaveAttachmentService)
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{
+			name:         "Success",
+			claimsToSet:  hrClaims,
+			leaveIDParam: leaveID,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().ListAttachments(gomock.Any(), leaveID, hrID).Return(attachments, nil).Times(1)
+			},
+			expectedStatus:  http.StatusOK,
+			expectedMessage: "Success",
+		},
+		{
+			name:            "Unauthorized - Missing Claims",
+			leaveIDParam:    leaveID,
+			expectedStatus:  http.StatusUnauthorized,
+			expectedMessage: "Unauthorized: Missing user claims",
+		},
+		{
+			name:            "Bad Request - Invalid ID",
+			claimsToSet:     hrClaims,
+			leaveIDParam:    "not-a-uuid",
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "Invalid leave request ID in URL path",
+		},
+		{
+			name:         "Forbidden - Not Owner",
+			claimsToSet:  hrClaims,
+			leaveIDParam: leaveID,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().ListAttachments(gomock.Any(), leaveID, hrID).Return(nil, services.ErrNotLeaveRequestOwner).Times(1)
+			},
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "Permission denied: You can only view attachments of your own leave requests or requests awaiting your approval",
+		},
+		{
+			name:         "Not Found",
+			claimsToSet:  hrClaims,
+			leaveIDParam: leaveID,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().ListAttachments(gomock.Any(), leaveID, hrID).Return(nil, services.ErrLeaveRequestNotFound).Times(1)
+			},
+			expectedStatus:  http.StatusNotFound,
+			expectedMessage: "Leave request not found",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			claimsToSet:  hrClaims,
+			leaveIDParam: leaveID,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().ListAttachments(gomock.Any(), leaveID, hrID).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "Failed to retrieve attachments",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAttachmentSvc := mocks.NewMockLeaveAttachmentService(ctrl)
+			handler := NewListLeaveAttachmentsHandler(mockAttachmentSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockAttachmentSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/leave-requests/"+tc.leaveIDParam+"/attachments", nil)
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tc.leaveIDParam}}
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+
+			handler.ListAttachments(c)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+			var resp struct {
+				Message string                   `json:"message"`
+				Data    []map[string]interface{} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatus == http.StatusOK {
+				require.Len(t, resp.Data, 1)
+				assert.Equal(t, "certificate.pdf", resp.Data[0]["file_name"])
+				assert.NotContains(t, resp.Data[0], "storage_key", "storage location must not be exposed")
+			}
+		})
+	}
+}
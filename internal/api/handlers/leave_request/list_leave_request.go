@@ -1,20 +1,24 @@
 package handlers
 
 import (
+	"errors"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/erinchen11/hr-system/internal/interfaces" 
+	"github.com/erinchen11/hr-system/internal/interfaces"
 	common "github.com/erinchen11/hr-system/internal/models/common"
 
-	"github.com/erinchen11/hr-system/internal/models" 
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/services"
 	"github.com/gin-gonic/gin"
 )
 
 // ListLeaveRequestsHandler 包含依賴
 type ListLeaveRequestsHandler struct {
-	leaveRequestSvc interfaces.LeaveRequestService 
+	leaveRequestSvc interfaces.LeaveRequestService
 }
 
 // NewListLeaveRequestsHandler 構造函數
@@ -24,14 +28,21 @@ func NewListLeaveRequestsHandler(leaveRequestSvc interfaces.LeaveRequestService)
 
 // LeaveRequestResponse 是對前端乾淨的回傳結構
 type LeaveRequestResponse struct {
-	Id          string     `json:"id"`
-	LeaveType   string     `json:"leave_type"`
-	StartDate   time.Time  `json:"start_date"`
-	EndDate     time.Time  `json:"end_date"`
-	Reason      string     `json:"reason,omitempty"`
-	Status      string     `json:"status"`
-	RequestedAt time.Time  `json:"requested_at"`
-	ApprovedAt  *time.Time `json:"approved_at,omitempty"`
+	Id           string     `json:"id"`
+	LeaveType    string     `json:"leave_type"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      time.Time  `json:"end_date"`
+	Days         string     `json:"days"` // 工作日數
+	DurationUnit string     `json:"duration_unit"`
+	StartTime    string     `json:"start_time,omitempty"` // 小時假的起訖時間 (HH:MM)
+	EndTime      string     `json:"end_time,omitempty"`
+	Reason       string     `json:"reason,omitempty"`
+	DecisionNote string     `json:"decision_note,omitempty"` // 審核人的決定說明
+	Status       string     `json:"status"`
+	RequestedAt  time.Time  `json:"requested_at"`
+	ApprovedAt   *time.Time `json:"approved_at,omitempty"`
+	OnBehalfOfID *string    `json:"on_behalf_of_id,omitempty"` // 代理審核時的原審核人
+	Version      int        `json:"version"`                   // 審核時可放入 If-Match 標頭
 
 	Applicant struct {
 		FirstName string `json:"first_name"`
@@ -46,6 +57,14 @@ type LeaveRequestResponse struct {
 	} `json:"approver,omitempty"`
 }
 
+// ListLeaveRequests 處理 HR 查詢假單列表的 HTTP 請求
+// 支援的查詢參數:
+//   - status: 以逗號分隔的狀態, 例如 pending,approved
+//   - leave_type: 假別
+//   - start_date / end_date: YYYY-MM-DD, 返回與此區間有交集的假單
+//   - applicant: 申請人 email 或姓名 (模糊搜尋)
+//   - sort: requested_at (預設) / start_date / days / status; order: asc / desc (預設)
+//   - page / page_size: 分頁 (預設第 1 頁, 每頁 20 筆, 最多 100 筆)，總筆數放在回應的 total
 func (h *ListLeaveRequestsHandler) ListLeaveRequests(c *gin.Context) {
 	claimsRaw, exists := c.Get("claims")
 	if !exists {
@@ -63,54 +82,36 @@ func (h *ListLeaveRequestsHandler) ListLeaveRequests(c *gin.Context) {
 		return
 	}
 
+	// 解析查詢參數
+	filter, err := parseLeaveRequestFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+		return
+	}
+
 	// 調用 Service
-	requests, err := h.leaveRequestSvc.ListAllRequests(c.Request.Context())
+	requests, total, err := h.leaveRequestSvc.ListRequests(c.Request.Context(), filter)
 	if err != nil {
-		log.Printf("Error fetching leave requests via service: %v", err)
-		c.JSON(http.StatusInternalServerError, common.Response{
-			Code:    http.StatusInternalServerError,
-			Message: "Failed to fetch leave requests",
-			Data:    nil,
-		})
+		switch {
+		case errors.Is(err, services.ErrInvalidLeaveFilter):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+		case errors.Is(err, services.ErrInvalidDateRange):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: end_date cannot be before start_date"})
+		default:
+			log.Printf("Error fetching leave requests via service: %v", err)
+			c.JSON(http.StatusInternalServerError, common.Response{
+				Code:    http.StatusInternalServerError,
+				Message: "Failed to fetch leave requests",
+				Data:    nil,
+			})
+		}
 		return
 	}
 
 	// --- 將原始模型轉換成乾淨的回傳結構 ---
 	response := make([]LeaveRequestResponse, 0, len(requests))
 	for _, r := range requests {
-		item := LeaveRequestResponse{
-			Id:          r.ID.String(),
-			LeaveType:   r.LeaveType,
-			StartDate:   r.StartDate,
-			EndDate:     r.EndDate,
-			Reason:      r.Reason,
-			Status:      r.Status,
-			RequestedAt: r.RequestedAt,
-			ApprovedAt:  r.ApprovedAt,
-			Applicant: struct {
-				FirstName string `json:"first_name"`
-				LastName  string `json:"last_name"`
-				Email     string `json:"email"`
-			}{
-				FirstName: r.Account.FirstName,
-				LastName:  r.Account.LastName,
-				Email:     r.Account.Email,
-			},
-		}
-
-		if r.Approver != nil {
-			item.Approver = &struct {
-				FirstName string `json:"first_name"`
-				LastName  string `json:"last_name"`
-				Email     string `json:"email"`
-			}{
-				FirstName: r.Approver.FirstName,
-				LastName:  r.Approver.LastName,
-				Email:     r.Approver.Email,
-			}
-		}
-
-		response = append(response, item)
+		response = append(response, toLeaveRequestResponse(r))
 	}
 
 	// --- 成功回傳 ---
@@ -118,5 +119,106 @@ func (h *ListLeaveRequestsHandler) ListLeaveRequests(c *gin.Context) {
 		Code:    http.StatusOK,
 		Message: "Success",
 		Data:    response,
+		Total:   &total,
 	})
 }
+
+// parseLeaveRequestFilter 將查詢參數轉換為 LeaveRequestFilter，格式錯誤時返回錯誤
+// 狀態與排序欄位是否合法交由 Service 層檢查
+func parseLeaveRequestFilter(c *gin.Context) (models.LeaveRequestFilter, error) {
+	filter := models.LeaveRequestFilter{
+		LeaveType: strings.TrimSpace(c.Query("leave_type")),
+		Applicant: strings.TrimSpace(c.Query("applicant")),
+		SortBy:    c.Query("sort"),
+		SortDesc:  true,
+	}
+	if statusStr := c.Query("status"); statusStr != "" {
+		for _, status := range strings.Split(statusStr, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				filter.Statuses = append(filter.Statuses, status)
+			}
+		}
+	}
+	if dateStr := c.Query("start_date"); dateStr != "" {
+		from, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return filter, errors.New("start_date must be YYYY-MM-DD")
+		}
+		filter.From = &from
+	}
+	if dateStr := c.Query("end_date"); dateStr != "" {
+		to, err := time.Parse("2006-01-02", dateStr)
+		if err != nil {
+			return filter, errors.New("end_date must be YYYY-MM-DD")
+		}
+		filter.To = &to
+	}
+	switch strings.ToLower(c.Query("order")) {
+	case "", "desc":
+	case "asc":
+		filter.SortDesc = false
+	default:
+		return filter, errors.New("order must be asc or desc")
+	}
+	if pageStr := c.Query("page"); pageStr != "" {
+		page, err := strconv.Atoi(pageStr)
+		if err != nil || page < 1 {
+			return filter, errors.New("page must be a positive integer")
+		}
+		filter.Page = page
+	}
+	if sizeStr := c.Query("page_size"); sizeStr != "" {
+		size, err := strconv.Atoi(sizeStr)
+		if err != nil || size < 1 {
+			return filter, errors.New("page_size must be a positive integer")
+		}
+		filter.PageSize = size
+	}
+	return filter, nil
+}
+
+// toLeaveRequestResponse 將假單模型 (含預加載的申請人 / 審核人) 轉換成回傳結構
+func toLeaveRequestResponse(r models.LeaveRequest) LeaveRequestResponse {
+	item := LeaveRequestResponse{
+		Id:           r.ID.String(),
+		LeaveType:    r.LeaveType,
+		StartDate:    r.StartDate,
+		EndDate:      r.EndDate,
+		Days:         r.Days.String(),
+		DurationUnit: r.DurationUnit,
+		StartTime:    r.StartTime,
+		EndTime:      r.EndTime,
+		Reason:       r.Reason,
+		DecisionNote: r.DecisionNote,
+		Status:       r.Status,
+		RequestedAt:  r.RequestedAt,
+		ApprovedAt:   r.ApprovedAt,
+		Version:      r.Version,
+		Applicant: struct {
+			FirstName string `json:"first_name"`
+			LastName  string `json:"last_name"`
+			Email     string `json:"email"`
+		}{
+			FirstName: r.Account.FirstName,
+			LastName:  r.Account.LastName,
+			Email:     r.Account.Email,
+		},
+	}
+
+	if r.OnBehalfOfID != nil {
+		onBehalfOf := r.OnBehalfOfID.String()
+		item.OnBehalfOfID = &onBehalfOf
+	}
+	if r.Approver != nil {
+		item.Approver = &struct {
+			FirstName string `json:"first_name"`
+			LastName  string `json:"last_name"`
+			Email     string `json:"email"`
+		}{
+			FirstName: r.Approver.FirstName,
+			LastName:  r.Approver.LastName,
+			Email:     r.Approver.Email,
+		}
+	}
+	return item
+}
@@ -4,6 +4,7 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/erinchen11/hr-system/internal/interfaces/mocks" 
 	"github.com/erinchen11/hr-system/internal/models"
 	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
 
 	"github.com/gin-gonic/gin"
 	gomock "github.com/golang/mock/gomock" 
@@ -53,7 +55,7 @@ func TestListLeaveRequestsHandler_ListLeaveRequests(t *testing.T) {
 			name:         "Success - HR gets list",
 			callerClaims: hrClaims, // HR 身份
 			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
-				mockLeaveSvc.EXPECT().ListAllRequests(gomock.Any()).Return(mockLeaveRequests, nil).Times(1)
+				mockLeaveSvc.EXPECT().ListRequests(gomock.Any(), gomock.Any()).Return(mockLeaveRequests, int64(2), nil).Times(1)
 			},
 			expectedStatusCode:   http.StatusOK,
 			expectedResponseCode: http.StatusOK,
@@ -64,7 +66,7 @@ func TestListLeaveRequestsHandler_ListLeaveRequests(t *testing.T) {
 			name:         "Success - HR gets empty list",
 			callerClaims: hrClaims,
 			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
-				mockLeaveSvc.EXPECT().ListAllRequests(gomock.Any()).Return([]models.LeaveRequest{}, nil).Times(1) // 返回空列表
+				mockLeaveSvc.EXPECT().ListRequests(gomock.Any(), gomock.Any()).Return([]models.LeaveRequest{}, int64(0), nil).Times(1) // 返回空列表
 			},
 			expectedStatusCode:   http.StatusOK,
 			expectedResponseCode: http.StatusOK,
@@ -112,7 +114,7 @@ func TestListLeaveRequestsHandler_ListLeaveRequests(t *testing.T) {
 			callerClaims: hrClaims,
 			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
 				dbError := errors.New("database connection issue")
-				mockLeaveSvc.EXPECT().ListAllRequests(gomock.Any()).Return(nil, dbError).Times(1) // 模擬 Service 出錯
+				mockLeaveSvc.EXPECT().ListRequests(gomock.Any(), gomock.Any()).Return(nil, int64(0), dbError).Times(1) // 模擬 Service 出錯
 			},
 			expectedStatusCode:   http.StatusInternalServerError,
 			expectedResponseCode: http.StatusInternalServerError,
@@ -187,3 +189,133 @@ func TestListLeaveRequestsHandler_ListLeaveRequests(t *testing.T) {
 		})
 	}
 }
+
+// 測試 ListLeaveRequests 的查詢參數解析、錯誤對應與總筆數回傳
+func TestListLeaveRequestsHandler_QueryParameters(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR, Email: "hr@test.com"}
+	mockRequests := []models.LeaveRequest{
+		{ID: uuid.New(), LeaveType: models.LeaveTypeAnnual, Status: models.LeaveStatusPending, Account: models.Account{Email: "alice@co.co"}},
+	}
+
+	testCases := []struct {
+		name               string
+		query              string
+		setupMocks         func(mockLeaveSvc *mocks.MockLeaveRequestService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedTotal      *int64
+	}{
+		{
+			name:  "Success - All parameters parsed into filter",
+			query: "?status=pending,%20approved&leave_type=annual&start_date=2025-05-01&end_date=2025-05-31&applicant=alice&sort=start_date&order=asc&page=3&page_size=10",
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ListRequests(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error) {
+						assert.Equal(t, []string{models.LeaveStatusPending, models.LeaveStatusApproved}, filter.Statuses)
+						assert.Equal(t, models.LeaveTypeAnnual, filter.LeaveType)
+						require.NotNil(t, filter.From)
+						require.NotNil(t, filter.To)
+						assert.Equal(t, "2025-05-01", filter.From.Format("2006-01-02"))
+						assert.Equal(t, "2025-05-31", filter.To.Format("2006-01-02"))
+						assert.Equal(t, "alice", filter.Applicant)
+						assert.Equal(t, models.LeaveSortStartDate, filter.SortBy)
+						assert.False(t, filter.SortDesc)
+						assert.Equal(t, 3, filter.Page)
+						assert.Equal(t, 10, filter.PageSize)
+						return mockRequests, 21, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedTotal:      func() *int64 { v := int64(21); return &v }(),
+		},
+		{
+			name:  "Success - Defaults to newest first",
+			query: "",
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ListRequests(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error) {
+						assert.True(t, filter.SortDesc)
+						assert.Empty(t, filter.Statuses)
+						assert.Nil(t, filter.From)
+						assert.Zero(t, filter.Page)
+						return mockRequests, 1, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedTotal:      func() *int64 { v := int64(1); return &v }(),
+		},
+		{
+			name:               "Bad Request - Invalid start_date",
+			query:              "?start_date=2025/05/01",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: start_date must be YYYY-MM-DD",
+		},
+		{
+			name:               "Bad Request - Invalid order",
+			query:              "?order=sideways",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: order must be asc or desc",
+		},
+		{
+			name:               "Bad Request - Invalid page",
+			query:              "?page=0",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: page must be a positive integer",
+		},
+		{
+			name:  "Bad Request - Service rejects filter",
+			query: "?sort=password",
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ListRequests(gomock.Any(), gomock.Any()).
+					Return(nil, int64(0), fmt.Errorf("%w: unknown sort field %q", services.ErrInvalidLeaveFilter, "password")).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    `Invalid query parameter: invalid leave request filter: unknown sort field "password"`,
+		},
+		{
+			name:  "Bad Request - End date before start date",
+			query: "?start_date=2025-05-31&end_date=2025-05-01",
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ListRequests(gomock.Any(), gomock.Any()).Return(nil, int64(0), services.ErrInvalidDateRange).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid query parameter: end_date cannot be before start_date",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLeaveSvc := mocks.NewMockLeaveRequestService(ctrl)
+			listHandler := NewListLeaveRequestsHandler(mockLeaveSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/api/v1/hr/leave-requests"+tc.query, nil)
+			c.Set("claims", hrClaims)
+
+			listHandler.ListLeaveRequests(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var actualResponse common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+			assert.Equal(t, tc.expectedStatusCode, actualResponse.Code)
+			assert.Equal(t, tc.expectedMessage, actualResponse.Message)
+			if tc.expectedTotal != nil {
+				require.NotNil(t, actualResponse.Total)
+				assert.Equal(t, *tc.expectedTotal, *actualResponse.Total)
+			} else {
+				assert.Nil(t, actualResponse.Total)
+			}
+		})
+	}
+}
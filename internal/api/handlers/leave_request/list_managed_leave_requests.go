@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+)
+
+// ListManagedLeaveRequestsHandler 包含依賴
+type ListManagedLeaveRequestsHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewListManagedLeaveRequestsHandler 構造函數
+func NewListManagedLeaveRequestsHandler(leaveRequestSvc interfaces.LeaveRequestService) *ListManagedLeaveRequestsHandler {
+	return &ListManagedLeaveRequestsHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// ListManagedLeaveRequests 方法處理主管查看直屬部屬假單的 HTTP 請求
+// 任何已登入的帳戶都可呼叫，沒有部屬時返回空列表
+func (h *ListManagedLeaveRequestsHandler) ListManagedLeaveRequests(c *gin.Context) {
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	requests, err := h.leaveRequestSvc.ListManagedRequests(c.Request.Context(), claims.UserID)
+	if err != nil {
+		log.Printf("Error fetching leave requests of reports for manager %s via service: %v", claims.UserID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to fetch leave requests"})
+		return
+	}
+
+	response := make([]LeaveRequestResponse, 0, len(requests))
+	for _, r := range requests {
+		response = append(response, toLeaveRequestResponse(r))
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    response,
+	})
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListManagedLeaveRequestsHandler_ListManagedLeaveRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	managerID := uuid.New().String()
+	managerClaims := &models.Claims{UserID: managerID, Email: "lead@example.com", Role: models.RoleEmployee}
+	reportID := uuid.New()
+	reportRequests := []models.LeaveRequest{
+		{ID: uuid.New(), AccountID: reportID, LeaveType: models.LeaveTypeAnnual, Status: models.LeaveStatusPending, Account: models.Account{ID: reportID, Email: "report@example.com"}},
+	}
+
+	testCases := []struct {
+		name               string
+		claimsToSet        interface{}
+		setupMocks         func(leaveSvc *mocks.MockLeaveRequestService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedDataLength int // -1 表示不檢查 Data
+	}{
+		{
+			name:        "Success - Manager Gets Reports' Requests",
+			claimsToSet: managerClaims,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ListManagedRequests(gomock.Any(), managerID).Return(reportRequests, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedDataLength: 1,
+		},
+		{
+			name:        "Success - No Reports",
+			claimsToSet: managerClaims,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ListManagedRequests(gomock.Any(), managerID).Return([]models.LeaveRequest{}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedDataLength: 0,
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			claimsToSet:        nil,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+			expectedDataLength: -1,
+		},
+		{
+			name:        "Internal Server Error - Service Error",
+			claimsToSet: managerClaims,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ListManagedRequests(gomock.Any(), managerID).Return(nil, errors.New("db down")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to fetch leave requests",
+			expectedDataLength: -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockLeaveReqSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewListManagedLeaveRequestsHandler(mockLeaveReqSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveReqSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/manager/leave-requests", nil)
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+
+			handler.ListManagedLeaveRequests(c)
+
+			assert.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var actualResponse struct {
+				Code    int                    `json:"code"`
+				Message string                 `json:"message"`
+				Data    []LeaveRequestResponse `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+			assert.Equal(t, tc.expectedStatusCode, actualResponse.Code)
+			assert.Equal(t, tc.expectedMessage, actualResponse.Message)
+			if tc.expectedDataLength >= 0 {
+				require.Len(t, actualResponse.Data, tc.expectedDataLength)
+				if tc.expectedDataLength > 0 {
+					assert.Equal(t, "report@example.com", actualResponse.Data[0].Applicant.Email)
+				}
+			}
+		})
+	}
+}
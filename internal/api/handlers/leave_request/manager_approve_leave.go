@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ManagerApproveLeaveHandler 包含依賴
+type ManagerApproveLeaveHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewManagerApproveLeaveHandler 構造函數
+func NewManagerApproveLeaveHandler(leaveRequestSvc interfaces.LeaveRequestService) *ManagerApproveLeaveHandler {
+	return &ManagerApproveLeaveHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// ApproveLeaveRequest 方法處理主管批准直屬部屬假單的 HTTP 請求
+// 是否為申請人的直屬主管由 Service 層判斷; 請求體可選, 只有 HR / Super Admin 可附上覆寫理由
+func (h *ManagerApproveLeaveHandler) ApproveLeaveRequest(c *gin.Context) {
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	leaveRequestIDStr := c.Param("id")
+	if leaveRequestIDStr == "" {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"})
+		return
+	}
+
+	var req ApproveLeaveRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request body format: " + err.Error()})
+		return
+	}
+	if req.OverrideJustification != "" && !canOverrideLeaveRules(claims) {
+		c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: errOverrideForbidden})
+		return
+	}
+
+	var err error
+	if req.OverrideJustification != "" {
+		err = h.leaveRequestSvc.ApproveRequestWithOverride(c.Request.Context(), leaveRequestIDStr, claims.UserID, req.OverrideJustification)
+	} else {
+		err = h.leaveRequestSvc.ApproveRequest(c.Request.Context(), leaveRequestIDStr, claims.UserID)
+	}
+	if err != nil {
+		var violationErr *services.LeaveRuleViolationError
+		switch {
+		case errors.As(err, &violationErr):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (" + violationErr.Violation.Message + ")", Data: violationErr.Violation})
+		case errors.Is(err, services.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrRuleOverrideNotAllowed):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: err.Error()})
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrInvalidProcessor):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You are not the manager of this employee"})
+		case errors.Is(err, services.ErrInvalidLeaveRequestState):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be approved (state is not pending)"})
+		case errors.Is(err, services.ErrInsufficientLeaveBalance):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be approved (insufficient leave balance)"})
+		case errors.Is(err, services.ErrOverlappingLeave):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (overlaps with an approved leave)"})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"})
+		case errors.Is(err, services.ErrLeaveRequestUpdateFailed):
+			log.Printf("Internal error approving leave request %s by manager %s: %v", leaveRequestIDStr, claims.UserID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request status"})
+		default:
+			log.Printf("Unexpected error approving leave request %s by manager %s: %v", leaveRequestIDStr, claims.UserID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Leave request approved successfully"})
+}
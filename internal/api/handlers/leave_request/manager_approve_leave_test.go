@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerApproveLeaveHandler_ApproveLeaveRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	managerID := uuid.New().String()
+	managerClaims := &models.Claims{UserID: managerID, Email: "lead@example.com", Role: models.RoleEmployee}
+	hrManagerID := uuid.New().String()
+	hrManagerClaims := &models.Claims{UserID: hrManagerID, Email: "hr-lead@example.com", Role: models.RoleHR}
+	testLeaveID := uuid.New().String()
+
+	testCases := []struct {
+		name             string
+		claimsToSet      interface{}
+		leaveIDParam     string
+		body             string
+		setupMocks       func(leaveSvc *mocks.MockLeaveRequestService)
+		expectedStatus   int
+		expectedResponse common.Response
+	}{
+		{
+			name:         "Success - Direct Manager",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, managerID).Return(nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request approved successfully"},
+		},
+		{
+			name:         "Conflict - Overlapping Leave",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, managerID).Return(services.ErrOverlappingLeave).Times(1)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (overlaps with an approved leave)"},
+		},
+		{
+			name:         "Conflict - Below Minimum Staffing",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				violation := models.LeaveRuleViolation{Rule: models.LeaveRuleMinStaffing, Department: "Engineering", MinOnDuty: 2,
+					Message: "approving this leave would leave Engineering below 2 people on duty"}
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, managerID).Return(&services.LeaveRuleViolationError{Violation: violation}).Times(1)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: common.Response{Code: http.StatusConflict, Message: "Leave request cannot be approved (approving this leave would leave Engineering below 2 people on duty)"},
+		},
+		{
+			name:             "Forbidden - Non-HR Manager Sends Override Justification",
+			claimsToSet:      managerClaims,
+			leaveIDParam:     testLeaveID,
+			body:             `{"override_justification":"team agreed to cover"}`,
+			setupMocks:       nil, // Service 不應被調用
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can override leave rules"},
+		},
+		{
+			name:         "Success - HR Manager Overrides Leave Rule",
+			claimsToSet:  hrManagerClaims,
+			leaveIDParam: testLeaveID,
+			body:         `{"override_justification":"team agreed to cover"}`,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequestWithOverride(gomock.Any(), testLeaveID, hrManagerID, "team agreed to cover").Return(nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request approved successfully"},
+		},
+		{
+			name:             "Bad Request - Malformed Body",
+			claimsToSet:      managerClaims,
+			leaveIDParam:     testLeaveID,
+			body:             `{"override_justification":`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Invalid request body format: unexpected EOF"},
+		},
+		{
+			name:             "Unauthorized - Missing Claims",
+			claimsToSet:      nil,
+			leaveIDParam:     testLeaveID,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"},
+		},
+		{
+			name:             "Bad Request - Missing Leave ID Param",
+			claimsToSet:      managerClaims,
+			leaveIDParam:     "",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"},
+		},
+		{
+			name:         "Forbidden - Not The Applicant's Manager",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, managerID).Return(services.ErrInvalidProcessor).Times(1)
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: common.Response{Code: http.StatusForbidden, Message: "Permission denied: You are not the manager of this employee"},
+		},
+		{
+			name:         "Not Found - Leave Request",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, managerID).Return(services.ErrLeaveRequestNotFound).Times(1)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: common.Response{Code: http.StatusNotFound, Message: "Leave request not found"},
+		},
+		{
+			name:         "Bad Request - Not Pending",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, managerID).Return(services.ErrInvalidLeaveRequestState).Times(1)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be approved (state is not pending)"},
+		},
+		{
+			name:         "Internal Server Error - Unexpected Service Error",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().ApproveRequest(gomock.Any(), testLeaveID, managerID).Return(errors.New("boom")).Times(1)
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockLeaveReqSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewManagerApproveLeaveHandler(mockLeaveReqSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveReqSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/manager/leave-requests/"+tc.leaveIDParam+"/approve", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+			if tc.leaveIDParam != "" {
+				c.Params = gin.Params{gin.Param{Key: "id", Value: tc.leaveIDParam}}
+			}
+
+			handler.ApproveLeaveRequest(c)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			var actualResponse common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+			assert.Equal(t, tc.expectedResponse.Code, actualResponse.Code)
+			assert.Equal(t, tc.expectedResponse.Message, actualResponse.Message)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ManagerRejectLeaveHandler 包含依賴
+type ManagerRejectLeaveHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewManagerRejectLeaveHandler 構造函數
+func NewManagerRejectLeaveHandler(leaveRequestSvc interfaces.LeaveRequestService) *ManagerRejectLeaveHandler {
+	return &ManagerRejectLeaveHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// RejectLeaveRequest 方法處理主管拒絕直屬部屬假單的 HTTP 請求 (請求體同 RejectLeaveRequestRequest)
+func (h *ManagerRejectLeaveHandler) RejectLeaveRequest(c *gin.Context) {
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	leaveRequestIDStr := c.Param("id")
+	if leaveRequestIDStr == "" {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"})
+		return
+	}
+
+	// 拒絕理由為可選
+	var req RejectLeaveRequestRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err.Error() != "EOF" {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request body format: " + err.Error()})
+		return
+	}
+
+	err := h.leaveRequestSvc.RejectRequest(c.Request.Context(), leaveRequestIDStr, claims.UserID, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrInvalidProcessor):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You are not the manager of this employee"})
+		case errors.Is(err, services.ErrInvalidLeaveRequestState):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be rejected (state is not pending)"})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"})
+		case errors.Is(err, services.ErrLeaveRequestUpdateFailed):
+			log.Printf("Internal error rejecting leave request %s by manager %s: %v", leaveRequestIDStr, claims.UserID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request status"})
+		default:
+			log.Printf("Unexpected error rejecting leave request %s by manager %s: %v", leaveRequestIDStr, claims.UserID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Leave request rejected successfully"})
+}
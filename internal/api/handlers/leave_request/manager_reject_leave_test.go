@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManagerRejectLeaveHandler_RejectLeaveRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	managerID := uuid.New().String()
+	managerClaims := &models.Claims{UserID: managerID, Email: "lead@example.com", Role: models.RoleEmployee}
+	testLeaveID := uuid.New().String()
+
+	testCases := []struct {
+		name             string
+		claimsToSet      interface{}
+		leaveIDParam     string
+		body             string
+		setupMocks       func(leaveSvc *mocks.MockLeaveRequestService)
+		expectedStatus   int
+		expectedResponse common.Response
+	}{
+		{
+			name:         "Success - Direct Manager",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().RejectRequest(gomock.Any(), testLeaveID, managerID, "").Return(nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request rejected successfully"},
+		},
+		{
+			name:         "Success - Reason Passed Through",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			body:         `{"reason":"Release week"}`,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().RejectRequest(gomock.Any(), testLeaveID, managerID, "Release week").Return(nil).Times(1)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: common.Response{Code: http.StatusOK, Message: "Leave request rejected successfully"},
+		},
+		{
+			name:             "Unauthorized - Missing Claims",
+			claimsToSet:      nil,
+			leaveIDParam:     testLeaveID,
+			expectedStatus:   http.StatusUnauthorized,
+			expectedResponse: common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"},
+		},
+		{
+			name:             "Bad Request - Missing Leave ID Param",
+			claimsToSet:      managerClaims,
+			leaveIDParam:     "",
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"},
+		},
+		{
+			name:         "Forbidden - Not The Applicant's Manager",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().RejectRequest(gomock.Any(), testLeaveID, managerID, "").Return(services.ErrInvalidProcessor).Times(1)
+			},
+			expectedStatus:   http.StatusForbidden,
+			expectedResponse: common.Response{Code: http.StatusForbidden, Message: "Permission denied: You are not the manager of this employee"},
+		},
+		{
+			name:         "Not Found - Leave Request",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().RejectRequest(gomock.Any(), testLeaveID, managerID, "").Return(services.ErrLeaveRequestNotFound).Times(1)
+			},
+			expectedStatus:   http.StatusNotFound,
+			expectedResponse: common.Response{Code: http.StatusNotFound, Message: "Leave request not found"},
+		},
+		{
+			name:         "Bad Request - Not Pending",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().RejectRequest(gomock.Any(), testLeaveID, managerID, "").Return(services.ErrInvalidLeaveRequestState).Times(1)
+			},
+			expectedStatus:   http.StatusBadRequest,
+			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be rejected (state is not pending)"},
+		},
+		{
+			name:         "Internal Server Error - Unexpected Service Error",
+			claimsToSet:  managerClaims,
+			leaveIDParam: testLeaveID,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().RejectRequest(gomock.Any(), testLeaveID, managerID, "").Return(errors.New("boom")).Times(1)
+			},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedResponse: common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockLeaveReqSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewManagerRejectLeaveHandler(mockLeaveReqSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockLeaveReqSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/manager/leave-requests/"+tc.leaveIDParam+"/reject", strings.NewReader(tc.body))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+			if tc.leaveIDParam != "" {
+				c.Params = gin.Params{gin.Param{Key: "id", Value: tc.leaveIDParam}}
+			}
+
+			handler.RejectLeaveRequest(c)
+
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+			var actualResponse common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+			assert.Equal(t, tc.expectedResponse.Code, actualResponse.Code)
+			assert.Equal(t, tc.expectedResponse.Message, actualResponse.Message)
+		})
+	}
+}
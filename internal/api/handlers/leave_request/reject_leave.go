@@ -86,6 +86,8 @@ func (h *RejectLeaveRequestHandler) RejectLeaveRequest(c *gin.Context) {
 			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
 		case errors.Is(err, services.ErrInvalidLeaveRequestState):
 			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be rejected (state is not pending)"})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"})
 		case errors.Is(err, services.ErrLeaveRequestUpdateFailed):
 			log.Printf("Internal error rejecting leave request %s: %v", leaveRequestIDStr, err)
 			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request status"})
@@ -126,6 +126,17 @@ func TestRejectLeaveRequestHandler_RejectLeaveRequest(t *testing.T) {
 			expectedStatus:   http.StatusBadRequest,
 			expectedResponse: common.Response{Code: http.StatusBadRequest, Message: "Leave request cannot be rejected (state is not pending)"},
 		},
+		{
+			name:         "Conflict - Modified By Another Request",
+			claimsToSet:  hrClaims,
+			leaveIDParam: testLeaveID,
+			requestBody:  `{}`,
+			setupMocks: func(leaveSvc *mocks.MockLeaveRequestService) {
+				leaveSvc.EXPECT().RejectRequest(gomock.Any(), testLeaveID, hrUserID, "").Return(services.ErrConcurrentModification).Times(1)
+			},
+			expectedStatus:   http.StatusConflict,
+			expectedResponse: common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"},
+		},
 		{
 			name:         "Internal Server Error - Service Update Failed",
 			claimsToSet:  hrClaims,
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// UpdateLeaveRequestHandler 包含依賴
+type UpdateLeaveRequestHandler struct {
+	leaveRequestSvc interfaces.LeaveRequestService
+}
+
+// NewUpdateLeaveRequestHandler 構造函數
+func NewUpdateLeaveRequestHandler(leaveRequestSvc interfaces.LeaveRequestService) *UpdateLeaveRequestHandler {
+	return &UpdateLeaveRequestHandler{leaveRequestSvc: leaveRequestSvc}
+}
+
+// UpdateLeaveRequest 方法處理員工修改自己待審假單的 HTTP 請求
+// 請求體與申請假單相同 (JSON)，以新內容整張取代; 附件請改用附件上傳 API
+func (h *UpdateLeaveRequestHandler) UpdateLeaveRequest(c *gin.Context) {
+	// 1. 授權檢查: 確保已登入 (HR / Super Admin 也可以管理自己的假單)
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		log.Println("UpdateLeaveRequest: Claims not found in context")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假單 ID 並解析請求體
+	leaveRequestIDStr := c.Param("id")
+	if leaveRequestIDStr == "" {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Missing leave request ID in URL path"})
+		return
+	}
+	var req ApplyLeaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+
+	// 3. 調用 Service 層
+	request, err := h.leaveRequestSvc.UpdatePendingRequest(c.Request.Context(), leaveRequestIDStr, claims.UserID, req.toInput(nil))
+	if err != nil {
+		var violationErr *services.LeaveRuleViolationError
+		switch {
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrNotLeaveRequestOwner):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only edit your own leave requests"})
+		case errors.Is(err, services.ErrInvalidLeaveRequestState):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Only pending leave requests can be edited"})
+		case errors.As(err, &violationErr):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: err.Error(), Data: violationErr.Violation})
+		case errors.Is(err, services.ErrOverlappingLeave):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: err.Error()})
+		case errors.Is(err, services.ErrLeaveTypeNotEligible), errors.Is(err, services.ErrRuleOverrideNotAllowed):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: err.Error()})
+		case errors.Is(err, services.ErrInvalidDateRange), errors.Is(err, services.ErrUnknownLeaveType),
+			errors.Is(err, services.ErrLeaveTypeInactive), errors.Is(err, services.ErrLeaveTypeMaxDaysExceeded),
+			errors.Is(err, services.ErrInsufficientLeaveBalance), errors.Is(err, services.ErrNoWorkingDaysInRange),
+			errors.Is(err, services.ErrInvalidLeaveDuration), errors.Is(err, services.ErrAttachmentRequired):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrConcurrentModification):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: "Leave request was modified by another request; reload it and retry"})
+		case errors.Is(err, services.ErrLeaveRequestUpdateFailed):
+			log.Printf("Internal error editing leave request %s: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave request"})
+		default:
+			log.Printf("Unexpected error editing leave request %s: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
+		}
+		return
+	}
+
+	// 4. 返回修改後的假單, ETag 為新的版本號
+	c.Header("ETag", middleware.ETag(request.Version))
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Leave request updated successfully",
+		Data:    toLeaveRequestStatusDTO(*request),
+	})
+}
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateLeaveRequestHandler_UpdateLeaveRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	employeeUserID := uuid.New().String()
+	employeeClaims := &models.Claims{UserID: employeeUserID, Role: models.RoleEmployee}
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	leaveID := uuid.New()
+	validBody := `{"start_date": "2025-03-04", "end_date": "2025-03-05", "leave_type": "annual", "reason": "Family trip"}`
+	updated := &models.LeaveRequest{ID: leaveID, LeaveType: models.LeaveTypeAnnual, Status: models.LeaveStatusPending,
+		StartDate: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+		Days: decimal.NewFromInt(2), DurationUnit: models.LeaveDurationFullDay}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockLeaveRequestService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - Employee edits pending request",
+			callerClaims: employeeClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().UpdatePendingRequest(gomock.Any(), leaveID.String(), employeeUserID, gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ string, _ string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
+						assert.Equal(t, models.LeaveTypeAnnual, input.LeaveType)
+						assert.Equal(t, "2025-03-04", input.StartDate.Format("2006-01-02"))
+						assert.Equal(t, "Family trip", input.Reason)
+						return updated, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Leave request updated successfully",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:         "Success - HR edits own pending request",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().UpdatePendingRequest(gomock.Any(), leaveID.String(), hrClaims.UserID, gomock.Any()).Return(updated, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Leave request updated successfully",
+		},
+		{
+			name:               "Bad Request - Invalid Date",
+			callerClaims:       employeeClaims,
+			requestBody:        `{"start_date": "04/03/2025", "end_date": "2025-03-05", "leave_type": "annual"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format: ",
+		},
+		{
+			name:         "Forbidden - Not Owner",
+			callerClaims: employeeClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().UpdatePendingRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrNotLeaveRequestOwner).Times(1)
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: You can only edit your own leave requests",
+		},
+		{
+			name:         "Conflict - No Longer Pending",
+			callerClaims: employeeClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().UpdatePendingRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrInvalidLeaveRequestState).Times(1)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    "Only pending leave requests can be edited",
+		},
+		{
+			name:         "Bad Request - Insufficient Balance",
+			callerClaims: employeeClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().UpdatePendingRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrInsufficientLeaveBalance).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInsufficientLeaveBalance.Error(),
+		},
+		{
+			name:         "Not Found",
+			callerClaims: employeeClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().UpdatePendingRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, services.ErrLeaveRequestNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Leave request not found",
+		},
+		{
+			name:         "Internal Server Error - Unexpected",
+			callerClaims: employeeClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRequestService) {
+				mockSvc.EXPECT().UpdatePendingRequest(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "An unexpected error occurred",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveRequestService(ctrl)
+			handler := NewUpdateLeaveRequestHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPut, "/employee/leave-requests/"+leaveID.String(), bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: leaveID.String()}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.UpdateLeaveRequest(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int                    `json:"code"`
+				Message string                 `json:"message"`
+				Data    *LeaveRequestStatusDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.NotNil(t, resp.Data)
+				assert.Equal(t, leaveID, resp.Data.ID)
+				assert.Equal(t, "2025-03-04", resp.Data.StartDate)
+				assert.Equal(t, "2", resp.Data.Days)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// UploadLeaveAttachmentHandler 包含依賴
+type UploadLeaveAttachmentHandler struct {
+	attachmentSvc      interfaces.LeaveAttachmentService
+	maxAttachmentBytes int64 // 單一附件的大小上限, 在讀入記憶體前檢查
+}
+
+// NewUploadLeaveAttachmentHandler 構造函數, maxAttachmentSizeMB 非正數時使用預設上限
+func NewUploadLeaveAttachmentHandler(attachmentSvc interfaces.LeaveAttachmentService, maxAttachmentSizeMB int) *UploadLeaveAttachmentHandler {
+	return &UploadLeaveAttachmentHandler{attachmentSvc: attachmentSvc, maxAttachmentBytes: attachmentSizeLimit(maxAttachmentSizeMB)}
+}
+
+// attachmentFormOverhead multipart 請求中檔案以外的欄位與邊界所預留的大小
+const attachmentFormOverhead = 1 << 20
+
+// errUploadTooLarge 上傳的檔案超過大小上限
+var errUploadTooLarge = errors.New("uploaded file exceeds the size limit")
+
+// UploadAttachment 方法處理為既有假單補上佐證文件的 HTTP 請求 (multipart 欄位 "file")
+func (h *UploadLeaveAttachmentHandler) UploadAttachment(c *gin.Context) {
+	// 1. 獲取登入者資訊 (是否可上傳由 Service 層判斷)
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假單 ID
+	leaveRequestIDStr := c.Param("id")
+	if _, err := uuid.Parse(leaveRequestIDStr); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid leave request ID in URL path"})
+		return
+	}
+
+	// 3. 讀取上傳檔案; 請求體與檔案大小在讀入記憶體前即受限
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxAttachmentBytes+attachmentFormOverhead)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		if isUploadTooLarge(err) {
+			writeUploadTooLarge(c, h.maxAttachmentBytes)
+			return
+		}
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: multipart field 'file' is required"})
+		return
+	}
+	upload, err := readAttachmentUpload(fileHeader, h.maxAttachmentBytes)
+	if err != nil {
+		if isUploadTooLarge(err) {
+			writeUploadTooLarge(c, h.maxAttachmentBytes)
+			return
+		}
+		log.Printf("Error reading uploaded attachment for leave request %s: %v", leaveRequestIDStr, err)
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: unable to read uploaded file"})
+		return
+	}
+
+	// 4. 調用 Service 層
+	attachment, err := h.attachmentSvc.UploadAttachment(c.Request.Context(), leaveRequestIDStr, claims.UserID, upload)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrAttachmentTooLarge), errors.Is(err, services.ErrUnsupportedAttachmentType):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrLeaveRequestNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave request not found"})
+		case errors.Is(err, services.ErrNotLeaveRequestOwner):
+			c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: You can only attach documents to your own leave requests"})
+		case errors.Is(err, services.ErrInvalidLeaveRequestState):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Attachments can only be added to pending or approved leave requests"})
+		default:
+			log.Printf("Error uploading attachment for leave request %s via service: %v", leaveRequestIDStr, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to save attachment"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Attachment uploaded successfully",
+		Data:    attachment,
+	})
+}
+
+// readAttachmentUpload 讀取 multipart 檔案內容, 超過 maxBytes 時返回 errUploadTooLarge; 類型由 Service 層驗證
+func readAttachmentUpload(fileHeader *multipart.FileHeader, maxBytes int64) (models.AttachmentUpload, error) {
+	if fileHeader.Size > maxBytes {
+		return models.AttachmentUpload{}, errUploadTooLarge
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return models.AttachmentUpload{}, fmt.Errorf("open uploaded file: %w", err)
+	}
+	defer file.Close()
+
+	// 宣告的大小不可信, 最多多讀一個位元組以判斷是否超過上限
+	data, err := io.ReadAll(io.LimitReader(file, maxBytes+1))
+	if err != nil {
+		return models.AttachmentUpload{}, fmt.Errorf("read uploaded file: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return models.AttachmentUpload{}, errUploadTooLarge
+	}
+	return models.AttachmentUpload{FileName: fileHeader.Filename, Data: data}, nil
+}
+
+// attachmentSizeLimit 將附件大小上限 (MB) 換算為位元組; 非正數時使用預設上限, 與 Service 層一致
+func attachmentSizeLimit(maxSizeMB int) int64 {
+	if maxSizeMB <= 0 {
+		maxSizeMB = models.DefaultMaxAttachmentSizeMB
+	}
+	return int64(maxSizeMB) << 20
+}
+
+// isUploadTooLarge 判斷錯誤是否因檔案或請求體超過大小上限
+func isUploadTooLarge(err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	return errors.Is(err, errUploadTooLarge) || errors.As(err, &maxBytesErr)
+}
+
+// writeUploadTooLarge 返回 413 與單一附件的大小上限
+func writeUploadTooLarge(c *gin.Context, maxBytes int64) {
+	c.JSON(http.StatusRequestEntityTooLarge, common.Response{
+		Code:    http.StatusRequestEntityTooLarge,
+		Message: fmt.Sprintf("Uploaded file is too large: limit is %d MB per file", maxBytes>>20),
+	})
+}
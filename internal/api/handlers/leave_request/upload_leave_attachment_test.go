@@ -0,0 +1,177 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadLeaveAttachmentHandler_UploadAttachment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	employeeID := uuid.New().String()
+	employeeClaims := &models.Claims{UserID: employeeID, Role: models.RoleEmployee}
+	leaveID := uuid.New().String()
+	certificate := []byte("%PDF-1.4 medical certificate")
+
+	testCases := []struct {
+		name            string
+		claimsToSet     interface{}
+		leaveIDParam    string
+		withFile        bool
+		fileData        []byte
+		setupMocks      func(attachmentSvc *mocks.MockLeaveAttachmentService)
+		expectedStatus  int
+		expectedMessage string
+	}{
+		{
+			name:         "Success",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			withFile:     true,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().UploadAttachment(gomock.Any(), leaveID, employeeID, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, reqID, uploaderID string, upload models.AttachmentUpload) (*models.LeaveAttachment, error) {
+						assert.Equal(t, "certificate.pdf", upload.FileName)
+						assert.Equal(t, certificate, upload.Data)
+						return &models.LeaveAttachment{ID: uuid.New(), FileName: upload.FileName}, nil
+					}).Times(1)
+			},
+			expectedStatus:  http.StatusCreated,
+			expectedMessage: "Attachment uploaded successfully",
+		},
+		{
+			name:            "Unauthorized - Missing Claims",
+			leaveIDParam:    leaveID,
+			withFile:        true,
+			expectedStatus:  http.StatusUnauthorized,
+			expectedMessage: "Unauthorized: Missing user claims",
+		},
+		{
+			name:            "Bad Request - Invalid ID",
+			claimsToSet:     employeeClaims,
+			leaveIDParam:    "not-a-uuid",
+			withFile:        true,
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "Invalid leave request ID in URL path",
+		},
+		{
+			name:            "Bad Request - Missing File",
+			claimsToSet:     employeeClaims,
+			leaveIDParam:    leaveID,
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "Invalid request format: multipart field 'file' is required",
+		},
+		{
+			name:         "Bad Request - File Too Large",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			withFile:     true,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().UploadAttachment(gomock.Any(), leaveID, employeeID, gomock.Any()).Return(nil, services.ErrAttachmentTooLarge).Times(1)
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: services.ErrAttachmentTooLarge.Error(),
+		},
+		{
+			name:            "Request Entity Too Large - Rejected Before Service",
+			claimsToSet:     employeeClaims,
+			leaveIDParam:    leaveID,
+			withFile:        true,
+			fileData:        bytes.Repeat([]byte("a"), 1<<20+1),
+			expectedStatus:  http.StatusRequestEntityTooLarge,
+			expectedMessage: "Uploaded file is too large: limit is 1 MB per file",
+		},
+		{
+			name:         "Forbidden - Not Owner",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			withFile:     true,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().UploadAttachment(gomock.Any(), leaveID, employeeID, gomock.Any()).Return(nil, services.ErrNotLeaveRequestOwner).Times(1)
+			},
+			expectedStatus:  http.StatusForbidden,
+			expectedMessage: "Permission denied: You can only attach documents to your own leave requests",
+		},
+		{
+			name:         "Bad Request - Request Already Closed",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			withFile:     true,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().UploadAttachment(gomock.Any(), leaveID, employeeID, gomock.Any()).Return(nil, services.ErrInvalidLeaveRequestState).Times(1)
+			},
+			expectedStatus:  http.StatusBadRequest,
+			expectedMessage: "Attachments can only be added to pending or approved leave requests",
+		},
+		{
+			name:         "Internal Server Error - Save Failed",
+			claimsToSet:  employeeClaims,
+			leaveIDParam: leaveID,
+			withFile:     true,
+			setupMocks: func(attachmentSvc *mocks.MockLeaveAttachmentService) {
+				attachmentSvc.EXPECT().UploadAttachment(gomock.Any(), leaveID, employeeID, gomock.Any()).Return(nil, errors.New("disk full")).Times(1)
+			},
+			expectedStatus:  http.StatusInternalServerError,
+			expectedMessage: "Failed to save attachment",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAttachmentSvc := mocks.NewMockLeaveAttachmentService(ctrl)
+			handler := NewUploadLeaveAttachmentHandler(mockAttachmentSvc, 1)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockAttachmentSvc)
+			}
+
+			body := &bytes.Buffer{}
+			writer := multipart.NewWriter(body)
+			if tc.withFile {
+				part, err := writer.CreateFormFile("file", "certificate.pdf")
+				require.NoError(t, err)
+				data := certificate
+				if tc.fileData != nil {
+					data = tc.fileData
+				}
+				_, err = part.Write(data)
+				require.NoError(t, err)
+			}
+			require.NoError(t, writer.Close())
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/leave-requests/"+tc.leaveIDParam+"/attachments", body)
+			c.Request.Header.Set("Content-Type", writer.FormDataContentType())
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tc.leaveIDParam}}
+			if tc.claimsToSet != nil {
+				c.Set("claims", tc.claimsToSet)
+			}
+
+			handler.UploadAttachment(c)
+
+			require.Equal(t, tc.expectedStatus, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ViewLeaveBalanceHandler 包含依賴
+type ViewLeaveBalanceHandler struct {
+	leaveBalanceSvc interfaces.LeaveBalanceService
+}
+
+// NewViewLeaveBalanceHandler 構造函數
+func NewViewLeaveBalanceHandler(leaveBalanceSvc interfaces.LeaveBalanceService) *ViewLeaveBalanceHandler {
+	return &ViewLeaveBalanceHandler{leaveBalanceSvc: leaveBalanceSvc}
+}
+
+// LeaveBalanceDTO 定義返回給客戶端的假期餘額
+type LeaveBalanceDTO struct {
+	LeaveType string          `json:"leave_type"`
+	Balance   decimal.Decimal `json:"balance"` // 剩餘天數
+}
+
+// ViewLeaveBalance 方法處理員工查看自己各假別剩餘天數的 HTTP 請求
+func (h *ViewLeaveBalanceHandler) ViewLeaveBalance(c *gin.Context) {
+	// 1. 授權檢查: 確保已登入 (HR / Super Admin 也可以管理自己的假單)
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		log.Println("ViewLeaveBalance: Claims not found in context")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	accountUUID, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		log.Printf("Error parsing account ID '%s' from claims: %v", claims.UserID, err)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity format"})
+		return
+	}
+
+	// 2. 調用 Service 層獲取餘額
+	balances, err := h.leaveBalanceSvc.GetBalances(c.Request.Context(), accountUUID)
+	if err != nil {
+		log.Printf("Error fetching leave balance for user %s via service: %v", accountUUID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve leave balance"})
+		return
+	}
+
+	// 3. 轉換為 DTO
+	responseDTOs := make([]LeaveBalanceDTO, 0, len(balances))
+	for _, b := range balances {
+		responseDTOs = append(responseDTOs, LeaveBalanceDTO{LeaveType: b.LeaveType, Balance: b.Balance})
+	}
+
+	// 4. 返回成功響應
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Success",
+		Data:    responseDTOs,
+	})
+}
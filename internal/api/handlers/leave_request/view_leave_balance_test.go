@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestViewLeaveBalanceHandler_ViewLeaveBalance(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	employeeID := uuid.New()
+	employeeClaims := &models.Claims{UserID: employeeID.String(), Role: models.RoleEmployee, Email: "emp@test.com"}
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR, Email: "hr@test.com"}
+
+	mockBalances := []models.LeaveBalance{
+		{LeaveType: models.LeaveTypeAnnual, Balance: decimal.RequireFromString("7.5")},
+		{LeaveType: models.LeaveTypeSick, Balance: decimal.NewFromInt(30)},
+	}
+
+	testCases := []struct {
+		name                 string
+		callerClaims         interface{}
+		setupMocks           func(mockBalanceSvc *mocks.MockLeaveBalanceService)
+		expectedStatusCode   int
+		expectedResponseCode int
+		expectedMessage      string
+		expectedDataLength   int // -1 表示不檢查 Data
+	}{
+		{
+			name:         "Success - Employee views balance",
+			callerClaims: employeeClaims,
+			setupMocks: func(mockBalanceSvc *mocks.MockLeaveBalanceService) {
+				mockBalanceSvc.EXPECT().GetBalances(gomock.Any(), employeeID).Return(mockBalances, nil).Times(1)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseCode: http.StatusOK,
+			expectedMessage:      "Success",
+			expectedDataLength:   2,
+		},
+		{
+			name:         "Success - HR views own balance",
+			callerClaims: hrClaims,
+			setupMocks: func(mockBalanceSvc *mocks.MockLeaveBalanceService) {
+				mockBalanceSvc.EXPECT().GetBalances(gomock.Any(), uuid.MustParse(hrClaims.UserID)).Return(mockBalances[:1], nil).Times(1)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseCode: http.StatusOK,
+			expectedMessage:      "Success",
+			expectedDataLength:   1,
+		},
+		{
+			name:                 "Unauthorized - Missing Claims",
+			callerClaims:         nil,
+			expectedStatusCode:   http.StatusUnauthorized,
+			expectedResponseCode: http.StatusUnauthorized,
+			expectedMessage:      "Unauthorized: Missing user claims",
+			expectedDataLength:   -1,
+		},
+		{
+			name:                 "Internal Server Error - Invalid Claims Type",
+			callerClaims:         "not-claims",
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseCode: http.StatusInternalServerError,
+			expectedMessage:      "Internal error processing user identity",
+			expectedDataLength:   -1,
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: employeeClaims,
+			setupMocks: func(mockBalanceSvc *mocks.MockLeaveBalanceService) {
+				mockBalanceSvc.EXPECT().GetBalances(gomock.Any(), employeeID).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode:   http.StatusInternalServerError,
+			expectedResponseCode: http.StatusInternalServerError,
+			expectedMessage:      "Failed to retrieve leave balance",
+			expectedDataLength:   -1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockBalanceSvc := mocks.NewMockLeaveBalanceService(ctrl)
+			handler := NewViewLeaveBalanceHandler(mockBalanceSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockBalanceSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/employee/leave-balance", nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ViewLeaveBalance(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int               `json:"code"`
+				Message string            `json:"message"`
+				Data    []LeaveBalanceDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedResponseCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedDataLength >= 0 {
+				require.Len(t, resp.Data, tc.expectedDataLength)
+				assert.Equal(t, models.LeaveTypeAnnual, resp.Data[0].LeaveType)
+				assert.True(t, decimal.RequireFromString("7.5").Equal(resp.Data[0].Balance))
+			}
+		})
+	}
+}
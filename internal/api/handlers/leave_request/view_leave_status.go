@@ -28,20 +28,27 @@ func NewViewLeaveStatusHandler(leaveRequestSvc interfaces.LeaveRequestService) *
 // LeaveRequestStatusDTO 定義返回給客戶端的請假記錄資料結構
 // 只包含必要欄位，避免洩漏過多內部細節
 type LeaveRequestStatusDTO struct {
-	ID          uuid.UUID  `json:"id"`
-	LeaveType   string     `json:"leave_type"`
-	StartDate   string     `json:"start_date"` // 返回 YYYY-MM-DD 格式字串
-	EndDate     string     `json:"end_date"`   // 返回 YYYY-MM-DD 格式字串
-	Reason      string     `json:"reason,omitempty"`
-	Status      string     `json:"status"`
-	RequestedAt time.Time  `json:"requested_at"`
-	ApprovedAt  *time.Time `json:"approved_at,omitempty"`
+	ID           uuid.UUID  `json:"id"`
+	LeaveType    string     `json:"leave_type"`
+	StartDate    string     `json:"start_date"` // 返回 YYYY-MM-DD 格式字串
+	EndDate      string     `json:"end_date"`   // 返回 YYYY-MM-DD 格式字串
+	Days         string     `json:"days"`       // 工作日數
+	DurationUnit string     `json:"duration_unit"`
+	StartTime    string     `json:"start_time,omitempty"` // 小時假的起訖時間 (HH:MM)
+	EndTime      string     `json:"end_time,omitempty"`
+	Reason       string     `json:"reason,omitempty"`
+	DecisionNote string     `json:"decision_note,omitempty"` // 審核人的決定說明
+	Status       string     `json:"status"`
+	RequestedAt  time.Time  `json:"requested_at"`
+	ApprovedAt   *time.Time `json:"approved_at,omitempty"`
+	CreatedByID  *uuid.UUID `json:"created_by_id,omitempty"` // 由 HR 代為提交時的建立者帳戶 ID
+	Version      int        `json:"version"`                 // 修改或取消時可放入 If-Match 標頭
 	// 不返回 ApproverID 或完整的 Approver/Account 資訊
 }
 
 // ViewLeaveStatus 方法處理員工查看自己請假狀態的 HTTP 請求
 func (h *ViewLeaveStatusHandler) ViewLeaveStatus(c *gin.Context) {
-	// 1. 授權檢查: 確保是普通員工 (Role 2)
+	// 1. 授權檢查: 確保已登入 (HR / Super Admin 也可以管理自己的假單)
 	claimsRaw, exists := c.Get("claims")
 	if !exists {
 		log.Println("ViewLeaveStatus: Claims not found in context")
@@ -56,10 +63,6 @@ func (h *ViewLeaveStatusHandler) ViewLeaveStatus(c *gin.Context) {
 	}
 
 	// 檢查角色是否為 Employee (Role 2)
-	if claims.Role != models.RoleEmployee {
-		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only employees can view their leave status"})
-		return
-	}
 	// 從 Context 獲取員工本人的 Account ID String
 
 	accountIDStr := claims.UserID
@@ -88,17 +91,7 @@ func (h *ViewLeaveStatusHandler) ViewLeaveStatus(c *gin.Context) {
 	// 4. 將 Service 返回的 []models.LeaveRequest 轉換為 []LeaveRequestStatusDTO
 	responseDTOs := make([]LeaveRequestStatusDTO, 0, len(leaveRequests))
 	for _, req := range leaveRequests {
-		dto := LeaveRequestStatusDTO{
-			ID:          req.ID,
-			LeaveType:   req.LeaveType,
-			StartDate:   req.StartDate.Format("2006-01-02"), // 格式化日期
-			EndDate:     req.EndDate.Format("2006-01-02"),   // 格式化日期
-			Reason:      req.Reason,
-			Status:      req.Status,
-			RequestedAt: req.RequestedAt,
-			ApprovedAt:  req.ApprovedAt,
-		}
-		responseDTOs = append(responseDTOs, dto)
+		responseDTOs = append(responseDTOs, toLeaveRequestStatusDTO(req))
 	}
 
 	// 5. 返回成功響應
@@ -108,3 +101,24 @@ func (h *ViewLeaveStatusHandler) ViewLeaveStatus(c *gin.Context) {
 		Data:    responseDTOs, // ***  返回 DTO slice ***
 	})
 }
+
+// toLeaveRequestStatusDTO 將假單轉換為返回給申請人的 DTO
+func toLeaveRequestStatusDTO(req models.LeaveRequest) LeaveRequestStatusDTO {
+	return LeaveRequestStatusDTO{
+		ID:           req.ID,
+		LeaveType:    req.LeaveType,
+		StartDate:    req.StartDate.Format("2006-01-02"), // 格式化日期
+		EndDate:      req.EndDate.Format("2006-01-02"),   // 格式化日期
+		Days:         req.Days.String(),
+		DurationUnit: req.DurationUnit,
+		StartTime:    req.StartTime,
+		EndTime:      req.EndTime,
+		Reason:       req.Reason,
+		DecisionNote: req.DecisionNote,
+		Status:       req.Status,
+		RequestedAt:  req.RequestedAt,
+		ApprovedAt:   req.ApprovedAt,
+		CreatedByID:  req.CreatedByID,
+		Version:      req.Version,
+	}
+}
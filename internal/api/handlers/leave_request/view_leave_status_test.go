@@ -91,13 +91,15 @@ func TestViewLeaveStatusHandler_ViewLeaveStatus(t *testing.T) {
 			expectedDataLength:   0, // 預期返回空列表
 		},
 		{
-			name:                 "Forbidden - HR tries to view employee status via this endpoint",
-			callerClaims:         hrClaims, // HR 身份
-			setupMocks:           nil,      // Service 不應被調用
-			expectedStatusCode:   http.StatusForbidden,
-			expectedResponseCode: http.StatusForbidden,
-			expectedMessage:      "Permission denied: Only employees can view their leave status",
-			expectedDataLength:   -1,
+			name:         "Success - HR views own leave status",
+			callerClaims: hrClaims, // HR 也可以查看自己的假單
+			setupMocks: func(mockLeaveSvc *mocks.MockLeaveRequestService) {
+				mockLeaveSvc.EXPECT().ListAccountRequests(gomock.Any(), hrClaims.UserID).Return([]models.LeaveRequest{}, nil).Times(1)
+			},
+			expectedStatusCode:   http.StatusOK,
+			expectedResponseCode: http.StatusOK,
+			expectedMessage:      "Success",
+			expectedDataLength:   0,
 		},
 		{
 			name:                 "Unauthorized - Missing Claims",
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateLeaveBlackoutHandler 包含依賴
+type CreateLeaveBlackoutHandler struct {
+	leaveRuleSvc interfaces.LeaveRuleService
+}
+
+// NewCreateLeaveBlackoutHandler 構造函數
+func NewCreateLeaveBlackoutHandler(leaveRuleSvc interfaces.LeaveRuleService) *CreateLeaveBlackoutHandler {
+	return &CreateLeaveBlackoutHandler{leaveRuleSvc: leaveRuleSvc}
+}
+
+// CreateLeaveBlackoutRequest 定義新增封鎖期間的請求體
+// Department / LeaveType 省略時分別表示全公司、所有假別
+type CreateLeaveBlackoutRequest struct {
+	Name       string `json:"name" binding:"required"`
+	Department string `json:"department"`
+	LeaveType  string `json:"leave_type"`
+	StartDate  string `json:"start_date" binding:"required,datetime=2006-01-02"`
+	EndDate    string `json:"end_date" binding:"required,datetime=2006-01-02"`
+}
+
+// CreateBlackout 方法處理 HR 新增封鎖期間的 HTTP 請求
+func (h *CreateLeaveBlackoutHandler) CreateBlackout(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave rules"})
+		return
+	}
+
+	// 2. 綁定並驗證請求體
+	var req CreateLeaveBlackoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	startDate, _ := time.Parse("2006-01-02", req.StartDate) // binding 已驗證格式
+	endDate, _ := time.Parse("2006-01-02", req.EndDate)
+
+	// 3. 調用 Service 層
+	blackout, err := h.leaveRuleSvc.CreateBlackout(c.Request.Context(), &models.LeaveBlackoutPeriod{
+		Name:       req.Name,
+		Department: req.Department,
+		LeaveType:  req.LeaveType,
+		StartDate:  startDate,
+		EndDate:    endDate,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidLeaveRule), errors.Is(err, services.ErrInvalidDateRange):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		default:
+			log.Printf("Error creating leave blackout %q via service: %v", req.Name, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to create blackout period"})
+		}
+		return
+	}
+
+	// 4. 返回成功響應
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Blackout period created successfully",
+		Data:    toLeaveBlackoutDTO(blackout),
+	})
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLeaveBlackoutHandler_CreateBlackout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	validBody := `{"name": "Q2 close", "department": "Finance", "leave_type": "annual", "start_date": "2025-06-25", "end_date": "2025-06-30"}`
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockLeaveRuleService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR creates blackout period",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().CreateBlackout(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, b *models.LeaveBlackoutPeriod) (*models.LeaveBlackoutPeriod, error) {
+						assert.Equal(t, "Q2 close", b.Name)
+						assert.Equal(t, "Finance", b.Department)
+						assert.Equal(t, models.LeaveTypeAnnual, b.LeaveType)
+						assert.Equal(t, time.Date(2025, time.June, 25, 0, 0, 0, 0, time.UTC), b.StartDate)
+						assert.Equal(t, time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC), b.EndDate)
+						created := *b
+						created.ID = uuid.New()
+						created.Active = true
+						return &created, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Blackout period created successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage leave rules",
+		},
+		{
+			name:               "Bad Request - Invalid Date",
+			callerClaims:       hrClaims,
+			requestBody:        `{"name": "Q2 close", "start_date": "25/06/2025", "end_date": "2025-06-30"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format",
+		},
+		{
+			name:         "Bad Request - End Before Start",
+			callerClaims: hrClaims,
+			requestBody:  `{"name": "Q2 close", "start_date": "2025-06-30", "end_date": "2025-06-25"}`,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().CreateBlackout(gomock.Any(), gomock.Any()).Return(nil, services.ErrInvalidDateRange).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInvalidDateRange.Error(),
+		},
+		{
+			name:         "Internal Server Error - Create Failed",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().CreateBlackout(gomock.Any(), gomock.Any()).Return(nil, services.ErrLeaveRuleCreateFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to create blackout period",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveRuleService(ctrl)
+			handler := NewCreateLeaveBlackoutHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/hr/leave-blackouts", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.CreateBlackout(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateStaffingRuleHandler 包含依賴
+type CreateStaffingRuleHandler struct {
+	leaveRuleSvc interfaces.LeaveRuleService
+}
+
+// NewCreateStaffingRuleHandler 構造函數
+func NewCreateStaffingRuleHandler(leaveRuleSvc interfaces.LeaveRuleService) *CreateStaffingRuleHandler {
+	return &CreateStaffingRuleHandler{leaveRuleSvc: leaveRuleSvc}
+}
+
+// CreateStaffingRuleRequest 定義新增最少在班人數規則的請求體
+type CreateStaffingRuleRequest struct {
+	Name       string `json:"name" binding:"required"`
+	Department string `json:"department" binding:"required"`
+	MinOnDuty  int    `json:"min_on_duty" binding:"required,min=1"`
+}
+
+// CreateStaffingRule 方法處理 HR 新增最少在班人數規則的 HTTP 請求
+func (h *CreateStaffingRuleHandler) CreateStaffingRule(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave rules"})
+		return
+	}
+
+	// 2. 綁定並驗證請求體
+	var req CreateStaffingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+
+	// 3. 調用 Service 層
+	rule, err := h.leaveRuleSvc.CreateStaffingRule(c.Request.Context(), &models.LeaveStaffingRule{
+		Name:       req.Name,
+		Department: req.Department,
+		MinOnDuty:  req.MinOnDuty,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidLeaveRule):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		default:
+			log.Printf("Error creating staffing rule %q via service: %v", req.Name, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to create staffing rule"})
+		}
+		return
+	}
+
+	// 4. 返回成功響應
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Staffing rule created successfully",
+		Data:    toStaffingRuleDTO(rule),
+	})
+}
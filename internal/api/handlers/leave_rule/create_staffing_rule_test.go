@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateStaffingRuleHandler_CreateStaffingRule(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	validBody := `{"name": "Two engineers on duty", "department": "Engineering", "min_on_duty": 2}`
+	expectedRule := &models.LeaveStaffingRule{Name: "Two engineers on duty", Department: "Engineering", MinOnDuty: 2}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockLeaveRuleService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR creates staffing rule",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().CreateStaffingRule(gomock.Any(), expectedRule).
+					Return(&models.LeaveStaffingRule{ID: uuid.New(), Name: "Two engineers on duty", Department: "Engineering", MinOnDuty: 2, Active: true}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Staffing rule created successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage leave rules",
+		},
+		{
+			name:               "Bad Request - Minimum Below One",
+			callerClaims:       hrClaims,
+			requestBody:        `{"name": "Nobody", "department": "Engineering", "min_on_duty": -1}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format",
+		},
+		{
+			name:               "Bad Request - Missing Department",
+			callerClaims:       hrClaims,
+			requestBody:        `{"name": "Two engineers on duty", "min_on_duty": 2}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format",
+		},
+		{
+			name:         "Bad Request - Service Rejects Rule",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().CreateStaffingRule(gomock.Any(), gomock.Any()).Return(nil, services.ErrInvalidLeaveRule).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInvalidLeaveRule.Error(),
+		},
+		{
+			name:         "Internal Server Error - Create Failed",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().CreateStaffingRule(gomock.Any(), gomock.Any()).Return(nil, services.ErrLeaveRuleCreateFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to create staffing rule",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveRuleService(ctrl)
+			handler := NewCreateStaffingRuleHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/hr/leave-staffing-rules", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.CreateStaffingRule(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+		})
+	}
+}
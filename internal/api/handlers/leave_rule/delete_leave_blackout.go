@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DeleteLeaveBlackoutHandler 包含依賴
+type DeleteLeaveBlackoutHandler struct {
+	leaveRuleSvc interfaces.LeaveRuleService
+}
+
+// NewDeleteLeaveBlackoutHandler 構造函數
+func NewDeleteLeaveBlackoutHandler(leaveRuleSvc interfaces.LeaveRuleService) *DeleteLeaveBlackoutHandler {
+	return &DeleteLeaveBlackoutHandler{leaveRuleSvc: leaveRuleSvc}
+}
+
+// DeleteBlackout 方法處理 HR 刪除封鎖期間的 HTTP 請求
+func (h *DeleteLeaveBlackoutHandler) DeleteBlackout(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave rules"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取封鎖期間 ID
+	blackoutID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid blackout period ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	if err := h.leaveRuleSvc.DeleteBlackout(c.Request.Context(), blackoutID); err != nil {
+		if errors.Is(err, services.ErrLeaveRuleNotFound) {
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Blackout period not found"})
+			return
+		}
+		log.Printf("Error deleting leave blackout %s via service: %v", blackoutID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to delete blackout period"})
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Blackout period deleted successfully"})
+}
@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListLeaveBlackoutsHandler 包含依賴
+type ListLeaveBlackoutsHandler struct {
+	leaveRuleSvc interfaces.LeaveRuleService
+}
+
+// NewListLeaveBlackoutsHandler 構造函數
+func NewListLeaveBlackoutsHandler(leaveRuleSvc interfaces.LeaveRuleService) *ListLeaveBlackoutsHandler {
+	return &ListLeaveBlackoutsHandler{leaveRuleSvc: leaveRuleSvc}
+}
+
+// LeaveBlackoutDTO 定義返回給客戶端的封鎖期間
+type LeaveBlackoutDTO struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Department string    `json:"department,omitempty"` // 空值表示全公司
+	LeaveType  string    `json:"leave_type,omitempty"` // 空值表示所有假別
+	StartDate  string    `json:"start_date"`           // YYYY-MM-DD
+	EndDate    string    `json:"end_date"`             // YYYY-MM-DD
+	Active     bool      `json:"active"`
+}
+
+// ListBlackouts 方法處理 HR 查詢封鎖期間的 HTTP 請求
+func (h *ListLeaveBlackoutsHandler) ListBlackouts(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave rules"})
+		return
+	}
+
+	// 2. 調用 Service 層
+	blackouts, err := h.leaveRuleSvc.ListBlackouts(c.Request.Context())
+	if err != nil {
+		log.Printf("Error listing leave blackout periods via service: %v", err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve blackout periods"})
+		return
+	}
+
+	// 3. 轉換為 DTO 並返回
+	dtos := make([]LeaveBlackoutDTO, 0, len(blackouts))
+	for _, blackout := range blackouts {
+		dtos = append(dtos, toLeaveBlackoutDTO(&blackout))
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: dtos})
+}
+
+// toLeaveBlackoutDTO 將封鎖期間轉換為 DTO
+func toLeaveBlackoutDTO(blackout *models.LeaveBlackoutPeriod) LeaveBlackoutDTO {
+	return LeaveBlackoutDTO{
+		ID:         blackout.ID,
+		Name:       blackout.Name,
+		Department: blackout.Department,
+		LeaveType:  blackout.LeaveType,
+		StartDate:  blackout.StartDate.Format("2006-01-02"),
+		EndDate:    blackout.EndDate.Format("2006-01-02"),
+		Active:     blackout.Active,
+	}
+}
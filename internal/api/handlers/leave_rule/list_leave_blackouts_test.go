@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListLeaveBlackoutsHandler_ListBlackouts(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	blackouts := []models.LeaveBlackoutPeriod{
+		{ID: uuid.New(), Name: "Q2 close", Department: "Finance", StartDate: time.Date(2025, time.June, 25, 0, 0, 0, 0, time.UTC),
+			EndDate: time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC), Active: true},
+	}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		setupMocks         func(mockSvc *mocks.MockLeaveRuleService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedCount      int
+	}{
+		{
+			name:         "Success - HR lists blackout periods",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().ListBlackouts(gomock.Any()).Return(blackouts, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedCount:      1,
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage leave rules",
+		},
+		{
+			name:               "Internal Server Error - Invalid Claims Type",
+			callerClaims:       "not-claims",
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Internal error processing user identity",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().ListBlackouts(gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve blackout periods",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveRuleService(ctrl)
+			handler := NewListLeaveBlackoutsHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/leave-blackouts", nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ListBlackouts(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int                `json:"code"`
+				Message string             `json:"message"`
+				Data    []LeaveBlackoutDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.Len(t, resp.Data, tc.expectedCount)
+				assert.Equal(t, "Q2 close", resp.Data[0].Name)
+				assert.Equal(t, "Finance", resp.Data[0].Department)
+				assert.Equal(t, "2025-06-25", resp.Data[0].StartDate)
+				assert.Equal(t, "2025-06-30", resp.Data[0].EndDate)
+			}
+		})
+	}
+}
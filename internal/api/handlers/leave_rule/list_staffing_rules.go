@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListStaffingRulesHandler 包含依賴
+type ListStaffingRulesHandler struct {
+	leaveRuleSvc interfaces.LeaveRuleService
+}
+
+// NewListStaffingRulesHandler 構造函數
+func NewListStaffingRulesHandler(leaveRuleSvc interfaces.LeaveRuleService) *ListStaffingRulesHandler {
+	return &ListStaffingRulesHandler{leaveRuleSvc: leaveRuleSvc}
+}
+
+// StaffingRuleDTO 定義返回給客戶端的最少在班人數規則
+type StaffingRuleDTO struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Department string    `json:"department"`
+	MinOnDuty  int       `json:"min_on_duty"`
+	Active     bool      `json:"active"`
+}
+
+// ListStaffingRules 方法處理 HR 查詢最少在班人數規則的 HTTP 請求
+func (h *ListStaffingRulesHandler) ListStaffingRules(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave rules"})
+		return
+	}
+
+	// 2. 調用 Service 層
+	rules, err := h.leaveRuleSvc.ListStaffingRules(c.Request.Context())
+	if err != nil {
+		log.Printf("Error listing staffing rules via service: %v", err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve staffing rules"})
+		return
+	}
+
+	// 3. 轉換為 DTO 並返回
+	dtos := make([]StaffingRuleDTO, 0, len(rules))
+	for _, rule := range rules {
+		dtos = append(dtos, toStaffingRuleDTO(&rule))
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: dtos})
+}
+
+// toStaffingRuleDTO 將最少在班人數規則轉換為 DTO
+func toStaffingRuleDTO(rule *models.LeaveStaffingRule) StaffingRuleDTO {
+	return StaffingRuleDTO{
+		ID:         rule.ID,
+		Name:       rule.Name,
+		Department: rule.Department,
+		MinOnDuty:  rule.MinOnDuty,
+		Active:     rule.Active,
+	}
+}
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListStaffingRulesHandler_ListStaffingRules(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	adminClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleSuperAdmin}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	rules := []models.LeaveStaffingRule{{ID: uuid.New(), Name: "Two engineers on duty", Department: "Engineering", MinOnDuty: 2, Active: true}}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		setupMocks         func(mockSvc *mocks.MockLeaveRuleService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - Super Admin lists staffing rules",
+			callerClaims: adminClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().ListStaffingRules(gomock.Any()).Return(rules, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing claims",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage leave rules",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: adminClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveRuleService) {
+				mockSvc.EXPECT().ListStaffingRules(gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve staffing rules",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveRuleService(ctrl)
+			handler := NewListStaffingRulesHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/leave-staffing-rules", nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ListStaffingRules(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int               `json:"code"`
+				Message string            `json:"message"`
+				Data    []StaffingRuleDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.Len(t, resp.Data, 1)
+				assert.Equal(t, "Engineering", resp.Data[0].Department)
+				assert.Equal(t, 2, resp.Data[0].MinOnDuty)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateLeaveTypeHandler 包含依賴
+type CreateLeaveTypeHandler struct {
+	leaveTypeSvc interfaces.LeaveTypeService
+}
+
+// NewCreateLeaveTypeHandler 構造函數
+func NewCreateLeaveTypeHandler(leaveTypeSvc interfaces.LeaveTypeService) *CreateLeaveTypeHandler {
+	return &CreateLeaveTypeHandler{leaveTypeSvc: leaveTypeSvc}
+}
+
+// CreateLeaveTypeRequest 定義新增假別的請求體
+type CreateLeaveTypeRequest struct {
+	Code               string `json:"code" binding:"required"`
+	Name               string `json:"name" binding:"required"`
+	Paid               *bool  `json:"paid"` // 未提供時視為有薪假
+	RequiresAttachment bool   `json:"requires_attachment"`
+	MaxConsecutiveDays int    `json:"max_consecutive_days" binding:"min=0"`
+	EligibleRoles      []int  `json:"eligible_roles" binding:"dive,min=0,max=2"` // 0:super, 1:hr, 2:employee; 空值表示所有角色
+}
+
+// CreateLeaveType 方法處理 HR 新增假別的 HTTP 請求
+func (h *CreateLeaveTypeHandler) CreateLeaveType(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave types"})
+		return
+	}
+
+	// 2. 綁定並驗證請求體
+	var req CreateLeaveTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	paid := true
+	if req.Paid != nil {
+		paid = *req.Paid
+	}
+
+	// 3. 調用 Service 層
+	leaveType, err := h.leaveTypeSvc.CreateLeaveType(c.Request.Context(), &models.LeaveType{
+		Code:               req.Code,
+		Name:               req.Name,
+		Paid:               paid,
+		RequiresAttachment: req.RequiresAttachment,
+		MaxConsecutiveDays: req.MaxConsecutiveDays,
+		EligibleRoles:      formatEligibleRoles(req.EligibleRoles),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidLeaveType):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrLeaveTypeExists):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: err.Error()})
+		default:
+			log.Printf("Error creating leave type %q via service: %v", req.Code, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to create leave type"})
+		}
+		return
+	}
+
+	// 4. 返回成功響應
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Leave type created successfully",
+		Data:    toLeaveTypeDTO(leaveType),
+	})
+}
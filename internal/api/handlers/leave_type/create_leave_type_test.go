@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateLeaveTypeHandler_CreateLeaveType(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	validBody := `{"code": "parental", "name": "Parental leave", "max_consecutive_days": 30, "eligible_roles": [2, 1]}`
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockLeaveTypeService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR creates paid leave type by default",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				expected := &models.LeaveType{Code: "parental", Name: "Parental leave", Paid: true, MaxConsecutiveDays: 30, EligibleRoles: "1,2"}
+				mockSvc.EXPECT().CreateLeaveType(gomock.Any(), expected).
+					DoAndReturn(func(ctx context.Context, lt *models.LeaveType) (*models.LeaveType, error) {
+						lt.ID, lt.Active = uuid.New(), true
+						return lt, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Leave type created successfully",
+		},
+		{
+			name:         "Success - Unpaid leave type",
+			callerClaims: hrClaims,
+			requestBody:  `{"code": "unpaid", "name": "Unpaid leave", "paid": false}`,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().CreateLeaveType(gomock.Any(), &models.LeaveType{Code: "unpaid", Name: "Unpaid leave"}).
+					Return(&models.LeaveType{ID: uuid.New(), Code: "unpaid", Name: "Unpaid leave", Active: true}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Leave type created successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			requestBody:        validBody,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage leave types",
+		},
+		{
+			name:               "Bad Request - Missing Code",
+			callerClaims:       hrClaims,
+			requestBody:        `{"name": "Parental leave"}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format",
+		},
+		{
+			name:               "Bad Request - Unknown Role",
+			callerClaims:       hrClaims,
+			requestBody:        `{"code": "parental", "name": "Parental leave", "eligible_roles": [7]}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format",
+		},
+		{
+			name:         "Bad Request - Service Rejects Code",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().CreateLeaveType(gomock.Any(), gomock.Any()).Return(nil, services.ErrInvalidLeaveType).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    services.ErrInvalidLeaveType.Error(),
+		},
+		{
+			name:         "Conflict - Code Exists",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().CreateLeaveType(gomock.Any(), gomock.Any()).Return(nil, services.ErrLeaveTypeExists).Times(1)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    services.ErrLeaveTypeExists.Error(),
+		},
+		{
+			name:         "Internal Server Error - Save Failed",
+			callerClaims: hrClaims,
+			requestBody:  validBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().CreateLeaveType(gomock.Any(), gomock.Any()).Return(nil, services.ErrLeaveTypeSaveFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to create leave type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveTypeService(ctrl)
+			handler := NewCreateLeaveTypeHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPost, "/hr/leave-types", bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.CreateLeaveType(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+		})
+	}
+}
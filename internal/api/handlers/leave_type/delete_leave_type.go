@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// DeleteLeaveTypeHandler 包含依賴
+type DeleteLeaveTypeHandler struct {
+	leaveTypeSvc interfaces.LeaveTypeService
+}
+
+// NewDeleteLeaveTypeHandler 構造函數
+func NewDeleteLeaveTypeHandler(leaveTypeSvc interfaces.LeaveTypeService) *DeleteLeaveTypeHandler {
+	return &DeleteLeaveTypeHandler{leaveTypeSvc: leaveTypeSvc}
+}
+
+// DeleteLeaveType 方法處理 HR 刪除假別的 HTTP 請求 (已被假單使用的假別只能停用)
+func (h *DeleteLeaveTypeHandler) DeleteLeaveType(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave types"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假別 ID
+	leaveTypeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid leave type ID in URL path"})
+		return
+	}
+
+	// 3. 調用 Service 層
+	if err := h.leaveTypeSvc.DeleteLeaveType(c.Request.Context(), leaveTypeID); err != nil {
+		switch {
+		case errors.Is(err, services.ErrLeaveTypeNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave type not found"})
+		case errors.Is(err, services.ErrLeaveTypeInUse):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: err.Error()})
+		default:
+			log.Printf("Error deleting leave type %s via service: %v", leaveTypeID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to delete leave type"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Leave type deleted successfully"})
+}
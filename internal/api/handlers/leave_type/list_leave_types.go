@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ListLeaveTypesHandler 包含依賴
+type ListLeaveTypesHandler struct {
+	leaveTypeSvc interfaces.LeaveTypeService
+}
+
+// NewListLeaveTypesHandler 構造函數
+func NewListLeaveTypesHandler(leaveTypeSvc interfaces.LeaveTypeService) *ListLeaveTypesHandler {
+	return &ListLeaveTypesHandler{leaveTypeSvc: leaveTypeSvc}
+}
+
+// LeaveTypeDTO 定義返回給客戶端的假別
+type LeaveTypeDTO struct {
+	ID                 uuid.UUID `json:"id"`
+	Code               string    `json:"code"`
+	Name               string    `json:"name"`
+	Paid               bool      `json:"paid"`
+	RequiresAttachment bool      `json:"requires_attachment"`
+	MaxConsecutiveDays int       `json:"max_consecutive_days"` // 0 表示不限
+	EligibleRoles      []int     `json:"eligible_roles"`       // 空陣列表示所有角色
+	Active             bool      `json:"active"`
+}
+
+// ListLeaveTypes 方法處理 HR 查詢假別的 HTTP 請求
+func (h *ListLeaveTypesHandler) ListLeaveTypes(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave types"})
+		return
+	}
+
+	// 2. 調用 Service 層
+	leaveTypes, err := h.leaveTypeSvc.ListLeaveTypes(c.Request.Context())
+	if err != nil {
+		log.Printf("Error listing leave types via service: %v", err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve leave types"})
+		return
+	}
+
+	// 3. 轉換為 DTO 並返回
+	dtos := make([]LeaveTypeDTO, 0, len(leaveTypes))
+	for _, leaveType := range leaveTypes {
+		dtos = append(dtos, toLeaveTypeDTO(&leaveType))
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: dtos})
+}
+
+// toLeaveTypeDTO 將假別轉換為 DTO
+func toLeaveTypeDTO(leaveType *models.LeaveType) LeaveTypeDTO {
+	roles := make([]int, 0)
+	for _, role := range leaveType.Roles() {
+		roles = append(roles, int(role))
+	}
+	return LeaveTypeDTO{
+		ID:                 leaveType.ID,
+		Code:               leaveType.Code,
+		Name:               leaveType.Name,
+		Paid:               leaveType.Paid,
+		RequiresAttachment: leaveType.RequiresAttachment,
+		MaxConsecutiveDays: leaveType.MaxConsecutiveDays,
+		EligibleRoles:      roles,
+		Active:             leaveType.Active,
+	}
+}
+
+// formatEligibleRoles 將請求中的角色列表轉換為 LeaveType.EligibleRoles 的儲存格式
+func formatEligibleRoles(roles []int) string {
+	values := make([]uint8, 0, len(roles))
+	for _, role := range roles {
+		values = append(values, uint8(role))
+	}
+	return models.FormatEligibleRoles(values)
+}
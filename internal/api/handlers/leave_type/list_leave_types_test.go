@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListLeaveTypesHandler_ListLeaveTypes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	leaveTypes := []models.LeaveType{
+		{ID: uuid.New(), Code: models.LeaveTypeAnnual, Name: "Annual leave", Paid: true, Active: true},
+		{ID: uuid.New(), Code: "parental", Name: "Parental leave", MaxConsecutiveDays: 30, EligibleRoles: "1,2", Active: true},
+	}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		setupMocks         func(mockSvc *mocks.MockLeaveTypeService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR lists leave types",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().ListLeaveTypes(gomock.Any()).Return(leaveTypes, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing claims",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage leave types",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().ListLeaveTypes(gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve leave types",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveTypeService(ctrl)
+			handler := NewListLeaveTypesHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/leave-types", nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ListLeaveTypes(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				Code    int            `json:"code"`
+				Message string         `json:"message"`
+				Data    []LeaveTypeDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.Len(t, resp.Data, 2)
+				assert.Equal(t, models.LeaveTypeAnnual, resp.Data[0].Code)
+				assert.Empty(t, resp.Data[0].EligibleRoles, "open to all roles")
+				assert.Equal(t, []int{1, 2}, resp.Data[1].EligibleRoles)
+				assert.Equal(t, 30, resp.Data[1].MaxConsecutiveDays)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// UpdateLeaveTypeHandler 包含依賴
+type UpdateLeaveTypeHandler struct {
+	leaveTypeSvc interfaces.LeaveTypeService
+}
+
+// NewUpdateLeaveTypeHandler 構造函數
+func NewUpdateLeaveTypeHandler(leaveTypeSvc interfaces.LeaveTypeService) *UpdateLeaveTypeHandler {
+	return &UpdateLeaveTypeHandler{leaveTypeSvc: leaveTypeSvc}
+}
+
+// UpdateLeaveTypeRequest 定義更新假別的請求體 (代碼不可修改; 停用請將 active 設為 false)
+type UpdateLeaveTypeRequest struct {
+	Name               string `json:"name" binding:"required"`
+	Paid               *bool  `json:"paid" binding:"required"`
+	RequiresAttachment bool   `json:"requires_attachment"`
+	MaxConsecutiveDays int    `json:"max_consecutive_days" binding:"min=0"`
+	EligibleRoles      []int  `json:"eligible_roles" binding:"dive,min=0,max=2"`
+	Active             *bool  `json:"active" binding:"required"`
+}
+
+// UpdateLeaveType 方法處理 HR 更新假別的 HTTP 請求
+func (h *UpdateLeaveTypeHandler) UpdateLeaveType(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can manage leave types"})
+		return
+	}
+
+	// 2. 從 URL 路徑參數獲取假別 ID 並綁定請求體
+	leaveTypeID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid leave type ID in URL path"})
+		return
+	}
+	var req UpdateLeaveTypeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+
+	// 3. 調用 Service 層
+	leaveType, err := h.leaveTypeSvc.UpdateLeaveType(c.Request.Context(), leaveTypeID, &models.LeaveType{
+		Name:               req.Name,
+		Paid:               *req.Paid,
+		RequiresAttachment: req.RequiresAttachment,
+		MaxConsecutiveDays: req.MaxConsecutiveDays,
+		EligibleRoles:      formatEligibleRoles(req.EligibleRoles),
+		Active:             *req.Active,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidLeaveType):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrLeaveTypeNotFound):
+			c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Leave type not found"})
+		default:
+			log.Printf("Error updating leave type %s via service: %v", leaveTypeID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update leave type"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Leave type updated successfully",
+		Data:    toLeaveTypeDTO(leaveType),
+	})
+}
@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateLeaveTypeHandler_UpdateLeaveType(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	leaveTypeID := uuid.New()
+	deactivateBody := `{"name": "Vacation", "paid": true, "active": false}`
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		requestBody        string
+		setupMocks         func(mockSvc *mocks.MockLeaveTypeService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - HR deactivates leave type",
+			callerClaims: hrClaims,
+			idParam:      leaveTypeID.String(),
+			requestBody:  deactivateBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().UpdateLeaveType(gomock.Any(), leaveTypeID, &models.LeaveType{Name: "Vacation", Paid: true, Active: false}).
+					Return(&models.LeaveType{ID: leaveTypeID, Code: models.LeaveTypeVacation, Name: "Vacation", Paid: true}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Leave type updated successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            leaveTypeID.String(),
+			requestBody:        deactivateBody,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can manage leave types",
+		},
+		{
+			name:               "Bad Request - Invalid ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			requestBody:        deactivateBody,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid leave type ID in URL path",
+		},
+		{
+			name:               "Bad Request - Missing Active Flag",
+			callerClaims:       hrClaims,
+			idParam:            leaveTypeID.String(),
+			requestBody:        `{"name": "Vacation", "paid": true}`,
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid request format",
+		},
+		{
+			name:         "Not Found",
+			callerClaims: hrClaims,
+			idParam:      leaveTypeID.String(),
+			requestBody:  deactivateBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().UpdateLeaveType(gomock.Any(), leaveTypeID, gomock.Any()).Return(nil, services.ErrLeaveTypeNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Leave type not found",
+		},
+		{
+			name:         "Internal Server Error - Save Failed",
+			callerClaims: hrClaims,
+			idParam:      leaveTypeID.String(),
+			requestBody:  deactivateBody,
+			setupMocks: func(mockSvc *mocks.MockLeaveTypeService) {
+				mockSvc.EXPECT().UpdateLeaveType(gomock.Any(), leaveTypeID, gomock.Any()).Return(nil, services.ErrLeaveTypeSaveFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to update leave type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockLeaveTypeService(ctrl)
+			handler := NewUpdateLeaveTypeHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			req, _ := http.NewRequest(http.MethodPut, "/hr/leave-types/"+tc.idParam, bytes.NewBufferString(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			c.Request = req
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tc.idParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.UpdateLeaveType(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Contains(t, resp.Message, tc.expectedMessage)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ApproveOvertimeClaimHandler 包含依賴
+type ApproveOvertimeClaimHandler struct {
+	overtimeSvc interfaces.OvertimeService
+}
+
+// NewApproveOvertimeClaimHandler 構造函數
+func NewApproveOvertimeClaimHandler(overtimeSvc interfaces.OvertimeService) *ApproveOvertimeClaimHandler {
+	return &ApproveOvertimeClaimHandler{overtimeSvc: overtimeSvc}
+}
+
+// ApproveOvertimeClaim 方法處理 HR 核准加班申請的 HTTP 請求; 核准後時數換算為補休
+func (h *ApproveOvertimeClaimHandler) ApproveOvertimeClaim(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can approve overtime claims"})
+		return
+	}
+
+	// 2. 調用 Service 層
+	claimID := c.Param("id")
+	if _, err := uuid.Parse(claimID); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid overtime claim ID in URL path"})
+		return
+	}
+	claim, err := h.overtimeSvc.ApproveClaim(c.Request.Context(), claimID, claims.UserID)
+	if err != nil {
+		writeProcessOvertimeError(c, err, claimID, "approved")
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Overtime claim approved successfully",
+		Data:    toOvertimeClaimDTO(*claim),
+	})
+}
+
+// writeProcessOvertimeError 將核准 / 拒絕加班申請的錯誤轉換為 HTTP 響應
+func writeProcessOvertimeError(c *gin.Context, err error, claimID, action string) {
+	switch {
+	case errors.Is(err, services.ErrOvertimeClaimNotFound):
+		c.JSON(http.StatusNotFound, common.Response{Code: http.StatusNotFound, Message: "Overtime claim not found"})
+	case errors.Is(err, services.ErrInvalidOvertimeClaimState):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Overtime claim cannot be " + action + " (state is not pending)"})
+	case errors.Is(err, services.ErrOvertimeClaimExpired):
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Overtime claim cannot be " + action + " (comp-off has already expired)"})
+	case errors.Is(err, services.ErrInvalidProcessor):
+		c.JSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: cannot process this overtime claim"})
+	case errors.Is(err, services.ErrOvertimeClaimSaveFailed), errors.Is(err, services.ErrLeaveBalanceUpdateFailed):
+		log.Printf("Internal error processing overtime claim %s: %v", claimID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to update overtime claim"})
+	default:
+		log.Printf("Unexpected error processing overtime claim %s: %v", claimID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "An unexpected error occurred"})
+	}
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApproveOvertimeClaimHandler_ApproveOvertimeClaim(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrID := uuid.New()
+	hrClaims := &models.Claims{UserID: hrID.String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	claimID := uuid.New()
+	expiresOn := time.Date(2025, 5, 30, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		idParam            string
+		setupMocks         func(mockSvc *mocks.MockOvertimeService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success",
+			callerClaims: hrClaims,
+			idParam:      claimID.String(),
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ApproveClaim(gomock.Any(), claimID.String(), hrID.String()).Return(&models.OvertimeClaim{
+					ID: claimID, Status: models.OvertimeStatusApproved, CompOffDays: decimal.RequireFromString("1.5"), CompOffExpiresOn: &expiresOn,
+				}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Overtime claim approved successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			idParam:            claimID.String(),
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can approve overtime claims",
+		},
+		{
+			name:               "Bad Request - Invalid Claim ID",
+			callerClaims:       hrClaims,
+			idParam:            "not-a-uuid",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Invalid overtime claim ID in URL path",
+		},
+		{
+			name:         "Forbidden - Own Claim",
+			callerClaims: hrClaims,
+			idParam:      claimID.String(),
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ApproveClaim(gomock.Any(), claimID.String(), hrID.String()).Return(nil, services.ErrInvalidProcessor).Times(1)
+			},
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: cannot process this overtime claim",
+		},
+		{
+			name:         "Not Found",
+			callerClaims: hrClaims,
+			idParam:      claimID.String(),
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ApproveClaim(gomock.Any(), claimID.String(), hrID.String()).Return(nil, services.ErrOvertimeClaimNotFound).Times(1)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "Overtime claim not found",
+		},
+		{
+			name:         "Bad Request - Not Pending",
+			callerClaims: hrClaims,
+			idParam:      claimID.String(),
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ApproveClaim(gomock.Any(), claimID.String(), hrID.String()).Return(nil, services.ErrInvalidOvertimeClaimState).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Overtime claim cannot be approved (state is not pending)",
+		},
+		{
+			name:         "Bad Request - Comp-Off Expired",
+			callerClaims: hrClaims,
+			idParam:      claimID.String(),
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ApproveClaim(gomock.Any(), claimID.String(), hrID.String()).Return(nil, services.ErrOvertimeClaimExpired).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Overtime claim cannot be approved (comp-off has already expired)",
+		},
+		{
+			name:         "Internal Server Error - Credit Failed",
+			callerClaims: hrClaims,
+			idParam:      claimID.String(),
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ApproveClaim(gomock.Any(), claimID.String(), hrID.String()).Return(nil, services.ErrLeaveBalanceUpdateFailed).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to update overtime claim",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockOvertimeService(ctrl)
+			handler := NewApproveOvertimeClaimHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/hr/overtime-claims/"+tc.idParam+"/approve", nil)
+			c.Params = gin.Params{gin.Param{Key: "id", Value: tc.idParam}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ApproveOvertimeClaim(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				common.Response
+				Data *OvertimeClaimDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.NotNil(t, resp.Data)
+				assert.Equal(t, "1.5", resp.Data.CompOffDays)
+				require.NotNil(t, resp.Data.CompOffExpiresOn)
+				assert.Equal(t, "2025-05-30", *resp.Data.CompOffExpiresOn)
+			}
+		})
+	}
+}
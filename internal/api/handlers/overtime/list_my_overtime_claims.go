@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+)
+
+// ListMyOvertimeClaimsHandler 包含依賴
+type ListMyOvertimeClaimsHandler struct {
+	overtimeSvc interfaces.OvertimeService
+}
+
+// NewListMyOvertimeClaimsHandler 構造函數
+func NewListMyOvertimeClaimsHandler(overtimeSvc interfaces.OvertimeService) *ListMyOvertimeClaimsHandler {
+	return &ListMyOvertimeClaimsHandler{overtimeSvc: overtimeSvc}
+}
+
+// ListMyOvertimeClaims 方法處理員工查詢本人加班申請的 HTTP 請求
+func (h *ListMyOvertimeClaimsHandler) ListMyOvertimeClaims(c *gin.Context) {
+	// 1. 確保已登入
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 調用 Service 層
+	overtimeClaims, err := h.overtimeSvc.ListMyClaims(c.Request.Context(), claims.UserID)
+	if err != nil {
+		log.Printf("Error listing overtime claims for account %s via service: %v", claims.UserID, err)
+		c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve overtime claims"})
+		return
+	}
+
+	// 3. 轉換為 DTO
+	dtos := make([]OvertimeClaimDTO, 0, len(overtimeClaims))
+	for _, claim := range overtimeClaims {
+		dtos = append(dtos, toOvertimeClaimDTO(claim))
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: dtos})
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMyOvertimeClaimsHandler_ListMyOvertimeClaims(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountID := uuid.New()
+	callerClaims := &models.Claims{UserID: accountID.String(), Role: models.RoleEmployee}
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		setupMocks         func(mockSvc *mocks.MockOvertimeService)
+		expectedStatusCode int
+		expectedMessage    string
+		expectedCount      int
+	}{
+		{
+			name:         "Success",
+			callerClaims: callerClaims,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ListMyClaims(gomock.Any(), accountID.String()).Return([]models.OvertimeClaim{
+					{ID: uuid.New(), AccountID: accountID, Status: models.OvertimeStatusApproved},
+					{ID: uuid.New(), AccountID: accountID, Status: models.OvertimeStatusPending},
+				}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+			expectedCount:      2,
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: callerClaims,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ListMyClaims(gomock.Any(), accountID.String()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to retrieve overtime claims",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockOvertimeService(ctrl)
+			handler := NewListMyOvertimeClaimsHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/employee/overtime-claims", nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ListMyOvertimeClaims(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				common.Response
+				Data []OvertimeClaimDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			assert.Len(t, resp.Data, tc.expectedCount)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+)
+
+// ListOvertimeClaimsHandler 包含依賴
+type ListOvertimeClaimsHandler struct {
+	overtimeSvc interfaces.OvertimeService
+}
+
+// NewListOvertimeClaimsHandler 構造函數
+func NewListOvertimeClaimsHandler(overtimeSvc interfaces.OvertimeService) *ListOvertimeClaimsHandler {
+	return &ListOvertimeClaimsHandler{overtimeSvc: overtimeSvc}
+}
+
+// ListOvertimeClaims 方法處理 HR 查詢加班申請列表的 HTTP 請求
+// 查詢參數 status: pending / approved / rejected, 省略表示不限狀態
+func (h *ListOvertimeClaimsHandler) ListOvertimeClaims(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can view overtime claims"})
+		return
+	}
+
+	// 2. 調用 Service 層
+	overtimeClaims, err := h.overtimeSvc.ListClaims(c.Request.Context(), c.Query("status"))
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidOvertimeClaim):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid query parameter: " + err.Error()})
+		default:
+			log.Printf("Error listing overtime claims via service: %v", err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to retrieve overtime claims"})
+		}
+		return
+	}
+
+	// 3. 轉換為 DTO
+	dtos := make([]OvertimeClaimDTO, 0, len(overtimeClaims))
+	for _, claim := range overtimeClaims {
+		dtos = append(dtos, toOvertimeClaimDTO(claim))
+	}
+	c.JSON(http.StatusOK, common.Response{Code: http.StatusOK, Message: "Success", Data: dtos})
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListOvertimeClaimsHandler_ListOvertimeClaims(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	applicantID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		query              string
+		setupMocks         func(mockSvc *mocks.MockOvertimeService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - Pending Claims With Applicant",
+			callerClaims: hrClaims,
+			query:        "?status=pending",
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ListClaims(gomock.Any(), models.OvertimeStatusPending).Return([]models.OvertimeClaim{{
+					ID: uuid.New(), AccountID: applicantID, Status: models.OvertimeStatusPending,
+					Account: models.Account{ID: applicantID, FirstName: "Amy", LastName: "Lin", Email: "amy@example.com"},
+				}}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Success",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can view overtime claims",
+		},
+		{
+			name:         "Bad Request - Unknown Status",
+			callerClaims: hrClaims,
+			query:        "?status=cancelled",
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().ListClaims(gomock.Any(), "cancelled").
+					Return(nil, fmt.Errorf("%w: unknown status %q", services.ErrInvalidOvertimeClaim, "cancelled")).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    `Invalid query parameter: invalid overtime claim: unknown status "cancelled"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockOvertimeService(ctrl)
+			handler := NewListOvertimeClaimsHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/hr/overtime-claims"+tc.query, nil)
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.ListOvertimeClaims(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp struct {
+				common.Response
+				Data []OvertimeClaimDTO `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+			if tc.expectedStatusCode == http.StatusOK {
+				require.Len(t, resp.Data, 1)
+				require.NotNil(t, resp.Data[0].Applicant)
+				assert.Equal(t, "amy@example.com", resp.Data[0].Applicant.Email)
+			}
+		})
+	}
+}
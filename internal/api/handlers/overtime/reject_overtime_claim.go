@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RejectOvertimeClaimHandler 包含依賴
+type RejectOvertimeClaimHandler struct {
+	overtimeSvc interfaces.OvertimeService
+}
+
+// NewRejectOvertimeClaimHandler 構造函數
+func NewRejectOvertimeClaimHandler(overtimeSvc interfaces.OvertimeService) *RejectOvertimeClaimHandler {
+	return &RejectOvertimeClaimHandler{overtimeSvc: overtimeSvc}
+}
+
+// RejectOvertimeClaimRequest 請求體 (可選)
+type RejectOvertimeClaimRequest struct {
+	Reason string `json:"reason"`
+}
+
+// RejectOvertimeClaim 方法處理 HR 拒絕加班申請的 HTTP 請求
+func (h *RejectOvertimeClaimHandler) RejectOvertimeClaim(c *gin.Context) {
+	// 1. 授權檢查: 確保是 HR 或 Super User
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+	if claims.Role != models.RoleHR && claims.Role != models.RoleSuperAdmin {
+		c.AbortWithStatusJSON(http.StatusForbidden, common.Response{Code: http.StatusForbidden, Message: "Permission denied: Only HR or Super Admin can reject overtime claims"})
+		return
+	}
+
+	// 2. 解析拒絕原因 (可選)
+	var req RejectOvertimeClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil && err.Error() != "EOF" {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request body format: " + err.Error()})
+		return
+	}
+
+	// 3. 調用 Service 層
+	claimID := c.Param("id")
+	if _, err := uuid.Parse(claimID); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid overtime claim ID in URL path"})
+		return
+	}
+	claim, err := h.overtimeSvc.RejectClaim(c.Request.Context(), claimID, claims.UserID, req.Reason)
+	if err != nil {
+		writeProcessOvertimeError(c, err, claimID, "rejected")
+		return
+	}
+
+	c.JSON(http.StatusOK, common.Response{
+		Code:    http.StatusOK,
+		Message: "Overtime claim rejected successfully",
+		Data:    toOvertimeClaimDTO(*claim),
+	})
+}
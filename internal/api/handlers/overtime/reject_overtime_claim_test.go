@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectOvertimeClaimHandler_RejectOvertimeClaim(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	hrID := uuid.New()
+	hrClaims := &models.Claims{UserID: hrID.String(), Role: models.RoleHR}
+	employeeClaims := &models.Claims{UserID: uuid.New().String(), Role: models.RoleEmployee}
+	claimID := uuid.New()
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		body               string
+		setupMocks         func(mockSvc *mocks.MockOvertimeService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success - With Reason",
+			callerClaims: hrClaims,
+			body:         `{"reason":"Not an approved incident"}`,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().RejectClaim(gomock.Any(), claimID.String(), hrID.String(), "Not an approved incident").
+					Return(&models.OvertimeClaim{ID: claimID, Status: models.OvertimeStatusRejected, DecisionNote: "Not an approved incident"}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Overtime claim rejected successfully",
+		},
+		{
+			name:         "Success - Without Body",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().RejectClaim(gomock.Any(), claimID.String(), hrID.String(), "").
+					Return(&models.OvertimeClaim{ID: claimID, Status: models.OvertimeStatusRejected}, nil).Times(1)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "Overtime claim rejected successfully",
+		},
+		{
+			name:               "Forbidden - Employee",
+			callerClaims:       employeeClaims,
+			expectedStatusCode: http.StatusForbidden,
+			expectedMessage:    "Permission denied: Only HR or Super Admin can reject overtime claims",
+		},
+		{
+			name:         "Bad Request - Not Pending",
+			callerClaims: hrClaims,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().RejectClaim(gomock.Any(), claimID.String(), hrID.String(), "").Return(nil, services.ErrInvalidOvertimeClaimState).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "Overtime claim cannot be rejected (state is not pending)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockOvertimeService(ctrl)
+			handler := NewRejectOvertimeClaimHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/hr/overtime-claims/"+claimID.String()+"/reject", strings.NewReader(tc.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = gin.Params{gin.Param{Key: "id", Value: claimID.String()}}
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.RejectOvertimeClaim(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			assert.Equal(t, tc.expectedMessage, resp.Message)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SubmitOvertimeClaimHandler 包含依賴
+type SubmitOvertimeClaimHandler struct {
+	overtimeSvc interfaces.OvertimeService
+}
+
+// NewSubmitOvertimeClaimHandler 構造函數
+func NewSubmitOvertimeClaimHandler(overtimeSvc interfaces.OvertimeService) *SubmitOvertimeClaimHandler {
+	return &SubmitOvertimeClaimHandler{overtimeSvc: overtimeSvc}
+}
+
+// SubmitOvertimeClaimRequest 定義提交加班申請的請求體
+type SubmitOvertimeClaimRequest struct {
+	WorkDate string          `json:"work_date" binding:"required,datetime=2006-01-02"`
+	Hours    decimal.Decimal `json:"hours"` // 例如 6 或 "2.5", 由 Service 層驗證範圍
+	Reason   string          `json:"reason" binding:"required"`
+}
+
+// OvertimeClaimDTO 定義返回給客戶端的加班申請
+type OvertimeClaimDTO struct {
+	ID               uuid.UUID  `json:"id"`
+	AccountID        uuid.UUID  `json:"account_id"`
+	WorkDate         string     `json:"work_date"` // YYYY-MM-DD
+	Hours            string     `json:"hours"`
+	Reason           string     `json:"reason"`
+	Status           string     `json:"status"`
+	ProcessedByID    *uuid.UUID `json:"processed_by_id,omitempty"`
+	ProcessedAt      *time.Time `json:"processed_at,omitempty"`
+	DecisionNote     string     `json:"decision_note,omitempty"`
+	CompOffDays      string     `json:"comp_off_days"`
+	CompOffExpiresOn *string    `json:"comp_off_expires_on,omitempty"` // YYYY-MM-DD
+	CreatedAt        time.Time  `json:"created_at"`
+
+	// 申請人資訊 (HR 列表才會帶出)
+	Applicant *struct {
+		FirstName string `json:"first_name"`
+		LastName  string `json:"last_name"`
+		Email     string `json:"email"`
+	} `json:"applicant,omitempty"`
+}
+
+// SubmitOvertimeClaim 方法處理員工提交加班申請的 HTTP 請求
+func (h *SubmitOvertimeClaimHandler) SubmitOvertimeClaim(c *gin.Context) {
+	// 1. 確保已登入
+	claimsRaw, exists := c.Get("claims")
+	if !exists {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{Code: http.StatusUnauthorized, Message: "Unauthorized: Missing user claims"})
+		return
+	}
+	claims, ok := claimsRaw.(*models.Claims)
+	if !ok || claims == nil {
+		log.Printf("Error: Invalid claims type in context: %T", claimsRaw)
+		c.AbortWithStatusJSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Internal error processing user identity"})
+		return
+	}
+
+	// 2. 綁定並驗證請求體
+	var req SubmitOvertimeClaimRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: "Invalid request format: " + err.Error()})
+		return
+	}
+	workDate, _ := time.Parse("2006-01-02", req.WorkDate) // binding 已驗證格式
+
+	// 3. 調用 Service 層
+	claim, err := h.overtimeSvc.SubmitClaim(c.Request.Context(), claims.UserID, models.OvertimeClaimInput{
+		WorkDate: workDate,
+		Hours:    req.Hours,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrInvalidOvertimeClaim), errors.Is(err, services.ErrOvertimeClaimExpired):
+			c.JSON(http.StatusBadRequest, common.Response{Code: http.StatusBadRequest, Message: err.Error()})
+		case errors.Is(err, services.ErrOvertimeClaimExists):
+			c.JSON(http.StatusConflict, common.Response{Code: http.StatusConflict, Message: err.Error()})
+		default:
+			log.Printf("Error submitting overtime claim for account %s via service: %v", claims.UserID, err)
+			c.JSON(http.StatusInternalServerError, common.Response{Code: http.StatusInternalServerError, Message: "Failed to submit overtime claim"})
+		}
+		return
+	}
+
+	// 4. 返回成功響應
+	c.JSON(http.StatusCreated, common.Response{
+		Code:    http.StatusCreated,
+		Message: "Overtime claim submitted successfully",
+		Data:    toOvertimeClaimDTO(*claim),
+	})
+}
+
+// toOvertimeClaimDTO 將加班申請模型轉換為 DTO; 有預加載申請人時一併帶出
+func toOvertimeClaimDTO(claim models.OvertimeClaim) OvertimeClaimDTO {
+	dto := OvertimeClaimDTO{
+		ID:            claim.ID,
+		AccountID:     claim.AccountID,
+		WorkDate:      claim.WorkDate.Format("2006-01-02"),
+		Hours:         claim.Hours.String(),
+		Reason:        claim.Reason,
+		Status:        claim.Status,
+		ProcessedByID: claim.ProcessedByID,
+		ProcessedAt:   claim.ProcessedAt,
+		DecisionNote:  claim.DecisionNote,
+		CompOffDays:   claim.CompOffDays.String(),
+		CreatedAt:     claim.CreatedAt,
+	}
+	if claim.CompOffExpiresOn != nil {
+		expiresOn := claim.CompOffExpiresOn.Format("2006-01-02")
+		dto.CompOffExpiresOn = &expiresOn
+	}
+	if claim.Account.ID != uuid.Nil {
+		dto.Applicant = &struct {
+			FirstName string `json:"first_name"`
+			LastName  string `json:"last_name"`
+			Email     string `json:"email"`
+		}{claim.Account.FirstName, claim.Account.LastName, claim.Account.Email}
+	}
+	return dto
+}
@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitOvertimeClaimHandler_SubmitOvertimeClaim(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	accountID := uuid.New()
+	callerClaims := &models.Claims{UserID: accountID.String(), Role: models.RoleEmployee}
+	validBody := `{"work_date":"2025-03-01","hours":6,"reason":"Weekend database incident"}`
+
+	testCases := []struct {
+		name               string
+		callerClaims       interface{}
+		body               string
+		setupMocks         func(mockSvc *mocks.MockOvertimeService)
+		expectedStatusCode int
+		expectedMessage    string
+	}{
+		{
+			name:         "Success",
+			callerClaims: callerClaims,
+			body:         validBody,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().SubmitClaim(gomock.Any(), accountID.String(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, _ string, input models.OvertimeClaimInput) (*models.OvertimeClaim, error) {
+						assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), input.WorkDate)
+						assert.True(t, decimal.NewFromInt(6).Equal(input.Hours))
+						return &models.OvertimeClaim{ID: uuid.New(), AccountID: accountID, WorkDate: input.WorkDate, Hours: input.Hours,
+							Reason: input.Reason, Status: models.OvertimeStatusPending}, nil
+					}).Times(1)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedMessage:    "Overtime claim submitted successfully",
+		},
+		{
+			name:               "Unauthorized - Missing Claims",
+			body:               validBody,
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedMessage:    "Unauthorized: Missing user claims",
+		},
+		{
+			name:               "Bad Request - Missing Reason",
+			callerClaims:       callerClaims,
+			body:               `{"work_date":"2025-03-01","hours":6}`,
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Bad Request - Invalid Hours",
+			callerClaims: callerClaims,
+			body:         `{"work_date":"2025-03-01","hours":30,"reason":"incident"}`,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().SubmitClaim(gomock.Any(), accountID.String(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: hours must be greater than 0 and at most 24", services.ErrInvalidOvertimeClaim)).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "invalid overtime claim: hours must be greater than 0 and at most 24",
+		},
+		{
+			name:         "Bad Request - Work Date Past Comp-Off Expiry",
+			callerClaims: callerClaims,
+			body:         validBody,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().SubmitClaim(gomock.Any(), accountID.String(), gomock.Any()).
+					Return(nil, fmt.Errorf("%w: work date is more than 90 days ago", services.ErrOvertimeClaimExpired)).Times(1)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedMessage:    "overtime claim is past the comp-off expiry period: work date is more than 90 days ago",
+		},
+		{
+			name:         "Conflict - Claim Already Exists For Work Date",
+			callerClaims: callerClaims,
+			body:         validBody,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().SubmitClaim(gomock.Any(), accountID.String(), gomock.Any()).Return(nil, services.ErrOvertimeClaimExists).Times(1)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedMessage:    "an overtime claim already exists for this work date",
+		},
+		{
+			name:         "Internal Server Error - Service Error",
+			callerClaims: callerClaims,
+			body:         validBody,
+			setupMocks: func(mockSvc *mocks.MockOvertimeService) {
+				mockSvc.EXPECT().SubmitClaim(gomock.Any(), accountID.String(), gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "Failed to submit overtime claim",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSvc := mocks.NewMockOvertimeService(ctrl)
+			handler := NewSubmitOvertimeClaimHandler(mockSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/employee/overtime-claims", strings.NewReader(tc.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			if tc.callerClaims != nil {
+				c.Set("claims", tc.callerClaims)
+			}
+
+			handler.SubmitOvertimeClaim(c)
+
+			require.Equal(t, tc.expectedStatusCode, recorder.Code)
+			var resp common.Response
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+			assert.Equal(t, tc.expectedStatusCode, resp.Code)
+			if tc.expectedMessage != "" {
+				assert.Equal(t, tc.expectedMessage, resp.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// FeedAuthMiddleware 結構體包含依賴, 驗證 iCalendar 訂閱網址中的 feed token
+type FeedAuthMiddleware struct {
+	calendarSvc interfaces.LeaveCalendarService
+}
+
+// NewFeedAuthMiddleware 構造函數
+func NewFeedAuthMiddleware(calendarSvc interfaces.LeaveCalendarService) *FeedAuthMiddleware {
+	return &FeedAuthMiddleware{calendarSvc: calendarSvc}
+}
+
+// Authenticate 用於 iCalendar 訂閱路由: 行事曆軟體無法設定 Authorization 標頭, 因此以查詢參數 token 傳遞
+// 只接受種類為 feed 且對象為路徑參數 subjectParam 的唯讀 feed token, 登入 JWT 與其他簽署連結一律拒絕
+// 已過期或已由訂閱者撤銷 (RevokeFeedTokens) 的 feed token 同樣拒絕
+// 驗證後以訂閱者身分設定 claims (僅含 UserID), 讀取時由 Service 重新檢查其權限
+func (m *FeedAuthMiddleware) Authenticate(feed string, subjectParam string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, err := m.calendarSvc.VerifyFeedToken(c.Request.Context(), c.Query("token"))
+		if err != nil || token.Feed != feed {
+			abortInvalidFeedToken(c)
+			return
+		}
+		subjectID, err := uuid.Parse(c.Param(subjectParam))
+		if err != nil || subjectID != token.SubjectID {
+			abortInvalidFeedToken(c)
+			return
+		}
+
+		viewerID := token.ViewerID.String()
+		c.Set("claims", &models.Claims{UserID: viewerID})
+		c.Set("user_id", viewerID)
+		c.Next()
+	}
+}
+
+// abortInvalidFeedToken 以 401 結束請求
+func abortInvalidFeedToken(c *gin.Context) {
+	c.AbortWithStatusJSON(http.StatusUnauthorized, common.Response{
+		Code:    http.StatusUnauthorized,
+		Message: "Invalid or missing calendar feed token",
+	})
+}
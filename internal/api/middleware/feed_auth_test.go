@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/erinchen11/hr-system/internal/services"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedAuthMiddleware_Authenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	viewerID := uuid.New()
+	accountID := uuid.New()
+	feedToken := &models.CalendarFeedToken{
+		Purpose: models.CalendarFeedTokenPurpose, Feed: models.CalendarFeedAccount, SubjectID: accountID, ViewerID: viewerID,
+	}
+
+	testCases := []struct {
+		name             string
+		token            string
+		accountIDParam   string
+		setupMocks       func(calendarSvc *mocks.MockLeaveCalendarService)
+		expectNextCalled bool
+	}{
+		{
+			name:           "Success - Token Scoped To Feed And Subject",
+			token:          "feed-token",
+			accountIDParam: accountID.String(),
+			setupMocks: func(calendarSvc *mocks.MockLeaveCalendarService) {
+				calendarSvc.EXPECT().VerifyFeedToken(gomock.Any(), "feed-token").Return(feedToken, nil).Times(1)
+			},
+			expectNextCalled: true,
+		},
+		{
+			name:           "Fail - Missing Token",
+			accountIDParam: accountID.String(),
+			setupMocks: func(calendarSvc *mocks.MockLeaveCalendarService) {
+				calendarSvc.EXPECT().VerifyFeedToken(gomock.Any(), "").Return(nil, services.ErrCalendarFeedTokenInvalid).Times(1)
+			},
+		},
+		{
+			name:           "Fail - Login JWT Rejected",
+			token:          "login.jwt.token",
+			accountIDParam: accountID.String(),
+			setupMocks: func(calendarSvc *mocks.MockLeaveCalendarService) {
+				calendarSvc.EXPECT().VerifyFeedToken(gomock.Any(), "login.jwt.token").Return(nil, services.ErrCalendarFeedTokenInvalid).Times(1)
+			},
+		},
+		{
+			name:           "Fail - Expired Or Revoked Token",
+			token:          "revoked-token",
+			accountIDParam: accountID.String(),
+			setupMocks: func(calendarSvc *mocks.MockLeaveCalendarService) {
+				calendarSvc.EXPECT().VerifyFeedToken(gomock.Any(), "revoked-token").Return(nil, services.ErrCalendarFeedTokenInvalid).Times(1)
+			},
+		},
+		{
+			name:           "Fail - Token For Another Subject",
+			token:          "feed-token",
+			accountIDParam: uuid.New().String(),
+			setupMocks: func(calendarSvc *mocks.MockLeaveCalendarService) {
+				calendarSvc.EXPECT().VerifyFeedToken(gomock.Any(), "feed-token").Return(feedToken, nil).Times(1)
+			},
+		},
+		{
+			name:           "Fail - Token For Another Feed",
+			token:          "team-token",
+			accountIDParam: accountID.String(),
+			setupMocks: func(calendarSvc *mocks.MockLeaveCalendarService) {
+				teamToken := *feedToken
+				teamToken.Feed = models.CalendarFeedTeam
+				calendarSvc.EXPECT().VerifyFeedToken(gomock.Any(), "team-token").Return(&teamToken, nil).Times(1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockCalendarSvc := mocks.NewMockLeaveCalendarService(ctrl)
+			feedAuthMiddleware := NewFeedAuthMiddleware(mockCalendarSvc)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockCalendarSvc)
+			}
+
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/calendar/accounts/"+tc.accountIDParam+"/leave.ics?token="+tc.token, nil)
+			c.Params = gin.Params{{Key: "account_id", Value: tc.accountIDParam}}
+
+			feedAuthMiddleware.Authenticate(models.CalendarFeedAccount, "account_id")(c)
+
+			assert.Equal(t, !tc.expectNextCalled, c.IsAborted())
+			if !tc.expectNextCalled {
+				assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+				var actualResponse common.Response
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &actualResponse))
+				assert.Equal(t, "Invalid or missing calendar feed token", actualResponse.Message)
+				return
+			}
+
+			userID, exists := c.Get("user_id")
+			assert.True(t, exists)
+			assert.Equal(t, viewerID.String(), userID)
+			claims, exists := c.Get("claims")
+			require.True(t, exists)
+			assert.Equal(t, viewerID.String(), claims.(*models.Claims).UserID)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+)
+
+// IfMatch 解析 If-Match 標頭並將客戶端預期的版本號放入 request context
+// 接受 "3"、W/"3" 或未加引號的 3; 未帶標頭或為 * 時不做版本檢查, 格式錯誤時返回 400
+// 版本號與記錄不符時由 Service 返回 ErrConcurrentModification, handler 對應為 409
+func IfMatch() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := strings.TrimSpace(c.GetHeader("If-Match"))
+		if header == "" || header == "*" {
+			c.Next()
+			return
+		}
+
+		version, ok := parseVersionTag(header)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusBadRequest, common.Response{
+				Code:    http.StatusBadRequest,
+				Message: "Invalid If-Match header: expected a single version ETag such as \"3\"",
+			})
+			return
+		}
+
+		c.Request = c.Request.WithContext(models.WithExpectedVersion(c.Request.Context(), version))
+		c.Next()
+	}
+}
+
+// ETag 將記錄的版本號格式化為 ETag 標頭值 (強驗證, 例如 "3")
+func ETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// parseVersionTag 解析單一 entity tag 中的版本號
+func parseVersionTag(tag string) (int, bool) {
+	tag = strings.TrimPrefix(tag, "W/")
+	if len(tag) >= 2 && strings.HasPrefix(tag, `"`) && strings.HasSuffix(tag, `"`) {
+		tag = tag[1 : len(tag)-1]
+	}
+	version, err := strconv.Atoi(tag)
+	if err != nil || version < 1 {
+		return 0, false
+	}
+	return version, true
+}
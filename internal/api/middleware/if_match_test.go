@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	common "github.com/erinchen11/hr-system/internal/models/common"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIfMatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	testCases := []struct {
+		name            string
+		ifMatch         string
+		expectAborted   bool
+		expectedVersion int // 0 表示 context 中不應帶有版本號
+	}{
+		{name: "Success - Quoted Version", ifMatch: `"3"`, expectedVersion: 3},
+		{name: "Success - Weak ETag", ifMatch: `W/"7"`, expectedVersion: 7},
+		{name: "Success - Bare Number", ifMatch: "2", expectedVersion: 2},
+		{name: "Success - No Header Skips Check", ifMatch: ""},
+		{name: "Success - Wildcard Skips Check", ifMatch: "*"},
+		{name: "Fail - Not A Number", ifMatch: `"abc"`, expectAborted: true},
+		{name: "Fail - Multiple Tags", ifMatch: `"1", "2"`, expectAborted: true},
+		{name: "Fail - Zero Version", ifMatch: `"0"`, expectAborted: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(recorder)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/test", nil)
+			if tc.ifMatch != "" {
+				c.Request.Header.Set("If-Match", tc.ifMatch)
+			}
+
+			IfMatch()(c)
+
+			assert.Equal(t, tc.expectAborted, c.IsAborted())
+			if tc.expectAborted {
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				var resp common.Response
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &resp))
+				assert.Equal(t, http.StatusBadRequest, resp.Code)
+				return
+			}
+			version, ok := models.ExpectedVersionFromContext(c.Request.Context())
+			assert.Equal(t, tc.expectedVersion != 0, ok)
+			assert.Equal(t, tc.expectedVersion, version)
+		})
+	}
+}
+
+func TestETag(t *testing.T) {
+	assert.Equal(t, `"4"`, ETag(4))
+	version, ok := parseVersionTag(ETag(4))
+	assert.True(t, ok)
+	assert.Equal(t, 4, version)
+}
@@ -3,11 +3,20 @@ package api
 import (
 	handlers "github.com/erinchen11/hr-system/internal/api/handlers"
 	account "github.com/erinchen11/hr-system/internal/api/handlers/account"
+	analyticshandler "github.com/erinchen11/hr-system/internal/api/handlers/analytics"
 	auth "github.com/erinchen11/hr-system/internal/api/handlers/auth"
+	calendarhandler "github.com/erinchen11/hr-system/internal/api/handlers/calendar"
+	delegationhandler "github.com/erinchen11/hr-system/internal/api/handlers/delegation"
+	employmenthandler "github.com/erinchen11/hr-system/internal/api/handlers/employment"
+	holidayhandler "github.com/erinchen11/hr-system/internal/api/handlers/holiday"
 	jobgradehandler "github.com/erinchen11/hr-system/internal/api/handlers/job_grade"
 	leaverequest "github.com/erinchen11/hr-system/internal/api/handlers/leave_request"
+	leaverulehandler "github.com/erinchen11/hr-system/internal/api/handlers/leave_rule"
+	leavetypehandler "github.com/erinchen11/hr-system/internal/api/handlers/leave_type"
+	overtimehandler "github.com/erinchen11/hr-system/internal/api/handlers/overtime"
 
 	"github.com/erinchen11/hr-system/internal/api/middleware"
+	"github.com/erinchen11/hr-system/internal/models"
 	"github.com/gin-gonic/gin"
 )
 
@@ -19,6 +28,7 @@ func RegisterRoutesV1(
 	checkLiveHandler *handlers.CheckLiveHandler, // 假設仍在 handlers 層級
 	loginHandler *auth.LoginHandler, // <--- 使用 auth.
 	authMiddleware *middleware.AuthMiddleware,
+	feedAuthMiddleware *middleware.FeedAuthMiddleware,
 	accountPasswordHandler *account.AccountPasswordHandler, // <--- 使用 account. (假設 Handler 內結構體名未改)
 	userCreationHandler *account.AccountCreationHandler, // <--- 使用 account. (假設 Handler 內結構體名未改)
 	listLeaveRequestsHandler *leaverequest.ListLeaveRequestsHandler, // <--- 使用 leave_request.
@@ -28,6 +38,57 @@ func RegisterRoutesV1(
 	applyLeaveHandler *leaverequest.ApplyLeaveHandler, // <--- 使用 leave_request.
 	viewLeaveStatusHandler *leaverequest.ViewLeaveStatusHandler, // <--- 使用 leave_request.
 	listJobGradesHandler *jobgradehandler.ListJobGradesHandler,
+	updateJobGradeHandler *jobgradehandler.UpdateJobGradeHandler,
+	viewLeaveBalanceHandler *leaverequest.ViewLeaveBalanceHandler,
+	listHolidaysHandler *holidayhandler.ListHolidaysHandler,
+	createHolidayHandler *holidayhandler.CreateHolidayHandler,
+	deleteHolidayHandler *holidayhandler.DeleteHolidayHandler,
+	updateWeekendDaysHandler *holidayhandler.UpdateWeekendDaysHandler,
+	cancelLeaveRequestHandler *leaverequest.CancelLeaveRequestHandler,
+	confirmLeaveCancellationHandler *leaverequest.ConfirmLeaveCancellationHandler,
+	declineLeaveCancellationHandler *leaverequest.DeclineLeaveCancellationHandler,
+	setManagerHandler *employmenthandler.SetManagerHandler,
+	listManagedLeaveRequestsHandler *leaverequest.ListManagedLeaveRequestsHandler,
+	managerApproveLeaveHandler *leaverequest.ManagerApproveLeaveHandler,
+	managerRejectLeaveHandler *leaverequest.ManagerRejectLeaveHandler,
+	createDelegationHandler *delegationhandler.CreateDelegationHandler,
+	listDelegationsHandler *delegationhandler.ListDelegationsHandler,
+	revokeDelegationHandler *delegationhandler.RevokeDelegationHandler,
+	leaveRequestHistoryHandler *leaverequest.LeaveRequestHistoryHandler,
+	teamLeaveCalendarHandler *calendarhandler.TeamLeaveCalendarHandler,
+	leaveFeedHandler *calendarhandler.LeaveFeedHandler,
+	leaveFeedTokenHandler *calendarhandler.LeaveFeedTokenHandler,
+	uploadLeaveAttachmentHandler *leaverequest.UploadLeaveAttachmentHandler,
+	listLeaveAttachmentsHandler *leaverequest.ListLeaveAttachmentsHandler,
+	downloadLeaveAttachmentHandler *leaverequest.DownloadLeaveAttachmentHandler,
+	listLeaveBlackoutsHandler *leaverulehandler.ListLeaveBlackoutsHandler,
+	createLeaveBlackoutHandler *leaverulehandler.CreateLeaveBlackoutHandler,
+	deleteLeaveBlackoutHandler *leaverulehandler.DeleteLeaveBlackoutHandler,
+	listStaffingRulesHandler *leaverulehandler.ListStaffingRulesHandler,
+	createStaffingRuleHandler *leaverulehandler.CreateStaffingRuleHandler,
+	deleteStaffingRuleHandler *leaverulehandler.DeleteStaffingRuleHandler,
+	leaveYearEndSummaryHandler *leaverequest.LeaveYearEndSummaryHandler,
+	listLeaveTypesHandler *leavetypehandler.ListLeaveTypesHandler,
+	createLeaveTypeHandler *leavetypehandler.CreateLeaveTypeHandler,
+	updateLeaveTypeHandler *leavetypehandler.UpdateLeaveTypeHandler,
+	deleteLeaveTypeHandler *leavetypehandler.DeleteLeaveTypeHandler,
+	bulkProcessLeaveRequestsHandler *leaverequest.BulkProcessLeaveRequestsHandler,
+	updateLeaveRequestHandler *leaverequest.UpdateLeaveRequestHandler,
+	applyLeaveOnBehalfHandler *leaverequest.ApplyLeaveOnBehalfHandler,
+	listEmploymentStatusChangesHandler *employmenthandler.ListEmploymentStatusChangesHandler,
+	submitOvertimeClaimHandler *overtimehandler.SubmitOvertimeClaimHandler,
+	listMyOvertimeClaimsHandler *overtimehandler.ListMyOvertimeClaimsHandler,
+	listOvertimeClaimsHandler *overtimehandler.ListOvertimeClaimsHandler,
+	approveOvertimeClaimHandler *overtimehandler.ApproveOvertimeClaimHandler,
+	rejectOvertimeClaimHandler *overtimehandler.RejectOvertimeClaimHandler,
+	absenceTotalsHandler *analyticshandler.AbsenceTotalsHandler,
+	bradfordFactorHandler *analyticshandler.BradfordFactorHandler,
+	approvalTurnaroundHandler *analyticshandler.ApprovalTurnaroundHandler,
+	terminateEmploymentHandler *employmenthandler.TerminateEmploymentHandler,
+	leaveActionLinkHandler *leaverequest.LeaveActionLinkHandler,
+	getLeaveRequestHandler *leaverequest.GetLeaveRequestHandler,
+	getJobGradeHandler *jobgradehandler.GetJobGradeHandler,
+	getManagerHandler *employmenthandler.GetManagerHandler,
 
 ) {
 	// --- 路由註冊邏輯保持不變 ---
@@ -36,24 +97,106 @@ func RegisterRoutesV1(
 	rg.GET("/check-live", checkLiveHandler.CheckLive)
 	rg.POST("/login", loginHandler.Login)
 
+	// Email 一次性審核連結 (連結本身經簽署, 不需登入; GET 只驗證, POST 才執行)
+	rg.GET("/leave-actions/:token", leaveActionLinkHandler.PreviewLeaveAction)
+	rg.POST("/leave-actions/:token", leaveActionLinkHandler.ExecuteLeaveAction)
+
+	// iCalendar 訂閱 (行事曆軟體無法設定標頭, 以 ?token= 傳遞 POST /leave-calendar/feeds 簽發的 feed token)
+	feeds := rg.Group("/calendar")
+	{
+		feeds.GET("/accounts/:account_id/leave.ics",
+			feedAuthMiddleware.Authenticate(models.CalendarFeedAccount, "account_id"), leaveFeedHandler.AccountFeed)
+		feeds.GET("/teams/:manager_id/leave.ics",
+			feedAuthMiddleware.Authenticate(models.CalendarFeedTeam, "manager_id"), leaveFeedHandler.TeamFeed)
+	}
+
 	// 需要登入後的
 	protected := rg.Group("/")
 	protected.Use(authMiddleware.Authenticate())
+	// 樂觀鎖: 更新假單 / 僱傭記錄 / 職等的路由可帶 If-Match 標頭, 版本不符時返回 409
+	ifMatch := middleware.IfMatch()
 	{
 		// --- 通用功能 ---
 		protected.POST("/change-password", accountPasswordHandler.ChangePassword)
 		protected.POST("/account/create", userCreationHandler.CreateUser) // 統一用戶創建入口
 
+		// --- 審核代理 (登入者為委託人) ---
+		protected.GET("/delegations", listDelegationsHandler.ListDelegations)
+		protected.POST("/delegations", createDelegationHandler.CreateDelegation)
+		protected.DELETE("/delegations/:id", revokeDelegationHandler.RevokeDelegation)
+
+		// --- 單一假單 (申請人本人或 HR, 以 ETag 返回版本號) ---
+		protected.GET("/leave-requests/:id", getLeaveRequestHandler.GetLeaveRequest)
+
+		// --- 假單歷程 (申請人本人或 HR, 由 Service 層判斷) ---
+		protected.GET("/leave-requests/:id/history", leaveRequestHistoryHandler.GetHistory)
+
+		// --- 假單佐證文件 (申請人本人或 HR, 由 Service 層判斷) ---
+		protected.POST("/leave-requests/:id/attachments", uploadLeaveAttachmentHandler.UploadAttachment)
+		protected.GET("/leave-requests/:id/attachments", listLeaveAttachmentsHandler.ListAttachments)
+		protected.GET("/leave-requests/:id/attachments/:attachment_id", downloadLeaveAttachmentHandler.DownloadAttachment)
+
+		// --- 團隊請假行事曆 (可查看的團隊由 Service 層判斷) ---
+		protected.GET("/leave-calendar", teamLeaveCalendarHandler.GetTeamCalendar)
+		protected.POST("/leave-calendar/feeds", leaveFeedTokenHandler.IssueFeedToken)
+		protected.DELETE("/leave-calendar/feeds", leaveFeedTokenHandler.RevokeFeedTokens)
+
 		// --- 特定角色 API ---
 
 		// HR APIs
 		hr := protected.Group("/hr")
 		{
 			hr.GET("/job-grades", listJobGradesHandler.ListJobGrades) 
+			hr.GET("/job-grades/:id", getJobGradeHandler.GetJobGrade)
+			hr.PUT("/job-grades/:id", ifMatch, updateJobGradeHandler.UpdateJobGrade)
 
 			hr.GET("/leave-requests", listLeaveRequestsHandler.ListLeaveRequests)
-			hr.POST("/leave-requests/:id/approve", approveLeaveRequestHandler.ApproveLeaveRequest)
-			hr.POST("/leave-requests/:id/reject", rejectLeaveRequestHandler.RejectLeaveRequest)
+			hr.POST("/leave-requests/bulk", bulkProcessLeaveRequestsHandler.BulkProcessLeaveRequests)
+			hr.POST("/leave-requests/:id/approve", ifMatch, approveLeaveRequestHandler.ApproveLeaveRequest)
+			hr.POST("/leave-requests/:id/reject", ifMatch, rejectLeaveRequestHandler.RejectLeaveRequest)
+			hr.POST("/leave-requests/:id/cancellation/confirm", ifMatch, confirmLeaveCancellationHandler.ConfirmLeaveCancellation)
+			hr.POST("/leave-requests/:id/cancellation/decline", ifMatch, declineLeaveCancellationHandler.DeclineLeaveCancellation)
+
+			hr.GET("/holidays", listHolidaysHandler.ListHolidays)
+			hr.POST("/holidays", createHolidayHandler.CreateHoliday)
+			hr.DELETE("/holidays/:id", deleteHolidayHandler.DeleteHoliday)
+			hr.PUT("/holiday-calendars/:year", updateWeekendDaysHandler.UpdateWeekendDays)
+
+			hr.GET("/leave-types", listLeaveTypesHandler.ListLeaveTypes)
+			hr.POST("/leave-types", createLeaveTypeHandler.CreateLeaveType)
+			hr.PUT("/leave-types/:id", updateLeaveTypeHandler.UpdateLeaveType)
+			hr.DELETE("/leave-types/:id", deleteLeaveTypeHandler.DeleteLeaveType)
+
+			hr.GET("/leave-blackouts", listLeaveBlackoutsHandler.ListBlackouts)
+			hr.POST("/leave-blackouts", createLeaveBlackoutHandler.CreateBlackout)
+			hr.DELETE("/leave-blackouts/:id", deleteLeaveBlackoutHandler.DeleteBlackout)
+			hr.GET("/leave-staffing-rules", listStaffingRulesHandler.ListStaffingRules)
+			hr.POST("/leave-staffing-rules", createStaffingRuleHandler.CreateStaffingRule)
+			hr.DELETE("/leave-staffing-rules/:id", deleteStaffingRuleHandler.DeleteStaffingRule)
+
+			hr.GET("/leave-year-end/:year", leaveYearEndSummaryHandler.GetYearEndSummary)
+
+			hr.GET("/employees/:account_id/manager", getManagerHandler.GetManager)
+			hr.PUT("/employees/:account_id/manager", ifMatch, setManagerHandler.SetManager)
+			hr.POST("/employees/:account_id/leave-requests", applyLeaveOnBehalfHandler.ApplyLeaveOnBehalf)
+			hr.GET("/employees/:account_id/status-changes", listEmploymentStatusChangesHandler.ListEmploymentStatusChanges)
+			hr.POST("/employees/:account_id/terminate", ifMatch, terminateEmploymentHandler.TerminateEmployment)
+
+			hr.GET("/overtime-claims", listOvertimeClaimsHandler.ListOvertimeClaims)
+			hr.POST("/overtime-claims/:id/approve", approveOvertimeClaimHandler.ApproveOvertimeClaim)
+			hr.POST("/overtime-claims/:id/reject", rejectOvertimeClaimHandler.RejectOvertimeClaim)
+
+			hr.GET("/analytics/absences", absenceTotalsHandler.GetAbsenceTotals)
+			hr.GET("/analytics/bradford-factor", bradfordFactorHandler.GetBradfordFactors)
+			hr.GET("/analytics/approval-turnaround", approvalTurnaroundHandler.GetApprovalTurnaround)
+		}
+
+		// Manager APIs (直屬主管審核部屬假單, 是否為主管由 Service 層判斷)
+		manager := protected.Group("/manager")
+		{
+			manager.GET("/leave-requests", listManagedLeaveRequestsHandler.ListManagedLeaveRequests)
+			manager.POST("/leave-requests/:id/approve", ifMatch, managerApproveLeaveHandler.ApproveLeaveRequest)
+			manager.POST("/leave-requests/:id/reject", ifMatch, managerRejectLeaveHandler.RejectLeaveRequest)
 		}
 
 		// Employee APIs
@@ -62,6 +205,11 @@ func RegisterRoutesV1(
 			employee.GET("/profile", userProfileHandler.GetProfile)
 			employee.POST("/apply-leave", applyLeaveHandler.ApplyLeave)
 			employee.GET("/leave-status", viewLeaveStatusHandler.ViewLeaveStatus)
+			employee.GET("/leave-balance", viewLeaveBalanceHandler.ViewLeaveBalance)
+			employee.PUT("/leave-requests/:id", ifMatch, updateLeaveRequestHandler.UpdateLeaveRequest)
+			employee.POST("/leave-requests/:id/cancel", ifMatch, cancelLeaveRequestHandler.CancelLeaveRequest)
+			employee.POST("/overtime-claims", submitOvertimeClaimHandler.SubmitOvertimeClaim)
+			employee.GET("/overtime-claims", listMyOvertimeClaimsHandler.ListMyOvertimeClaims)
 		}
 
 		// Super User APIs (可選)
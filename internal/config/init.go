@@ -49,6 +49,23 @@ func LoadConfig() {
 	environment.JwtExpireHours = getEnv("JWT_EXPIRE_HOURS", environment.DefaultJwtExpireHours)
 	environment.DefaultPassword = getEnv("DEFAULT_PASSWORD", "")
 
+	environment.AttachmentStorageDir = getEnv("ATTACHMENT_STORAGE_DIR", environment.DefaultAttachmentStorageDir)
+	environment.AttachmentMaxSizeMB = getEnv("ATTACHMENT_MAX_SIZE_MB", environment.DefaultAttachmentMaxSizeMB)
+
+	environment.LeaveSchedulerIntervalMinutes = getEnv("LEAVE_SCHEDULER_INTERVAL_MINUTES", environment.DefaultLeaveSchedulerIntervalMinutes)
+	environment.LeaveReminderAfterHours = getEnv("LEAVE_REMINDER_AFTER_HOURS", environment.DefaultLeaveReminderAfterHours)
+	environment.LeaveEscalateAfterHours = getEnv("LEAVE_ESCALATE_AFTER_HOURS", environment.DefaultLeaveEscalateAfterHours)
+	environment.LeaveAutoApproveSickMaxDays = getEnv("LEAVE_AUTO_APPROVE_SICK_MAX_DAYS", environment.DefaultLeaveAutoApproveSickMaxDays)
+
+	environment.LongLeaveThresholdDays = getEnv("LEAVE_LONG_LEAVE_THRESHOLD_DAYS", environment.DefaultLongLeaveThresholdDays)
+
+	environment.CompOffExpiryDays = getEnv("COMP_OFF_EXPIRY_DAYS", environment.DefaultCompOffExpiryDays)
+
+	environment.LeaveActionLinkBaseURL = getEnv("LEAVE_ACTION_LINK_BASE_URL", "")
+	environment.LeaveActionLinkTTLHours = getEnv("LEAVE_ACTION_LINK_TTL_HOURS", environment.DefaultLeaveActionLinkTTLHours)
+
+	environment.LeaveCalendarFeedTTLDays = getEnv("LEAVE_CALENDAR_FEED_TTL_DAYS", environment.DefaultLeaveCalendarFeedTTLDays)
+
 	checkCriticalConfigs()
 	log.Println("Configuration loading complete.")
 }
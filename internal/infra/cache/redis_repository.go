@@ -91,3 +91,16 @@ func (r *redisCacheRepository) Delete(ctx context.Context, keys ...string) error
 	}
 	return nil
 }
+
+// SetNX 僅在 key 不存在時寫入 (JSON 格式)，返回是否寫入成功
+func (r *redisCacheRepository) SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, fmt.Errorf("redis marshal failed for key %s: %w", key, err)
+	}
+	ok, err := r.client.SetNX(ctx, key, data, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis setnx failed for key %s: %w", key, err)
+	}
+	return ok, nil
+}
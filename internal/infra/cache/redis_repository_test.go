@@ -192,3 +192,50 @@ func TestRedisCacheRepository_Delete(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+// 測試 SetNX 方法
+func TestRedisCacheRepository_SetNX(t *testing.T) {
+	ctx := context.Background()
+	db, mock := redismock.NewClientMock()
+	repo := NewRedisCacheRepository(db)
+
+	key := "test:setnx"
+	value := "replica-1"
+	jsonData, _ := json.Marshal(value)
+	ttl := time.Minute
+
+	// 測試案例 1: key 不存在, 寫入成功
+	t.Run("Acquired", func(t *testing.T) {
+		mock.ExpectSetNX(key, jsonData, ttl).SetVal(true)
+
+		ok, err := repo.SetNX(ctx, key, value, ttl)
+
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	// 測試案例 2: key 已存在
+	t.Run("Already Held", func(t *testing.T) {
+		mock.ExpectSetNX(key, jsonData, ttl).SetVal(false)
+
+		ok, err := repo.SetNX(ctx, key, value, ttl)
+
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	// 測試案例 3: Redis 錯誤
+	t.Run("Redis Error", func(t *testing.T) {
+		expectedErr := errors.New("redis setnx error")
+		mock.ExpectSetNX(key, jsonData, ttl).SetErr(expectedErr)
+
+		ok, err := repo.SetNX(ctx, key, value, ttl)
+
+		assert.False(t, ok)
+		assert.Contains(t, err.Error(), "redis setnx failed")
+		assert.ErrorIs(t, err, expectedErr)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
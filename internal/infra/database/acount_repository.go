@@ -74,3 +74,29 @@ func (r *gormAccountRepository) UpdatePassword(ctx context.Context, id uuid.UUID
 	}
 	return nil
 }
+
+// ListAccountsByRoles 列出屬於指定角色的所有帳戶
+func (r *gormAccountRepository) ListAccountsByRoles(ctx context.Context, roles ...uint8) ([]models.Account, error) {
+	var accounts []models.Account
+	if len(roles) == 0 {
+		return accounts, nil
+	}
+	err := r.db.WithContext(ctx).Where("role IN ?", roles).Order("created_at asc").Find(&accounts).Error
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// IncrementFeedVersion 遞增帳戶的訂閱行事曆版本
+func (r *gormAccountRepository) IncrementFeedVersion(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Model(&models.Account{}).Where("id = ?", id).
+		Update("feed_version", gorm.Expr("feed_version + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormApprovalDelegationRepository 實現了 ApprovalDelegationRepository 介面
+type gormApprovalDelegationRepository struct {
+	db *gorm.DB
+}
+
+// NewGormApprovalDelegationRepository 構造函數
+func NewGormApprovalDelegationRepository(db *gorm.DB) interfaces.ApprovalDelegationRepository {
+	return &gormApprovalDelegationRepository{db: db}
+}
+
+// Create 建立代理記錄
+func (r *gormApprovalDelegationRepository) Create(ctx context.Context, delegation *models.ApprovalDelegation) error {
+	if err := r.db.WithContext(ctx).Create(delegation).Error; err != nil {
+		return fmt.Errorf("failed to create approval delegation: %w", err)
+	}
+	return nil
+}
+
+// GetByID 根據 ID 獲取代理記錄
+func (r *gormApprovalDelegationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ApprovalDelegation, error) {
+	var delegation models.ApprovalDelegation
+	if err := r.db.WithContext(ctx).First(&delegation, "id = ?", id).Error; err != nil {
+		return nil, err // 包含 gorm.ErrRecordNotFound
+	}
+	return &delegation, nil
+}
+
+// Delete 刪除代理記錄
+func (r *gormApprovalDelegationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.ApprovalDelegation{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete approval delegation %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListByAccountID 列出帳戶委託出去或被委託的所有代理
+func (r *gormApprovalDelegationRepository) ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.ApprovalDelegation, error) {
+	var delegations []models.ApprovalDelegation
+	err := r.db.WithContext(ctx).
+		Preload("Delegator").
+		Preload("Delegate").
+		Where("delegator_id = ? OR delegate_id = ?", accountID, accountID).
+		Order("start_date desc").
+		Find(&delegations).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching approval delegations for account %s: %w", accountID, err)
+	}
+	return delegations, nil
+}
+
+// ListActiveForDelegate 列出代理人在指定日期有效的代理記錄
+func (r *gormApprovalDelegationRepository) ListActiveForDelegate(ctx context.Context, delegateID uuid.UUID, on time.Time) ([]models.ApprovalDelegation, error) {
+	var delegations []models.ApprovalDelegation
+	day := on.Format("2006-01-02")
+	err := r.db.WithContext(ctx).
+		Where("delegate_id = ? AND start_date <= ? AND end_date >= ?", delegateID, day, day).
+		Find(&delegations).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching active delegations for delegate %s: %w", delegateID, err)
+	}
+	return delegations, nil
+}
+
+// ListActiveForDelegators 列出委託人在指定日期有效的代理記錄
+func (r *gormApprovalDelegationRepository) ListActiveForDelegators(ctx context.Context, delegatorIDs []uuid.UUID, on time.Time) ([]models.ApprovalDelegation, error) {
+	var delegations []models.ApprovalDelegation
+	if len(delegatorIDs) == 0 {
+		return delegations, nil
+	}
+	day := on.Format("2006-01-02")
+	err := r.db.WithContext(ctx).
+		Preload("Delegate").
+		Where("delegator_id IN ? AND start_date <= ? AND end_date >= ?", delegatorIDs, day, day).
+		Find(&delegations).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching active delegations for %d delegator(s): %w", len(delegatorIDs), err)
+	}
+	return delegations, nil
+}
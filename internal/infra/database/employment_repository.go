@@ -24,7 +24,7 @@ func NewGormEmploymentRepository(db *gorm.DB) interfaces.EmploymentRepository {
 // CreateEmployment 創建新的僱傭記錄
 func (r *gormEmploymentRepository) CreateEmployment(ctx context.Context, employment *models.Employment) error {
 	// BeforeCreate Hook 會處理 UUID 和預設 Status
-	if err := r.db.WithContext(ctx).Create(employment).Error; err != nil {
+	if err := conn(ctx, r.db).Create(employment).Error; err != nil {
 		// 可以考慮檢查特定錯誤，例如外鍵約束失敗 (AccountID 不存在)
 		// 或者唯一約束失敗 (如果 AccountID 被設為 unique)
 		return fmt.Errorf("failed to create employment record: %w", err)
@@ -38,7 +38,7 @@ func (r *gormEmploymentRepository) CreateEmployment(ctx context.Context, employm
 func (r *gormEmploymentRepository) GetEmploymentByAccountID(ctx context.Context, accountID uuid.UUID) (*models.Employment, error) {
 	var employment models.Employment
 	// 根據 account_id 查找。如果未來支持歷史記錄，可能需要加入 status='active' 或其他排序邏輯
-	err := r.db.WithContext(ctx).Where("account_id = ?", accountID).First(&employment).Error
+	err := conn(ctx, r.db).Where("account_id = ?", accountID).First(&employment).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, gorm.ErrRecordNotFound // 直接返回 GORM 的 NotFound 錯誤
@@ -52,7 +52,7 @@ func (r *gormEmploymentRepository) GetEmploymentByAccountID(ctx context.Context,
 // 注意：這裡沒有 Preload Account 或 JobGrade
 func (r *gormEmploymentRepository) GetEmploymentByID(ctx context.Context, id uuid.UUID) (*models.Employment, error) {
 	var employment models.Employment
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&employment).Error
+	err := conn(ctx, r.db).Where("id = ?", id).First(&employment).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, gorm.ErrRecordNotFound
@@ -64,21 +64,15 @@ func (r *gormEmploymentRepository) GetEmploymentByID(ctx context.Context, id uui
 
 // UpdateEmployment 更新僱傭記錄
 func (r *gormEmploymentRepository) UpdateEmployment(ctx context.Context, employment *models.Employment) error {
-	// 使用 Save 更新所有非零值欄位，確保 employment.ID 有效
+	// 以樂觀鎖更新所有欄位，確保 employment.ID 有效
 	if employment.ID == uuid.Nil {
 		return errors.New("cannot update employment record with zero ID")
 	}
-	// GORM 的 Save 會自動更新 UpdatedAt (如果有 autoUpdateTime tag)
-	result := r.db.WithContext(ctx).Save(employment)
-	if result.Error != nil {
-		return fmt.Errorf("failed to update employment record %s: %w", employment.ID, result.Error)
-	}
-	// 檢查是否有記錄被更新
-	if result.RowsAffected == 0 {
-		// 如果 Save 沒報錯，但 RowsAffected 為 0，表示找不到對應 ID 的記錄
-		return gorm.ErrRecordNotFound
+	err := saveWithVersion(ctx, r.db, employment, employment.ID, &employment.Version)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && !errors.Is(err, interfaces.ErrConcurrentModification) {
+		return fmt.Errorf("failed to update employment record %s: %w", employment.ID, err)
 	}
-	return nil
+	return err
 }
 
 // ListEmployments 列出僱傭記錄
@@ -86,7 +80,7 @@ func (r *gormEmploymentRepository) UpdateEmployment(ctx context.Context, employm
 func (r *gormEmploymentRepository) ListEmployments(ctx context.Context /*, filterOptions, paginationOptions */) ([]models.Employment, error) {
 	var employments []models.Employment
 	// 建議加入排序，例如按創建時間或 AccountID
-	err := r.db.WithContext(ctx).Order("created_at desc").Find(&employments).Error
+	err := conn(ctx, r.db).Order("created_at desc").Find(&employments).Error
 	if err != nil {
 		// Find 不會因為找不到記錄而返回 gorm.ErrRecordNotFound
 		return nil, fmt.Errorf("error fetching employments: %w", err)
@@ -100,10 +94,56 @@ func (r *gormEmploymentRepository) ListEmployments(ctx context.Context /*, filte
 func (r *gormEmploymentRepository) GetEmploymentCountByJobGradeID(ctx context.Context, jobGradeID uuid.UUID) (int64, error) {
 	var count int64
 	// 使用 Model 指定查詢 employments 表，並用 Where 過濾 job_grade_id
-	err := r.db.WithContext(ctx).Model(&models.Employment{}).Where("job_grade_id = ?", jobGradeID).Count(&count).Error
+	err := conn(ctx, r.db).Model(&models.Employment{}).Where("job_grade_id = ?", jobGradeID).Count(&count).Error
 	if err != nil {
 		// Count 出錯時，GORM 可能不會返回 ErrRecordNotFound，直接返回錯誤
 		return 0, fmt.Errorf("error counting employments for job grade %s: %w", jobGradeID, err)
 	}
 	return count, nil
 }
+
+// ListEmploymentsByManagerID 列出直屬主管為指定帳戶的僱傭記錄
+func (r *gormEmploymentRepository) ListEmploymentsByManagerID(ctx context.Context, managerAccountID uuid.UUID) ([]models.Employment, error) {
+	var employments []models.Employment
+	err := conn(ctx, r.db).Where("manager_id = ?", managerAccountID).Order("created_at desc").Find(&employments).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching employments for manager %s: %w", managerAccountID, err)
+	}
+	return employments, nil
+}
+
+// ListActiveEmploymentsByDepartment 列出部門中在職的僱傭記錄
+func (r *gormEmploymentRepository) ListActiveEmploymentsByDepartment(ctx context.Context, department string) ([]models.Employment, error) {
+	var employments []models.Employment
+	err := conn(ctx, r.db).
+		Where("department = ? AND status = ?", department, models.EmploymentStatusActive).
+		Find(&employments).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching active employments of department %s: %w", department, err)
+	}
+	return employments, nil
+}
+
+// ListEmploymentsByStatus 列出指定僱傭狀態的所有記錄
+func (r *gormEmploymentRepository) ListEmploymentsByStatus(ctx context.Context, status string) ([]models.Employment, error) {
+	var employments []models.Employment
+	err := conn(ctx, r.db).Where("status = ?", status).Find(&employments).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching employments with status %s: %w", status, err)
+	}
+	return employments, nil
+}
+
+// UpdateEmploymentStatus 以條件更新僱傭狀態; 目前狀態不是 fromStatus 時返回 gorm.ErrRecordNotFound
+func (r *gormEmploymentRepository) UpdateEmploymentStatus(ctx context.Context, employmentID uuid.UUID, fromStatus, toStatus string) error {
+	result := conn(ctx, r.db).Model(&models.Employment{}).
+		Where("id = ? AND status = ?", employmentID, fromStatus).
+		Updates(map[string]interface{}{"status": toStatus, "version": gorm.Expr("version + 1")})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update status of employment %s: %w", employmentID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
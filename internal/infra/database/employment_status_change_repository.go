@@ -0,0 +1,59 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormEmploymentStatusChangeRepository 實現了 EmploymentStatusChangeRepository 介面
+type gormEmploymentStatusChangeRepository struct {
+	db *gorm.DB
+}
+
+// NewGormEmploymentStatusChangeRepository 構造函數
+func NewGormEmploymentStatusChangeRepository(db *gorm.DB) interfaces.EmploymentStatusChangeRepository {
+	return &gormEmploymentStatusChangeRepository{db: db}
+}
+
+// Create 新增一筆狀態變更記錄
+func (r *gormEmploymentStatusChangeRepository) Create(ctx context.Context, change *models.EmploymentStatusChange) error {
+	if err := conn(ctx, r.db).Create(change).Error; err != nil {
+		return fmt.Errorf("failed to create employment status change: %w", err)
+	}
+	return nil
+}
+
+// ListByAccountID 依時間先後列出帳戶的所有狀態變更記錄
+func (r *gormEmploymentStatusChangeRepository) ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.EmploymentStatusChange, error) {
+	var changes []models.EmploymentStatusChange
+	err := conn(ctx, r.db).
+		Where("account_id = ?", accountID).
+		Order("changed_at asc").
+		Find(&changes).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching employment status changes for account %s: %w", accountID, err)
+	}
+	return changes, nil
+}
+
+// GetLatestByEmploymentID 取得僱傭記錄最近一次的狀態變更
+func (r *gormEmploymentStatusChangeRepository) GetLatestByEmploymentID(ctx context.Context, employmentID uuid.UUID) (*models.EmploymentStatusChange, error) {
+	var change models.EmploymentStatusChange
+	err := conn(ctx, r.db).
+		Where("employment_id = ?", employmentID).
+		Order("changed_at desc").
+		First(&change).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("error fetching latest status change of employment %s: %w", employmentID, err)
+	}
+	return &change, nil
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormHolidayRepository 實現了 HolidayRepository 介面
+type gormHolidayRepository struct {
+	db *gorm.DB
+}
+
+// NewGormHolidayRepository 是 gormHolidayRepository 的構造函數
+func NewGormHolidayRepository(db *gorm.DB) interfaces.HolidayRepository {
+	return &gormHolidayRepository{db: db}
+}
+
+// CreateHoliday 新增假日
+func (r *gormHolidayRepository) CreateHoliday(ctx context.Context, holiday *models.Holiday) error {
+	if err := r.db.WithContext(ctx).Create(holiday).Error; err != nil {
+		return fmt.Errorf("failed to create holiday: %w", err)
+	}
+	return nil
+}
+
+// GetHolidayByDate 依日期查詢假日
+func (r *gormHolidayRepository) GetHolidayByDate(ctx context.Context, date time.Time) (*models.Holiday, error) {
+	var holiday models.Holiday
+	err := r.db.WithContext(ctx).Where("date = ?", date.Format("2006-01-02")).First(&holiday).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("error fetching holiday by date %s: %w", date.Format("2006-01-02"), err)
+	}
+	return &holiday, nil
+}
+
+// ListHolidaysByYear 列出某年度所有假日
+func (r *gormHolidayRepository) ListHolidaysByYear(ctx context.Context, year int) ([]models.Holiday, error) {
+	var holidays []models.Holiday
+	err := r.db.WithContext(ctx).Where("year = ?", year).Order("date asc").Find(&holidays).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching holidays for year %d: %w", year, err)
+	}
+	return holidays, nil
+}
+
+// ListHolidaysBetween 列出區間內的假日
+func (r *gormHolidayRepository) ListHolidaysBetween(ctx context.Context, start, end time.Time) ([]models.Holiday, error) {
+	var holidays []models.Holiday
+	err := r.db.WithContext(ctx).
+		Where("date BETWEEN ? AND ?", start.Format("2006-01-02"), end.Format("2006-01-02")).
+		Order("date asc").
+		Find(&holidays).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching holidays between %s and %s: %w", start.Format("2006-01-02"), end.Format("2006-01-02"), err)
+	}
+	return holidays, nil
+}
+
+// DeleteHoliday 刪除假日
+func (r *gormHolidayRepository) DeleteHoliday(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.Holiday{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete holiday %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// GetCalendarByYear 取得某年度的工作週設定
+func (r *gormHolidayRepository) GetCalendarByYear(ctx context.Context, year int) (*models.HolidayCalendar, error) {
+	var calendar models.HolidayCalendar
+	err := r.db.WithContext(ctx).Where("year = ?", year).First(&calendar).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("error fetching holiday calendar for year %d: %w", year, err)
+	}
+	return &calendar, nil
+}
+
+// SaveCalendar 新增或更新年度工作週設定 (ID 為空時新增)
+func (r *gormHolidayRepository) SaveCalendar(ctx context.Context, calendar *models.HolidayCalendar) error {
+	var err error
+	if calendar.ID == uuid.Nil {
+		err = r.db.WithContext(ctx).Create(calendar).Error
+	} else {
+		err = r.db.WithContext(ctx).Save(calendar).Error
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save holiday calendar for year %d: %w", calendar.Year, err)
+	}
+	return nil
+}
@@ -59,17 +59,16 @@ func (r *gormJobGradeRepository) GetJobGradeByCode(ctx context.Context, code str
 
 // UpdateJobGrade 更新現有的職等記錄
 func (r *gormJobGradeRepository) UpdateJobGrade(ctx context.Context, jobGrade *models.JobGrade) error {
-	// 使用 Save 會更新所有非零值欄位 (基於傳入的 jobGrade struct)
+	// 以樂觀鎖更新所有欄位 (基於傳入的 jobGrade struct)
 	// 確保 jobGrade.ID 是有效的
 	if jobGrade.ID == uuid.Nil {
 		return errors.New("cannot update job grade with zero ID")
 	}
-	result := r.db.WithContext(ctx).Save(jobGrade)
-	if result.Error != nil {
-		return fmt.Errorf("failed to update job grade %s: %w", jobGrade.ID, result.Error)
+	err := saveWithVersion(ctx, r.db, jobGrade, jobGrade.ID, &jobGrade.Version)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && !errors.Is(err, interfaces.ErrConcurrentModification) {
+		return fmt.Errorf("failed to update job grade %s: %w", jobGrade.ID, err)
 	}
-
-	return nil
+	return err
 }
 
 // ListJobGrades 列出所有職等記錄
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"gorm.io/gorm"
+)
+
+// gormLeaveAnalyticsRepository 實現了 LeaveAnalyticsRepository 介面
+type gormLeaveAnalyticsRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLeaveAnalyticsRepository 構造函數
+func NewGormLeaveAnalyticsRepository(db *gorm.DB) interfaces.LeaveAnalyticsRepository {
+	return &gormLeaveAnalyticsRepository{db: db}
+}
+
+// turnaroundSeconds 從提交到核准經過的秒數
+const turnaroundSeconds = "TIMESTAMPDIFF(SECOND, leave_requests.requested_at, leave_requests.approved_at)"
+
+// latestEmploymentJoin 只帶入申請人最新的一筆僱傭記錄，避免有多筆僱傭記錄 (例如離職後再入職) 的申請人被重複統計
+const latestEmploymentJoin = "LEFT JOIN employments ON employments.id = (" +
+	"SELECT latest.id FROM employments AS latest WHERE latest.account_id = leave_requests.account_id " +
+	"ORDER BY latest.created_at DESC, latest.id DESC LIMIT 1)"
+
+// AbsenceTotals 依分組維度統計假單數與天數; 假單依開始日歸入所屬月份
+func (r *gormLeaveAnalyticsRepository) AbsenceTotals(ctx context.Context, groupBy string, filter models.LeaveAnalyticsFilter) ([]models.LeaveAbsenceTotal, error) {
+	key, label, err := absenceGroupColumns(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	query := r.filtered(ctx, filter, "leave_requests.start_date")
+	if groupBy == models.LeaveAnalyticsByJobGrade {
+		query = query.Joins("LEFT JOIN job_grades ON job_grades.id = employments.job_grade_id")
+	}
+
+	var totals []models.LeaveAbsenceTotal
+	err = query.
+		Select(key + " AS group_key, " + label + " AS group_label, COUNT(*) AS requests, COALESCE(SUM(leave_requests.days), 0) AS days").
+		Group("group_key, group_label").
+		Order("group_key").
+		Scan(&totals).Error
+	if err != nil {
+		return nil, fmt.Errorf("error aggregating leave absences by %s: %w", groupBy, err)
+	}
+	return totals, nil
+}
+
+// absenceGroupColumns 將分組維度對應到 SQL 運算式，未知維度返回錯誤，避免拼接任意 SQL
+func absenceGroupColumns(groupBy string) (key, label string, err error) {
+	switch groupBy {
+	case models.LeaveAnalyticsByMonth:
+		return "DATE_FORMAT(leave_requests.start_date, '%Y-%m')", "''", nil
+	case models.LeaveAnalyticsByLeaveType:
+		return "leave_requests.leave_type", "''", nil
+	case models.LeaveAnalyticsByStatus:
+		return "leave_requests.status", "''", nil
+	case models.LeaveAnalyticsByDepartment:
+		return "COALESCE(employments.department, '')", "''", nil
+	case models.LeaveAnalyticsByJobGrade:
+		return "COALESCE(job_grades.code, '')", "COALESCE(job_grades.name, '')", nil
+	default:
+		return "", "", fmt.Errorf("unsupported leave analytics dimension %q", groupBy)
+	}
+}
+
+// BradfordFactors 以每張假單為一次缺勤計算 S² × D，分數相同時依帳戶 ID 排序以保持結果穩定
+func (r *gormLeaveAnalyticsRepository) BradfordFactors(ctx context.Context, filter models.LeaveAnalyticsFilter, limit int) ([]models.BradfordFactorScore, error) {
+	var scores []models.BradfordFactorScore
+	err := r.filtered(ctx, filter, "leave_requests.start_date").
+		Joins("JOIN accounts ON accounts.id = leave_requests.account_id").
+		Select("leave_requests.account_id, accounts.first_name, accounts.last_name, accounts.email, " +
+			"COALESCE(employments.department, '') AS department, COUNT(*) AS spells, " +
+			"SUM(leave_requests.days) AS days, COUNT(*) * COUNT(*) * SUM(leave_requests.days) AS score").
+		Group("leave_requests.account_id, accounts.first_name, accounts.last_name, accounts.email, employments.department").
+		Order("score desc").
+		Order("leave_requests.account_id").
+		Limit(limit).
+		Scan(&scores).Error
+	if err != nil {
+		return nil, fmt.Errorf("error calculating bradford factors: %w", err)
+	}
+	return scores, nil
+}
+
+// ApprovalTurnaroundByLeaveType 依假別統計核准時效，只計入申請人自行提交、由審核人核准的假單
+// approved_at 在拒絕、排程自動核准 (沒有審核人) 時也會寫入，因此以狀態與 approver_id 排除
+// HR 代為提交的假單 (created_by_id 不為空) 可能在提交時即核准，不反映審核時效，一併排除
+func (r *gormLeaveAnalyticsRepository) ApprovalTurnaroundByLeaveType(ctx context.Context, filter models.LeaveAnalyticsFilter) ([]models.LeaveTurnaroundStat, error) {
+	var stats []models.LeaveTurnaroundStat
+	err := r.filtered(ctx, filter, "leave_requests.requested_at").
+		Where("leave_requests.status = ?", models.LeaveStatusApproved).
+		Where("leave_requests.approver_id IS NOT NULL AND leave_requests.approved_at IS NOT NULL").
+		Where("leave_requests.created_by_id IS NULL").
+		Select("leave_requests.leave_type, COUNT(*) AS decisions, " +
+			"AVG(" + turnaroundSeconds + ") AS avg_seconds, MIN(" + turnaroundSeconds + ") AS min_seconds, MAX(" + turnaroundSeconds + ") AS max_seconds").
+		Group("leave_requests.leave_type").
+		Order("leave_requests.leave_type").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, fmt.Errorf("error aggregating leave approval turnaround: %w", err)
+	}
+	return stats, nil
+}
+
+// filtered 套用共用的查詢條件: dateColumn 落在 [From, To]、部門、假別與狀態
+// 以 LEFT JOIN 帶入申請人最新的僱傭資料，沒有僱傭資料的申請人在未指定部門時仍會被統計
+func (r *gormLeaveAnalyticsRepository) filtered(ctx context.Context, filter models.LeaveAnalyticsFilter, dateColumn string) *gorm.DB {
+	query := r.db.WithContext(ctx).Model(&models.LeaveRequest{}).
+		Joins(latestEmploymentJoin).
+		Where(dateColumn+" >= ? AND "+dateColumn+" < ?", filter.From, filter.To.AddDate(0, 0, 1))
+	if filter.Department != "" {
+		query = query.Where("employments.department = ?", filter.Department)
+	}
+	if filter.LeaveType != "" {
+		query = query.Where("leave_requests.leave_type = ?", filter.LeaveType)
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("leave_requests.status IN ?", filter.Statuses)
+	}
+	return query
+}
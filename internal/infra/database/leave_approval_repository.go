@@ -0,0 +1,81 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormLeaveApprovalRepository 實現了 LeaveApprovalRepository 介面
+type gormLeaveApprovalRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLeaveApprovalRepository 構造函數
+func NewGormLeaveApprovalRepository(db *gorm.DB) interfaces.LeaveApprovalRepository {
+	return &gormLeaveApprovalRepository{db: db}
+}
+
+// ListActivePolicies 列出所有啟用中的審核政策
+func (r *gormLeaveApprovalRepository) ListActivePolicies(ctx context.Context) ([]models.LeaveApprovalPolicy, error) {
+	var policies []models.LeaveApprovalPolicy
+	if err := conn(ctx, r.db).Where("active = ?", true).Order("min_days desc").Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("error fetching leave approval policies: %w", err)
+	}
+	return policies, nil
+}
+
+// CreateSteps 批次建立審核步驟
+func (r *gormLeaveApprovalRepository) CreateSteps(ctx context.Context, steps []models.LeaveApprovalStep) error {
+	if len(steps) == 0 {
+		return nil
+	}
+	if err := conn(ctx, r.db).Create(&steps).Error; err != nil {
+		return fmt.Errorf("failed to create leave approval steps: %w", err)
+	}
+	return nil
+}
+
+// ListStepsByRequestID 依步驟順序列出假單的審核步驟
+func (r *gormLeaveApprovalRepository) ListStepsByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveApprovalStep, error) {
+	var steps []models.LeaveApprovalStep
+	err := conn(ctx, r.db).Where("leave_request_id = ?", leaveRequestID).Order("step_order asc").Find(&steps).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching approval steps for leave request %s: %w", leaveRequestID, err)
+	}
+	return steps, nil
+}
+
+// UpdateStep 更新審核步驟，僅在資料庫中的步驟仍為待審時寫入
+// 步驟已被其他請求處理時返回 interfaces.ErrConcurrentModification，步驟不存在時返回 gorm.ErrRecordNotFound
+func (r *gormLeaveApprovalRepository) UpdateStep(ctx context.Context, step *models.LeaveApprovalStep) error {
+	result := conn(ctx, r.db).Model(step).Select("*").
+		Where("status = ?", models.ApprovalStepStatusPending).Updates(step)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update approval step %s: %w", step.ID, result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := conn(ctx, r.db).Model(&models.LeaveApprovalStep{}).Where("id = ?", step.ID).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check approval step %s: %w", step.ID, err)
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return interfaces.ErrConcurrentModification
+}
+
+// DeleteStepsByRequestID 刪除假單的所有審核步驟; 沒有步驟時不視為錯誤
+func (r *gormLeaveApprovalRepository) DeleteStepsByRequestID(ctx context.Context, leaveRequestID uuid.UUID) error {
+	if err := conn(ctx, r.db).Where("leave_request_id = ?", leaveRequestID).Delete(&models.LeaveApprovalStep{}).Error; err != nil {
+		return fmt.Errorf("failed to delete approval steps of leave request %s: %w", leaveRequestID, err)
+	}
+	return nil
+}
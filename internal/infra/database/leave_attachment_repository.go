@@ -0,0 +1,79 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormLeaveAttachmentRepository 實現了 LeaveAttachmentRepository 介面
+type gormLeaveAttachmentRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLeaveAttachmentRepository 構造函數
+func NewGormLeaveAttachmentRepository(db *gorm.DB) interfaces.LeaveAttachmentRepository {
+	return &gormLeaveAttachmentRepository{db: db}
+}
+
+// Create 新增附件記錄
+func (r *gormLeaveAttachmentRepository) Create(ctx context.Context, attachment *models.LeaveAttachment) error {
+	if err := r.db.WithContext(ctx).Create(attachment).Error; err != nil {
+		return fmt.Errorf("failed to create leave attachment: %w", err)
+	}
+	return nil
+}
+
+// GetByID 根據 ID 獲取附件記錄
+func (r *gormLeaveAttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.LeaveAttachment, error) {
+	var attachment models.LeaveAttachment
+	if err := r.db.WithContext(ctx).First(&attachment, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("error fetching leave attachment %s: %w", id, err)
+	}
+	return &attachment, nil
+}
+
+// ListByRequestID 依上傳時間列出假單的所有附件
+func (r *gormLeaveAttachmentRepository) ListByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveAttachment, error) {
+	var attachments []models.LeaveAttachment
+	err := r.db.WithContext(ctx).
+		Where("leave_request_id = ?", leaveRequestID).
+		Order("created_at asc").
+		Find(&attachments).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching attachments for leave request %s: %w", leaveRequestID, err)
+	}
+	return attachments, nil
+}
+
+// CountByRequestID 計算假單的附件數量
+func (r *gormLeaveAttachmentRepository) CountByRequestID(ctx context.Context, leaveRequestID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&models.LeaveAttachment{}).
+		Where("leave_request_id = ?", leaveRequestID).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("error counting attachments for leave request %s: %w", leaveRequestID, err)
+	}
+	return count, nil
+}
+
+// GetRuleByLeaveType 獲取假別的附件規則
+func (r *gormLeaveAttachmentRepository) GetRuleByLeaveType(ctx context.Context, leaveType string) (*models.LeaveAttachmentRule, error) {
+	var rule models.LeaveAttachmentRule
+	if err := r.db.WithContext(ctx).Where("leave_type = ?", leaveType).First(&rule).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("error fetching attachment rule for %s: %w", leaveType, err)
+	}
+	return &rule, nil
+}
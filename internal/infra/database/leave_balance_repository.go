@@ -0,0 +1,156 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// gormLeaveBalanceRepository 實現了 LeaveBalanceRepository 介面
+type gormLeaveBalanceRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLeaveBalanceRepository 構造函數
+func NewGormLeaveBalanceRepository(db *gorm.DB) interfaces.LeaveBalanceRepository {
+	return &gormLeaveBalanceRepository{db: db}
+}
+
+// CreateEntry 新增帳本分錄，帶有 IdempotencyKey 時遇到重複鍵則不做任何事
+func (r *gormLeaveBalanceRepository) CreateEntry(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+	tx := conn(ctx, r.db)
+	if entry.IdempotencyKey != nil {
+		tx = tx.Clauses(clause.OnConflict{DoNothing: true})
+	}
+	if err := tx.Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to create leave balance entry for account %s: %w", entry.AccountID, err)
+	}
+	return nil
+}
+
+// LockAccount 以 FOR UPDATE 鎖定帳戶資料列，須在事務中呼叫
+func (r *gormLeaveBalanceRepository) LockAccount(ctx context.Context, accountID uuid.UUID) error {
+	var account models.Account
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", accountID).
+		Take(&account).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return gorm.ErrRecordNotFound
+		}
+		return fmt.Errorf("error locking leave balance of account %s: %w", accountID, err)
+	}
+	return nil
+}
+
+// SumByAccount 依假別加總分錄金額
+func (r *gormLeaveBalanceRepository) SumByAccount(ctx context.Context, accountID uuid.UUID, asOf time.Time) ([]models.LeaveBalance, error) {
+	var balances []models.LeaveBalance
+	err := conn(ctx, r.db).Model(&models.LeaveBalanceEntry{}).
+		Select("leave_type, COALESCE(SUM(amount), 0) AS balance").
+		Where("account_id = ? AND effective_date <= ?", accountID, asOf).
+		Group("leave_type").
+		Order("leave_type asc").
+		Scan(&balances).Error
+	if err != nil {
+		return nil, fmt.Errorf("error summing leave balances for account %s: %w", accountID, err)
+	}
+	return balances, nil
+}
+
+// GetLatestEntry 取得生效日最晚的一筆分錄
+func (r *gormLeaveBalanceRepository) GetLatestEntry(ctx context.Context, accountID uuid.UUID, leaveType, entryType string) (*models.LeaveBalanceEntry, error) {
+	var entry models.LeaveBalanceEntry
+	err := conn(ctx, r.db).
+		Where("account_id = ? AND leave_type = ? AND entry_type = ?", accountID, leaveType, entryType).
+		Order("effective_date desc").
+		First(&entry).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("error fetching latest %s entry for account %s: %w", entryType, accountID, err)
+	}
+	return &entry, nil
+}
+
+// ListEntries 依生效日先後列出指定帳戶、假別的所有分錄
+func (r *gormLeaveBalanceRepository) ListEntries(ctx context.Context, accountID uuid.UUID, leaveType string) ([]models.LeaveBalanceEntry, error) {
+	var entries []models.LeaveBalanceEntry
+	err := conn(ctx, r.db).
+		Where("account_id = ? AND leave_type = ?", accountID, leaveType).
+		Order("effective_date asc, created_at asc").
+		Find(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %s entries for account %s: %w", leaveType, accountID, err)
+	}
+	return entries, nil
+}
+
+// ListActiveAccrualRules 列出啟用中的累積規則
+func (r *gormLeaveBalanceRepository) ListActiveAccrualRules(ctx context.Context) ([]models.LeaveAccrualRule, error) {
+	var rules []models.LeaveAccrualRule
+	if err := conn(ctx, r.db).Where("active = ?", true).Order("leave_type asc").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("error fetching leave accrual rules: %w", err)
+	}
+	return rules, nil
+}
+
+// SumEntriesBetween 加總指定分錄類型在期間內的金額
+func (r *gormLeaveBalanceRepository) SumEntriesBetween(ctx context.Context, accountID uuid.UUID, leaveType string, entryTypes []string, from, to time.Time) (decimal.Decimal, error) {
+	var total decimal.Decimal
+	err := conn(ctx, r.db).Model(&models.LeaveBalanceEntry{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("account_id = ? AND leave_type = ? AND entry_type IN ? AND effective_date BETWEEN ? AND ?", accountID, leaveType, entryTypes, from, to).
+		Scan(&total).Error
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("error summing %s entries for account %s: %w", leaveType, accountID, err)
+	}
+	return total, nil
+}
+
+// PostYearEnd 以事務寫入結算分錄與結算記錄，記錄已存在時不做任何事
+func (r *gormLeaveBalanceRepository) PostYearEnd(ctx context.Context, record *models.LeaveCarryOverRecord, entries []*models.LeaveBalanceEntry) error {
+	err := conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil // 已結算過
+		}
+		for _, entry := range entries {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(entry).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to post %d year-end entries for account %s: %w", record.Year, record.AccountID, err)
+	}
+	return nil
+}
+
+// ListCarryOverRecords 列出某年度的結算記錄
+func (r *gormLeaveBalanceRepository) ListCarryOverRecords(ctx context.Context, year int) ([]models.LeaveCarryOverRecord, error) {
+	var records []models.LeaveCarryOverRecord
+	err := conn(ctx, r.db).Preload("Account").
+		Where("year = ?", year).
+		Order("leave_type asc, account_id asc").
+		Find(&records).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %d carry-over records: %w", year, err)
+	}
+	return records, nil
+}
@@ -0,0 +1,43 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormLeaveRequestEventRepository 實現了 LeaveRequestEventRepository 介面
+type gormLeaveRequestEventRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLeaveRequestEventRepository 構造函數
+func NewGormLeaveRequestEventRepository(db *gorm.DB) interfaces.LeaveRequestEventRepository {
+	return &gormLeaveRequestEventRepository{db: db}
+}
+
+// Create 新增一筆假單事件
+func (r *gormLeaveRequestEventRepository) Create(ctx context.Context, event *models.LeaveRequestEvent) error {
+	if err := conn(ctx, r.db).Create(event).Error; err != nil {
+		return fmt.Errorf("failed to create leave request event: %w", err)
+	}
+	return nil
+}
+
+// ListByRequestID 依時間先後列出假單的所有事件
+func (r *gormLeaveRequestEventRepository) ListByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveRequestEvent, error) {
+	var events []models.LeaveRequestEvent
+	err := conn(ctx, r.db).
+		Preload("Actor").
+		Where("leave_request_id = ?", leaveRequestID).
+		Order("created_at asc").
+		Find(&events).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching events for leave request %s: %w", leaveRequestID, err)
+	}
+	return events, nil
+}
@@ -5,11 +5,14 @@ import (
 	"context"
 	"errors"
 	"log" // 用於記錄錯誤
+	"strings"
+	"time"
 
 	"github.com/erinchen11/hr-system/internal/interfaces"
 	"github.com/erinchen11/hr-system/internal/models"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // gormLeaveRequestRepository 實現了 LeaveRequestRepository 介面
@@ -25,7 +28,7 @@ func NewGormLeaveRequestRepository(db *gorm.DB) interfaces.LeaveRequestRepositor
 // ListAllWithEmployee 實現獲取所有請假記錄（含員工資訊）
 func (r *gormLeaveRequestRepository) ListAllWithAccount(ctx context.Context) ([]models.LeaveRequest, error) {
 	var requests []models.LeaveRequest
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Preload("Account").
 		Preload("Approver").
 		Find(&requests).Error; err != nil {
@@ -34,11 +37,83 @@ func (r *gormLeaveRequestRepository) ListAllWithAccount(ctx context.Context) ([]
 	return requests, nil
 }
 
+// ListFiltered 依狀態、假別、日期區間與申請人過濾假單，排序後分頁返回
+func (r *gormLeaveRequestRepository) ListFiltered(ctx context.Context, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error) {
+	query := conn(ctx, r.db).Model(&models.LeaveRequest{})
+	if len(filter.Statuses) > 0 {
+		query = query.Where("leave_requests.status IN ?", filter.Statuses)
+	}
+	if filter.LeaveType != "" {
+		query = query.Where("leave_requests.leave_type = ?", filter.LeaveType)
+	}
+	// 與查詢區間有交集的假單: start_date <= To 且 end_date >= From
+	if filter.From != nil {
+		query = query.Where("leave_requests.end_date >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("leave_requests.start_date <= ?", *filter.To)
+	}
+	if filter.Applicant != "" {
+		// 申請人輸入的 % 與 _ 視為一般字元，不作為萬用字元
+		like := "%" + escapeLike(filter.Applicant) + "%"
+		query = query.Joins("JOIN accounts ON accounts.id = leave_requests.account_id").
+			Where(`(accounts.email LIKE ? ESCAPE '\\' OR accounts.first_name LIKE ? ESCAPE '\\' OR accounts.last_name LIKE ? ESCAPE '\\' OR CONCAT(accounts.first_name, ' ', accounts.last_name) LIKE ? ESCAPE '\\')`,
+				like, like, like, like)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		log.Printf("Error counting filtered leave requests: %v", err)
+		return nil, 0, err
+	}
+
+	order := leaveRequestSortColumn(filter.SortBy)
+	if filter.SortDesc {
+		order += " desc"
+	}
+	var requests []models.LeaveRequest
+	err := query.
+		Preload("Account").
+		Preload("Approver").
+		Order(order).
+		Order("leave_requests.id"). // 排序值相同時保持分頁結果穩定
+		Offset((filter.Page - 1) * filter.PageSize).
+		Limit(filter.PageSize).
+		Find(&requests).Error
+	if err != nil {
+		log.Printf("Error fetching filtered leave requests: %v", err)
+		return nil, 0, err
+	}
+	return requests, total, nil
+}
+
+// likeEscaper 跳脫 LIKE 模式中的特殊字元，搭配 ESCAPE '\\' 使用
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike 跳脫使用者輸入中的 \、% 與 _，使其在 LIKE 中按字面比對
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+// leaveRequestSortColumn 將排序欄位對應到資料表欄位，未知欄位一律使用申請時間，避免拼接任意 SQL
+func leaveRequestSortColumn(sortBy string) string {
+	switch sortBy {
+	case models.LeaveSortStartDate:
+		return "leave_requests.start_date"
+	case models.LeaveSortDays:
+		return "leave_requests.days"
+	case models.LeaveSortStatus:
+		return "leave_requests.status"
+	default:
+		return "leave_requests.requested_at"
+	}
+}
+
 // GetByID 根據 ID 獲取請假單
 func (r *gormLeaveRequestRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.LeaveRequest, error) {
 	var request models.LeaveRequest
 	// 預加載 Employee 資訊可能也有用，取決于 Service 是否需要
-	err := r.db.WithContext(ctx).Preload("Account").Preload("Approver").First(&request, "id = ?", id).Error
+	err := conn(ctx, r.db).Preload("Account").Preload("Approver").First(&request, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, gorm.ErrRecordNotFound // 返回 GORM 錯誤
@@ -49,26 +124,20 @@ func (r *gormLeaveRequestRepository) GetByID(ctx context.Context, id uuid.UUID)
 	return &request, nil
 }
 
-// Update 更新請假單記錄
+// Update 以樂觀鎖更新請假單記錄的所有欄位 (不含預加載的 Account / Approver 關聯)
+// 它也會自動更新 UpdatedAt 欄位 (如果模型中有 gorm:"autoUpdateTime")
 func (r *gormLeaveRequestRepository) Update(ctx context.Context, request *models.LeaveRequest) error {
-	// 使用 Save 會更新所有欄位，適用於從 GetByID 獲取狀態等欄位
-	// GORM 的 Save 會自動處理主鍵，執行更新操作
-	// 它也會自動更新 UpdatedAt 欄位 (如果模型中有 gorm:"autoUpdateTime")
-	err := r.db.WithContext(ctx).Save(request).Error
-	if err != nil {
+	err := saveWithVersion(ctx, r.db, request, request.ID, &request.Version)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) && !errors.Is(err, interfaces.ErrConcurrentModification) {
 		log.Printf("Error updating leave request %s: %v", request.ID, err)
-		// 可以檢查是否是記錄不存在的錯誤 (雖然 Save 通常不報)
-		// if errors.Is(err, gorm.ErrRecordNotFound) { return gorm.ErrRecordNotFound }
 	}
-	// 檢查 RowsAffected 可能更可靠些，如果 Save 對象沒有主鍵會插入
-	// 但在此場景下是先 Get 再 Save，所以對象一定有 ID
-	return err // 可包裝錯誤
+	return err
 }
 
 // Create 創建新的請假記錄
 func (r *gormLeaveRequestRepository) Create(ctx context.Context, request *models.LeaveRequest) error {
 	// BeforeCreate Hook 會處理 ID 和 RequestedAt (如果模型中配置了 autoCreateTime)
-	err := r.db.WithContext(ctx).Create(request).Error
+	err := conn(ctx, r.db).Create(request).Error
 	if err != nil {
 		// 可以檢查特定錯誤，例如外鍵約束失敗
 		log.Printf("Error creating leave request for employee %s: %v", request.AccountID, err)
@@ -82,7 +151,7 @@ func (r *gormLeaveRequestRepository) ListByAccountID(ctx context.Context, accoun
 	var requests []models.LeaveRequest
 	// 根據 employee_id 查詢，可以按申請時間排序
 	// 不需要 Preload Employee，因為是員工自己查詢
-	err := r.db.WithContext(ctx).Where("account_id = ?", accountID).Order("requested_at desc").Find(&requests).Error
+	err := conn(ctx, r.db).Where("account_id = ?", accountID).Order("requested_at desc").Find(&requests).Error
 	if err != nil {
 		// Find 在找不到記錄時不返回 ErrRecordNotFound，而是返回空 slice 和 nil error
 		log.Printf("Error fetching leave requests for employee %s: %v", accountID, err)
@@ -92,3 +161,114 @@ func (r *gormLeaveRequestRepository) ListByAccountID(ctx context.Context, accoun
 	return requests, nil
 }
 
+// ListByAccountIDs 查詢多個帳戶的請假記錄（含申請人與審核人資訊）
+func (r *gormLeaveRequestRepository) ListByAccountIDs(ctx context.Context, accountIDs []uuid.UUID) ([]models.LeaveRequest, error) {
+	var requests []models.LeaveRequest
+	err := conn(ctx, r.db).
+		Preload("Account").
+		Preload("Approver").
+		Where("account_id IN ?", accountIDs).
+		Order("requested_at desc").
+		Find(&requests).Error
+	if err != nil {
+		log.Printf("Error fetching leave requests for %d accounts: %v", len(accountIDs), err)
+		return nil, err
+	}
+	return requests, nil
+}
+
+// ListOverlapping 查詢帳戶中與指定日期區間有交集的假單 (start_date <= end 且 end_date >= start)
+func (r *gormLeaveRequestRepository) ListOverlapping(ctx context.Context, accountID uuid.UUID, start, end time.Time, statuses []string) ([]models.LeaveRequest, error) {
+	var requests []models.LeaveRequest
+	err := conn(ctx, r.db).
+		Where("account_id = ? AND start_date <= ? AND end_date >= ?", accountID, end, start).
+		Where("status IN ?", statuses).
+		Order("start_date asc").
+		Find(&requests).Error
+	if err != nil {
+		log.Printf("Error fetching overlapping leave requests for account %s: %v", accountID, err)
+		return nil, err
+	}
+	return requests, nil
+}
+
+// LockOverlapping 以 FOR UPDATE 鎖定帳戶中與指定日期區間有交集的所有假單 (不限狀態)，須在事務中呼叫
+func (r *gormLeaveRequestRepository) LockOverlapping(ctx context.Context, accountID uuid.UUID, start, end time.Time) ([]models.LeaveRequest, error) {
+	var requests []models.LeaveRequest
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("account_id = ? AND start_date <= ? AND end_date >= ?", accountID, end, start).
+		Order("start_date asc").
+		Find(&requests).Error
+	if err != nil {
+		log.Printf("Error locking overlapping leave requests for account %s: %v", accountID, err)
+		return nil, err
+	}
+	return requests, nil
+}
+
+// ListInRange 查詢與指定日期區間有交集的假單 (含申請人資訊)，accountIDs 為 nil 時查詢所有帳戶
+func (r *gormLeaveRequestRepository) ListInRange(ctx context.Context, accountIDs []uuid.UUID, start, end time.Time, statuses []string) ([]models.LeaveRequest, error) {
+	query := conn(ctx, r.db).
+		Preload("Account").
+		Where("start_date <= ? AND end_date >= ?", end, start).
+		Where("status IN ?", statuses)
+	if accountIDs != nil {
+		query = query.Where("account_id IN ?", accountIDs)
+	}
+	var requests []models.LeaveRequest
+	if err := query.Order("start_date asc").Find(&requests).Error; err != nil {
+		log.Printf("Error fetching leave requests between %s and %s: %v", start.Format("2006-01-02"), end.Format("2006-01-02"), err)
+		return nil, err
+	}
+	return requests, nil
+}
+
+// ListPendingRequestedBefore 查詢在指定時間前提交、仍待審核的假單 (含申請人資訊)，依提交時間排序
+func (r *gormLeaveRequestRepository) ListPendingRequestedBefore(ctx context.Context, before time.Time) ([]models.LeaveRequest, error) {
+	var requests []models.LeaveRequest
+	err := conn(ctx, r.db).
+		Preload("Account").
+		Where("status = ? AND requested_at < ?", models.LeaveStatusPending, before).
+		Order("requested_at asc").
+		Find(&requests).Error
+	if err != nil {
+		log.Printf("Error fetching pending leave requests submitted before %s: %v", before.Format(time.RFC3339), err)
+		return nil, err
+	}
+	return requests, nil
+}
+
+// UpdateFollowUp 只更新提醒與升級時間欄位; 假單已不是待審狀態時返回 gorm.ErrRecordNotFound
+func (r *gormLeaveRequestRepository) UpdateFollowUp(ctx context.Context, request *models.LeaveRequest) error {
+	result := conn(ctx, r.db).Model(&models.LeaveRequest{}).
+		Where("id = ? AND status = ?", request.ID, models.LeaveStatusPending).
+		Updates(map[string]interface{}{
+			"last_reminded_at": request.LastRemindedAt,
+			"escalated_at":     request.EscalatedAt,
+		})
+	if result.Error != nil {
+		log.Printf("Error updating follow-up times of leave request %s: %v", request.ID, result.Error)
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// ListApprovedCoveringDate 查詢假期包含指定日期的已核准假單 (含申請取消中的假單)
+func (r *gormLeaveRequestRepository) ListApprovedCoveringDate(ctx context.Context, date time.Time) ([]models.LeaveRequest, error) {
+	var requests []models.LeaveRequest
+	day := date.Format("2006-01-02")
+	err := conn(ctx, r.db).
+		Where("status IN ? AND start_date <= ? AND end_date >= ?",
+			[]string{models.LeaveStatusApproved, models.LeaveStatusCancellationRequested}, day, day).
+		Order("start_date asc").
+		Find(&requests).Error
+	if err != nil {
+		log.Printf("Error fetching approved leave requests covering %s: %v", day, err)
+		return nil, err
+	}
+	return requests, nil
+}
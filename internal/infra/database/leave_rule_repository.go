@@ -0,0 +1,106 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormLeaveRuleRepository 實現了 LeaveRuleRepository 介面
+type gormLeaveRuleRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLeaveRuleRepository 是 gormLeaveRuleRepository 的構造函數
+func NewGormLeaveRuleRepository(db *gorm.DB) interfaces.LeaveRuleRepository {
+	return &gormLeaveRuleRepository{db: db}
+}
+
+// CreateBlackout 新增封鎖期間
+func (r *gormLeaveRuleRepository) CreateBlackout(ctx context.Context, blackout *models.LeaveBlackoutPeriod) error {
+	if err := r.db.WithContext(ctx).Create(blackout).Error; err != nil {
+		return fmt.Errorf("failed to create blackout period: %w", err)
+	}
+	return nil
+}
+
+// ListBlackouts 列出所有封鎖期間
+func (r *gormLeaveRuleRepository) ListBlackouts(ctx context.Context) ([]models.LeaveBlackoutPeriod, error) {
+	var blackouts []models.LeaveBlackoutPeriod
+	if err := r.db.WithContext(ctx).Order("start_date asc").Find(&blackouts).Error; err != nil {
+		return nil, fmt.Errorf("error fetching blackout periods: %w", err)
+	}
+	return blackouts, nil
+}
+
+// ListActiveBlackoutsBetween 列出與區間重疊且啟用中的封鎖期間
+func (r *gormLeaveRuleRepository) ListActiveBlackoutsBetween(ctx context.Context, start, end time.Time) ([]models.LeaveBlackoutPeriod, error) {
+	var blackouts []models.LeaveBlackoutPeriod
+	err := r.db.WithContext(ctx).
+		Where("active = ? AND start_date <= ? AND end_date >= ?", true, end.Format("2006-01-02"), start.Format("2006-01-02")).
+		Order("start_date asc").
+		Find(&blackouts).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching blackout periods between %s and %s: %w", start.Format("2006-01-02"), end.Format("2006-01-02"), err)
+	}
+	return blackouts, nil
+}
+
+// DeleteBlackout 刪除封鎖期間
+func (r *gormLeaveRuleRepository) DeleteBlackout(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.LeaveBlackoutPeriod{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete blackout period %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CreateStaffingRule 新增最少在班人數規則
+func (r *gormLeaveRuleRepository) CreateStaffingRule(ctx context.Context, rule *models.LeaveStaffingRule) error {
+	if err := r.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return fmt.Errorf("failed to create staffing rule: %w", err)
+	}
+	return nil
+}
+
+// ListStaffingRules 列出所有最少在班人數規則
+func (r *gormLeaveRuleRepository) ListStaffingRules(ctx context.Context) ([]models.LeaveStaffingRule, error) {
+	var rules []models.LeaveStaffingRule
+	if err := r.db.WithContext(ctx).Order("department asc, created_at asc").Find(&rules).Error; err != nil {
+		return nil, fmt.Errorf("error fetching staffing rules: %w", err)
+	}
+	return rules, nil
+}
+
+// ListActiveStaffingRulesByDepartment 列出部門啟用中的最少在班人數規則
+func (r *gormLeaveRuleRepository) ListActiveStaffingRulesByDepartment(ctx context.Context, department string) ([]models.LeaveStaffingRule, error) {
+	var rules []models.LeaveStaffingRule
+	err := r.db.WithContext(ctx).
+		Where("department = ? AND active = ?", department, true).
+		Order("min_on_duty desc").
+		Find(&rules).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching staffing rules of department %s: %w", department, err)
+	}
+	return rules, nil
+}
+
+// DeleteStaffingRule 刪除最少在班人數規則
+func (r *gormLeaveRuleRepository) DeleteStaffingRule(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.LeaveStaffingRule{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete staffing rule %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
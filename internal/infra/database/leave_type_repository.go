@@ -0,0 +1,92 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// gormLeaveTypeRepository 實現了 LeaveTypeRepository 介面
+type gormLeaveTypeRepository struct {
+	db *gorm.DB
+}
+
+// NewGormLeaveTypeRepository 是 gormLeaveTypeRepository 的構造函數
+func NewGormLeaveTypeRepository(db *gorm.DB) interfaces.LeaveTypeRepository {
+	return &gormLeaveTypeRepository{db: db}
+}
+
+// Create 新增假別
+func (r *gormLeaveTypeRepository) Create(ctx context.Context, leaveType *models.LeaveType) error {
+	if err := r.db.WithContext(ctx).Create(leaveType).Error; err != nil {
+		return fmt.Errorf("failed to create leave type %s: %w", leaveType.Code, err)
+	}
+	return nil
+}
+
+// Update 以 Save 更新假別的所有欄位
+func (r *gormLeaveTypeRepository) Update(ctx context.Context, leaveType *models.LeaveType) error {
+	if err := r.db.WithContext(ctx).Save(leaveType).Error; err != nil {
+		return fmt.Errorf("failed to update leave type %s: %w", leaveType.Code, err)
+	}
+	return nil
+}
+
+// List 依代碼列出所有假別
+func (r *gormLeaveTypeRepository) List(ctx context.Context) ([]models.LeaveType, error) {
+	var leaveTypes []models.LeaveType
+	if err := r.db.WithContext(ctx).Order("code asc").Find(&leaveTypes).Error; err != nil {
+		return nil, fmt.Errorf("error fetching leave types: %w", err)
+	}
+	return leaveTypes, nil
+}
+
+// GetByID 根據 ID 查詢假別
+func (r *gormLeaveTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.LeaveType, error) {
+	var leaveType models.LeaveType
+	if err := r.db.WithContext(ctx).First(&leaveType, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("error fetching leave type %s: %w", id, err)
+	}
+	return &leaveType, nil
+}
+
+// GetByCode 根據代碼查詢假別
+func (r *gormLeaveTypeRepository) GetByCode(ctx context.Context, code string) (*models.LeaveType, error) {
+	var leaveType models.LeaveType
+	if err := r.db.WithContext(ctx).Where("code = ?", code).First(&leaveType).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("error fetching leave type %s: %w", code, err)
+	}
+	return &leaveType, nil
+}
+
+// Delete 刪除假別
+func (r *gormLeaveTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&models.LeaveType{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete leave type %s: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CountLeaveRequests 計算使用此假別代碼的假單數量
+func (r *gormLeaveTypeRepository) CountLeaveRequests(ctx context.Context, code string) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.LeaveRequest{}).Where("leave_type = ?", code).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("error counting leave requests of type %s: %w", code, err)
+	}
+	return count, nil
+}
@@ -12,7 +12,23 @@ func RunMigrations(db *gorm.DB) error {
 		&models.Account{},
 		&models.Employment{},
 		&models.JobGrade{},
+		&models.LeaveType{},
 		&models.LeaveRequest{},
+		&models.LeaveBalanceEntry{},
+		&models.LeaveAccrualRule{},
+		&models.HolidayCalendar{},
+		&models.Holiday{},
+		&models.LeaveApprovalPolicy{},
+		&models.LeaveApprovalStep{},
+		&models.ApprovalDelegation{},
+		&models.LeaveRequestEvent{},
+		&models.LeaveAttachment{},
+		&models.LeaveAttachmentRule{},
+		&models.LeaveBlackoutPeriod{},
+		&models.LeaveStaffingRule{},
+		&models.LeaveCarryOverRecord{},
+		&models.EmploymentStatusChange{},
+		&models.OvertimeClaim{},
 	); err != nil {
 		return fmt.Errorf("migration failed: %w", err)
 	}
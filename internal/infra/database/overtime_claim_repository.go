@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// gormOvertimeClaimRepository 實現了 OvertimeClaimRepository 介面
+type gormOvertimeClaimRepository struct {
+	db *gorm.DB
+}
+
+// NewGormOvertimeClaimRepository 構造函數
+func NewGormOvertimeClaimRepository(db *gorm.DB) interfaces.OvertimeClaimRepository {
+	return &gormOvertimeClaimRepository{db: db}
+}
+
+// Create 新增加班申請
+func (r *gormOvertimeClaimRepository) Create(ctx context.Context, claim *models.OvertimeClaim) error {
+	if err := conn(ctx, r.db).Create(claim).Error; err != nil {
+		return fmt.Errorf("failed to create overtime claim for account %s: %w", claim.AccountID, err)
+	}
+	return nil
+}
+
+// GetByID 根據 ID 查詢加班申請
+func (r *gormOvertimeClaimRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.OvertimeClaim, error) {
+	var claim models.OvertimeClaim
+	if err := conn(ctx, r.db).Preload("Account").First(&claim, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("error fetching overtime claim %s: %w", id, err)
+	}
+	return &claim, nil
+}
+
+// Update 更新加班申請的所有欄位 (不更新關聯的帳戶)，僅在資料庫中的申請仍為待審時寫入
+// 申請已被其他請求處理時返回 interfaces.ErrConcurrentModification，申請不存在時返回 gorm.ErrRecordNotFound
+func (r *gormOvertimeClaimRepository) Update(ctx context.Context, claim *models.OvertimeClaim) error {
+	result := conn(ctx, r.db).Model(claim).Select("*").Omit("Account").
+		Where("status = ?", models.OvertimeStatusPending).Updates(claim)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update overtime claim %s: %w", claim.ID, result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	var count int64
+	if err := conn(ctx, r.db).Model(&models.OvertimeClaim{}).Where("id = ?", claim.ID).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check overtime claim %s: %w", claim.ID, err)
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return interfaces.ErrConcurrentModification
+}
+
+// ListByAccountID 依加班日期由新到舊列出帳戶的加班申請
+func (r *gormOvertimeClaimRepository) ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.OvertimeClaim, error) {
+	var claims []models.OvertimeClaim
+	err := conn(ctx, r.db).
+		Where("account_id = ?", accountID).
+		Order("work_date desc, created_at desc").
+		Find(&claims).Error
+	if err != nil {
+		return nil, fmt.Errorf("error fetching overtime claims for account %s: %w", accountID, err)
+	}
+	return claims, nil
+}
+
+// List 依提交時間先後列出加班申請
+func (r *gormOvertimeClaimRepository) List(ctx context.Context, status string) ([]models.OvertimeClaim, error) {
+	var claims []models.OvertimeClaim
+	query := conn(ctx, r.db).Preload("Account")
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if err := query.Order("created_at asc").Find(&claims).Error; err != nil {
+		return nil, fmt.Errorf("error fetching overtime claims: %w", err)
+	}
+	return claims, nil
+}
+
+// LockAccount 以 FOR UPDATE 鎖定申請人的帳戶資料列，須在事務中呼叫
+func (r *gormOvertimeClaimRepository) LockAccount(ctx context.Context, accountID uuid.UUID) error {
+	var account models.Account
+	err := conn(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", accountID).
+		Take(&account).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return gorm.ErrRecordNotFound
+		}
+		return fmt.Errorf("error locking overtime claims of account %s: %w", accountID, err)
+	}
+	return nil
+}
+
+// CountActiveByWorkDate 計算帳戶在指定加班日期仍待審或已核准的加班申請數量
+func (r *gormOvertimeClaimRepository) CountActiveByWorkDate(ctx context.Context, accountID uuid.UUID, workDate time.Time) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&models.OvertimeClaim{}).
+		Where("account_id = ? AND work_date = ? AND status IN ?", accountID, workDate,
+			[]string{models.OvertimeStatusPending, models.OvertimeStatusApproved}).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("error counting overtime claims for account %s on %s: %w", accountID, workDate.Format("2006-01-02"), err)
+	}
+	return count, nil
+}
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"gorm.io/gorm"
+)
+
+// txContextKey 是 ctx 中存放目前事務的 key
+type txContextKey struct{}
+
+// gormTransactionManager 實現了 TransactionManager 介面
+type gormTransactionManager struct {
+	db *gorm.DB
+}
+
+// NewGormTransactionManager 構造函數
+func NewGormTransactionManager(db *gorm.DB) interfaces.TransactionManager {
+	return &gormTransactionManager{db: db}
+}
+
+// WithinTransaction 開啟事務並將其放入 ctx 後執行 fn; ctx 中已有事務時直接沿用
+func (m *gormTransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// conn 返回 ctx 中的事務; 不在事務中時返回 db
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
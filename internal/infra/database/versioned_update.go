@@ -0,0 +1,36 @@
+package database
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// saveWithVersion 以樂觀鎖更新整筆記錄 (不含關聯): 僅在資料庫中的 version 仍為 *version 時寫入，成功後 *version 加一
+// 沒有符合的記錄時，記錄仍存在表示已被其他請求更新，返回 interfaces.ErrConcurrentModification; 否則返回 gorm.ErrRecordNotFound
+func saveWithVersion(ctx context.Context, db *gorm.DB, record interface{}, id uuid.UUID, version *int) error {
+	expected := *version
+	*version = expected + 1
+	result := conn(ctx, db).Model(record).Select("*").Omit(clause.Associations).
+		Where("version = ?", expected).Updates(record)
+	if result.Error != nil {
+		*version = expected
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	*version = expected
+	var count int64
+	if err := conn(ctx, db).Model(record).Where("id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return interfaces.ErrConcurrentModification
+}
@@ -0,0 +1,28 @@
+package notification
+
+import (
+	"context"
+	"log"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// logNotifier 將通知寫入 log，在尚未串接 Email 服務前使用
+type logNotifier struct{}
+
+// NewLogNotifier 構造函數
+func NewLogNotifier() interfaces.Notifier {
+	return &logNotifier{}
+}
+
+// Private 寫入 log 的內容其他人也讀得到，不能用來傳送 Email 審核連結等憑證
+func (n *logNotifier) Private() bool {
+	return false
+}
+
+// Notify 以 log 記錄收件人、主旨與內容
+func (n *logNotifier) Notify(ctx context.Context, recipient models.Account, subject string, body string) error {
+	log.Printf("[notification] to %s <%s>: %s\n%s", recipient.FirstName+" "+recipient.LastName, recipient.Email, subject, body)
+	return nil
+}
@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+)
+
+// localFileStorage 以本機檔案系統實現 FileStorage 介面, 所有檔案存放在 baseDir 之下
+type localFileStorage struct {
+	baseDir string
+}
+
+// NewLocalFileStorage 構造函數, baseDir 不存在時會自動建立
+func NewLocalFileStorage(baseDir string) (interfaces.FileStorage, error) {
+	absDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage directory %q: %w", baseDir, err)
+	}
+	if err := os.MkdirAll(absDir, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create storage directory %q: %w", absDir, err)
+	}
+	return &localFileStorage{baseDir: absDir}, nil
+}
+
+// Save 先寫入暫存檔再改名, 避免讀取到寫到一半的檔案
+func (s *localFileStorage) Save(ctx context.Context, key string, content io.Reader) error {
+	path, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create directory for %q: %w", key, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file for %q: %w", key, err)
+	}
+	defer os.Remove(tmp.Name()) // 改名成功後此檔已不存在, 刪除失敗可忽略
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %q: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write %q: %w", key, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store %q: %w", key, err)
+	}
+	return nil
+}
+
+// Open 開啟檔案
+func (s *localFileStorage) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.resolve(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, interfaces.ErrFileNotFound
+		}
+		return nil, fmt.Errorf("failed to open %q: %w", key, err)
+	}
+	return file, nil
+}
+
+// Delete 刪除檔案
+func (s *localFileStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.resolve(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to delete %q: %w", key, err)
+	}
+	return nil
+}
+
+// resolve 將 key 轉換為 baseDir 下的路徑, 拒絕跳出 baseDir 的 key (例如包含 "..")
+func (s *localFileStorage) resolve(key string) (string, error) {
+	if key == "" {
+		return "", errors.New("storage key cannot be empty")
+	}
+	path := filepath.Join(s.baseDir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.baseDir+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return path, nil
+}
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalFileStorage(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalFileStorage(t.TempDir())
+	require.NoError(t, err)
+
+	t.Run("Save Open Delete", func(t *testing.T) {
+		key := "leave-requests/abc/def"
+		require.NoError(t, store.Save(ctx, key, strings.NewReader("certificate")))
+
+		file, err := store.Open(ctx, key)
+		require.NoError(t, err)
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		require.NoError(t, file.Close())
+		assert.Equal(t, "certificate", string(content))
+
+		// 覆寫
+		require.NoError(t, store.Save(ctx, key, strings.NewReader("updated")))
+		file, err = store.Open(ctx, key)
+		require.NoError(t, err)
+		content, _ = io.ReadAll(file)
+		file.Close()
+		assert.Equal(t, "updated", string(content))
+
+		require.NoError(t, store.Delete(ctx, key))
+		_, err = store.Open(ctx, key)
+		assert.ErrorIs(t, err, interfaces.ErrFileNotFound)
+
+		// 重複刪除不視為錯誤
+		assert.NoError(t, store.Delete(ctx, key))
+	})
+
+	t.Run("Rejects Keys Outside Base Directory", func(t *testing.T) {
+		for _, key := range []string{"", "../escape", "a/../../escape", "."} {
+			assert.Error(t, store.Save(ctx, key, strings.NewReader("x")), "key %q", key)
+			_, err := store.Open(ctx, key)
+			assert.Error(t, err, "key %q", key)
+		}
+	})
+}
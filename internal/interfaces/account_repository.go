@@ -26,6 +26,12 @@ type AccountRepository interface {
 	// id 指的是 Account 的 ID。
 	UpdatePassword(ctx context.Context, id uuid.UUID, newHashedPassword string) error
 
+	// ListAccountsByRoles 列出屬於指定角色的所有帳戶 (例如通知所有 HR)
+	ListAccountsByRoles(ctx context.Context, roles ...uint8) ([]models.Account, error)
+
+	// IncrementFeedVersion 遞增帳戶的訂閱行事曆版本，使先前簽發的 feed token 全部失效
+	IncrementFeedVersion(ctx context.Context, id uuid.UUID) error
+
 	// --- 可能需要的其他方法 ---
 
 }
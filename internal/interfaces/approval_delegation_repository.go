@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// ApprovalDelegationRepository 定義了審核代理資料的資料庫操作介面
+type ApprovalDelegationRepository interface {
+	// Create 建立代理記錄
+	Create(ctx context.Context, delegation *models.ApprovalDelegation) error
+
+	// GetByID 根據 ID 獲取代理記錄
+	GetByID(ctx context.Context, id uuid.UUID) (*models.ApprovalDelegation, error)
+
+	// Delete 刪除 (撤銷) 代理記錄
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListByAccountID 列出帳戶委託出去或被委託的所有代理 (含雙方帳戶資訊)
+	ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.ApprovalDelegation, error)
+
+	// ListActiveForDelegate 列出代理人在指定日期有效的代理記錄
+	ListActiveForDelegate(ctx context.Context, delegateID uuid.UUID, on time.Time) ([]models.ApprovalDelegation, error)
+
+	// ListActiveForDelegators 列出委託人在指定日期有效的代理記錄 (含代理人帳戶資訊)
+	ListActiveForDelegators(ctx context.Context, delegatorIDs []uuid.UUID, on time.Time) ([]models.ApprovalDelegation, error)
+}
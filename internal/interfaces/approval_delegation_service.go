@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// ApprovalDelegationService 定義了審核代理相關的業務邏輯操作
+type ApprovalDelegationService interface {
+	// CreateDelegation 由委託人建立代理，期間內代理人可代為審核假單
+	CreateDelegation(ctx context.Context, delegatorIDStr string, input models.ApprovalDelegationInput) (*models.ApprovalDelegation, error)
+
+	// ListDelegations 列出帳戶委託出去及被委託的代理
+	ListDelegations(ctx context.Context, accountIDStr string) ([]models.ApprovalDelegation, error)
+
+	// RevokeDelegation 撤銷代理，僅委託人本人或 HR / Super Admin 可操作
+	RevokeDelegation(ctx context.Context, delegationIDStr string, processorAccountIDStr string) error
+}
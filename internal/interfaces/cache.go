@@ -10,6 +10,8 @@ type CacheRepository interface {
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) error
 	Get(ctx context.Context, key string, dest interface{}) error
 	Delete(ctx context.Context, keys ...string) error
+	// SetNX 僅在 key 不存在時寫入，返回是否寫入成功 (用於多副本間的分散式鎖)
+	SetNX(ctx context.Context, key string, value interface{}, expiration time.Duration) (bool, error)
 }
 
 var ErrCacheMiss = errors.New("cache: key not found")
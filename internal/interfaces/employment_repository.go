@@ -23,7 +23,7 @@ type EmploymentRepository interface {
 
 	// UpdateEmployment 更新僱傭記錄
 	// 可以用於更新職等、職稱、薪資、狀態、離職日期等。
-	// 實現時可能使用 GORM 的 Save (更新所有欄位) 或 Updates (更新指定欄位)。
+	// 以樂觀鎖更新 (規則同 LeaveRequestRepository.Update)，版本號不符時返回 ErrConcurrentModification
 	UpdateEmployment(ctx context.Context, employment *models.Employment) error
 
 	// ListEmployments 列出僱傭記錄
@@ -32,6 +32,19 @@ type EmploymentRepository interface {
 	ListEmployments(ctx context.Context /*, filterOptions, paginationOptions */) ([]models.Employment, error)
 
 	GetEmploymentCountByJobGradeID(ctx context.Context, jobGradeID uuid.UUID) (int64, error)
+
+	// ListEmploymentsByManagerID 列出直屬主管為指定帳戶的僱傭記錄 (即該主管的直屬部屬)
+	ListEmploymentsByManagerID(ctx context.Context, managerAccountID uuid.UUID) ([]models.Employment, error)
+
+	// ListActiveEmploymentsByDepartment 列出指定部門中在職 (status = active) 的僱傭記錄
+	ListActiveEmploymentsByDepartment(ctx context.Context, department string) ([]models.Employment, error)
+
+	// ListEmploymentsByStatus 列出指定僱傭狀態的所有記錄
+	ListEmploymentsByStatus(ctx context.Context, status string) ([]models.Employment, error)
+
+	// UpdateEmploymentStatus 僅在目前狀態為 fromStatus 時將狀態改為 toStatus
+	// 狀態已被其他操作變更 (例如同時離職) 時返回 gorm.ErrRecordNotFound，避免覆寫。成功時版本號加一
+	UpdateEmploymentStatus(ctx context.Context, employmentID uuid.UUID, fromStatus, toStatus string) error
 	// --- 可能需要的其他方法 ---
 
 }
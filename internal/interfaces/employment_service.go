@@ -22,12 +22,19 @@ type EmploymentService interface {
 	UpdateEmploymentDetails(ctx context.Context, employmentID uuid.UUID, updates *models.Employment) (*models.Employment, error)
 
 	// TerminateEmployment 處理員工離職
-	// 設定離職日期和狀態
-	TerminateEmployment(ctx context.Context, employmentID uuid.UUID, terminationDate time.Time) error
+	// 設定離職日期和狀態，自動取消開始日晚於離職日的待審核 / 已核准假單，
+	// 並依離職日比例計算剩餘年假、以月薪換算的日薪折算金額，返回可供 HR 列印的結算摘要
+	// 離職狀態、狀態變更記錄與結算在同一事務中寫入，任一步驟失敗時全部回滾 (以相同參數重新呼叫即可重試)
+	// 對已於同一天離職的記錄重複呼叫時只重新計算結算 (可用於補印)
+	TerminateEmployment(ctx context.Context, employmentID uuid.UUID, terminationDate time.Time, processorAccountID uuid.UUID) (*models.TerminationSettlement, error)
 
 	// ListEmployments 列出僱傭記錄 (可擴展以支持過濾和分頁)
 	ListEmployments(ctx context.Context /*, filters, pagination */) ([]models.Employment, error)
 
+	// SetManager 設定 (或在 managerAccountID 為 nil 時清除) 員工的直屬主管
+	// 主管必須是存在的帳戶，且不可形成回報循環 (包含指定自己為主管)。
+	SetManager(ctx context.Context, accountID uuid.UUID, managerAccountID *uuid.UUID) (*models.Employment, error)
+
 	// --- 可能需要的其他方法 ---
 
 }
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// EmploymentStatusChangeRepository 定義了僱傭狀態變更記錄的資料庫操作介面
+type EmploymentStatusChangeRepository interface {
+	// Create 新增一筆狀態變更記錄
+	Create(ctx context.Context, change *models.EmploymentStatusChange) error
+
+	// ListByAccountID 依時間先後列出帳戶的所有狀態變更記錄
+	ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.EmploymentStatusChange, error)
+
+	// GetLatestByEmploymentID 取得僱傭記錄最近一次的狀態變更; 沒有記錄時返回 gorm.ErrRecordNotFound
+	GetLatestByEmploymentID(ctx context.Context, employmentID uuid.UUID) (*models.EmploymentStatusChange, error)
+}
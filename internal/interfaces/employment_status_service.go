@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// EmploymentStatusService 定義了僱傭狀態的自動變更與變更記錄查詢
+type EmploymentStatusService interface {
+	// SyncLongLeaveStatuses 依員工今日是否處於長假中 (已核准且日曆天超過門檻) 同步僱傭狀態，由排程每日呼叫:
+	// 長假開始時 active 改為 on_leave，長假結束後恢復為 active; 只恢復由長假設定的 on_leave，HR 手動設定的狀態不變。
+	// 單一員工失敗不影響其他員工，只在無法查詢假單或僱傭記錄時返回錯誤
+	SyncLongLeaveStatuses(ctx context.Context, now time.Time) (*models.EmploymentStatusSyncReport, error)
+
+	// ListStatusChanges 依時間先後列出員工的僱傭狀態變更記錄
+	ListStatusChanges(ctx context.Context, accountID uuid.UUID) ([]models.EmploymentStatusChange, error)
+}
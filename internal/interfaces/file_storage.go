@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// FileStorage 定義了上傳檔案的存放介面
+// 目前提供本機檔案系統實作, 之後可替換為 S3 相容的物件儲存
+type FileStorage interface {
+	// Save 將內容寫入 key 指定的位置, 已存在時覆寫
+	Save(ctx context.Context, key string, content io.Reader) error
+
+	// Open 開啟 key 指定的檔案, 不存在時返回 ErrFileNotFound; 呼叫端負責關閉
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete 刪除 key 指定的檔案, 不存在時不視為錯誤
+	Delete(ctx context.Context, key string) error
+}
+
+var ErrFileNotFound = errors.New("storage: file not found")
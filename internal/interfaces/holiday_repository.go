@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// HolidayRepository 定義了假日行事曆 (Holiday / HolidayCalendar) 資料庫操作相關的介面
+type HolidayRepository interface {
+	// CreateHoliday 新增假日
+	CreateHoliday(ctx context.Context, holiday *models.Holiday) error
+
+	// GetHolidayByDate 依日期查詢假日，找不到時返回 gorm.ErrRecordNotFound
+	GetHolidayByDate(ctx context.Context, date time.Time) (*models.Holiday, error)
+
+	// ListHolidaysByYear 列出某年度所有假日 (依日期排序)
+	ListHolidaysByYear(ctx context.Context, year int) ([]models.Holiday, error)
+
+	// ListHolidaysBetween 列出 [start, end] 區間內 (含頭尾) 的假日
+	ListHolidaysBetween(ctx context.Context, start, end time.Time) ([]models.Holiday, error)
+
+	// DeleteHoliday 刪除假日，找不到時返回 gorm.ErrRecordNotFound
+	DeleteHoliday(ctx context.Context, id uuid.UUID) error
+
+	// GetCalendarByYear 取得某年度的工作週設定，找不到時返回 gorm.ErrRecordNotFound
+	GetCalendarByYear(ctx context.Context, year int) (*models.HolidayCalendar, error)
+
+	// SaveCalendar 新增或更新年度工作週設定
+	SaveCalendar(ctx context.Context, calendar *models.HolidayCalendar) error
+}
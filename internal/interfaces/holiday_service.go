@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// HolidayService 定義了假日行事曆與工作日計算相關的業務邏輯操作
+type HolidayService interface {
+	// GetCalendar 取得某年度的工作週設定，尚未設定時返回預設週末 (週六、週日)
+	GetCalendar(ctx context.Context, year int) (*models.HolidayCalendar, error)
+
+	// SetWeekendDays 設定某年度的週末
+	SetWeekendDays(ctx context.Context, year int, weekendDays []time.Weekday) (*models.HolidayCalendar, error)
+
+	// ListHolidays 列出某年度所有假日
+	ListHolidays(ctx context.Context, year int) ([]models.Holiday, error)
+
+	// AddHoliday 新增假日，同一日期不可重複
+	AddHoliday(ctx context.Context, date time.Time, name string) (*models.Holiday, error)
+
+	// DeleteHoliday 刪除假日
+	DeleteHoliday(ctx context.Context, id uuid.UUID) error
+
+	// CountWorkingDays 計算 [start, end] 區間 (含頭尾) 扣除週末與假日後的工作日數
+	CountWorkingDays(ctx context.Context, start, end time.Time) (decimal.Decimal, error)
+
+	// ListWorkingDays 依日期順序列出 [start, end] 區間 (含頭尾) 內的工作日
+	ListWorkingDays(ctx context.Context, start, end time.Time) ([]time.Time, error)
+}
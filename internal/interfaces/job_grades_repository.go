@@ -11,6 +11,7 @@ type JobGradeRepository interface {
 	CreateJobGrade(ctx context.Context, jobGrade *models.JobGrade) error
 	GetJobGradeByID(ctx context.Context, id uuid.UUID) (*models.JobGrade, error)
 	GetJobGradeByCode(ctx context.Context, code string) (*models.JobGrade, error)
+	// UpdateJobGrade 以樂觀鎖更新職等 (規則同 LeaveRequestRepository.Update)，版本號不符時返回 ErrConcurrentModification
 	UpdateJobGrade(ctx context.Context, jobGrade *models.JobGrade) error
 	ListJobGrades(ctx context.Context) ([]models.JobGrade, error)
 	DeleteJobGrade(ctx context.Context, id uuid.UUID) error
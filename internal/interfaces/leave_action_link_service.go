@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// LeaveActionLinkGenerator 定義了 Email 審核連結的產生 (不依賴假單服務, 供通知審核人時使用)
+type LeaveActionLinkGenerator interface {
+	// GenerateLinks 為審核人產生核准與拒絕連結 (兩者共用同一個 nonce)
+	GenerateLinks(ctx context.Context, leaveRequestID uuid.UUID, approverID uuid.UUID) (*models.LeaveActionLinks, error)
+}
+
+// LeaveActionLinkService 定義了 Email 中一次性審核連結的產生與執行
+// 連結以 HMAC 簽署並帶有效期限, 審核人不需登入即可核准或拒絕假單
+type LeaveActionLinkService interface {
+	LeaveActionLinkGenerator
+	// InspectLink 驗證連結並返回其內容, 不消耗連結 (供確認頁面顯示)
+	InspectLink(ctx context.Context, token string) (*models.LeaveActionToken, error)
+	// ExecuteLink 驗證並消耗連結, 以連結中的審核人身分核准或拒絕假單
+	// 核准或拒絕失敗時釋放連結, 審核人可修正後重試或改用另一個連結
+	ExecuteLink(ctx context.Context, token string, reason string) (*models.LeaveActionToken, error)
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// LeaveAnalyticsRepository 定義了假期統計的資料存取操作, 皆以 SQL 聚合計算, 不載入個別假單
+type LeaveAnalyticsRepository interface {
+	// AbsenceTotals 依指定維度 (models.LeaveAnalyticsBy*) 統計假單數與請假天數
+	AbsenceTotals(ctx context.Context, groupBy string, filter models.LeaveAnalyticsFilter) ([]models.LeaveAbsenceTotal, error)
+	// BradfordFactors 依 Bradford factor 由高至低列出員工, 最多 limit 筆
+	BradfordFactors(ctx context.Context, filter models.LeaveAnalyticsFilter, limit int) ([]models.BradfordFactorScore, error)
+	// ApprovalTurnaroundByLeaveType 依假別統計由審核人核准的假單從申請到核准的時間 (秒); 拒絕、系統自動核准與 HR 代為提交的假單不計入
+	ApprovalTurnaroundByLeaveType(ctx context.Context, filter models.LeaveAnalyticsFilter) ([]models.LeaveTurnaroundStat, error)
+}
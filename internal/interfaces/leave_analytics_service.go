@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// LeaveAnalyticsService 定義了 HR 假期統計相關的業務邏輯操作
+type LeaveAnalyticsService interface {
+	// GetAbsenceTotals 依月份 / 假別 / 職等 / 部門 / 狀態統計缺勤
+	// 未指定狀態時, 依狀態分組會列出所有狀態, 其他分組只計入已核准 (含申請取消中) 的假單
+	GetAbsenceTotals(ctx context.Context, groupBy string, filter models.LeaveAnalyticsFilter) ([]models.LeaveAbsenceTotal, error)
+
+	// GetBradfordFactors 計算員工的 Bradford factor 並由高至低排序; 未指定假別時只計入病假
+	GetBradfordFactors(ctx context.Context, filter models.LeaveAnalyticsFilter, limit int) ([]models.BradfordFactorScore, error)
+
+	// GetApprovalTurnaround 統計由審核人核准的假單從 RequestedAt 到 ApprovedAt 的核准時效 (拒絕、系統自動核准與 HR 代為提交的假單不計入)
+	GetApprovalTurnaround(ctx context.Context, filter models.LeaveAnalyticsFilter) (*models.LeaveTurnaroundReport, error)
+}
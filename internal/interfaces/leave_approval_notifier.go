@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// LeaveApprovalNotifier 定義了通知假單審核人的操作 (提交、進入下一審核步驟與待審提醒)
+type LeaveApprovalNotifier interface {
+	// NotifyApprovers 通知指定步驟類型的審核人: manager 步驟為直屬主管 (沒有主管時改通知 HR)，hr 步驟為所有 HR 與 Super Admin
+	// 啟用 Email 審核連結且 Notifier 為 Private 時，每位審核人收到以自己身分核准或拒絕的連結; 返回已通知的審核人
+	NotifyApprovers(ctx context.Context, request *models.LeaveRequest, approverKind string, subject string) ([]models.Account, error)
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// LeaveApprovalRepository 定義了假單審核流程 (政策與步驟) 的資料庫操作介面
+type LeaveApprovalRepository interface {
+	// ListActivePolicies 列出所有啟用中的審核政策
+	ListActivePolicies(ctx context.Context) ([]models.LeaveApprovalPolicy, error)
+
+	// CreateSteps 建立一張假單的所有審核步驟
+	CreateSteps(ctx context.Context, steps []models.LeaveApprovalStep) error
+
+	// ListStepsByRequestID 依步驟順序列出假單的審核步驟
+	ListStepsByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveApprovalStep, error)
+
+	// UpdateStep 更新審核步驟 (記錄決定、審核人與時間)，僅在步驟仍為待審時寫入
+	// 步驟已被其他請求處理時返回 ErrConcurrentModification，步驟不存在時返回 gorm.ErrRecordNotFound
+	UpdateStep(ctx context.Context, step *models.LeaveApprovalStep) error
+
+	// DeleteStepsByRequestID 刪除假單的所有審核步驟 (假單修改後重新建立審核流程)
+	DeleteStepsByRequestID(ctx context.Context, leaveRequestID uuid.UUID) error
+}
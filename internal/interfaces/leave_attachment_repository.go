@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// LeaveAttachmentRepository 定義了假單附件與附件規則的資料庫操作介面
+type LeaveAttachmentRepository interface {
+	// Create 新增附件記錄
+	Create(ctx context.Context, attachment *models.LeaveAttachment) error
+
+	// GetByID 根據 ID 獲取附件記錄
+	GetByID(ctx context.Context, id uuid.UUID) (*models.LeaveAttachment, error)
+
+	// ListByRequestID 依上傳時間列出假單的所有附件
+	ListByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveAttachment, error)
+
+	// CountByRequestID 計算假單的附件數量
+	CountByRequestID(ctx context.Context, leaveRequestID uuid.UUID) (int64, error)
+
+	// GetRuleByLeaveType 獲取假別的附件規則，沒有規則時返回 gorm.ErrRecordNotFound
+	GetRuleByLeaveType(ctx context.Context, leaveType string) (*models.LeaveAttachmentRule, error)
+}
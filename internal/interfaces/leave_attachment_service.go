@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LeaveAttachmentService 定義了假單佐證文件相關的業務邏輯操作
+type LeaveAttachmentService interface {
+	// IsAttachmentRequired 依假別的附件規則判斷指定天數的假單是否必須附上佐證文件
+	IsAttachmentRequired(ctx context.Context, leaveType string, days decimal.Decimal) (bool, error)
+
+	// HasAttachments 判斷假單是否已有附件
+	HasAttachments(ctx context.Context, leaveRequestID uuid.UUID) (bool, error)
+
+	// ValidateUpload 檢查附件大小與類型 (依檔案內容偵測)
+	ValidateUpload(upload models.AttachmentUpload) error
+
+	// SaveAttachment 儲存附件檔案並建立記錄，不檢查權限 (供申請假單時使用)
+	SaveAttachment(ctx context.Context, leaveRequestID uuid.UUID, uploaderID uuid.UUID, upload models.AttachmentUpload) (*models.LeaveAttachment, error)
+
+	// UploadAttachment 為既有假單上傳附件，僅申請人本人或 HR / Super Admin 可操作
+	UploadAttachment(ctx context.Context, leaveRequestIDStr string, uploaderAccountIDStr string, upload models.AttachmentUpload) (*models.LeaveAttachment, error)
+
+	// ListAttachments 列出假單的附件，申請人本人、HR / Super Admin 及待審假單目前步驟的審核人 (含代理人) 可查看
+	ListAttachments(ctx context.Context, leaveRequestIDStr string, viewerAccountIDStr string) ([]models.LeaveAttachment, error)
+
+	// OpenAttachment 開啟附件內容供下載，權限規則同 ListAttachments; 呼叫端負責關閉 io.ReadCloser
+	OpenAttachment(ctx context.Context, leaveRequestIDStr string, attachmentIDStr string, viewerAccountIDStr string) (*models.LeaveAttachment, io.ReadCloser, error)
+}
@@ -0,0 +1,44 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LeaveBalanceRepository 定義了假期帳本 (LeaveBalanceEntry) 與累積規則的資料庫操作
+type LeaveBalanceRepository interface {
+	// CreateEntry 新增一筆帳本分錄
+	// 若 entry.IdempotencyKey 已存在，實現應靜默忽略 (不報錯、不重複入帳)。
+	CreateEntry(ctx context.Context, entry *models.LeaveBalanceEntry) error
+
+	// LockAccount 以 SELECT ... FOR UPDATE 鎖定帳戶，讓同一帳戶的餘額檢查與扣除依序進行
+	// 須在事務中呼叫，鎖定到事務結束為止; 帳戶不存在時返回 gorm.ErrRecordNotFound
+	LockAccount(ctx context.Context, accountID uuid.UUID) error
+
+	// SumByAccount 依假別加總指定帳戶在 asOf (含) 之前生效的分錄
+	SumByAccount(ctx context.Context, accountID uuid.UUID, asOf time.Time) ([]models.LeaveBalance, error)
+
+	// GetLatestEntry 取得指定帳戶、假別、分錄類型中生效日最晚的一筆
+	// 找不到時返回 gorm.ErrRecordNotFound
+	GetLatestEntry(ctx context.Context, accountID uuid.UUID, leaveType, entryType string) (*models.LeaveBalanceEntry, error)
+
+	// ListEntries 依生效日先後列出指定帳戶、假別的所有分錄
+	ListEntries(ctx context.Context, accountID uuid.UUID, leaveType string) ([]models.LeaveBalanceEntry, error)
+
+	// ListActiveAccrualRules 列出所有啟用中的累積規則
+	ListActiveAccrualRules(ctx context.Context) ([]models.LeaveAccrualRule, error)
+
+	// SumEntriesBetween 加總指定帳戶、假別中屬於 entryTypes、生效日在 [from, to] 之間的分錄
+	SumEntriesBetween(ctx context.Context, accountID uuid.UUID, leaveType string, entryTypes []string, from, to time.Time) (decimal.Decimal, error)
+
+	// PostYearEnd 在同一個事務中寫入年底結算的分錄與結算記錄
+	// 同一年、帳戶、假別的結算記錄已存在時，實現應靜默忽略 (不報錯、不重複入帳)。
+	PostYearEnd(ctx context.Context, record *models.LeaveCarryOverRecord, entries []*models.LeaveBalanceEntry) error
+
+	// ListCarryOverRecords 列出某年度的年底結算記錄 (預加載 Account)
+	ListCarryOverRecords(ctx context.Context, year int) ([]models.LeaveCarryOverRecord, error)
+}
@@ -0,0 +1,48 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// LeaveBalanceService 定義了假期餘額相關的業務邏輯
+type LeaveBalanceService interface {
+	// GetBalances 獲取帳戶各假別目前的餘額 (只讀取帳本, 累積分錄由 PostAccruals 入帳)
+	GetBalances(ctx context.Context, accountID uuid.UUID) ([]models.LeaveBalance, error)
+
+	// PostAccruals 為所有帳戶補入 asOf (含) 以前尚未入帳的累積分錄，並讓已過期的結轉天數與補休失效
+	// 由背景排程定期執行，重複執行不會重複入帳
+	PostAccruals(ctx context.Context, asOf time.Time) error
+
+	// CheckSufficientBalance 檢查帳戶某假別餘額是否足夠扣除 days 天
+	// 不足時返回 ErrInsufficientLeaveBalance; 沒有累積規則的假別 (補休除外) 沒有額度限制, 一律通過
+	CheckSufficientBalance(ctx context.Context, accountID uuid.UUID, leaveType string, days decimal.Decimal) error
+
+	// DebitForLeave 在假單核准後扣除對應天數 (同一張假單只會扣一次)
+	// 須在核准事務中呼叫: 鎖定帳戶後重新檢查餘額，不足時返回 ErrInsufficientLeaveBalance
+	DebitForLeave(ctx context.Context, request *models.LeaveRequest, days decimal.Decimal) error
+
+	// RestoreForLeave 在已核准的假單取消後退回 request.Days 天 (同一張假單只會退一次)
+	RestoreForLeave(ctx context.Context, request *models.LeaveRequest) error
+
+	// RestoreForShortenedLeave 在已核准的假單截短後退回縮減的 days 天 (同一張假單只會退一次)
+	RestoreForShortenedLeave(ctx context.Context, request *models.LeaveRequest, days decimal.Decimal) error
+
+	// CreditCompOff 在加班申請核准後存入 claim.CompOffDays 天補休，於 claim.CompOffExpiresOn 後失效 (同一筆申請只會存入一次)
+	CreditCompOff(ctx context.Context, claim *models.OvertimeClaim) error
+
+	// SettleForTermination 離職結算: 補入至離職日的累積分錄，扣回離職日之後尚未賺得的部分，返回結算後的剩餘天數
+	// 同一離職日重複執行不會重複入帳
+	SettleForTermination(ctx context.Context, accountID uuid.UUID, leaveType string, terminationDate time.Time) (*models.LeaveBalanceSettlement, error)
+
+	// RunYearEnd 對 year 年做年底結算 (結轉至隔年並讓超過上限的天數失效)，返回結算報表
+	// 同一年重複執行不會重複入帳; 年度尚未結束時返回 ErrYearNotEnded
+	RunYearEnd(ctx context.Context, year int) (*models.LeaveYearEndSummary, error)
+
+	// GetYearEndSummary 獲取 year 年的年底結算報表，尚未結算時返回 ErrYearEndNotProcessed
+	GetYearEndSummary(ctx context.Context, year int) (*models.LeaveYearEndSummary, error)
+}
@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// LeaveCalendarService 定義了團隊請假行事曆與 iCalendar 訂閱相關的業務邏輯操作
+type LeaveCalendarService interface {
+	// GetTeamCalendar 依日期列出區間內已核准 (可選擇包含待審核) 的假單
+	// HR / Super Admin 可查看全公司或任一團隊，其他人僅能查看自己所屬或管理的團隊
+	GetTeamCalendar(ctx context.Context, viewerAccountIDStr string, query models.LeaveCalendarQuery) ([]models.LeaveCalendarDay, error)
+
+	// ListAccountFeed 列出個人訂閱行事曆所需的假單 (含已取消 / 已拒絕，供行事曆軟體移除事件)
+	// 僅本人、其直屬主管或 HR / Super Admin 可查看
+	ListAccountFeed(ctx context.Context, viewerAccountIDStr string, accountIDStr string) ([]models.LeaveRequest, error)
+
+	// ListTeamFeed 列出團隊 (主管本人與其直屬部屬) 訂閱行事曆所需的假單，權限規則同 GetTeamCalendar
+	ListTeamFeed(ctx context.Context, viewerAccountIDStr string, managerAccountIDStr string) ([]models.LeaveRequest, error)
+
+	// IssueFeedToken 確認帳戶可讀取指定的訂閱行事曆後，簽發只能讀取該行事曆且有期限的 feed token
+	// feed 為 models.CalendarFeedAccount 或 models.CalendarFeedTeam，subjectIDStr 為對應的帳戶或主管 ID
+	IssueFeedToken(ctx context.Context, viewerAccountIDStr string, feed string, subjectIDStr string) (string, error)
+
+	// VerifyFeedToken 驗證 feed token 的簽章、用途、期限與是否已撤銷並返回其內容 (不檢查權限，讀取行事曆時由 ListAccountFeed / ListTeamFeed 檢查)
+	VerifyFeedToken(ctx context.Context, token string) (*models.CalendarFeedToken, error)
+
+	// RevokeFeedTokens 撤銷帳戶先前簽發的所有 feed token (例如訂閱網址外流時)，之後需重新簽發
+	RevokeFeedTokens(ctx context.Context, viewerAccountIDStr string) error
+}
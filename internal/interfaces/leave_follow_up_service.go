@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// LeaveFollowUpService 定義了逾時未審假單的後續處理 (由排程定期呼叫)
+type LeaveFollowUpService interface {
+	// ProcessStaleRequests 找出待審超過門檻的假單: 提醒目前步驟的審核人、逾時過久時升級給 Super Admin，
+	// 並依設定自動核准短天數病假。單張假單失敗不影響其他假單，只在無法查詢假單時返回錯誤
+	ProcessStaleRequests(ctx context.Context, now time.Time) (*models.LeaveFollowUpReport, error)
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// LeaveRequestEventRepository 定義了假單歷程記錄的資料庫操作介面
+type LeaveRequestEventRepository interface {
+	// Create 新增一筆假單事件
+	Create(ctx context.Context, event *models.LeaveRequestEvent) error
+
+	// ListByRequestID 依時間先後列出假單的所有事件 (含執行人帳戶資訊)
+	ListByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveRequestEvent, error)
+}
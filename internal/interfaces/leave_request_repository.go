@@ -2,6 +2,8 @@ package interfaces
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/erinchen11/hr-system/internal/models" // 導入 models 包
 	"github.com/google/uuid"
@@ -19,7 +21,8 @@ type LeaveRequestRepository interface {
 
 	// Update 更新現有的請假申請記錄
 	// 主要用於 Service 層更新狀態、審批人、審批時間等。
-	// 實現時可能使用 GORM 的 Save 或 Updates。
+	// 以樂觀鎖更新: 僅在資料庫中的版本號仍為 request.Version 時寫入，成功後 request.Version 加一;
+	// 記錄已被其他請求更新時返回 ErrConcurrentModification，記錄不存在時返回 gorm.ErrRecordNotFound
 	Update(ctx context.Context, request *models.LeaveRequest) error
 
 	// ListAllWithAccount 列出所有請假申請記錄，並預加載申請人 (Account) 資訊
@@ -27,10 +30,45 @@ type LeaveRequestRepository interface {
 	// 可擴展以支持過濾和分頁。
 	ListAllWithAccount(ctx context.Context) ([]models.LeaveRequest, error)
 
+	// ListFiltered 依過濾條件查詢假單 (預加載申請人與審核人)，並返回分頁前的總筆數
+	// filter 的排序欄位與分頁參數應已由 Service 層驗證並補上預設值。
+	ListFiltered(ctx context.Context, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error)
+
 	// ListByAccountID 列出指定帳戶 (AccountID) 的所有請假申請記錄
 	// 通常需要按申請時間排序。
 	ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.LeaveRequest, error)
 
+	// ListByAccountIDs 列出多個帳戶的請假申請記錄，並預加載申請人與審核人資訊
+	// 用於主管查看直屬部屬的假單。
+	ListByAccountIDs(ctx context.Context, accountIDs []uuid.UUID) ([]models.LeaveRequest, error)
+
+	// ListOverlapping 列出指定帳戶中日期區間與 [start, end] 有交集且狀態屬於 statuses 的假單
+	// 用於申請與核准時檢查同一員工的假期是否重疊。
+	ListOverlapping(ctx context.Context, accountID uuid.UUID, start, end time.Time, statuses []string) ([]models.LeaveRequest, error)
+
+	// LockOverlapping 以 SELECT ... FOR UPDATE 鎖定並列出指定帳戶中日期區間與 [start, end] 有交集的所有假單 (不限狀態)
+	// 須在 TransactionManager.WithinTransaction 中呼叫: 同一員工重疊假單的核准因此依序進行，後者可讀到前者已提交的狀態。
+	LockOverlapping(ctx context.Context, accountID uuid.UUID, start, end time.Time) ([]models.LeaveRequest, error)
+
+	// ListInRange 列出日期區間與 [start, end] 有交集且狀態屬於 statuses 的假單，並預加載申請人資訊
+	// accountIDs 為 nil 時不限申請人 (用於 HR 查看全公司行事曆)。
+	ListInRange(ctx context.Context, accountIDs []uuid.UUID, start, end time.Time, statuses []string) ([]models.LeaveRequest, error)
+
+	// ListPendingRequestedBefore 列出在 before 之前提交且仍待審核的假單，並預加載申請人資訊
+	// 用於排程找出逾時未審的假單。
+	ListPendingRequestedBefore(ctx context.Context, before time.Time) ([]models.LeaveRequest, error)
+
+	// UpdateFollowUp 僅更新假單的提醒與升級時間 (LastRemindedAt / EscalatedAt)，且只在假單仍待審時更新
+	// 避免排程覆寫同時間審核人對假單狀態的變更。
+	UpdateFollowUp(ctx context.Context, request *models.LeaveRequest) error
+
+	// ListApprovedCoveringDate 列出假期包含指定日期的已核准假單 (含申請取消中、尚未確認的假單)
+	ListApprovedCoveringDate(ctx context.Context, date time.Time) ([]models.LeaveRequest, error)
+
 	// --- 可能需要的其他方法 ---
 	
-}
\ No newline at end of file
+}
+
+// ErrConcurrentModification 記錄在讀取後已被其他請求更新 (樂觀鎖版本號不符)
+// LeaveRequestRepository.Update、EmploymentRepository.UpdateEmployment 與 JobGradeRepository.UpdateJobGrade 共用
+var ErrConcurrentModification = errors.New("record was modified by another request")
@@ -2,9 +2,10 @@ package interfaces
 
 import (
 	"context"
-	"time" // 導入 time 套件
+	"time"
 
-	"github.com/erinchen11/hr-system/internal/models" 
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
 )
 
 // LeaveRequestService 定義了與請假申請相關的業務邏輯操作
@@ -12,16 +13,63 @@ type LeaveRequestService interface {
 	// ListAllRequests 獲取所有請假請求 (通常帶有關聯的 Account)
 	ListAllRequests(ctx context.Context) ([]models.LeaveRequest, error)
 
+	// ListRequests 依過濾、排序與分頁條件查詢假單，返回當頁資料與符合條件的總筆數
+	ListRequests(ctx context.Context, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error)
+
+	// ListManagedRequests 列出指定主管所有直屬部屬的請假申請
+	ListManagedRequests(ctx context.Context, managerAccountIDStr string) ([]models.LeaveRequest, error)
+
 	// ApproveRequest 批准指定的請假申請
+	// 處理人須為 HR / Super Admin，或申請人的直屬主管; 假別規則要求附件而假單尚無附件時不可核准
+	// 落在封鎖期間或使部門在班人數不足時返回 *LeaveRuleViolationError; 通過中間步驟時通知下一步驟的審核人
 	ApproveRequest(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string) error
 
-	// RejectRequest 拒絕指定的請假申請
+	// ApproveRequestWithOverride 同 ApproveRequest，但 HR / Super Admin 可附上理由覆寫封鎖期間與人力規則
+	// 覆寫時在假單歷程記錄 rule_overridden 事件與理由; 其他角色返回 ErrRuleOverrideNotAllowed
+	ApproveRequestWithOverride(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string, justification string) error
+
+	// RejectRequest 拒絕指定的請假申請 (權限規則同 ApproveRequest)
+	// reason 記錄在 DecisionNote，不覆蓋員工填寫的請假原因
 	RejectRequest(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string, reason string) error
 
+	// UpdatePendingRequest 申請人修改仍待審核的假單，重新執行所有申請檢查並記錄修改內容
+	UpdatePendingRequest(ctx context.Context, leaveRequestIDStr string, accountIDStr string, input models.LeaveRequestInput) (*models.LeaveRequest, error)
+
+	// AutoApproveRequest 由排程以系統身份核准假單 (不經審核人)，檢查與人工核准相同且不可覆寫規則
+	AutoApproveRequest(ctx context.Context, leaveRequestIDStr string, comment string) error
+
+	// BulkProcessRequests 依序以 ApproveRequest / RejectRequest 處理多張假單 (decision 為 approve 或 reject)
+	// 單張失敗不影響其他假單，每張假單各返回一筆結果; 僅在 decision 或 ID 數量不合法時返回錯誤
+	BulkProcessRequests(ctx context.Context, leaveRequestIDs []string, processorAccountIDStr string, decision string, reason string) ([]models.BulkLeaveDecisionResult, error)
+
 	// ApplyForLeave 員工提交新的請假申請
-	// ***  後的方法簽名 ***
-	// 添加了 leaveType string 參數
-	ApplyForLeave(ctx context.Context, accountIDStr, leaveType, reason string, startDate, endDate time.Time) (*models.LeaveRequest, error)
+	// input 包含假別、日期區間與時長單位 (整天 / 上午半天 / 下午半天 / 小時)，以及選填的佐證文件
+	// 假別規則要求附件但未附上時返回 ErrAttachmentRequired; 假單已建立但附件儲存失敗時，同時返回假單與錯誤
+	// 落在封鎖期間或使部門在班人數不足時返回 *LeaveRuleViolationError; HR / Super Admin 可附 input.OverrideJustification 覆寫,
+	// 覆寫時記錄 rule_overridden 事件且審核時不再以該規則阻擋, 其他角色附理由時返回 ErrRuleOverrideNotAllowed
+	// 提交後通知第一步驟的審核人 (通知失敗不影響申請)
+	ApplyForLeave(ctx context.Context, accountIDStr string, input models.LeaveRequestInput) (*models.LeaveRequest, error)
+
+	// ApplyForLeaveOnBehalf HR / Super Admin 代指定帳戶提交假單，建立者記錄於 CreatedByID
+	// preApproved 為 true 時建立後直接由建立者核准 (不可用於自己的假單); 預先核准失敗時假單維持 pending，同時返回假單與 ErrLeavePreApprovalFailed
+	ApplyForLeaveOnBehalf(ctx context.Context, creatorAccountIDStr string, accountIDStr string, input models.LeaveRequestInput, preApproved bool) (*models.LeaveRequest, error)
+
+	// CancelRequest 員工取消自己的假單
+	// pending 直接變為 cancelled；尚未開始的 approved 假單變為 cancellation_requested，等待 HR 確認
+	CancelRequest(ctx context.Context, leaveRequestIDStr string, accountIDStr string) (*models.LeaveRequest, error)
+
+	// ConfirmCancellation HR 確認取消已核准的假單並退回天數
+	ConfirmCancellation(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string) error
+
+	// DeclineCancellation HR 駁回取消申請，假單恢復為 approved
+	DeclineCancellation(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string) error
+
+	// CancelForTermination 員工離職時取消所有開始日晚於離職日的待審核 / 已核准假單，並將跨越離職日的假單截短至離職日
+	// 已扣除的天數中離職日之後的部分會退回; 返回被取消與被截短的假單
+	CancelForTermination(ctx context.Context, accountID uuid.UUID, terminationDate time.Time, processorAccountID uuid.UUID) (cancelled, shortened []models.LeaveRequest, err error)
+
+	// GetRequestHistory 依時間先後返回假單的狀態歷程，僅申請人本人或 HR / Super Admin 可查看
+	GetRequestHistory(ctx context.Context, leaveRequestIDStr string, viewerAccountIDStr string) ([]models.LeaveRequestEvent, error)
 
 	// ListAccountRequests 列出指定帳戶的所有請假申請
 	// ***  後的方法名 ***
@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// LeaveRuleRepository 定義了封鎖期間與最少在班人數規則的資料庫操作介面
+type LeaveRuleRepository interface {
+	// CreateBlackout 新增封鎖期間
+	CreateBlackout(ctx context.Context, blackout *models.LeaveBlackoutPeriod) error
+
+	// ListBlackouts 依開始日期列出所有封鎖期間
+	ListBlackouts(ctx context.Context) ([]models.LeaveBlackoutPeriod, error)
+
+	// ListActiveBlackoutsBetween 列出與 [start, end] 重疊且啟用中的封鎖期間
+	ListActiveBlackoutsBetween(ctx context.Context, start, end time.Time) ([]models.LeaveBlackoutPeriod, error)
+
+	// DeleteBlackout 刪除封鎖期間，找不到時返回 gorm.ErrRecordNotFound
+	DeleteBlackout(ctx context.Context, id uuid.UUID) error
+
+	// CreateStaffingRule 新增最少在班人數規則
+	CreateStaffingRule(ctx context.Context, rule *models.LeaveStaffingRule) error
+
+	// ListStaffingRules 依部門列出所有最少在班人數規則
+	ListStaffingRules(ctx context.Context) ([]models.LeaveStaffingRule, error)
+
+	// ListActiveStaffingRulesByDepartment 列出部門啟用中的最少在班人數規則
+	ListActiveStaffingRulesByDepartment(ctx context.Context, department string) ([]models.LeaveStaffingRule, error)
+
+	// DeleteStaffingRule 刪除最少在班人數規則，找不到時返回 gorm.ErrRecordNotFound
+	DeleteStaffingRule(ctx context.Context, id uuid.UUID) error
+}
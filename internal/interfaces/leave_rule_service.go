@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// LeaveRuleService 定義了封鎖期間與最少在班人數規則的管理與檢查
+type LeaveRuleService interface {
+	// CheckLeaveRules 檢查假單是否落在封鎖期間，或核准後會使部門在班人數低於下限
+	// 沒有違反任何規則時返回 nil, nil; 違反時返回第一條被違反的規則
+	CheckLeaveRules(ctx context.Context, request *models.LeaveRequest) (*models.LeaveRuleViolation, error)
+
+	// ListBlackouts 列出所有封鎖期間
+	ListBlackouts(ctx context.Context) ([]models.LeaveBlackoutPeriod, error)
+
+	// CreateBlackout 新增封鎖期間 (Department / LeaveType 為空表示全部適用)
+	CreateBlackout(ctx context.Context, blackout *models.LeaveBlackoutPeriod) (*models.LeaveBlackoutPeriod, error)
+
+	// DeleteBlackout 刪除封鎖期間
+	DeleteBlackout(ctx context.Context, id uuid.UUID) error
+
+	// ListStaffingRules 列出所有最少在班人數規則
+	ListStaffingRules(ctx context.Context) ([]models.LeaveStaffingRule, error)
+
+	// CreateStaffingRule 新增部門的最少在班人數規則
+	CreateStaffingRule(ctx context.Context, rule *models.LeaveStaffingRule) (*models.LeaveStaffingRule, error)
+
+	// DeleteStaffingRule 刪除最少在班人數規則
+	DeleteStaffingRule(ctx context.Context, id uuid.UUID) error
+}
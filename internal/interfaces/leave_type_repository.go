@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// LeaveTypeRepository 定義了假別的資料庫操作介面
+type LeaveTypeRepository interface {
+	// Create 新增假別
+	Create(ctx context.Context, leaveType *models.LeaveType) error
+
+	// Update 更新假別
+	Update(ctx context.Context, leaveType *models.LeaveType) error
+
+	// List 依代碼列出所有假別 (包含停用的)
+	List(ctx context.Context) ([]models.LeaveType, error)
+
+	// GetByID 根據 ID 查詢假別，找不到時返回 gorm.ErrRecordNotFound
+	GetByID(ctx context.Context, id uuid.UUID) (*models.LeaveType, error)
+
+	// GetByCode 根據代碼查詢假別，找不到時返回 gorm.ErrRecordNotFound
+	GetByCode(ctx context.Context, code string) (*models.LeaveType, error)
+
+	// Delete 刪除假別，找不到時返回 gorm.ErrRecordNotFound
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// CountLeaveRequests 計算使用此假別代碼的假單數量
+	CountLeaveRequests(ctx context.Context, code string) (int64, error)
+}
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// LeaveTypeService 定義了假別的管理與申請時的檢查
+type LeaveTypeService interface {
+	// ResolveLeaveType 返回指定代碼的假別，並確認其為啟用中且申請人的角色可以申請
+	ResolveLeaveType(ctx context.Context, code string, role uint8) (*models.LeaveType, error)
+
+	// ListLeaveTypes 列出所有假別 (包含停用的)
+	ListLeaveTypes(ctx context.Context) ([]models.LeaveType, error)
+
+	// CreateLeaveType 新增假別 (代碼不可重複)
+	CreateLeaveType(ctx context.Context, leaveType *models.LeaveType) (*models.LeaveType, error)
+
+	// UpdateLeaveType 更新假別的名稱與設定 (代碼不可修改)
+	UpdateLeaveType(ctx context.Context, id uuid.UUID, update *models.LeaveType) (*models.LeaveType, error)
+
+	// DeleteLeaveType 刪除尚未被任何假單使用的假別
+	DeleteLeaveType(ctx context.Context, id uuid.UUID) error
+}
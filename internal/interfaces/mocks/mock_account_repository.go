@@ -80,6 +80,40 @@ func (mr *MockAccountRepositoryMockRecorder) GetAccountByID(ctx, id interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByID", reflect.TypeOf((*MockAccountRepository)(nil).GetAccountByID), ctx, id)
 }
 
+// IncrementFeedVersion mocks base method.
+func (m *MockAccountRepository) IncrementFeedVersion(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrementFeedVersion", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IncrementFeedVersion indicates an expected call of IncrementFeedVersion.
+func (mr *MockAccountRepositoryMockRecorder) IncrementFeedVersion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrementFeedVersion", reflect.TypeOf((*MockAccountRepository)(nil).IncrementFeedVersion), ctx, id)
+}
+
+// ListAccountsByRoles mocks base method.
+func (m *MockAccountRepository) ListAccountsByRoles(ctx context.Context, roles ...uint8) ([]models.Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range roles {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountsByRoles", varargs...)
+	ret0, _ := ret[0].([]models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsByRoles indicates an expected call of ListAccountsByRoles.
+func (mr *MockAccountRepositoryMockRecorder) ListAccountsByRoles(ctx interface{}, roles ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, roles...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsByRoles", reflect.TypeOf((*MockAccountRepository)(nil).ListAccountsByRoles), varargs...)
+}
+
 // UpdatePassword mocks base method.
 func (m *MockAccountRepository) UpdatePassword(ctx context.Context, id uuid.UUID, newHashedPassword string) error {
 	m.ctrl.T.Helper()
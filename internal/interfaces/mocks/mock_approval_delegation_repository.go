@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/approval_delegation_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockApprovalDelegationRepository is a mock of ApprovalDelegationRepository interface.
+type MockApprovalDelegationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockApprovalDelegationRepositoryMockRecorder
+}
+
+// MockApprovalDelegationRepositoryMockRecorder is the mock recorder for MockApprovalDelegationRepository.
+type MockApprovalDelegationRepositoryMockRecorder struct {
+	mock *MockApprovalDelegationRepository
+}
+
+// NewMockApprovalDelegationRepository creates a new mock instance.
+func NewMockApprovalDelegationRepository(ctrl *gomock.Controller) *MockApprovalDelegationRepository {
+	mock := &MockApprovalDelegationRepository{ctrl: ctrl}
+	mock.recorder = &MockApprovalDelegationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApprovalDelegationRepository) EXPECT() *MockApprovalDelegationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockApprovalDelegationRepository) Create(ctx context.Context, delegation *models.ApprovalDelegation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, delegation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockApprovalDelegationRepositoryMockRecorder) Create(ctx, delegation interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockApprovalDelegationRepository)(nil).Create), ctx, delegation)
+}
+
+// Delete mocks base method.
+func (m *MockApprovalDelegationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockApprovalDelegationRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockApprovalDelegationRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockApprovalDelegationRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.ApprovalDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.ApprovalDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockApprovalDelegationRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockApprovalDelegationRepository)(nil).GetByID), ctx, id)
+}
+
+// ListActiveForDelegate mocks base method.
+func (m *MockApprovalDelegationRepository) ListActiveForDelegate(ctx context.Context, delegateID uuid.UUID, on time.Time) ([]models.ApprovalDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveForDelegate", ctx, delegateID, on)
+	ret0, _ := ret[0].([]models.ApprovalDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveForDelegate indicates an expected call of ListActiveForDelegate.
+func (mr *MockApprovalDelegationRepositoryMockRecorder) ListActiveForDelegate(ctx, delegateID, on interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveForDelegate", reflect.TypeOf((*MockApprovalDelegationRepository)(nil).ListActiveForDelegate), ctx, delegateID, on)
+}
+
+// ListActiveForDelegators mocks base method.
+func (m *MockApprovalDelegationRepository) ListActiveForDelegators(ctx context.Context, delegatorIDs []uuid.UUID, on time.Time) ([]models.ApprovalDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveForDelegators", ctx, delegatorIDs, on)
+	ret0, _ := ret[0].([]models.ApprovalDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveForDelegators indicates an expected call of ListActiveForDelegators.
+func (mr *MockApprovalDelegationRepositoryMockRecorder) ListActiveForDelegators(ctx, delegatorIDs, on interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveForDelegators", reflect.TypeOf((*MockApprovalDelegationRepository)(nil).ListActiveForDelegators), ctx, delegatorIDs, on)
+}
+
+// ListByAccountID mocks base method.
+func (m *MockApprovalDelegationRepository) ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.ApprovalDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountID", ctx, accountID)
+	ret0, _ := ret[0].([]models.ApprovalDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountID indicates an expected call of ListByAccountID.
+func (mr *MockApprovalDelegationRepositoryMockRecorder) ListByAccountID(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockApprovalDelegationRepository)(nil).ListByAccountID), ctx, accountID)
+}
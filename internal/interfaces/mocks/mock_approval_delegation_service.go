@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/approval_delegation_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockApprovalDelegationService is a mock of ApprovalDelegationService interface.
+type MockApprovalDelegationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApprovalDelegationServiceMockRecorder
+}
+
+// MockApprovalDelegationServiceMockRecorder is the mock recorder for MockApprovalDelegationService.
+type MockApprovalDelegationServiceMockRecorder struct {
+	mock *MockApprovalDelegationService
+}
+
+// NewMockApprovalDelegationService creates a new mock instance.
+func NewMockApprovalDelegationService(ctrl *gomock.Controller) *MockApprovalDelegationService {
+	mock := &MockApprovalDelegationService{ctrl: ctrl}
+	mock.recorder = &MockApprovalDelegationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApprovalDelegationService) EXPECT() *MockApprovalDelegationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateDelegation mocks base method.
+func (m *MockApprovalDelegationService) CreateDelegation(ctx context.Context, delegatorIDStr string, input models.ApprovalDelegationInput) (*models.ApprovalDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelegation", ctx, delegatorIDStr, input)
+	ret0, _ := ret[0].(*models.ApprovalDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDelegation indicates an expected call of CreateDelegation.
+func (mr *MockApprovalDelegationServiceMockRecorder) CreateDelegation(ctx, delegatorIDStr, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelegation", reflect.TypeOf((*MockApprovalDelegationService)(nil).CreateDelegation), ctx, delegatorIDStr, input)
+}
+
+// ListDelegations mocks base method.
+func (m *MockApprovalDelegationService) ListDelegations(ctx context.Context, accountIDStr string) ([]models.ApprovalDelegation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDelegations", ctx, accountIDStr)
+	ret0, _ := ret[0].([]models.ApprovalDelegation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDelegations indicates an expected call of ListDelegations.
+func (mr *MockApprovalDelegationServiceMockRecorder) ListDelegations(ctx, accountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDelegations", reflect.TypeOf((*MockApprovalDelegationService)(nil).ListDelegations), ctx, accountIDStr)
+}
+
+// RevokeDelegation mocks base method.
+func (m *MockApprovalDelegationService) RevokeDelegation(ctx context.Context, delegationIDStr, processorAccountIDStr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeDelegation", ctx, delegationIDStr, processorAccountIDStr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeDelegation indicates an expected call of RevokeDelegation.
+func (mr *MockApprovalDelegationServiceMockRecorder) RevokeDelegation(ctx, delegationIDStr, processorAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeDelegation", reflect.TypeOf((*MockApprovalDelegationService)(nil).RevokeDelegation), ctx, delegationIDStr, processorAccountIDStr)
+}
@@ -95,6 +95,21 @@ func (mr *MockEmploymentRepositoryMockRecorder) GetEmploymentCountByJobGradeID(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmploymentCountByJobGradeID", reflect.TypeOf((*MockEmploymentRepository)(nil).GetEmploymentCountByJobGradeID), ctx, jobGradeID)
 }
 
+// ListActiveEmploymentsByDepartment mocks base method.
+func (m *MockEmploymentRepository) ListActiveEmploymentsByDepartment(ctx context.Context, department string) ([]models.Employment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveEmploymentsByDepartment", ctx, department)
+	ret0, _ := ret[0].([]models.Employment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveEmploymentsByDepartment indicates an expected call of ListActiveEmploymentsByDepartment.
+func (mr *MockEmploymentRepositoryMockRecorder) ListActiveEmploymentsByDepartment(ctx, department interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveEmploymentsByDepartment", reflect.TypeOf((*MockEmploymentRepository)(nil).ListActiveEmploymentsByDepartment), ctx, department)
+}
+
 // ListEmployments mocks base method.
 func (m *MockEmploymentRepository) ListEmployments(ctx context.Context) ([]models.Employment, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +125,36 @@ func (mr *MockEmploymentRepositoryMockRecorder) ListEmployments(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmployments", reflect.TypeOf((*MockEmploymentRepository)(nil).ListEmployments), ctx)
 }
 
+// ListEmploymentsByManagerID mocks base method.
+func (m *MockEmploymentRepository) ListEmploymentsByManagerID(ctx context.Context, managerAccountID uuid.UUID) ([]models.Employment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmploymentsByManagerID", ctx, managerAccountID)
+	ret0, _ := ret[0].([]models.Employment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmploymentsByManagerID indicates an expected call of ListEmploymentsByManagerID.
+func (mr *MockEmploymentRepositoryMockRecorder) ListEmploymentsByManagerID(ctx, managerAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmploymentsByManagerID", reflect.TypeOf((*MockEmploymentRepository)(nil).ListEmploymentsByManagerID), ctx, managerAccountID)
+}
+
+// ListEmploymentsByStatus mocks base method.
+func (m *MockEmploymentRepository) ListEmploymentsByStatus(ctx context.Context, status string) ([]models.Employment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmploymentsByStatus", ctx, status)
+	ret0, _ := ret[0].([]models.Employment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEmploymentsByStatus indicates an expected call of ListEmploymentsByStatus.
+func (mr *MockEmploymentRepositoryMockRecorder) ListEmploymentsByStatus(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmploymentsByStatus", reflect.TypeOf((*MockEmploymentRepository)(nil).ListEmploymentsByStatus), ctx, status)
+}
+
 // UpdateEmployment mocks base method.
 func (m *MockEmploymentRepository) UpdateEmployment(ctx context.Context, employment *models.Employment) error {
 	m.ctrl.T.Helper()
@@ -123,3 +168,17 @@ func (mr *MockEmploymentRepositoryMockRecorder) UpdateEmployment(ctx, employment
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployment", reflect.TypeOf((*MockEmploymentRepository)(nil).UpdateEmployment), ctx, employment)
 }
+
+// UpdateEmploymentStatus mocks base method.
+func (m *MockEmploymentRepository) UpdateEmploymentStatus(ctx context.Context, employmentID uuid.UUID, fromStatus, toStatus string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmploymentStatus", ctx, employmentID, fromStatus, toStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmploymentStatus indicates an expected call of UpdateEmploymentStatus.
+func (mr *MockEmploymentRepositoryMockRecorder) UpdateEmploymentStatus(ctx, employmentID, fromStatus, toStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmploymentStatus", reflect.TypeOf((*MockEmploymentRepository)(nil).UpdateEmploymentStatus), ctx, employmentID, fromStatus, toStatus)
+}
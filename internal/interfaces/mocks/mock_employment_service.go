@@ -82,18 +82,34 @@ func (mr *MockEmploymentServiceMockRecorder) ListEmployments(ctx interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmployments", reflect.TypeOf((*MockEmploymentService)(nil).ListEmployments), ctx)
 }
 
+// SetManager mocks base method.
+func (m *MockEmploymentService) SetManager(ctx context.Context, accountID uuid.UUID, managerAccountID *uuid.UUID) (*models.Employment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetManager", ctx, accountID, managerAccountID)
+	ret0, _ := ret[0].(*models.Employment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetManager indicates an expected call of SetManager.
+func (mr *MockEmploymentServiceMockRecorder) SetManager(ctx, accountID, managerAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetManager", reflect.TypeOf((*MockEmploymentService)(nil).SetManager), ctx, accountID, managerAccountID)
+}
+
 // TerminateEmployment mocks base method.
-func (m *MockEmploymentService) TerminateEmployment(ctx context.Context, employmentID uuid.UUID, terminationDate time.Time) error {
+func (m *MockEmploymentService) TerminateEmployment(ctx context.Context, employmentID uuid.UUID, terminationDate time.Time, processorAccountID uuid.UUID) (*models.TerminationSettlement, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TerminateEmployment", ctx, employmentID, terminationDate)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "TerminateEmployment", ctx, employmentID, terminationDate, processorAccountID)
+	ret0, _ := ret[0].(*models.TerminationSettlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // TerminateEmployment indicates an expected call of TerminateEmployment.
-func (mr *MockEmploymentServiceMockRecorder) TerminateEmployment(ctx, employmentID, terminationDate interface{}) *gomock.Call {
+func (mr *MockEmploymentServiceMockRecorder) TerminateEmployment(ctx, employmentID, terminationDate, processorAccountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateEmployment", reflect.TypeOf((*MockEmploymentService)(nil).TerminateEmployment), ctx, employmentID, terminationDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateEmployment", reflect.TypeOf((*MockEmploymentService)(nil).TerminateEmployment), ctx, employmentID, terminationDate, processorAccountID)
 }
 
 // UpdateEmploymentDetails mocks base method.
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/employment_status_change_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockEmploymentStatusChangeRepository is a mock of EmploymentStatusChangeRepository interface.
+type MockEmploymentStatusChangeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmploymentStatusChangeRepositoryMockRecorder
+}
+
+// MockEmploymentStatusChangeRepositoryMockRecorder is the mock recorder for MockEmploymentStatusChangeRepository.
+type MockEmploymentStatusChangeRepositoryMockRecorder struct {
+	mock *MockEmploymentStatusChangeRepository
+}
+
+// NewMockEmploymentStatusChangeRepository creates a new mock instance.
+func NewMockEmploymentStatusChangeRepository(ctrl *gomock.Controller) *MockEmploymentStatusChangeRepository {
+	mock := &MockEmploymentStatusChangeRepository{ctrl: ctrl}
+	mock.recorder = &MockEmploymentStatusChangeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmploymentStatusChangeRepository) EXPECT() *MockEmploymentStatusChangeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockEmploymentStatusChangeRepository) Create(ctx context.Context, change *models.EmploymentStatusChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockEmploymentStatusChangeRepositoryMockRecorder) Create(ctx, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockEmploymentStatusChangeRepository)(nil).Create), ctx, change)
+}
+
+// GetLatestByEmploymentID mocks base method.
+func (m *MockEmploymentStatusChangeRepository) GetLatestByEmploymentID(ctx context.Context, employmentID uuid.UUID) (*models.EmploymentStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestByEmploymentID", ctx, employmentID)
+	ret0, _ := ret[0].(*models.EmploymentStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestByEmploymentID indicates an expected call of GetLatestByEmploymentID.
+func (mr *MockEmploymentStatusChangeRepositoryMockRecorder) GetLatestByEmploymentID(ctx, employmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestByEmploymentID", reflect.TypeOf((*MockEmploymentStatusChangeRepository)(nil).GetLatestByEmploymentID), ctx, employmentID)
+}
+
+// ListByAccountID mocks base method.
+func (m *MockEmploymentStatusChangeRepository) ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.EmploymentStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountID", ctx, accountID)
+	ret0, _ := ret[0].([]models.EmploymentStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountID indicates an expected call of ListByAccountID.
+func (mr *MockEmploymentStatusChangeRepositoryMockRecorder) ListByAccountID(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockEmploymentStatusChangeRepository)(nil).ListByAccountID), ctx, accountID)
+}
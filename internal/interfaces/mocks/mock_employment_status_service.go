@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/employment_status_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockEmploymentStatusService is a mock of EmploymentStatusService interface.
+type MockEmploymentStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmploymentStatusServiceMockRecorder
+}
+
+// MockEmploymentStatusServiceMockRecorder is the mock recorder for MockEmploymentStatusService.
+type MockEmploymentStatusServiceMockRecorder struct {
+	mock *MockEmploymentStatusService
+}
+
+// NewMockEmploymentStatusService creates a new mock instance.
+func NewMockEmploymentStatusService(ctrl *gomock.Controller) *MockEmploymentStatusService {
+	mock := &MockEmploymentStatusService{ctrl: ctrl}
+	mock.recorder = &MockEmploymentStatusServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmploymentStatusService) EXPECT() *MockEmploymentStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// ListStatusChanges mocks base method.
+func (m *MockEmploymentStatusService) ListStatusChanges(ctx context.Context, accountID uuid.UUID) ([]models.EmploymentStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatusChanges", ctx, accountID)
+	ret0, _ := ret[0].([]models.EmploymentStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatusChanges indicates an expected call of ListStatusChanges.
+func (mr *MockEmploymentStatusServiceMockRecorder) ListStatusChanges(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatusChanges", reflect.TypeOf((*MockEmploymentStatusService)(nil).ListStatusChanges), ctx, accountID)
+}
+
+// SyncLongLeaveStatuses mocks base method.
+func (m *MockEmploymentStatusService) SyncLongLeaveStatuses(ctx context.Context, now time.Time) (*models.EmploymentStatusSyncReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncLongLeaveStatuses", ctx, now)
+	ret0, _ := ret[0].(*models.EmploymentStatusSyncReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncLongLeaveStatuses indicates an expected call of SyncLongLeaveStatuses.
+func (mr *MockEmploymentStatusServiceMockRecorder) SyncLongLeaveStatuses(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncLongLeaveStatuses", reflect.TypeOf((*MockEmploymentStatusService)(nil).SyncLongLeaveStatuses), ctx, now)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/holiday_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockHolidayRepository is a mock of HolidayRepository interface.
+type MockHolidayRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHolidayRepositoryMockRecorder
+}
+
+// MockHolidayRepositoryMockRecorder is the mock recorder for MockHolidayRepository.
+type MockHolidayRepositoryMockRecorder struct {
+	mock *MockHolidayRepository
+}
+
+// NewMockHolidayRepository creates a new mock instance.
+func NewMockHolidayRepository(ctrl *gomock.Controller) *MockHolidayRepository {
+	mock := &MockHolidayRepository{ctrl: ctrl}
+	mock.recorder = &MockHolidayRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHolidayRepository) EXPECT() *MockHolidayRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateHoliday mocks base method.
+func (m *MockHolidayRepository) CreateHoliday(ctx context.Context, holiday *models.Holiday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHoliday", ctx, holiday)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateHoliday indicates an expected call of CreateHoliday.
+func (mr *MockHolidayRepositoryMockRecorder) CreateHoliday(ctx, holiday interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHoliday", reflect.TypeOf((*MockHolidayRepository)(nil).CreateHoliday), ctx, holiday)
+}
+
+// DeleteHoliday mocks base method.
+func (m *MockHolidayRepository) DeleteHoliday(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *MockHolidayRepositoryMockRecorder) DeleteHoliday(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*MockHolidayRepository)(nil).DeleteHoliday), ctx, id)
+}
+
+// GetCalendarByYear mocks base method.
+func (m *MockHolidayRepository) GetCalendarByYear(ctx context.Context, year int) (*models.HolidayCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarByYear", ctx, year)
+	ret0, _ := ret[0].(*models.HolidayCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarByYear indicates an expected call of GetCalendarByYear.
+func (mr *MockHolidayRepositoryMockRecorder) GetCalendarByYear(ctx, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarByYear", reflect.TypeOf((*MockHolidayRepository)(nil).GetCalendarByYear), ctx, year)
+}
+
+// GetHolidayByDate mocks base method.
+func (m *MockHolidayRepository) GetHolidayByDate(ctx context.Context, date time.Time) (*models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHolidayByDate", ctx, date)
+	ret0, _ := ret[0].(*models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHolidayByDate indicates an expected call of GetHolidayByDate.
+func (mr *MockHolidayRepositoryMockRecorder) GetHolidayByDate(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolidayByDate", reflect.TypeOf((*MockHolidayRepository)(nil).GetHolidayByDate), ctx, date)
+}
+
+// ListHolidaysBetween mocks base method.
+func (m *MockHolidayRepository) ListHolidaysBetween(ctx context.Context, start, end time.Time) ([]models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolidaysBetween", ctx, start, end)
+	ret0, _ := ret[0].([]models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolidaysBetween indicates an expected call of ListHolidaysBetween.
+func (mr *MockHolidayRepositoryMockRecorder) ListHolidaysBetween(ctx, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolidaysBetween", reflect.TypeOf((*MockHolidayRepository)(nil).ListHolidaysBetween), ctx, start, end)
+}
+
+// ListHolidaysByYear mocks base method.
+func (m *MockHolidayRepository) ListHolidaysByYear(ctx context.Context, year int) ([]models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolidaysByYear", ctx, year)
+	ret0, _ := ret[0].([]models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolidaysByYear indicates an expected call of ListHolidaysByYear.
+func (mr *MockHolidayRepositoryMockRecorder) ListHolidaysByYear(ctx, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolidaysByYear", reflect.TypeOf((*MockHolidayRepository)(nil).ListHolidaysByYear), ctx, year)
+}
+
+// SaveCalendar mocks base method.
+func (m *MockHolidayRepository) SaveCalendar(ctx context.Context, calendar *models.HolidayCalendar) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCalendar", ctx, calendar)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCalendar indicates an expected call of SaveCalendar.
+func (mr *MockHolidayRepositoryMockRecorder) SaveCalendar(ctx, calendar interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCalendar", reflect.TypeOf((*MockHolidayRepository)(nil).SaveCalendar), ctx, calendar)
+}
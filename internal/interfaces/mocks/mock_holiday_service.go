@@ -0,0 +1,143 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/holiday_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockHolidayService is a mock of HolidayService interface.
+type MockHolidayService struct {
+	ctrl     *gomock.Controller
+	recorder *MockHolidayServiceMockRecorder
+}
+
+// MockHolidayServiceMockRecorder is the mock recorder for MockHolidayService.
+type MockHolidayServiceMockRecorder struct {
+	mock *MockHolidayService
+}
+
+// NewMockHolidayService creates a new mock instance.
+func NewMockHolidayService(ctrl *gomock.Controller) *MockHolidayService {
+	mock := &MockHolidayService{ctrl: ctrl}
+	mock.recorder = &MockHolidayServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHolidayService) EXPECT() *MockHolidayServiceMockRecorder {
+	return m.recorder
+}
+
+// AddHoliday mocks base method.
+func (m *MockHolidayService) AddHoliday(ctx context.Context, date time.Time, name string) (*models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHoliday", ctx, date, name)
+	ret0, _ := ret[0].(*models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddHoliday indicates an expected call of AddHoliday.
+func (mr *MockHolidayServiceMockRecorder) AddHoliday(ctx, date, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHoliday", reflect.TypeOf((*MockHolidayService)(nil).AddHoliday), ctx, date, name)
+}
+
+// CountWorkingDays mocks base method.
+func (m *MockHolidayService) CountWorkingDays(ctx context.Context, start, end time.Time) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountWorkingDays", ctx, start, end)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountWorkingDays indicates an expected call of CountWorkingDays.
+func (mr *MockHolidayServiceMockRecorder) CountWorkingDays(ctx, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountWorkingDays", reflect.TypeOf((*MockHolidayService)(nil).CountWorkingDays), ctx, start, end)
+}
+
+// DeleteHoliday mocks base method.
+func (m *MockHolidayService) DeleteHoliday(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *MockHolidayServiceMockRecorder) DeleteHoliday(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*MockHolidayService)(nil).DeleteHoliday), ctx, id)
+}
+
+// GetCalendar mocks base method.
+func (m *MockHolidayService) GetCalendar(ctx context.Context, year int) (*models.HolidayCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx, year)
+	ret0, _ := ret[0].(*models.HolidayCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockHolidayServiceMockRecorder) GetCalendar(ctx, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockHolidayService)(nil).GetCalendar), ctx, year)
+}
+
+// ListHolidays mocks base method.
+func (m *MockHolidayService) ListHolidays(ctx context.Context, year int) ([]models.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolidays", ctx, year)
+	ret0, _ := ret[0].([]models.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolidays indicates an expected call of ListHolidays.
+func (mr *MockHolidayServiceMockRecorder) ListHolidays(ctx, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolidays", reflect.TypeOf((*MockHolidayService)(nil).ListHolidays), ctx, year)
+}
+
+// ListWorkingDays mocks base method.
+func (m *MockHolidayService) ListWorkingDays(ctx context.Context, start, end time.Time) ([]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkingDays", ctx, start, end)
+	ret0, _ := ret[0].([]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkingDays indicates an expected call of ListWorkingDays.
+func (mr *MockHolidayServiceMockRecorder) ListWorkingDays(ctx, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkingDays", reflect.TypeOf((*MockHolidayService)(nil).ListWorkingDays), ctx, start, end)
+}
+
+// SetWeekendDays mocks base method.
+func (m *MockHolidayService) SetWeekendDays(ctx context.Context, year int, weekendDays []time.Weekday) (*models.HolidayCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWeekendDays", ctx, year, weekendDays)
+	ret0, _ := ret[0].(*models.HolidayCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWeekendDays indicates an expected call of SetWeekendDays.
+func (mr *MockHolidayServiceMockRecorder) SetWeekendDays(ctx, year, weekendDays interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWeekendDays", reflect.TypeOf((*MockHolidayService)(nil).SetWeekendDays), ctx, year, weekendDays)
+}
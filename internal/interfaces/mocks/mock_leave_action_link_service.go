@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_action_link_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeaveActionLinkGenerator is a mock of LeaveActionLinkGenerator interface.
+type MockLeaveActionLinkGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveActionLinkGeneratorMockRecorder
+}
+
+// MockLeaveActionLinkGeneratorMockRecorder is the mock recorder for MockLeaveActionLinkGenerator.
+type MockLeaveActionLinkGeneratorMockRecorder struct {
+	mock *MockLeaveActionLinkGenerator
+}
+
+// NewMockLeaveActionLinkGenerator creates a new mock instance.
+func NewMockLeaveActionLinkGenerator(ctrl *gomock.Controller) *MockLeaveActionLinkGenerator {
+	mock := &MockLeaveActionLinkGenerator{ctrl: ctrl}
+	mock.recorder = &MockLeaveActionLinkGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveActionLinkGenerator) EXPECT() *MockLeaveActionLinkGeneratorMockRecorder {
+	return m.recorder
+}
+
+// GenerateLinks mocks base method.
+func (m *MockLeaveActionLinkGenerator) GenerateLinks(ctx context.Context, leaveRequestID, approverID uuid.UUID) (*models.LeaveActionLinks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLinks", ctx, leaveRequestID, approverID)
+	ret0, _ := ret[0].(*models.LeaveActionLinks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLinks indicates an expected call of GenerateLinks.
+func (mr *MockLeaveActionLinkGeneratorMockRecorder) GenerateLinks(ctx, leaveRequestID, approverID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLinks", reflect.TypeOf((*MockLeaveActionLinkGenerator)(nil).GenerateLinks), ctx, leaveRequestID, approverID)
+}
+
+// MockLeaveActionLinkService is a mock of LeaveActionLinkService interface.
+type MockLeaveActionLinkService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveActionLinkServiceMockRecorder
+}
+
+// MockLeaveActionLinkServiceMockRecorder is the mock recorder for MockLeaveActionLinkService.
+type MockLeaveActionLinkServiceMockRecorder struct {
+	mock *MockLeaveActionLinkService
+}
+
+// NewMockLeaveActionLinkService creates a new mock instance.
+func NewMockLeaveActionLinkService(ctrl *gomock.Controller) *MockLeaveActionLinkService {
+	mock := &MockLeaveActionLinkService{ctrl: ctrl}
+	mock.recorder = &MockLeaveActionLinkServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveActionLinkService) EXPECT() *MockLeaveActionLinkServiceMockRecorder {
+	return m.recorder
+}
+
+// ExecuteLink mocks base method.
+func (m *MockLeaveActionLinkService) ExecuteLink(ctx context.Context, token, reason string) (*models.LeaveActionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteLink", ctx, token, reason)
+	ret0, _ := ret[0].(*models.LeaveActionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteLink indicates an expected call of ExecuteLink.
+func (mr *MockLeaveActionLinkServiceMockRecorder) ExecuteLink(ctx, token, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteLink", reflect.TypeOf((*MockLeaveActionLinkService)(nil).ExecuteLink), ctx, token, reason)
+}
+
+// GenerateLinks mocks base method.
+func (m *MockLeaveActionLinkService) GenerateLinks(ctx context.Context, leaveRequestID, approverID uuid.UUID) (*models.LeaveActionLinks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateLinks", ctx, leaveRequestID, approverID)
+	ret0, _ := ret[0].(*models.LeaveActionLinks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateLinks indicates an expected call of GenerateLinks.
+func (mr *MockLeaveActionLinkServiceMockRecorder) GenerateLinks(ctx, leaveRequestID, approverID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateLinks", reflect.TypeOf((*MockLeaveActionLinkService)(nil).GenerateLinks), ctx, leaveRequestID, approverID)
+}
+
+// InspectLink mocks base method.
+func (m *MockLeaveActionLinkService) InspectLink(ctx context.Context, token string) (*models.LeaveActionToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InspectLink", ctx, token)
+	ret0, _ := ret[0].(*models.LeaveActionToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InspectLink indicates an expected call of InspectLink.
+func (mr *MockLeaveActionLinkServiceMockRecorder) InspectLink(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InspectLink", reflect.TypeOf((*MockLeaveActionLinkService)(nil).InspectLink), ctx, token)
+}
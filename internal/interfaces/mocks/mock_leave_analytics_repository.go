@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_analytics_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLeaveAnalyticsRepository is a mock of LeaveAnalyticsRepository interface.
+type MockLeaveAnalyticsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveAnalyticsRepositoryMockRecorder
+}
+
+// MockLeaveAnalyticsRepositoryMockRecorder is the mock recorder for MockLeaveAnalyticsRepository.
+type MockLeaveAnalyticsRepositoryMockRecorder struct {
+	mock *MockLeaveAnalyticsRepository
+}
+
+// NewMockLeaveAnalyticsRepository creates a new mock instance.
+func NewMockLeaveAnalyticsRepository(ctrl *gomock.Controller) *MockLeaveAnalyticsRepository {
+	mock := &MockLeaveAnalyticsRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaveAnalyticsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveAnalyticsRepository) EXPECT() *MockLeaveAnalyticsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AbsenceTotals mocks base method.
+func (m *MockLeaveAnalyticsRepository) AbsenceTotals(ctx context.Context, groupBy string, filter models.LeaveAnalyticsFilter) ([]models.LeaveAbsenceTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbsenceTotals", ctx, groupBy, filter)
+	ret0, _ := ret[0].([]models.LeaveAbsenceTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbsenceTotals indicates an expected call of AbsenceTotals.
+func (mr *MockLeaveAnalyticsRepositoryMockRecorder) AbsenceTotals(ctx, groupBy, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbsenceTotals", reflect.TypeOf((*MockLeaveAnalyticsRepository)(nil).AbsenceTotals), ctx, groupBy, filter)
+}
+
+// ApprovalTurnaroundByLeaveType mocks base method.
+func (m *MockLeaveAnalyticsRepository) ApprovalTurnaroundByLeaveType(ctx context.Context, filter models.LeaveAnalyticsFilter) ([]models.LeaveTurnaroundStat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApprovalTurnaroundByLeaveType", ctx, filter)
+	ret0, _ := ret[0].([]models.LeaveTurnaroundStat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApprovalTurnaroundByLeaveType indicates an expected call of ApprovalTurnaroundByLeaveType.
+func (mr *MockLeaveAnalyticsRepositoryMockRecorder) ApprovalTurnaroundByLeaveType(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApprovalTurnaroundByLeaveType", reflect.TypeOf((*MockLeaveAnalyticsRepository)(nil).ApprovalTurnaroundByLeaveType), ctx, filter)
+}
+
+// BradfordFactors mocks base method.
+func (m *MockLeaveAnalyticsRepository) BradfordFactors(ctx context.Context, filter models.LeaveAnalyticsFilter, limit int) ([]models.BradfordFactorScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BradfordFactors", ctx, filter, limit)
+	ret0, _ := ret[0].([]models.BradfordFactorScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BradfordFactors indicates an expected call of BradfordFactors.
+func (mr *MockLeaveAnalyticsRepositoryMockRecorder) BradfordFactors(ctx, filter, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BradfordFactors", reflect.TypeOf((*MockLeaveAnalyticsRepository)(nil).BradfordFactors), ctx, filter, limit)
+}
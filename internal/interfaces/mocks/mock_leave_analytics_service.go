@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_analytics_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLeaveAnalyticsService is a mock of LeaveAnalyticsService interface.
+type MockLeaveAnalyticsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveAnalyticsServiceMockRecorder
+}
+
+// MockLeaveAnalyticsServiceMockRecorder is the mock recorder for MockLeaveAnalyticsService.
+type MockLeaveAnalyticsServiceMockRecorder struct {
+	mock *MockLeaveAnalyticsService
+}
+
+// NewMockLeaveAnalyticsService creates a new mock instance.
+func NewMockLeaveAnalyticsService(ctrl *gomock.Controller) *MockLeaveAnalyticsService {
+	mock := &MockLeaveAnalyticsService{ctrl: ctrl}
+	mock.recorder = &MockLeaveAnalyticsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveAnalyticsService) EXPECT() *MockLeaveAnalyticsServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAbsenceTotals mocks base method.
+func (m *MockLeaveAnalyticsService) GetAbsenceTotals(ctx context.Context, groupBy string, filter models.LeaveAnalyticsFilter) ([]models.LeaveAbsenceTotal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbsenceTotals", ctx, groupBy, filter)
+	ret0, _ := ret[0].([]models.LeaveAbsenceTotal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbsenceTotals indicates an expected call of GetAbsenceTotals.
+func (mr *MockLeaveAnalyticsServiceMockRecorder) GetAbsenceTotals(ctx, groupBy, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbsenceTotals", reflect.TypeOf((*MockLeaveAnalyticsService)(nil).GetAbsenceTotals), ctx, groupBy, filter)
+}
+
+// GetApprovalTurnaround mocks base method.
+func (m *MockLeaveAnalyticsService) GetApprovalTurnaround(ctx context.Context, filter models.LeaveAnalyticsFilter) (*models.LeaveTurnaroundReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApprovalTurnaround", ctx, filter)
+	ret0, _ := ret[0].(*models.LeaveTurnaroundReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApprovalTurnaround indicates an expected call of GetApprovalTurnaround.
+func (mr *MockLeaveAnalyticsServiceMockRecorder) GetApprovalTurnaround(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApprovalTurnaround", reflect.TypeOf((*MockLeaveAnalyticsService)(nil).GetApprovalTurnaround), ctx, filter)
+}
+
+// GetBradfordFactors mocks base method.
+func (m *MockLeaveAnalyticsService) GetBradfordFactors(ctx context.Context, filter models.LeaveAnalyticsFilter, limit int) ([]models.BradfordFactorScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBradfordFactors", ctx, filter, limit)
+	ret0, _ := ret[0].([]models.BradfordFactorScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBradfordFactors indicates an expected call of GetBradfordFactors.
+func (mr *MockLeaveAnalyticsServiceMockRecorder) GetBradfordFactors(ctx, filter, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBradfordFactors", reflect.TypeOf((*MockLeaveAnalyticsService)(nil).GetBradfordFactors), ctx, filter, limit)
+}
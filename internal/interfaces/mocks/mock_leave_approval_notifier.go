@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_approval_notifier.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLeaveApprovalNotifier is a mock of LeaveApprovalNotifier interface.
+type MockLeaveApprovalNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveApprovalNotifierMockRecorder
+}
+
+// MockLeaveApprovalNotifierMockRecorder is the mock recorder for MockLeaveApprovalNotifier.
+type MockLeaveApprovalNotifierMockRecorder struct {
+	mock *MockLeaveApprovalNotifier
+}
+
+// NewMockLeaveApprovalNotifier creates a new mock instance.
+func NewMockLeaveApprovalNotifier(ctrl *gomock.Controller) *MockLeaveApprovalNotifier {
+	mock := &MockLeaveApprovalNotifier{ctrl: ctrl}
+	mock.recorder = &MockLeaveApprovalNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveApprovalNotifier) EXPECT() *MockLeaveApprovalNotifierMockRecorder {
+	return m.recorder
+}
+
+// NotifyApprovers mocks base method.
+func (m *MockLeaveApprovalNotifier) NotifyApprovers(ctx context.Context, request *models.LeaveRequest, approverKind, subject string) ([]models.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyApprovers", ctx, request, approverKind, subject)
+	ret0, _ := ret[0].([]models.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyApprovers indicates an expected call of NotifyApprovers.
+func (mr *MockLeaveApprovalNotifierMockRecorder) NotifyApprovers(ctx, request, approverKind, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyApprovers", reflect.TypeOf((*MockLeaveApprovalNotifier)(nil).NotifyApprovers), ctx, request, approverKind, subject)
+}
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_approval_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeaveApprovalRepository is a mock of LeaveApprovalRepository interface.
+type MockLeaveApprovalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveApprovalRepositoryMockRecorder
+}
+
+// MockLeaveApprovalRepositoryMockRecorder is the mock recorder for MockLeaveApprovalRepository.
+type MockLeaveApprovalRepositoryMockRecorder struct {
+	mock *MockLeaveApprovalRepository
+}
+
+// NewMockLeaveApprovalRepository creates a new mock instance.
+func NewMockLeaveApprovalRepository(ctrl *gomock.Controller) *MockLeaveApprovalRepository {
+	mock := &MockLeaveApprovalRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaveApprovalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveApprovalRepository) EXPECT() *MockLeaveApprovalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSteps mocks base method.
+func (m *MockLeaveApprovalRepository) CreateSteps(ctx context.Context, steps []models.LeaveApprovalStep) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSteps", ctx, steps)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSteps indicates an expected call of CreateSteps.
+func (mr *MockLeaveApprovalRepositoryMockRecorder) CreateSteps(ctx, steps interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSteps", reflect.TypeOf((*MockLeaveApprovalRepository)(nil).CreateSteps), ctx, steps)
+}
+
+// DeleteStepsByRequestID mocks base method.
+func (m *MockLeaveApprovalRepository) DeleteStepsByRequestID(ctx context.Context, leaveRequestID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStepsByRequestID", ctx, leaveRequestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStepsByRequestID indicates an expected call of DeleteStepsByRequestID.
+func (mr *MockLeaveApprovalRepositoryMockRecorder) DeleteStepsByRequestID(ctx, leaveRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStepsByRequestID", reflect.TypeOf((*MockLeaveApprovalRepository)(nil).DeleteStepsByRequestID), ctx, leaveRequestID)
+}
+
+// ListActivePolicies mocks base method.
+func (m *MockLeaveApprovalRepository) ListActivePolicies(ctx context.Context) ([]models.LeaveApprovalPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivePolicies", ctx)
+	ret0, _ := ret[0].([]models.LeaveApprovalPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivePolicies indicates an expected call of ListActivePolicies.
+func (mr *MockLeaveApprovalRepositoryMockRecorder) ListActivePolicies(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivePolicies", reflect.TypeOf((*MockLeaveApprovalRepository)(nil).ListActivePolicies), ctx)
+}
+
+// ListStepsByRequestID mocks base method.
+func (m *MockLeaveApprovalRepository) ListStepsByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveApprovalStep, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStepsByRequestID", ctx, leaveRequestID)
+	ret0, _ := ret[0].([]models.LeaveApprovalStep)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStepsByRequestID indicates an expected call of ListStepsByRequestID.
+func (mr *MockLeaveApprovalRepositoryMockRecorder) ListStepsByRequestID(ctx, leaveRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStepsByRequestID", reflect.TypeOf((*MockLeaveApprovalRepository)(nil).ListStepsByRequestID), ctx, leaveRequestID)
+}
+
+// UpdateStep mocks base method.
+func (m *MockLeaveApprovalRepository) UpdateStep(ctx context.Context, step *models.LeaveApprovalStep) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStep", ctx, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStep indicates an expected call of UpdateStep.
+func (mr *MockLeaveApprovalRepositoryMockRecorder) UpdateStep(ctx, step interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStep", reflect.TypeOf((*MockLeaveApprovalRepository)(nil).UpdateStep), ctx, step)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_attachment_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeaveAttachmentRepository is a mock of LeaveAttachmentRepository interface.
+type MockLeaveAttachmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveAttachmentRepositoryMockRecorder
+}
+
+// MockLeaveAttachmentRepositoryMockRecorder is the mock recorder for MockLeaveAttachmentRepository.
+type MockLeaveAttachmentRepositoryMockRecorder struct {
+	mock *MockLeaveAttachmentRepository
+}
+
+// NewMockLeaveAttachmentRepository creates a new mock instance.
+func NewMockLeaveAttachmentRepository(ctrl *gomock.Controller) *MockLeaveAttachmentRepository {
+	mock := &MockLeaveAttachmentRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaveAttachmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveAttachmentRepository) EXPECT() *MockLeaveAttachmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByRequestID mocks base method.
+func (m *MockLeaveAttachmentRepository) CountByRequestID(ctx context.Context, leaveRequestID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByRequestID", ctx, leaveRequestID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByRequestID indicates an expected call of CountByRequestID.
+func (mr *MockLeaveAttachmentRepositoryMockRecorder) CountByRequestID(ctx, leaveRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByRequestID", reflect.TypeOf((*MockLeaveAttachmentRepository)(nil).CountByRequestID), ctx, leaveRequestID)
+}
+
+// Create mocks base method.
+func (m *MockLeaveAttachmentRepository) Create(ctx context.Context, attachment *models.LeaveAttachment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, attachment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLeaveAttachmentRepositoryMockRecorder) Create(ctx, attachment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLeaveAttachmentRepository)(nil).Create), ctx, attachment)
+}
+
+// GetByID mocks base method.
+func (m *MockLeaveAttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.LeaveAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.LeaveAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLeaveAttachmentRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLeaveAttachmentRepository)(nil).GetByID), ctx, id)
+}
+
+// GetRuleByLeaveType mocks base method.
+func (m *MockLeaveAttachmentRepository) GetRuleByLeaveType(ctx context.Context, leaveType string) (*models.LeaveAttachmentRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRuleByLeaveType", ctx, leaveType)
+	ret0, _ := ret[0].(*models.LeaveAttachmentRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRuleByLeaveType indicates an expected call of GetRuleByLeaveType.
+func (mr *MockLeaveAttachmentRepositoryMockRecorder) GetRuleByLeaveType(ctx, leaveType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRuleByLeaveType", reflect.TypeOf((*MockLeaveAttachmentRepository)(nil).GetRuleByLeaveType), ctx, leaveType)
+}
+
+// ListByRequestID mocks base method.
+func (m *MockLeaveAttachmentRepository) ListByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByRequestID", ctx, leaveRequestID)
+	ret0, _ := ret[0].([]models.LeaveAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByRequestID indicates an expected call of ListByRequestID.
+func (mr *MockLeaveAttachmentRepositoryMockRecorder) ListByRequestID(ctx, leaveRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRequestID", reflect.TypeOf((*MockLeaveAttachmentRepository)(nil).ListByRequestID), ctx, leaveRequestID)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_attachment_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockLeaveAttachmentService is a mock of LeaveAttachmentService interface.
+type MockLeaveAttachmentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveAttachmentServiceMockRecorder
+}
+
+// MockLeaveAttachmentServiceMockRecorder is the mock recorder for MockLeaveAttachmentService.
+type MockLeaveAttachmentServiceMockRecorder struct {
+	mock *MockLeaveAttachmentService
+}
+
+// NewMockLeaveAttachmentService creates a new mock instance.
+func NewMockLeaveAttachmentService(ctrl *gomock.Controller) *MockLeaveAttachmentService {
+	mock := &MockLeaveAttachmentService{ctrl: ctrl}
+	mock.recorder = &MockLeaveAttachmentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveAttachmentService) EXPECT() *MockLeaveAttachmentServiceMockRecorder {
+	return m.recorder
+}
+
+// HasAttachments mocks base method.
+func (m *MockLeaveAttachmentService) HasAttachments(ctx context.Context, leaveRequestID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasAttachments", ctx, leaveRequestID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasAttachments indicates an expected call of HasAttachments.
+func (mr *MockLeaveAttachmentServiceMockRecorder) HasAttachments(ctx, leaveRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAttachments", reflect.TypeOf((*MockLeaveAttachmentService)(nil).HasAttachments), ctx, leaveRequestID)
+}
+
+// IsAttachmentRequired mocks base method.
+func (m *MockLeaveAttachmentService) IsAttachmentRequired(ctx context.Context, leaveType string, days decimal.Decimal) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAttachmentRequired", ctx, leaveType, days)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAttachmentRequired indicates an expected call of IsAttachmentRequired.
+func (mr *MockLeaveAttachmentServiceMockRecorder) IsAttachmentRequired(ctx, leaveType, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAttachmentRequired", reflect.TypeOf((*MockLeaveAttachmentService)(nil).IsAttachmentRequired), ctx, leaveType, days)
+}
+
+// ListAttachments mocks base method.
+func (m *MockLeaveAttachmentService) ListAttachments(ctx context.Context, leaveRequestIDStr, viewerAccountIDStr string) ([]models.LeaveAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, leaveRequestIDStr, viewerAccountIDStr)
+	ret0, _ := ret[0].([]models.LeaveAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockLeaveAttachmentServiceMockRecorder) ListAttachments(ctx, leaveRequestIDStr, viewerAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockLeaveAttachmentService)(nil).ListAttachments), ctx, leaveRequestIDStr, viewerAccountIDStr)
+}
+
+// OpenAttachment mocks base method.
+func (m *MockLeaveAttachmentService) OpenAttachment(ctx context.Context, leaveRequestIDStr, attachmentIDStr, viewerAccountIDStr string) (*models.LeaveAttachment, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAttachment", ctx, leaveRequestIDStr, attachmentIDStr, viewerAccountIDStr)
+	ret0, _ := ret[0].(*models.LeaveAttachment)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenAttachment indicates an expected call of OpenAttachment.
+func (mr *MockLeaveAttachmentServiceMockRecorder) OpenAttachment(ctx, leaveRequestIDStr, attachmentIDStr, viewerAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAttachment", reflect.TypeOf((*MockLeaveAttachmentService)(nil).OpenAttachment), ctx, leaveRequestIDStr, attachmentIDStr, viewerAccountIDStr)
+}
+
+// SaveAttachment mocks base method.
+func (m *MockLeaveAttachmentService) SaveAttachment(ctx context.Context, leaveRequestID, uploaderID uuid.UUID, upload models.AttachmentUpload) (*models.LeaveAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttachment", ctx, leaveRequestID, uploaderID, upload)
+	ret0, _ := ret[0].(*models.LeaveAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveAttachment indicates an expected call of SaveAttachment.
+func (mr *MockLeaveAttachmentServiceMockRecorder) SaveAttachment(ctx, leaveRequestID, uploaderID, upload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttachment", reflect.TypeOf((*MockLeaveAttachmentService)(nil).SaveAttachment), ctx, leaveRequestID, uploaderID, upload)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockLeaveAttachmentService) UploadAttachment(ctx context.Context, leaveRequestIDStr, uploaderAccountIDStr string, upload models.AttachmentUpload) (*models.LeaveAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, leaveRequestIDStr, uploaderAccountIDStr, upload)
+	ret0, _ := ret[0].(*models.LeaveAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockLeaveAttachmentServiceMockRecorder) UploadAttachment(ctx, leaveRequestIDStr, uploaderAccountIDStr, upload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockLeaveAttachmentService)(nil).UploadAttachment), ctx, leaveRequestIDStr, uploaderAccountIDStr, upload)
+}
+
+// ValidateUpload mocks base method.
+func (m *MockLeaveAttachmentService) ValidateUpload(upload models.AttachmentUpload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUpload", upload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateUpload indicates an expected call of ValidateUpload.
+func (mr *MockLeaveAttachmentServiceMockRecorder) ValidateUpload(upload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUpload", reflect.TypeOf((*MockLeaveAttachmentService)(nil).ValidateUpload), upload)
+}
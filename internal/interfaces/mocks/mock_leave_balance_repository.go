@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_balance_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockLeaveBalanceRepository is a mock of LeaveBalanceRepository interface.
+type MockLeaveBalanceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveBalanceRepositoryMockRecorder
+}
+
+// MockLeaveBalanceRepositoryMockRecorder is the mock recorder for MockLeaveBalanceRepository.
+type MockLeaveBalanceRepositoryMockRecorder struct {
+	mock *MockLeaveBalanceRepository
+}
+
+// NewMockLeaveBalanceRepository creates a new mock instance.
+func NewMockLeaveBalanceRepository(ctrl *gomock.Controller) *MockLeaveBalanceRepository {
+	mock := &MockLeaveBalanceRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaveBalanceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveBalanceRepository) EXPECT() *MockLeaveBalanceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEntry mocks base method.
+func (m *MockLeaveBalanceRepository) CreateEntry(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) CreateEntry(ctx, entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).CreateEntry), ctx, entry)
+}
+
+// GetLatestEntry mocks base method.
+func (m *MockLeaveBalanceRepository) GetLatestEntry(ctx context.Context, accountID uuid.UUID, leaveType, entryType string) (*models.LeaveBalanceEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestEntry", ctx, accountID, leaveType, entryType)
+	ret0, _ := ret[0].(*models.LeaveBalanceEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestEntry indicates an expected call of GetLatestEntry.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) GetLatestEntry(ctx, accountID, leaveType, entryType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestEntry", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).GetLatestEntry), ctx, accountID, leaveType, entryType)
+}
+
+// ListActiveAccrualRules mocks base method.
+func (m *MockLeaveBalanceRepository) ListActiveAccrualRules(ctx context.Context) ([]models.LeaveAccrualRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveAccrualRules", ctx)
+	ret0, _ := ret[0].([]models.LeaveAccrualRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveAccrualRules indicates an expected call of ListActiveAccrualRules.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) ListActiveAccrualRules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveAccrualRules", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).ListActiveAccrualRules), ctx)
+}
+
+// ListCarryOverRecords mocks base method.
+func (m *MockLeaveBalanceRepository) ListCarryOverRecords(ctx context.Context, year int) ([]models.LeaveCarryOverRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCarryOverRecords", ctx, year)
+	ret0, _ := ret[0].([]models.LeaveCarryOverRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCarryOverRecords indicates an expected call of ListCarryOverRecords.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) ListCarryOverRecords(ctx, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCarryOverRecords", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).ListCarryOverRecords), ctx, year)
+}
+
+// ListEntries mocks base method.
+func (m *MockLeaveBalanceRepository) ListEntries(ctx context.Context, accountID uuid.UUID, leaveType string) ([]models.LeaveBalanceEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, accountID, leaveType)
+	ret0, _ := ret[0].([]models.LeaveBalanceEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) ListEntries(ctx, accountID, leaveType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).ListEntries), ctx, accountID, leaveType)
+}
+
+// LockAccount mocks base method.
+func (m *MockLeaveBalanceRepository) LockAccount(ctx context.Context, accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccount", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAccount indicates an expected call of LockAccount.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) LockAccount(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccount", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).LockAccount), ctx, accountID)
+}
+
+// PostYearEnd mocks base method.
+func (m *MockLeaveBalanceRepository) PostYearEnd(ctx context.Context, record *models.LeaveCarryOverRecord, entries []*models.LeaveBalanceEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostYearEnd", ctx, record, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostYearEnd indicates an expected call of PostYearEnd.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) PostYearEnd(ctx, record, entries interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostYearEnd", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).PostYearEnd), ctx, record, entries)
+}
+
+// SumByAccount mocks base method.
+func (m *MockLeaveBalanceRepository) SumByAccount(ctx context.Context, accountID uuid.UUID, asOf time.Time) ([]models.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumByAccount", ctx, accountID, asOf)
+	ret0, _ := ret[0].([]models.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumByAccount indicates an expected call of SumByAccount.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) SumByAccount(ctx, accountID, asOf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumByAccount", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).SumByAccount), ctx, accountID, asOf)
+}
+
+// SumEntriesBetween mocks base method.
+func (m *MockLeaveBalanceRepository) SumEntriesBetween(ctx context.Context, accountID uuid.UUID, leaveType string, entryTypes []string, from, to time.Time) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumEntriesBetween", ctx, accountID, leaveType, entryTypes, from, to)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumEntriesBetween indicates an expected call of SumEntriesBetween.
+func (mr *MockLeaveBalanceRepositoryMockRecorder) SumEntriesBetween(ctx, accountID, leaveType, entryTypes, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumEntriesBetween", reflect.TypeOf((*MockLeaveBalanceRepository)(nil).SumEntriesBetween), ctx, accountID, leaveType, entryTypes, from, to)
+}
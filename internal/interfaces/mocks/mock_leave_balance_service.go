@@ -0,0 +1,183 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_balance_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	decimal "github.com/shopspring/decimal"
+)
+
+// MockLeaveBalanceService is a mock of LeaveBalanceService interface.
+type MockLeaveBalanceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveBalanceServiceMockRecorder
+}
+
+// MockLeaveBalanceServiceMockRecorder is the mock recorder for MockLeaveBalanceService.
+type MockLeaveBalanceServiceMockRecorder struct {
+	mock *MockLeaveBalanceService
+}
+
+// NewMockLeaveBalanceService creates a new mock instance.
+func NewMockLeaveBalanceService(ctrl *gomock.Controller) *MockLeaveBalanceService {
+	mock := &MockLeaveBalanceService{ctrl: ctrl}
+	mock.recorder = &MockLeaveBalanceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveBalanceService) EXPECT() *MockLeaveBalanceServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckSufficientBalance mocks base method.
+func (m *MockLeaveBalanceService) CheckSufficientBalance(ctx context.Context, accountID uuid.UUID, leaveType string, days decimal.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckSufficientBalance", ctx, accountID, leaveType, days)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckSufficientBalance indicates an expected call of CheckSufficientBalance.
+func (mr *MockLeaveBalanceServiceMockRecorder) CheckSufficientBalance(ctx, accountID, leaveType, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSufficientBalance", reflect.TypeOf((*MockLeaveBalanceService)(nil).CheckSufficientBalance), ctx, accountID, leaveType, days)
+}
+
+// CreditCompOff mocks base method.
+func (m *MockLeaveBalanceService) CreditCompOff(ctx context.Context, claim *models.OvertimeClaim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreditCompOff", ctx, claim)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreditCompOff indicates an expected call of CreditCompOff.
+func (mr *MockLeaveBalanceServiceMockRecorder) CreditCompOff(ctx, claim interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreditCompOff", reflect.TypeOf((*MockLeaveBalanceService)(nil).CreditCompOff), ctx, claim)
+}
+
+// DebitForLeave mocks base method.
+func (m *MockLeaveBalanceService) DebitForLeave(ctx context.Context, request *models.LeaveRequest, days decimal.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DebitForLeave", ctx, request, days)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DebitForLeave indicates an expected call of DebitForLeave.
+func (mr *MockLeaveBalanceServiceMockRecorder) DebitForLeave(ctx, request, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DebitForLeave", reflect.TypeOf((*MockLeaveBalanceService)(nil).DebitForLeave), ctx, request, days)
+}
+
+// GetBalances mocks base method.
+func (m *MockLeaveBalanceService) GetBalances(ctx context.Context, accountID uuid.UUID) ([]models.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalances", ctx, accountID)
+	ret0, _ := ret[0].([]models.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalances indicates an expected call of GetBalances.
+func (mr *MockLeaveBalanceServiceMockRecorder) GetBalances(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalances", reflect.TypeOf((*MockLeaveBalanceService)(nil).GetBalances), ctx, accountID)
+}
+
+// GetYearEndSummary mocks base method.
+func (m *MockLeaveBalanceService) GetYearEndSummary(ctx context.Context, year int) (*models.LeaveYearEndSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetYearEndSummary", ctx, year)
+	ret0, _ := ret[0].(*models.LeaveYearEndSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetYearEndSummary indicates an expected call of GetYearEndSummary.
+func (mr *MockLeaveBalanceServiceMockRecorder) GetYearEndSummary(ctx, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearEndSummary", reflect.TypeOf((*MockLeaveBalanceService)(nil).GetYearEndSummary), ctx, year)
+}
+
+// PostAccruals mocks base method.
+func (m *MockLeaveBalanceService) PostAccruals(ctx context.Context, asOf time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostAccruals", ctx, asOf)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PostAccruals indicates an expected call of PostAccruals.
+func (mr *MockLeaveBalanceServiceMockRecorder) PostAccruals(ctx, asOf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostAccruals", reflect.TypeOf((*MockLeaveBalanceService)(nil).PostAccruals), ctx, asOf)
+}
+
+// RestoreForLeave mocks base method.
+func (m *MockLeaveBalanceService) RestoreForLeave(ctx context.Context, request *models.LeaveRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreForLeave", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreForLeave indicates an expected call of RestoreForLeave.
+func (mr *MockLeaveBalanceServiceMockRecorder) RestoreForLeave(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreForLeave", reflect.TypeOf((*MockLeaveBalanceService)(nil).RestoreForLeave), ctx, request)
+}
+
+// RestoreForShortenedLeave mocks base method.
+func (m *MockLeaveBalanceService) RestoreForShortenedLeave(ctx context.Context, request *models.LeaveRequest, days decimal.Decimal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreForShortenedLeave", ctx, request, days)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreForShortenedLeave indicates an expected call of RestoreForShortenedLeave.
+func (mr *MockLeaveBalanceServiceMockRecorder) RestoreForShortenedLeave(ctx, request, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreForShortenedLeave", reflect.TypeOf((*MockLeaveBalanceService)(nil).RestoreForShortenedLeave), ctx, request, days)
+}
+
+// RunYearEnd mocks base method.
+func (m *MockLeaveBalanceService) RunYearEnd(ctx context.Context, year int) (*models.LeaveYearEndSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunYearEnd", ctx, year)
+	ret0, _ := ret[0].(*models.LeaveYearEndSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunYearEnd indicates an expected call of RunYearEnd.
+func (mr *MockLeaveBalanceServiceMockRecorder) RunYearEnd(ctx, year interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunYearEnd", reflect.TypeOf((*MockLeaveBalanceService)(nil).RunYearEnd), ctx, year)
+}
+
+// SettleForTermination mocks base method.
+func (m *MockLeaveBalanceService) SettleForTermination(ctx context.Context, accountID uuid.UUID, leaveType string, terminationDate time.Time) (*models.LeaveBalanceSettlement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleForTermination", ctx, accountID, leaveType, terminationDate)
+	ret0, _ := ret[0].(*models.LeaveBalanceSettlement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleForTermination indicates an expected call of SettleForTermination.
+func (mr *MockLeaveBalanceServiceMockRecorder) SettleForTermination(ctx, accountID, leaveType, terminationDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleForTermination", reflect.TypeOf((*MockLeaveBalanceService)(nil).SettleForTermination), ctx, accountID, leaveType, terminationDate)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_calendar_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLeaveCalendarService is a mock of LeaveCalendarService interface.
+type MockLeaveCalendarService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveCalendarServiceMockRecorder
+}
+
+// MockLeaveCalendarServiceMockRecorder is the mock recorder for MockLeaveCalendarService.
+type MockLeaveCalendarServiceMockRecorder struct {
+	mock *MockLeaveCalendarService
+}
+
+// NewMockLeaveCalendarService creates a new mock instance.
+func NewMockLeaveCalendarService(ctrl *gomock.Controller) *MockLeaveCalendarService {
+	mock := &MockLeaveCalendarService{ctrl: ctrl}
+	mock.recorder = &MockLeaveCalendarServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveCalendarService) EXPECT() *MockLeaveCalendarServiceMockRecorder {
+	return m.recorder
+}
+
+// GetTeamCalendar mocks base method.
+func (m *MockLeaveCalendarService) GetTeamCalendar(ctx context.Context, viewerAccountIDStr string, query models.LeaveCalendarQuery) ([]models.LeaveCalendarDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamCalendar", ctx, viewerAccountIDStr, query)
+	ret0, _ := ret[0].([]models.LeaveCalendarDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamCalendar indicates an expected call of GetTeamCalendar.
+func (mr *MockLeaveCalendarServiceMockRecorder) GetTeamCalendar(ctx, viewerAccountIDStr, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamCalendar", reflect.TypeOf((*MockLeaveCalendarService)(nil).GetTeamCalendar), ctx, viewerAccountIDStr, query)
+}
+
+// IssueFeedToken mocks base method.
+func (m *MockLeaveCalendarService) IssueFeedToken(ctx context.Context, viewerAccountIDStr, feed, subjectIDStr string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueFeedToken", ctx, viewerAccountIDStr, feed, subjectIDStr)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueFeedToken indicates an expected call of IssueFeedToken.
+func (mr *MockLeaveCalendarServiceMockRecorder) IssueFeedToken(ctx, viewerAccountIDStr, feed, subjectIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueFeedToken", reflect.TypeOf((*MockLeaveCalendarService)(nil).IssueFeedToken), ctx, viewerAccountIDStr, feed, subjectIDStr)
+}
+
+// ListAccountFeed mocks base method.
+func (m *MockLeaveCalendarService) ListAccountFeed(ctx context.Context, viewerAccountIDStr, accountIDStr string) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountFeed", ctx, viewerAccountIDStr, accountIDStr)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountFeed indicates an expected call of ListAccountFeed.
+func (mr *MockLeaveCalendarServiceMockRecorder) ListAccountFeed(ctx, viewerAccountIDStr, accountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountFeed", reflect.TypeOf((*MockLeaveCalendarService)(nil).ListAccountFeed), ctx, viewerAccountIDStr, accountIDStr)
+}
+
+// ListTeamFeed mocks base method.
+func (m *MockLeaveCalendarService) ListTeamFeed(ctx context.Context, viewerAccountIDStr, managerAccountIDStr string) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTeamFeed", ctx, viewerAccountIDStr, managerAccountIDStr)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTeamFeed indicates an expected call of ListTeamFeed.
+func (mr *MockLeaveCalendarServiceMockRecorder) ListTeamFeed(ctx, viewerAccountIDStr, managerAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTeamFeed", reflect.TypeOf((*MockLeaveCalendarService)(nil).ListTeamFeed), ctx, viewerAccountIDStr, managerAccountIDStr)
+}
+
+// RevokeFeedTokens mocks base method.
+func (m *MockLeaveCalendarService) RevokeFeedTokens(ctx context.Context, viewerAccountIDStr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFeedTokens", ctx, viewerAccountIDStr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFeedTokens indicates an expected call of RevokeFeedTokens.
+func (mr *MockLeaveCalendarServiceMockRecorder) RevokeFeedTokens(ctx, viewerAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFeedTokens", reflect.TypeOf((*MockLeaveCalendarService)(nil).RevokeFeedTokens), ctx, viewerAccountIDStr)
+}
+
+// VerifyFeedToken mocks base method.
+func (m *MockLeaveCalendarService) VerifyFeedToken(ctx context.Context, token string) (*models.CalendarFeedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyFeedToken", ctx, token)
+	ret0, _ := ret[0].(*models.CalendarFeedToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyFeedToken indicates an expected call of VerifyFeedToken.
+func (mr *MockLeaveCalendarServiceMockRecorder) VerifyFeedToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyFeedToken", reflect.TypeOf((*MockLeaveCalendarService)(nil).VerifyFeedToken), ctx, token)
+}
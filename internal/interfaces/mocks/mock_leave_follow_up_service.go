@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_follow_up_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLeaveFollowUpService is a mock of LeaveFollowUpService interface.
+type MockLeaveFollowUpService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveFollowUpServiceMockRecorder
+}
+
+// MockLeaveFollowUpServiceMockRecorder is the mock recorder for MockLeaveFollowUpService.
+type MockLeaveFollowUpServiceMockRecorder struct {
+	mock *MockLeaveFollowUpService
+}
+
+// NewMockLeaveFollowUpService creates a new mock instance.
+func NewMockLeaveFollowUpService(ctrl *gomock.Controller) *MockLeaveFollowUpService {
+	mock := &MockLeaveFollowUpService{ctrl: ctrl}
+	mock.recorder = &MockLeaveFollowUpServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveFollowUpService) EXPECT() *MockLeaveFollowUpServiceMockRecorder {
+	return m.recorder
+}
+
+// ProcessStaleRequests mocks base method.
+func (m *MockLeaveFollowUpService) ProcessStaleRequests(ctx context.Context, now time.Time) (*models.LeaveFollowUpReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessStaleRequests", ctx, now)
+	ret0, _ := ret[0].(*models.LeaveFollowUpReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessStaleRequests indicates an expected call of ProcessStaleRequests.
+func (mr *MockLeaveFollowUpServiceMockRecorder) ProcessStaleRequests(ctx, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessStaleRequests", reflect.TypeOf((*MockLeaveFollowUpService)(nil).ProcessStaleRequests), ctx, now)
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_request_event_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeaveRequestEventRepository is a mock of LeaveRequestEventRepository interface.
+type MockLeaveRequestEventRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveRequestEventRepositoryMockRecorder
+}
+
+// MockLeaveRequestEventRepositoryMockRecorder is the mock recorder for MockLeaveRequestEventRepository.
+type MockLeaveRequestEventRepositoryMockRecorder struct {
+	mock *MockLeaveRequestEventRepository
+}
+
+// NewMockLeaveRequestEventRepository creates a new mock instance.
+func NewMockLeaveRequestEventRepository(ctrl *gomock.Controller) *MockLeaveRequestEventRepository {
+	mock := &MockLeaveRequestEventRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaveRequestEventRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveRequestEventRepository) EXPECT() *MockLeaveRequestEventRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockLeaveRequestEventRepository) Create(ctx context.Context, event *models.LeaveRequestEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLeaveRequestEventRepositoryMockRecorder) Create(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLeaveRequestEventRepository)(nil).Create), ctx, event)
+}
+
+// ListByRequestID mocks base method.
+func (m *MockLeaveRequestEventRepository) ListByRequestID(ctx context.Context, leaveRequestID uuid.UUID) ([]models.LeaveRequestEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByRequestID", ctx, leaveRequestID)
+	ret0, _ := ret[0].([]models.LeaveRequestEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByRequestID indicates an expected call of ListByRequestID.
+func (mr *MockLeaveRequestEventRepositoryMockRecorder) ListByRequestID(ctx, leaveRequestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByRequestID", reflect.TypeOf((*MockLeaveRequestEventRepository)(nil).ListByRequestID), ctx, leaveRequestID)
+}
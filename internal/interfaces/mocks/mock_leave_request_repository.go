@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	models "github.com/erinchen11/hr-system/internal/models"
 	gomock "github.com/golang/mock/gomock"
@@ -80,6 +81,21 @@ func (mr *MockLeaveRequestRepositoryMockRecorder) ListAllWithAccount(ctx interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllWithAccount", reflect.TypeOf((*MockLeaveRequestRepository)(nil).ListAllWithAccount), ctx)
 }
 
+// ListApprovedCoveringDate mocks base method.
+func (m *MockLeaveRequestRepository) ListApprovedCoveringDate(ctx context.Context, date time.Time) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApprovedCoveringDate", ctx, date)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApprovedCoveringDate indicates an expected call of ListApprovedCoveringDate.
+func (mr *MockLeaveRequestRepositoryMockRecorder) ListApprovedCoveringDate(ctx, date interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApprovedCoveringDate", reflect.TypeOf((*MockLeaveRequestRepository)(nil).ListApprovedCoveringDate), ctx, date)
+}
+
 // ListByAccountID mocks base method.
 func (m *MockLeaveRequestRepository) ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.LeaveRequest, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +111,97 @@ func (mr *MockLeaveRequestRepositoryMockRecorder) ListByAccountID(ctx, accountID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockLeaveRequestRepository)(nil).ListByAccountID), ctx, accountID)
 }
 
+// ListByAccountIDs mocks base method.
+func (m *MockLeaveRequestRepository) ListByAccountIDs(ctx context.Context, accountIDs []uuid.UUID) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountIDs", ctx, accountIDs)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountIDs indicates an expected call of ListByAccountIDs.
+func (mr *MockLeaveRequestRepositoryMockRecorder) ListByAccountIDs(ctx, accountIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountIDs", reflect.TypeOf((*MockLeaveRequestRepository)(nil).ListByAccountIDs), ctx, accountIDs)
+}
+
+// ListFiltered mocks base method.
+func (m *MockLeaveRequestRepository) ListFiltered(ctx context.Context, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiltered", ctx, filter)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFiltered indicates an expected call of ListFiltered.
+func (mr *MockLeaveRequestRepositoryMockRecorder) ListFiltered(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiltered", reflect.TypeOf((*MockLeaveRequestRepository)(nil).ListFiltered), ctx, filter)
+}
+
+// ListInRange mocks base method.
+func (m *MockLeaveRequestRepository) ListInRange(ctx context.Context, accountIDs []uuid.UUID, start, end time.Time, statuses []string) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInRange", ctx, accountIDs, start, end, statuses)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInRange indicates an expected call of ListInRange.
+func (mr *MockLeaveRequestRepositoryMockRecorder) ListInRange(ctx, accountIDs, start, end, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInRange", reflect.TypeOf((*MockLeaveRequestRepository)(nil).ListInRange), ctx, accountIDs, start, end, statuses)
+}
+
+// ListOverlapping mocks base method.
+func (m *MockLeaveRequestRepository) ListOverlapping(ctx context.Context, accountID uuid.UUID, start, end time.Time, statuses []string) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverlapping", ctx, accountID, start, end, statuses)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverlapping indicates an expected call of ListOverlapping.
+func (mr *MockLeaveRequestRepositoryMockRecorder) ListOverlapping(ctx, accountID, start, end, statuses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverlapping", reflect.TypeOf((*MockLeaveRequestRepository)(nil).ListOverlapping), ctx, accountID, start, end, statuses)
+}
+
+// ListPendingRequestedBefore mocks base method.
+func (m *MockLeaveRequestRepository) ListPendingRequestedBefore(ctx context.Context, before time.Time) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingRequestedBefore", ctx, before)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingRequestedBefore indicates an expected call of ListPendingRequestedBefore.
+func (mr *MockLeaveRequestRepositoryMockRecorder) ListPendingRequestedBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingRequestedBefore", reflect.TypeOf((*MockLeaveRequestRepository)(nil).ListPendingRequestedBefore), ctx, before)
+}
+
+// LockOverlapping mocks base method.
+func (m *MockLeaveRequestRepository) LockOverlapping(ctx context.Context, accountID uuid.UUID, start, end time.Time) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockOverlapping", ctx, accountID, start, end)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockOverlapping indicates an expected call of LockOverlapping.
+func (mr *MockLeaveRequestRepositoryMockRecorder) LockOverlapping(ctx, accountID, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockOverlapping", reflect.TypeOf((*MockLeaveRequestRepository)(nil).LockOverlapping), ctx, accountID, start, end)
+}
+
 // Update mocks base method.
 func (m *MockLeaveRequestRepository) Update(ctx context.Context, request *models.LeaveRequest) error {
 	m.ctrl.T.Helper()
@@ -108,3 +215,17 @@ func (mr *MockLeaveRequestRepositoryMockRecorder) Update(ctx, request interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLeaveRequestRepository)(nil).Update), ctx, request)
 }
+
+// UpdateFollowUp mocks base method.
+func (m *MockLeaveRequestRepository) UpdateFollowUp(ctx context.Context, request *models.LeaveRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFollowUp", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFollowUp indicates an expected call of UpdateFollowUp.
+func (mr *MockLeaveRequestRepositoryMockRecorder) UpdateFollowUp(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFollowUp", reflect.TypeOf((*MockLeaveRequestRepository)(nil).UpdateFollowUp), ctx, request)
+}
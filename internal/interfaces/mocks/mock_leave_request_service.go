@@ -11,6 +11,7 @@ import (
 
 	models "github.com/erinchen11/hr-system/internal/models"
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 )
 
 // MockLeaveRequestService is a mock of LeaveRequestService interface.
@@ -37,18 +38,33 @@ func (m *MockLeaveRequestService) EXPECT() *MockLeaveRequestServiceMockRecorder
 }
 
 // ApplyForLeave mocks base method.
-func (m *MockLeaveRequestService) ApplyForLeave(ctx context.Context, accountIDStr, leaveType, reason string, startDate, endDate time.Time) (*models.LeaveRequest, error) {
+func (m *MockLeaveRequestService) ApplyForLeave(ctx context.Context, accountIDStr string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ApplyForLeave", ctx, accountIDStr, leaveType, reason, startDate, endDate)
+	ret := m.ctrl.Call(m, "ApplyForLeave", ctx, accountIDStr, input)
 	ret0, _ := ret[0].(*models.LeaveRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ApplyForLeave indicates an expected call of ApplyForLeave.
-func (mr *MockLeaveRequestServiceMockRecorder) ApplyForLeave(ctx, accountIDStr, leaveType, reason, startDate, endDate interface{}) *gomock.Call {
+func (mr *MockLeaveRequestServiceMockRecorder) ApplyForLeave(ctx, accountIDStr, input interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyForLeave", reflect.TypeOf((*MockLeaveRequestService)(nil).ApplyForLeave), ctx, accountIDStr, leaveType, reason, startDate, endDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyForLeave", reflect.TypeOf((*MockLeaveRequestService)(nil).ApplyForLeave), ctx, accountIDStr, input)
+}
+
+// ApplyForLeaveOnBehalf mocks base method.
+func (m *MockLeaveRequestService) ApplyForLeaveOnBehalf(ctx context.Context, creatorAccountIDStr, accountIDStr string, input models.LeaveRequestInput, preApproved bool) (*models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyForLeaveOnBehalf", ctx, creatorAccountIDStr, accountIDStr, input, preApproved)
+	ret0, _ := ret[0].(*models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyForLeaveOnBehalf indicates an expected call of ApplyForLeaveOnBehalf.
+func (mr *MockLeaveRequestServiceMockRecorder) ApplyForLeaveOnBehalf(ctx, creatorAccountIDStr, accountIDStr, input, preApproved interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyForLeaveOnBehalf", reflect.TypeOf((*MockLeaveRequestService)(nil).ApplyForLeaveOnBehalf), ctx, creatorAccountIDStr, accountIDStr, input, preApproved)
 }
 
 // ApproveRequest mocks base method.
@@ -65,6 +81,108 @@ func (mr *MockLeaveRequestServiceMockRecorder) ApproveRequest(ctx, leaveRequestI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRequest", reflect.TypeOf((*MockLeaveRequestService)(nil).ApproveRequest), ctx, leaveRequestIDStr, processorAccountIDStr)
 }
 
+// ApproveRequestWithOverride mocks base method.
+func (m *MockLeaveRequestService) ApproveRequestWithOverride(ctx context.Context, leaveRequestIDStr, processorAccountIDStr, justification string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveRequestWithOverride", ctx, leaveRequestIDStr, processorAccountIDStr, justification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveRequestWithOverride indicates an expected call of ApproveRequestWithOverride.
+func (mr *MockLeaveRequestServiceMockRecorder) ApproveRequestWithOverride(ctx, leaveRequestIDStr, processorAccountIDStr, justification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRequestWithOverride", reflect.TypeOf((*MockLeaveRequestService)(nil).ApproveRequestWithOverride), ctx, leaveRequestIDStr, processorAccountIDStr, justification)
+}
+
+// AutoApproveRequest mocks base method.
+func (m *MockLeaveRequestService) AutoApproveRequest(ctx context.Context, leaveRequestIDStr, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoApproveRequest", ctx, leaveRequestIDStr, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AutoApproveRequest indicates an expected call of AutoApproveRequest.
+func (mr *MockLeaveRequestServiceMockRecorder) AutoApproveRequest(ctx, leaveRequestIDStr, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoApproveRequest", reflect.TypeOf((*MockLeaveRequestService)(nil).AutoApproveRequest), ctx, leaveRequestIDStr, comment)
+}
+
+// BulkProcessRequests mocks base method.
+func (m *MockLeaveRequestService) BulkProcessRequests(ctx context.Context, leaveRequestIDs []string, processorAccountIDStr, decision, reason string) ([]models.BulkLeaveDecisionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkProcessRequests", ctx, leaveRequestIDs, processorAccountIDStr, decision, reason)
+	ret0, _ := ret[0].([]models.BulkLeaveDecisionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkProcessRequests indicates an expected call of BulkProcessRequests.
+func (mr *MockLeaveRequestServiceMockRecorder) BulkProcessRequests(ctx, leaveRequestIDs, processorAccountIDStr, decision, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkProcessRequests", reflect.TypeOf((*MockLeaveRequestService)(nil).BulkProcessRequests), ctx, leaveRequestIDs, processorAccountIDStr, decision, reason)
+}
+
+// CancelForTermination mocks base method.
+func (m *MockLeaveRequestService) CancelForTermination(ctx context.Context, accountID uuid.UUID, terminationDate time.Time, processorAccountID uuid.UUID) ([]models.LeaveRequest, []models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelForTermination", ctx, accountID, terminationDate, processorAccountID)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].([]models.LeaveRequest)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CancelForTermination indicates an expected call of CancelForTermination.
+func (mr *MockLeaveRequestServiceMockRecorder) CancelForTermination(ctx, accountID, terminationDate, processorAccountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelForTermination", reflect.TypeOf((*MockLeaveRequestService)(nil).CancelForTermination), ctx, accountID, terminationDate, processorAccountID)
+}
+
+// CancelRequest mocks base method.
+func (m *MockLeaveRequestService) CancelRequest(ctx context.Context, leaveRequestIDStr, accountIDStr string) (*models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelRequest", ctx, leaveRequestIDStr, accountIDStr)
+	ret0, _ := ret[0].(*models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelRequest indicates an expected call of CancelRequest.
+func (mr *MockLeaveRequestServiceMockRecorder) CancelRequest(ctx, leaveRequestIDStr, accountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRequest", reflect.TypeOf((*MockLeaveRequestService)(nil).CancelRequest), ctx, leaveRequestIDStr, accountIDStr)
+}
+
+// ConfirmCancellation mocks base method.
+func (m *MockLeaveRequestService) ConfirmCancellation(ctx context.Context, leaveRequestIDStr, processorAccountIDStr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmCancellation", ctx, leaveRequestIDStr, processorAccountIDStr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmCancellation indicates an expected call of ConfirmCancellation.
+func (mr *MockLeaveRequestServiceMockRecorder) ConfirmCancellation(ctx, leaveRequestIDStr, processorAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmCancellation", reflect.TypeOf((*MockLeaveRequestService)(nil).ConfirmCancellation), ctx, leaveRequestIDStr, processorAccountIDStr)
+}
+
+// DeclineCancellation mocks base method.
+func (m *MockLeaveRequestService) DeclineCancellation(ctx context.Context, leaveRequestIDStr, processorAccountIDStr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineCancellation", ctx, leaveRequestIDStr, processorAccountIDStr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineCancellation indicates an expected call of DeclineCancellation.
+func (mr *MockLeaveRequestServiceMockRecorder) DeclineCancellation(ctx, leaveRequestIDStr, processorAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineCancellation", reflect.TypeOf((*MockLeaveRequestService)(nil).DeclineCancellation), ctx, leaveRequestIDStr, processorAccountIDStr)
+}
+
 // GetLeaveRequestByID mocks base method.
 func (m *MockLeaveRequestService) GetLeaveRequestByID(ctx context.Context, leaveRequestIDStr string) (*models.LeaveRequest, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +198,21 @@ func (mr *MockLeaveRequestServiceMockRecorder) GetLeaveRequestByID(ctx, leaveReq
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaveRequestByID", reflect.TypeOf((*MockLeaveRequestService)(nil).GetLeaveRequestByID), ctx, leaveRequestIDStr)
 }
 
+// GetRequestHistory mocks base method.
+func (m *MockLeaveRequestService) GetRequestHistory(ctx context.Context, leaveRequestIDStr, viewerAccountIDStr string) ([]models.LeaveRequestEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestHistory", ctx, leaveRequestIDStr, viewerAccountIDStr)
+	ret0, _ := ret[0].([]models.LeaveRequestEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestHistory indicates an expected call of GetRequestHistory.
+func (mr *MockLeaveRequestServiceMockRecorder) GetRequestHistory(ctx, leaveRequestIDStr, viewerAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestHistory", reflect.TypeOf((*MockLeaveRequestService)(nil).GetRequestHistory), ctx, leaveRequestIDStr, viewerAccountIDStr)
+}
+
 // ListAccountRequests mocks base method.
 func (m *MockLeaveRequestService) ListAccountRequests(ctx context.Context, accountIDStr string) ([]models.LeaveRequest, error) {
 	m.ctrl.T.Helper()
@@ -110,6 +243,37 @@ func (mr *MockLeaveRequestServiceMockRecorder) ListAllRequests(ctx interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRequests", reflect.TypeOf((*MockLeaveRequestService)(nil).ListAllRequests), ctx)
 }
 
+// ListManagedRequests mocks base method.
+func (m *MockLeaveRequestService) ListManagedRequests(ctx context.Context, managerAccountIDStr string) ([]models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListManagedRequests", ctx, managerAccountIDStr)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManagedRequests indicates an expected call of ListManagedRequests.
+func (mr *MockLeaveRequestServiceMockRecorder) ListManagedRequests(ctx, managerAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagedRequests", reflect.TypeOf((*MockLeaveRequestService)(nil).ListManagedRequests), ctx, managerAccountIDStr)
+}
+
+// ListRequests mocks base method.
+func (m *MockLeaveRequestService) ListRequests(ctx context.Context, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRequests", ctx, filter)
+	ret0, _ := ret[0].([]models.LeaveRequest)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListRequests indicates an expected call of ListRequests.
+func (mr *MockLeaveRequestServiceMockRecorder) ListRequests(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRequests", reflect.TypeOf((*MockLeaveRequestService)(nil).ListRequests), ctx, filter)
+}
+
 // RejectRequest mocks base method.
 func (m *MockLeaveRequestService) RejectRequest(ctx context.Context, leaveRequestIDStr, processorAccountIDStr, reason string) error {
 	m.ctrl.T.Helper()
@@ -123,3 +287,18 @@ func (mr *MockLeaveRequestServiceMockRecorder) RejectRequest(ctx, leaveRequestID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectRequest", reflect.TypeOf((*MockLeaveRequestService)(nil).RejectRequest), ctx, leaveRequestIDStr, processorAccountIDStr, reason)
 }
+
+// UpdatePendingRequest mocks base method.
+func (m *MockLeaveRequestService) UpdatePendingRequest(ctx context.Context, leaveRequestIDStr, accountIDStr string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePendingRequest", ctx, leaveRequestIDStr, accountIDStr, input)
+	ret0, _ := ret[0].(*models.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePendingRequest indicates an expected call of UpdatePendingRequest.
+func (mr *MockLeaveRequestServiceMockRecorder) UpdatePendingRequest(ctx, leaveRequestIDStr, accountIDStr, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePendingRequest", reflect.TypeOf((*MockLeaveRequestService)(nil).UpdatePendingRequest), ctx, leaveRequestIDStr, accountIDStr, input)
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_rule_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeaveRuleRepository is a mock of LeaveRuleRepository interface.
+type MockLeaveRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveRuleRepositoryMockRecorder
+}
+
+// MockLeaveRuleRepositoryMockRecorder is the mock recorder for MockLeaveRuleRepository.
+type MockLeaveRuleRepositoryMockRecorder struct {
+	mock *MockLeaveRuleRepository
+}
+
+// NewMockLeaveRuleRepository creates a new mock instance.
+func NewMockLeaveRuleRepository(ctrl *gomock.Controller) *MockLeaveRuleRepository {
+	mock := &MockLeaveRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaveRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveRuleRepository) EXPECT() *MockLeaveRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBlackout mocks base method.
+func (m *MockLeaveRuleRepository) CreateBlackout(ctx context.Context, blackout *models.LeaveBlackoutPeriod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlackout", ctx, blackout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBlackout indicates an expected call of CreateBlackout.
+func (mr *MockLeaveRuleRepositoryMockRecorder) CreateBlackout(ctx, blackout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlackout", reflect.TypeOf((*MockLeaveRuleRepository)(nil).CreateBlackout), ctx, blackout)
+}
+
+// CreateStaffingRule mocks base method.
+func (m *MockLeaveRuleRepository) CreateStaffingRule(ctx context.Context, rule *models.LeaveStaffingRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStaffingRule", ctx, rule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStaffingRule indicates an expected call of CreateStaffingRule.
+func (mr *MockLeaveRuleRepositoryMockRecorder) CreateStaffingRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStaffingRule", reflect.TypeOf((*MockLeaveRuleRepository)(nil).CreateStaffingRule), ctx, rule)
+}
+
+// DeleteBlackout mocks base method.
+func (m *MockLeaveRuleRepository) DeleteBlackout(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlackout", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlackout indicates an expected call of DeleteBlackout.
+func (mr *MockLeaveRuleRepositoryMockRecorder) DeleteBlackout(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlackout", reflect.TypeOf((*MockLeaveRuleRepository)(nil).DeleteBlackout), ctx, id)
+}
+
+// DeleteStaffingRule mocks base method.
+func (m *MockLeaveRuleRepository) DeleteStaffingRule(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaffingRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStaffingRule indicates an expected call of DeleteStaffingRule.
+func (mr *MockLeaveRuleRepositoryMockRecorder) DeleteStaffingRule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaffingRule", reflect.TypeOf((*MockLeaveRuleRepository)(nil).DeleteStaffingRule), ctx, id)
+}
+
+// ListActiveBlackoutsBetween mocks base method.
+func (m *MockLeaveRuleRepository) ListActiveBlackoutsBetween(ctx context.Context, start, end time.Time) ([]models.LeaveBlackoutPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveBlackoutsBetween", ctx, start, end)
+	ret0, _ := ret[0].([]models.LeaveBlackoutPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveBlackoutsBetween indicates an expected call of ListActiveBlackoutsBetween.
+func (mr *MockLeaveRuleRepositoryMockRecorder) ListActiveBlackoutsBetween(ctx, start, end interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveBlackoutsBetween", reflect.TypeOf((*MockLeaveRuleRepository)(nil).ListActiveBlackoutsBetween), ctx, start, end)
+}
+
+// ListActiveStaffingRulesByDepartment mocks base method.
+func (m *MockLeaveRuleRepository) ListActiveStaffingRulesByDepartment(ctx context.Context, department string) ([]models.LeaveStaffingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveStaffingRulesByDepartment", ctx, department)
+	ret0, _ := ret[0].([]models.LeaveStaffingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveStaffingRulesByDepartment indicates an expected call of ListActiveStaffingRulesByDepartment.
+func (mr *MockLeaveRuleRepositoryMockRecorder) ListActiveStaffingRulesByDepartment(ctx, department interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveStaffingRulesByDepartment", reflect.TypeOf((*MockLeaveRuleRepository)(nil).ListActiveStaffingRulesByDepartment), ctx, department)
+}
+
+// ListBlackouts mocks base method.
+func (m *MockLeaveRuleRepository) ListBlackouts(ctx context.Context) ([]models.LeaveBlackoutPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlackouts", ctx)
+	ret0, _ := ret[0].([]models.LeaveBlackoutPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlackouts indicates an expected call of ListBlackouts.
+func (mr *MockLeaveRuleRepositoryMockRecorder) ListBlackouts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlackouts", reflect.TypeOf((*MockLeaveRuleRepository)(nil).ListBlackouts), ctx)
+}
+
+// ListStaffingRules mocks base method.
+func (m *MockLeaveRuleRepository) ListStaffingRules(ctx context.Context) ([]models.LeaveStaffingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaffingRules", ctx)
+	ret0, _ := ret[0].([]models.LeaveStaffingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaffingRules indicates an expected call of ListStaffingRules.
+func (mr *MockLeaveRuleRepositoryMockRecorder) ListStaffingRules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaffingRules", reflect.TypeOf((*MockLeaveRuleRepository)(nil).ListStaffingRules), ctx)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_rule_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeaveRuleService is a mock of LeaveRuleService interface.
+type MockLeaveRuleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveRuleServiceMockRecorder
+}
+
+// MockLeaveRuleServiceMockRecorder is the mock recorder for MockLeaveRuleService.
+type MockLeaveRuleServiceMockRecorder struct {
+	mock *MockLeaveRuleService
+}
+
+// NewMockLeaveRuleService creates a new mock instance.
+func NewMockLeaveRuleService(ctrl *gomock.Controller) *MockLeaveRuleService {
+	mock := &MockLeaveRuleService{ctrl: ctrl}
+	mock.recorder = &MockLeaveRuleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveRuleService) EXPECT() *MockLeaveRuleServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckLeaveRules mocks base method.
+func (m *MockLeaveRuleService) CheckLeaveRules(ctx context.Context, request *models.LeaveRequest) (*models.LeaveRuleViolation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckLeaveRules", ctx, request)
+	ret0, _ := ret[0].(*models.LeaveRuleViolation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckLeaveRules indicates an expected call of CheckLeaveRules.
+func (mr *MockLeaveRuleServiceMockRecorder) CheckLeaveRules(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckLeaveRules", reflect.TypeOf((*MockLeaveRuleService)(nil).CheckLeaveRules), ctx, request)
+}
+
+// CreateBlackout mocks base method.
+func (m *MockLeaveRuleService) CreateBlackout(ctx context.Context, blackout *models.LeaveBlackoutPeriod) (*models.LeaveBlackoutPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlackout", ctx, blackout)
+	ret0, _ := ret[0].(*models.LeaveBlackoutPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBlackout indicates an expected call of CreateBlackout.
+func (mr *MockLeaveRuleServiceMockRecorder) CreateBlackout(ctx, blackout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlackout", reflect.TypeOf((*MockLeaveRuleService)(nil).CreateBlackout), ctx, blackout)
+}
+
+// CreateStaffingRule mocks base method.
+func (m *MockLeaveRuleService) CreateStaffingRule(ctx context.Context, rule *models.LeaveStaffingRule) (*models.LeaveStaffingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStaffingRule", ctx, rule)
+	ret0, _ := ret[0].(*models.LeaveStaffingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStaffingRule indicates an expected call of CreateStaffingRule.
+func (mr *MockLeaveRuleServiceMockRecorder) CreateStaffingRule(ctx, rule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStaffingRule", reflect.TypeOf((*MockLeaveRuleService)(nil).CreateStaffingRule), ctx, rule)
+}
+
+// DeleteBlackout mocks base method.
+func (m *MockLeaveRuleService) DeleteBlackout(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlackout", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlackout indicates an expected call of DeleteBlackout.
+func (mr *MockLeaveRuleServiceMockRecorder) DeleteBlackout(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlackout", reflect.TypeOf((*MockLeaveRuleService)(nil).DeleteBlackout), ctx, id)
+}
+
+// DeleteStaffingRule mocks base method.
+func (m *MockLeaveRuleService) DeleteStaffingRule(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaffingRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStaffingRule indicates an expected call of DeleteStaffingRule.
+func (mr *MockLeaveRuleServiceMockRecorder) DeleteStaffingRule(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaffingRule", reflect.TypeOf((*MockLeaveRuleService)(nil).DeleteStaffingRule), ctx, id)
+}
+
+// ListBlackouts mocks base method.
+func (m *MockLeaveRuleService) ListBlackouts(ctx context.Context) ([]models.LeaveBlackoutPeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlackouts", ctx)
+	ret0, _ := ret[0].([]models.LeaveBlackoutPeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlackouts indicates an expected call of ListBlackouts.
+func (mr *MockLeaveRuleServiceMockRecorder) ListBlackouts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlackouts", reflect.TypeOf((*MockLeaveRuleService)(nil).ListBlackouts), ctx)
+}
+
+// ListStaffingRules mocks base method.
+func (m *MockLeaveRuleService) ListStaffingRules(ctx context.Context) ([]models.LeaveStaffingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaffingRules", ctx)
+	ret0, _ := ret[0].([]models.LeaveStaffingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaffingRules indicates an expected call of ListStaffingRules.
+func (mr *MockLeaveRuleServiceMockRecorder) ListStaffingRules(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaffingRules", reflect.TypeOf((*MockLeaveRuleService)(nil).ListStaffingRules), ctx)
+}
@@ -0,0 +1,139 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_type_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeaveTypeRepository is a mock of LeaveTypeRepository interface.
+type MockLeaveTypeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveTypeRepositoryMockRecorder
+}
+
+// MockLeaveTypeRepositoryMockRecorder is the mock recorder for MockLeaveTypeRepository.
+type MockLeaveTypeRepositoryMockRecorder struct {
+	mock *MockLeaveTypeRepository
+}
+
+// NewMockLeaveTypeRepository creates a new mock instance.
+func NewMockLeaveTypeRepository(ctrl *gomock.Controller) *MockLeaveTypeRepository {
+	mock := &MockLeaveTypeRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaveTypeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveTypeRepository) EXPECT() *MockLeaveTypeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountLeaveRequests mocks base method.
+func (m *MockLeaveTypeRepository) CountLeaveRequests(ctx context.Context, code string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLeaveRequests", ctx, code)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLeaveRequests indicates an expected call of CountLeaveRequests.
+func (mr *MockLeaveTypeRepositoryMockRecorder) CountLeaveRequests(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLeaveRequests", reflect.TypeOf((*MockLeaveTypeRepository)(nil).CountLeaveRequests), ctx, code)
+}
+
+// Create mocks base method.
+func (m *MockLeaveTypeRepository) Create(ctx context.Context, leaveType *models.LeaveType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, leaveType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockLeaveTypeRepositoryMockRecorder) Create(ctx, leaveType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockLeaveTypeRepository)(nil).Create), ctx, leaveType)
+}
+
+// Delete mocks base method.
+func (m *MockLeaveTypeRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockLeaveTypeRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockLeaveTypeRepository)(nil).Delete), ctx, id)
+}
+
+// GetByCode mocks base method.
+func (m *MockLeaveTypeRepository) GetByCode(ctx context.Context, code string) (*models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", ctx, code)
+	ret0, _ := ret[0].(*models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode.
+func (mr *MockLeaveTypeRepositoryMockRecorder) GetByCode(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockLeaveTypeRepository)(nil).GetByCode), ctx, code)
+}
+
+// GetByID mocks base method.
+func (m *MockLeaveTypeRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockLeaveTypeRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockLeaveTypeRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockLeaveTypeRepository) List(ctx context.Context) ([]models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockLeaveTypeRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockLeaveTypeRepository)(nil).List), ctx)
+}
+
+// Update mocks base method.
+func (m *MockLeaveTypeRepository) Update(ctx context.Context, leaveType *models.LeaveType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, leaveType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockLeaveTypeRepositoryMockRecorder) Update(ctx, leaveType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockLeaveTypeRepository)(nil).Update), ctx, leaveType)
+}
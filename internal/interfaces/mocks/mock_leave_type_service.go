@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/leave_type_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockLeaveTypeService is a mock of LeaveTypeService interface.
+type MockLeaveTypeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaveTypeServiceMockRecorder
+}
+
+// MockLeaveTypeServiceMockRecorder is the mock recorder for MockLeaveTypeService.
+type MockLeaveTypeServiceMockRecorder struct {
+	mock *MockLeaveTypeService
+}
+
+// NewMockLeaveTypeService creates a new mock instance.
+func NewMockLeaveTypeService(ctrl *gomock.Controller) *MockLeaveTypeService {
+	mock := &MockLeaveTypeService{ctrl: ctrl}
+	mock.recorder = &MockLeaveTypeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaveTypeService) EXPECT() *MockLeaveTypeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateLeaveType mocks base method.
+func (m *MockLeaveTypeService) CreateLeaveType(ctx context.Context, leaveType *models.LeaveType) (*models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLeaveType", ctx, leaveType)
+	ret0, _ := ret[0].(*models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLeaveType indicates an expected call of CreateLeaveType.
+func (mr *MockLeaveTypeServiceMockRecorder) CreateLeaveType(ctx, leaveType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLeaveType", reflect.TypeOf((*MockLeaveTypeService)(nil).CreateLeaveType), ctx, leaveType)
+}
+
+// DeleteLeaveType mocks base method.
+func (m *MockLeaveTypeService) DeleteLeaveType(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLeaveType", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLeaveType indicates an expected call of DeleteLeaveType.
+func (mr *MockLeaveTypeServiceMockRecorder) DeleteLeaveType(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLeaveType", reflect.TypeOf((*MockLeaveTypeService)(nil).DeleteLeaveType), ctx, id)
+}
+
+// ListLeaveTypes mocks base method.
+func (m *MockLeaveTypeService) ListLeaveTypes(ctx context.Context) ([]models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeaveTypes", ctx)
+	ret0, _ := ret[0].([]models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeaveTypes indicates an expected call of ListLeaveTypes.
+func (mr *MockLeaveTypeServiceMockRecorder) ListLeaveTypes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeaveTypes", reflect.TypeOf((*MockLeaveTypeService)(nil).ListLeaveTypes), ctx)
+}
+
+// ResolveLeaveType mocks base method.
+func (m *MockLeaveTypeService) ResolveLeaveType(ctx context.Context, code string, role uint8) (*models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveLeaveType", ctx, code, role)
+	ret0, _ := ret[0].(*models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveLeaveType indicates an expected call of ResolveLeaveType.
+func (mr *MockLeaveTypeServiceMockRecorder) ResolveLeaveType(ctx, code, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveLeaveType", reflect.TypeOf((*MockLeaveTypeService)(nil).ResolveLeaveType), ctx, code, role)
+}
+
+// UpdateLeaveType mocks base method.
+func (m *MockLeaveTypeService) UpdateLeaveType(ctx context.Context, id uuid.UUID, update *models.LeaveType) (*models.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLeaveType", ctx, id, update)
+	ret0, _ := ret[0].(*models.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLeaveType indicates an expected call of UpdateLeaveType.
+func (mr *MockLeaveTypeServiceMockRecorder) UpdateLeaveType(ctx, id, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLeaveType", reflect.TypeOf((*MockLeaveTypeService)(nil).UpdateLeaveType), ctx, id, update)
+}
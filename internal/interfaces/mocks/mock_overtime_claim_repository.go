@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/overtime_claim_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+)
+
+// MockOvertimeClaimRepository is a mock of OvertimeClaimRepository interface.
+type MockOvertimeClaimRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOvertimeClaimRepositoryMockRecorder
+}
+
+// MockOvertimeClaimRepositoryMockRecorder is the mock recorder for MockOvertimeClaimRepository.
+type MockOvertimeClaimRepositoryMockRecorder struct {
+	mock *MockOvertimeClaimRepository
+}
+
+// NewMockOvertimeClaimRepository creates a new mock instance.
+func NewMockOvertimeClaimRepository(ctrl *gomock.Controller) *MockOvertimeClaimRepository {
+	mock := &MockOvertimeClaimRepository{ctrl: ctrl}
+	mock.recorder = &MockOvertimeClaimRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOvertimeClaimRepository) EXPECT() *MockOvertimeClaimRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountActiveByWorkDate mocks base method.
+func (m *MockOvertimeClaimRepository) CountActiveByWorkDate(ctx context.Context, accountID uuid.UUID, workDate time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveByWorkDate", ctx, accountID, workDate)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveByWorkDate indicates an expected call of CountActiveByWorkDate.
+func (mr *MockOvertimeClaimRepositoryMockRecorder) CountActiveByWorkDate(ctx, accountID, workDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveByWorkDate", reflect.TypeOf((*MockOvertimeClaimRepository)(nil).CountActiveByWorkDate), ctx, accountID, workDate)
+}
+
+// Create mocks base method.
+func (m *MockOvertimeClaimRepository) Create(ctx context.Context, claim *models.OvertimeClaim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, claim)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOvertimeClaimRepositoryMockRecorder) Create(ctx, claim interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOvertimeClaimRepository)(nil).Create), ctx, claim)
+}
+
+// GetByID mocks base method.
+func (m *MockOvertimeClaimRepository) GetByID(ctx context.Context, id uuid.UUID) (*models.OvertimeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*models.OvertimeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockOvertimeClaimRepositoryMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockOvertimeClaimRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockOvertimeClaimRepository) List(ctx context.Context, status string) ([]models.OvertimeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, status)
+	ret0, _ := ret[0].([]models.OvertimeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockOvertimeClaimRepositoryMockRecorder) List(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOvertimeClaimRepository)(nil).List), ctx, status)
+}
+
+// ListByAccountID mocks base method.
+func (m *MockOvertimeClaimRepository) ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.OvertimeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAccountID", ctx, accountID)
+	ret0, _ := ret[0].([]models.OvertimeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAccountID indicates an expected call of ListByAccountID.
+func (mr *MockOvertimeClaimRepositoryMockRecorder) ListByAccountID(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAccountID", reflect.TypeOf((*MockOvertimeClaimRepository)(nil).ListByAccountID), ctx, accountID)
+}
+
+// LockAccount mocks base method.
+func (m *MockOvertimeClaimRepository) LockAccount(ctx context.Context, accountID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccount", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockAccount indicates an expected call of LockAccount.
+func (mr *MockOvertimeClaimRepositoryMockRecorder) LockAccount(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccount", reflect.TypeOf((*MockOvertimeClaimRepository)(nil).LockAccount), ctx, accountID)
+}
+
+// Update mocks base method.
+func (m *MockOvertimeClaimRepository) Update(ctx context.Context, claim *models.OvertimeClaim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, claim)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOvertimeClaimRepositoryMockRecorder) Update(ctx, claim interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOvertimeClaimRepository)(nil).Update), ctx, claim)
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/interfaces/overtime_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/erinchen11/hr-system/internal/models"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockOvertimeService is a mock of OvertimeService interface.
+type MockOvertimeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOvertimeServiceMockRecorder
+}
+
+// MockOvertimeServiceMockRecorder is the mock recorder for MockOvertimeService.
+type MockOvertimeServiceMockRecorder struct {
+	mock *MockOvertimeService
+}
+
+// NewMockOvertimeService creates a new mock instance.
+func NewMockOvertimeService(ctrl *gomock.Controller) *MockOvertimeService {
+	mock := &MockOvertimeService{ctrl: ctrl}
+	mock.recorder = &MockOvertimeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOvertimeService) EXPECT() *MockOvertimeServiceMockRecorder {
+	return m.recorder
+}
+
+// ApproveClaim mocks base method.
+func (m *MockOvertimeService) ApproveClaim(ctx context.Context, claimIDStr, processorAccountIDStr string) (*models.OvertimeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveClaim", ctx, claimIDStr, processorAccountIDStr)
+	ret0, _ := ret[0].(*models.OvertimeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveClaim indicates an expected call of ApproveClaim.
+func (mr *MockOvertimeServiceMockRecorder) ApproveClaim(ctx, claimIDStr, processorAccountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveClaim", reflect.TypeOf((*MockOvertimeService)(nil).ApproveClaim), ctx, claimIDStr, processorAccountIDStr)
+}
+
+// ListClaims mocks base method.
+func (m *MockOvertimeService) ListClaims(ctx context.Context, status string) ([]models.OvertimeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClaims", ctx, status)
+	ret0, _ := ret[0].([]models.OvertimeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClaims indicates an expected call of ListClaims.
+func (mr *MockOvertimeServiceMockRecorder) ListClaims(ctx, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClaims", reflect.TypeOf((*MockOvertimeService)(nil).ListClaims), ctx, status)
+}
+
+// ListMyClaims mocks base method.
+func (m *MockOvertimeService) ListMyClaims(ctx context.Context, accountIDStr string) ([]models.OvertimeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyClaims", ctx, accountIDStr)
+	ret0, _ := ret[0].([]models.OvertimeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyClaims indicates an expected call of ListMyClaims.
+func (mr *MockOvertimeServiceMockRecorder) ListMyClaims(ctx, accountIDStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyClaims", reflect.TypeOf((*MockOvertimeService)(nil).ListMyClaims), ctx, accountIDStr)
+}
+
+// RejectClaim mocks base method.
+func (m *MockOvertimeService) RejectClaim(ctx context.Context, claimIDStr, processorAccountIDStr, reason string) (*models.OvertimeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectClaim", ctx, claimIDStr, processorAccountIDStr, reason)
+	ret0, _ := ret[0].(*models.OvertimeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectClaim indicates an expected call of RejectClaim.
+func (mr *MockOvertimeServiceMockRecorder) RejectClaim(ctx, claimIDStr, processorAccountIDStr, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectClaim", reflect.TypeOf((*MockOvertimeService)(nil).RejectClaim), ctx, claimIDStr, processorAccountIDStr, reason)
+}
+
+// SubmitClaim mocks base method.
+func (m *MockOvertimeService) SubmitClaim(ctx context.Context, accountIDStr string, input models.OvertimeClaimInput) (*models.OvertimeClaim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitClaim", ctx, accountIDStr, input)
+	ret0, _ := ret[0].(*models.OvertimeClaim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitClaim indicates an expected call of SubmitClaim.
+func (mr *MockOvertimeServiceMockRecorder) SubmitClaim(ctx, accountIDStr, input interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitClaim", reflect.TypeOf((*MockOvertimeService)(nil).SubmitClaim), ctx, accountIDStr, input)
+}
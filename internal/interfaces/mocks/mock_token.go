@@ -94,6 +94,57 @@ func (mr *MockTokenParserMockRecorder) ParseJWT(tokenStr interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseJWT", reflect.TypeOf((*MockTokenParser)(nil).ParseJWT), varargs...)
 }
 
+// MockMessageSigner is a mock of MessageSigner interface.
+type MockMessageSigner struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageSignerMockRecorder
+}
+
+// MockMessageSignerMockRecorder is the mock recorder for MockMessageSigner.
+type MockMessageSignerMockRecorder struct {
+	mock *MockMessageSigner
+}
+
+// NewMockMessageSigner creates a new mock instance.
+func NewMockMessageSigner(ctrl *gomock.Controller) *MockMessageSigner {
+	mock := &MockMessageSigner{ctrl: ctrl}
+	mock.recorder = &MockMessageSignerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageSigner) EXPECT() *MockMessageSignerMockRecorder {
+	return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockMessageSigner) Sign(message []byte) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", message)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockMessageSignerMockRecorder) Sign(message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockMessageSigner)(nil).Sign), message)
+}
+
+// Verify mocks base method.
+func (m *MockMessageSigner) Verify(message, signature []byte) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", message, signature)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockMessageSignerMockRecorder) Verify(message, signature interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockMessageSigner)(nil).Verify), message, signature)
+}
+
 // MockTokenService is a mock of TokenService interface.
 type MockTokenService struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// Notifier 定義了通知帳戶的介面 (例如待審假單提醒)
+// 目前提供寫入 log 的實作, 之後可替換為 Email 或即時通訊
+type Notifier interface {
+	// Notify 將主旨與內容通知給指定帳戶
+	Notify(ctx context.Context, recipient models.Account, subject string, body string) error
+
+	// Private 表示內容只會送達收件人本人 (例如 Email); 寫入 log 等其他人也讀得到的實作返回 false
+	// 等同憑證的內容 (例如 Email 審核連結) 只能經由 Private 的實作送出
+	Private() bool
+}
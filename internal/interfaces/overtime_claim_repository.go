@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// OvertimeClaimRepository 定義了加班申請 (OvertimeClaim) 的資料庫操作介面
+type OvertimeClaimRepository interface {
+	// Create 新增加班申請
+	Create(ctx context.Context, claim *models.OvertimeClaim) error
+
+	// GetByID 根據 ID 查詢加班申請 (預加載申請人)，找不到時返回 gorm.ErrRecordNotFound
+	GetByID(ctx context.Context, id uuid.UUID) (*models.OvertimeClaim, error)
+
+	// Update 更新加班申請的所有欄位，僅在資料庫中的申請仍為待審時寫入
+	// 申請已被其他請求核准或拒絕時返回 ErrConcurrentModification，申請不存在時返回 gorm.ErrRecordNotFound
+	Update(ctx context.Context, claim *models.OvertimeClaim) error
+
+	// ListByAccountID 依加班日期由新到舊列出帳戶的加班申請
+	ListByAccountID(ctx context.Context, accountID uuid.UUID) ([]models.OvertimeClaim, error)
+
+	// List 依提交時間先後列出加班申請 (預加載申請人); status 為空表示不限狀態
+	List(ctx context.Context, status string) ([]models.OvertimeClaim, error)
+
+	// LockAccount 鎖定申請人的帳戶資料列直到事務結束 (須在事務中呼叫)，讓同一帳戶的重複檢查與新增依序執行
+	LockAccount(ctx context.Context, accountID uuid.UUID) error
+
+	// CountActiveByWorkDate 計算帳戶在指定加班日期仍待審或已核准的加班申請數量
+	CountActiveByWorkDate(ctx context.Context, accountID uuid.UUID, workDate time.Time) (int64, error)
+}
@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// OvertimeService 定義了加班申請與補休換算相關的業務邏輯
+type OvertimeService interface {
+	// SubmitClaim 由員工提交加班申請 (加班日期不可晚於今天, 也不可早於補休有效期)
+	// 同一加班日已有待審或已核准的申請時返回 ErrOvertimeClaimExists
+	SubmitClaim(ctx context.Context, accountIDStr string, input models.OvertimeClaimInput) (*models.OvertimeClaim, error)
+
+	// ListMyClaims 列出員工本人的加班申請
+	ListMyClaims(ctx context.Context, accountIDStr string) ([]models.OvertimeClaim, error)
+
+	// ListClaims 供 HR 列出加班申請，status 為空表示不限狀態
+	ListClaims(ctx context.Context, status string) ([]models.OvertimeClaim, error)
+
+	// ApproveClaim 由 HR / Super Admin 核准加班申請，並將時數換算為補休存入假期帳本
+	// 補休已失效的申請返回 ErrOvertimeClaimExpired
+	ApproveClaim(ctx context.Context, claimIDStr string, processorAccountIDStr string) (*models.OvertimeClaim, error)
+
+	// RejectClaim 由 HR / Super Admin 拒絕加班申請
+	RejectClaim(ctx context.Context, claimIDStr string, processorAccountIDStr string, reason string) (*models.OvertimeClaim, error)
+}
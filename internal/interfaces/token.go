@@ -15,6 +15,12 @@ type TokenParser interface {
 	ParseJWT(tokenStr string, opts ...jwt.ParserOption) (*models.Claims, error)
 }
 
+// MessageSigner 簽署與驗證任意訊息 (HMAC), 用於不需登入的一次性連結
+type MessageSigner interface {
+	Sign(message []byte) []byte
+	Verify(message, signature []byte) bool
+}
+
 // TokenService 處理 Token 的生成、驗證和緩存邏輯
 type TokenService interface {
 	GenerateAndCacheToken(ctx context.Context, user *models.Account) (string, error)
@@ -0,0 +1,10 @@
+package interfaces
+
+import "context"
+
+// TransactionManager 讓 Service 層將多個 Repository 寫入包在同一個資料庫事務中
+type TransactionManager interface {
+	// WithinTransaction 開啟事務並以帶有事務的 ctx 執行 fn: fn 返回錯誤時回滾，否則提交
+	// fn 內以該 ctx 呼叫的 Repository 方法都在同一個事務中執行; 已在事務中時直接沿用外層事務
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -16,6 +16,7 @@ type Account struct {
 	Password    string    `gorm:"type:varchar(255);not null" json:"-"`                 // Auth credential
 	Role        uint8     `gorm:"type:tinyint unsigned;not null;index" json:"role"`    // 0:super, 1:hr, 2:employee
 	PhoneNumber string    `gorm:"type:varchar(20)" json:"phone_number,omitempty"`      // Nullable contact info
+	FeedVersion uint      `gorm:"not null;default:0" json:"-"`                         // 訂閱行事曆 feed token 的版本, 遞增後先前簽發的訂閱網址全部失效
 	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
 
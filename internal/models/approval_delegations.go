@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// --- 代理範圍 ---
+const (
+	DelegationScopeAll     = "all"     // 代理委託人所有的審核權限
+	DelegationScopeManager = "manager" // 僅代理主管審核步驟
+	DelegationScopeHR      = "hr"      // 僅代理 HR 審核步驟
+)
+
+// ApprovalDelegation 記錄審核人 (委託人) 在一段期間內將審核權限交由代理人行使
+type ApprovalDelegation struct {
+	ID          uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	DelegatorID uuid.UUID `gorm:"type:char(36);not null;index" json:"delegator_id"` // 原審核人
+	DelegateID  uuid.UUID `gorm:"type:char(36);not null;index" json:"delegate_id"`  // 代理人
+	StartDate   time.Time `gorm:"type:date;not null;index" json:"start_date"`
+	EndDate     time.Time `gorm:"type:date;not null;index" json:"end_date"` // 含當日
+	Scope       string    `gorm:"type:varchar(20);not null;default:'all'" json:"scope"`
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	// --- GORM 關聯 ---
+	Delegator Account `gorm:"foreignKey:DelegatorID" json:"delegator,omitempty"`
+	Delegate  Account `gorm:"foreignKey:DelegateID" json:"delegate,omitempty"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (ApprovalDelegation) TableName() string {
+	return "approval_delegations"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (d *ApprovalDelegation) BeforeCreate(tx *gorm.DB) (err error) {
+	if d.ID == uuid.Nil {
+		d.ID = uuid.New()
+	}
+	return
+}
+
+// Covers 判斷此代理是否涵蓋指定類型的審核步驟
+func (d ApprovalDelegation) Covers(approverKind string) bool {
+	return d.Scope == DelegationScopeAll || d.Scope == approverKind
+}
+
+// ApprovalDelegationInput 定義建立代理時的輸入資料 (非資料表)
+type ApprovalDelegationInput struct {
+	DelegateID uuid.UUID
+	StartDate  time.Time
+	EndDate    time.Time
+	Scope      string // 空字串視為 all
+}
@@ -4,4 +4,5 @@ type Response struct {
 	Code    int         `json:"code"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data"`
+	Total   *int64      `json:"total,omitempty"` // 分頁查詢時符合條件的總筆數
 }
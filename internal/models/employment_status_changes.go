@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DefaultLongLeaveThresholdDays 假期 (日曆天) 超過此天數視為長假, 假期期間僱傭狀態設為 on_leave
+const DefaultLongLeaveThresholdDays = 30
+
+// EmploymentStatusChange 記錄僱傭狀態的每一次變更 (只新增, 不修改)
+type EmploymentStatusChange struct {
+	ID             uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	EmploymentID   uuid.UUID  `gorm:"type:char(36);not null;index" json:"employment_id"`
+	AccountID      uuid.UUID  `gorm:"type:char(36);not null;index" json:"account_id"`
+	FromStatus     string     `gorm:"type:varchar(20);not null" json:"from_status"`
+	ToStatus       string     `gorm:"type:varchar(20);not null" json:"to_status"`
+	Reason         string     `gorm:"type:text" json:"reason,omitempty"`
+	LeaveRequestID *uuid.UUID `gorm:"type:char(36);index" json:"leave_request_id,omitempty"` // 因長假變更時對應的假單
+	ChangedByID    *uuid.UUID `gorm:"type:char(36)" json:"changed_by_id,omitempty"`          // 執行變更的帳戶, 排程變更時為空
+	ChangedAt      time.Time  `gorm:"autoCreateTime;index" json:"changed_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (EmploymentStatusChange) TableName() string {
+	return "employment_status_changes"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (c *EmploymentStatusChange) BeforeCreate(tx *gorm.DB) (err error) {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return
+}
+
+// EmploymentStatusSyncReport 單次長假狀態同步的處理結果 (非資料表)
+type EmploymentStatusSyncReport struct {
+	OnLongLeave int `json:"on_long_leave"` // 今日處於長假中的員工數
+	SetOnLeave  int `json:"set_on_leave"`  // 狀態改為 on_leave 的員工數
+	Restored    int `json:"restored"`      // 長假結束, 狀態恢復為 active 的員工數
+	Failed      int `json:"failed"`        // 處理失敗的員工數 (下次排程重試)
+}
@@ -21,10 +21,13 @@ type Employment struct {
 	AccountID       uuid.UUID        `gorm:"type:char(36);not null;index" json:"account_id"`                 // *** FK renamed to AccountID ***
 	JobGradeID      *uuid.UUID       `gorm:"type:char(36);index" json:"job_grade_id,omitempty"`              // FK to JobGrade, nullable
 	PositionTitle   string           `gorm:"type:varchar(50)" json:"position_title,omitempty"`               // 具體職稱, 可為 NULL
+	Department      string           `gorm:"type:varchar(50);index" json:"department,omitempty"`             // 所屬部門 (例如 Finance, Engineering), 可為空
 	Salary          *decimal.Decimal `gorm:"type:decimal(12,2)" json:"salary,omitempty"`                     // 薪資, 可為 NULL
 	HireDate        *time.Time       `gorm:"type:date;index" json:"hire_date,omitempty"`                     // 入職日期, 可為 NULL
 	TerminationDate *time.Time       `gorm:"type:date;index" json:"termination_date,omitempty"`              // 離職日期, 可為 NULL
 	Status          string           `gorm:"type:varchar(20);not null;default:'active';index" json:"status"` // 僱傭狀態
+	ManagerID       *uuid.UUID       `gorm:"type:char(36);index" json:"manager_id,omitempty"`                // 直屬主管的 Account ID, 可為 NULL
+	Version         int              `gorm:"not null;default:1" json:"version"`                              // 樂觀鎖版本號, 每次更新加一
 	CreatedAt       time.Time        `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt       time.Time        `gorm:"autoUpdateTime" json:"updated_at"`
 
@@ -38,7 +41,7 @@ func (Employment) TableName() string {
 	return "employments"
 }
 
-// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID 並設定初始版本號
 func (e *Employment) BeforeCreate(tx *gorm.DB) (err error) {
 	if e.ID == uuid.Nil {
 		e.ID = uuid.New()
@@ -46,5 +49,34 @@ func (e *Employment) BeforeCreate(tx *gorm.DB) (err error) {
 	if e.Status == "" {
 		e.Status = EmploymentStatusActive
 	}
+	if e.Version == 0 {
+		e.Version = 1
+	}
 	return
 }
+
+// SettlementDaysPerMonth 離職結算時以月薪換算日薪的天數 (日薪 = 月薪 / 30)
+const SettlementDaysPerMonth = 30
+
+// TerminationSettlement 離職結算摘要，供 HR 列印 (非資料表)
+// 剩餘年假為負數時 (預支超休)，折算金額也為負數，表示需自最後薪資扣回
+type TerminationSettlement struct {
+	EmploymentID    uuid.UUID
+	AccountID       uuid.UUID
+	FirstName       string
+	LastName        string
+	Email           string
+	Department      string
+	PositionTitle   string
+	HireDate        *time.Time
+	TerminationDate time.Time
+
+	AnnualLeave LeaveBalanceSettlement // 依離職日比例計算後的剩餘年假
+
+	MonthlySalary    *decimal.Decimal // 未設定薪資時為 nil, 此時不折算金額
+	DailyRate        *decimal.Decimal // 月薪 / SettlementDaysPerMonth
+	EncashmentAmount *decimal.Decimal // 剩餘年假天數 × 日薪
+
+	CancelledLeaveRequests []LeaveRequest // 因離職自動取消的假單 (開始日晚於離職日)
+	ShortenedLeaveRequests []LeaveRequest // 跨越離職日而截短至離職日的假單 (離職日後的天數已退回)
+}
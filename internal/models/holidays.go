@@ -0,0 +1,105 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// DefaultWeekendDays 未設定年度行事曆時的預設週末 (週六、週日)
+var DefaultWeekendDays = []time.Weekday{time.Saturday, time.Sunday}
+
+// HolidayCalendar 定義某一年度的工作週設定
+type HolidayCalendar struct {
+	ID          uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Year        int       `gorm:"not null;uniqueIndex" json:"year"`
+	WeekendDays string    `gorm:"type:varchar(20);not null;default:'0,6'" json:"weekend_days"` // 以逗號分隔的 time.Weekday (0=週日 ... 6=週六)
+	CreatedAt   time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (HolidayCalendar) TableName() string {
+	return "holiday_calendars"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (hc *HolidayCalendar) BeforeCreate(tx *gorm.DB) (err error) {
+	if hc.ID == uuid.Nil {
+		hc.ID = uuid.New()
+	}
+	return
+}
+
+// Weekends 解析 WeekendDays 欄位，格式錯誤時退回預設週末
+func (hc HolidayCalendar) Weekends() []time.Weekday {
+	days, err := ParseWeekendDays(hc.WeekendDays)
+	if err != nil {
+		return DefaultWeekendDays
+	}
+	return days
+}
+
+// Holiday 定義公司行事曆上的國定假日 / 公司休假日
+type Holiday struct {
+	ID        uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Date      time.Time `gorm:"type:date;not null;uniqueIndex" json:"date"`
+	Year      int       `gorm:"not null;index" json:"year"` // 冗餘欄位, 方便依年度查詢
+	Name      string    `gorm:"type:varchar(100);not null" json:"name"`
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (Holiday) TableName() string {
+	return "holidays"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID 並同步年度
+func (h *Holiday) BeforeCreate(tx *gorm.DB) (err error) {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	h.Year = h.Date.Year()
+	return
+}
+
+// ParseWeekendDays 將 "0,6" 格式的字串解析為 time.Weekday 列表
+func ParseWeekendDays(s string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n < int(time.Sunday) || n > int(time.Saturday) {
+			return nil, fmt.Errorf("invalid weekday value '%s'", part)
+		}
+		days = append(days, time.Weekday(n))
+	}
+	return days, nil
+}
+
+// FormatWeekendDays 將 time.Weekday 列表格式化為排序後、去重的 "0,6" 字串
+func FormatWeekendDays(days []time.Weekday) string {
+	seen := make(map[time.Weekday]bool, len(days))
+	values := make([]int, 0, len(days))
+	for _, d := range days {
+		if !seen[d] {
+			seen[d] = true
+			values = append(values, int(d))
+		}
+	}
+	sort.Ints(values)
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, strconv.Itoa(v))
+	}
+	return strings.Join(parts, ",")
+}
@@ -16,6 +16,7 @@ type JobGrade struct {
 	Description string          `gorm:"type:text" json:"description,omitempty"`                      // 職等描述 (可選)
 	MinSalary   decimal.Decimal `gorm:"type:decimal(12,2);default:0.00" json:"min_salary,omitempty"` // 最低薪資 (可選) - 注意 omitempty
 	MaxSalary   decimal.Decimal `gorm:"type:decimal(12,2);default:0.00" json:"max_salary,omitempty"` // 最高薪資 (可選) - 注意 omitempty
+	Version     int             `gorm:"not null;default:1" json:"version"`                           // 樂觀鎖版本號, 每次更新加一
 	CreatedAt   time.Time       `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt   time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
 }
@@ -25,10 +26,13 @@ func (JobGrade) TableName() string {
 	return "job_grades"
 }
 
-// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID 並設定初始版本號
 func (jg *JobGrade) BeforeCreate(tx *gorm.DB) (err error) {
 	if jg.ID == uuid.Nil {
 		jg.ID = uuid.New()
 	}
+	if jg.Version == 0 {
+		jg.Version = 1
+	}
 	return
 }
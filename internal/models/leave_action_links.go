@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// --- Email 審核連結的動作 ---
+const (
+	LeaveActionApprove = "approve"
+	LeaveActionReject  = "reject"
+)
+
+// DefaultLeaveActionLinkTTLHours Email 審核連結的預設有效時數
+const DefaultLeaveActionLinkTTLHours = 72
+
+// LeaveActionToken 是 Email 審核連結中經簽署的內容 (非資料表)
+// 同一封通知中的核准與拒絕連結共用 Nonce, 任一連結使用成功後兩者皆失效
+type LeaveActionToken struct {
+	LeaveRequestID uuid.UUID `json:"leave_request_id"`
+	ApproverID     uuid.UUID `json:"approver_id"` // 以此帳戶身分核准或拒絕
+	Action         string    `json:"action"`      // approve 或 reject
+	Nonce          string    `json:"nonce"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+// LeaveActionLinks 為單一審核人產生的一組審核連結 (非資料表)
+type LeaveActionLinks struct {
+	ApproveURL string
+	RejectURL  string
+	ExpiresAt  time.Time
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// --- 假期統計的分組維度 ---
+const (
+	LeaveAnalyticsByMonth      = "month"      // 依假期開始日的年月 (YYYY-MM)
+	LeaveAnalyticsByLeaveType  = "leave_type" // 依假別
+	LeaveAnalyticsByJobGrade   = "job_grade"  // 依申請人目前的職等
+	LeaveAnalyticsByDepartment = "department" // 依申請人目前的部門
+	LeaveAnalyticsByStatus     = "status"     // 依假單狀態
+)
+
+// MaxLeaveAnalyticsDays 單次統計的最長期間 (日曆天)
+const MaxLeaveAnalyticsDays = 366
+
+// Bradford factor 排名的預設筆數與上限
+const (
+	DefaultBradfordFactorLimit = 20
+	MaxBradfordFactorLimit     = 200
+)
+
+// LeaveAnalyticsFilter 定義假期統計的查詢條件 (非資料表)
+// 缺勤統計與 Bradford factor 依假期開始日落在 [From, To] 篩選; 核准時效依申請時間篩選
+type LeaveAnalyticsFilter struct {
+	From       time.Time
+	To         time.Time
+	Department string   // 空值表示不限部門
+	LeaveType  string   // 空值表示不限假別
+	Statuses   []string // 空值時由 Service 依統計項目決定預設狀態
+}
+
+// LeaveAbsenceTotal 缺勤統計中的一個分組 (非資料表)
+type LeaveAbsenceTotal struct {
+	Key      string          `gorm:"column:group_key"`   // 分組值, 例如 2025-03、sick、P2; 申請人沒有職等 / 部門時為空字串
+	Label    string          `gorm:"column:group_label"` // 顯示名稱, 目前僅職等分組提供 (職等名稱)
+	Requests int64           `gorm:"column:requests"`    // 假單數
+	Days     decimal.Decimal `gorm:"column:days"`        // 請假工作日數合計
+}
+
+// BradfordFactorScore 單一員工的 Bradford factor (S² × D) (非資料表)
+type BradfordFactorScore struct {
+	AccountID  uuid.UUID       `gorm:"column:account_id"`
+	FirstName  string          `gorm:"column:first_name"`
+	LastName   string          `gorm:"column:last_name"`
+	Email      string          `gorm:"column:email"`
+	Department string          `gorm:"column:department"`
+	Spells     int64           `gorm:"column:spells"` // 缺勤次數 S (每張假單算一次)
+	Days       decimal.Decimal `gorm:"column:days"`   // 缺勤工作日數合計 D
+	Score      decimal.Decimal `gorm:"column:score"`  // S² × D
+}
+
+// LeaveTurnaroundStat 核准時效統計 (從申請時間到審核人核准的時間) (非資料表)
+type LeaveTurnaroundStat struct {
+	LeaveType  string  `gorm:"column:leave_type"` // 整體統計時為空字串
+	Decisions  int64   `gorm:"column:decisions"`  // 由審核人核准的假單數
+	AvgSeconds float64 `gorm:"column:avg_seconds"`
+	MinSeconds int64   `gorm:"column:min_seconds"`
+	MaxSeconds int64   `gorm:"column:max_seconds"`
+}
+
+// LeaveTurnaroundReport 核准時效報表: 整體與各假別 (非資料表)
+type LeaveTurnaroundReport struct {
+	Overall     LeaveTurnaroundStat
+	ByLeaveType []LeaveTurnaroundStat
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// --- 審核步驟的審核人類型 ---
+const (
+	ApprovalStepManager = "manager" // 申請人的直屬主管 (HR / Super Admin 可覆核)
+	ApprovalStepHR      = "hr"      // HR 或 Super Admin
+)
+
+// --- 審核步驟狀態 ---
+const (
+	ApprovalStepStatusPending  = "pending"
+	ApprovalStepStatusApproved = "approved"
+	ApprovalStepStatusRejected = "rejected"
+	ApprovalStepStatusSkipped  = "skipped" // 前面的步驟被拒絕, 後續步驟不再審核
+)
+
+// DefaultApprovalSteps 沒有符合的審核政策時使用的預設流程 (單一步驟: 直屬主管或 HR)
+var DefaultApprovalSteps = []string{ApprovalStepManager}
+
+// LeaveApprovalPolicy 定義假單的審核流程
+// 假別符合 (LeaveType 為空表示適用所有假別) 且天數 >= MinDays 時套用; 多條符合時取假別明確且 MinDays 最大者
+type LeaveApprovalPolicy struct {
+	ID        uuid.UUID       `gorm:"type:char(36);primaryKey" json:"id"`
+	LeaveType string          `gorm:"type:varchar(50);index" json:"leave_type,omitempty"`
+	MinDays   decimal.Decimal `gorm:"type:decimal(6,2);not null;default:0" json:"min_days"`
+	Steps     string          `gorm:"type:varchar(255);not null" json:"steps"` // 依序的審核人類型, 以逗號分隔, 例如 "manager,hr"
+	Active    bool            `gorm:"not null;default:true" json:"active"`
+	CreatedAt time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveApprovalPolicy) TableName() string {
+	return "leave_approval_policies"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (p *LeaveApprovalPolicy) BeforeCreate(tx *gorm.DB) (err error) {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return
+}
+
+// StepList 返回政策定義的審核人類型列表 (忽略空白項目)
+func (p LeaveApprovalPolicy) StepList() []string {
+	steps := make([]string, 0, 2)
+	for _, part := range strings.Split(p.Steps, ",") {
+		if step := strings.TrimSpace(part); step != "" {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+// LeaveApprovalStep 記錄假單審核流程中的一個步驟及其決定
+type LeaveApprovalStep struct {
+	ID             uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	LeaveRequestID uuid.UUID  `gorm:"type:char(36);not null;uniqueIndex:idx_approval_step_order" json:"leave_request_id"`
+	StepOrder      int        `gorm:"not null;uniqueIndex:idx_approval_step_order" json:"step_order"` // 從 1 開始
+	ApproverKind   string     `gorm:"type:varchar(20);not null" json:"approver_kind"`                 // manager / hr
+	Status         string     `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	ActorID        *uuid.UUID `gorm:"type:char(36);index" json:"actor_id,omitempty"`  // 做出決定的帳戶
+	OnBehalfOfID   *uuid.UUID `gorm:"type:char(36)" json:"on_behalf_of_id,omitempty"` // 代理審核時的委託人
+	DecidedAt      *time.Time `json:"decided_at,omitempty"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt      time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveApprovalStep) TableName() string {
+	return "leave_approval_steps"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (s *LeaveApprovalStep) BeforeCreate(tx *gorm.DB) (err error) {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	if s.Status == "" {
+		s.Status = ApprovalStepStatusPending
+	}
+	return
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// DefaultMaxAttachmentSizeMB 單一附件的預設大小上限 (MB)
+const DefaultMaxAttachmentSizeMB = 5
+
+// MaxAttachmentsPerApplication 提交假單時一次可附上的佐證文件數量上限
+const MaxAttachmentsPerApplication = 5
+
+// AllowedAttachmentContentTypes 允許上傳的附件類型 (依檔案內容偵測, 不採信客戶端宣告的類型)
+var AllowedAttachmentContentTypes = []string{"application/pdf", "image/jpeg", "image/png"}
+
+// LeaveAttachment 定義了假單的佐證文件 (例如病假的醫生證明)
+type LeaveAttachment struct {
+	ID             uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	LeaveRequestID uuid.UUID `gorm:"type:char(36);not null;index" json:"leave_request_id"`
+	FileName       string    `gorm:"type:varchar(255);not null" json:"file_name"`    // 上傳時的原始檔名, 僅供顯示
+	ContentType    string    `gorm:"type:varchar(100);not null" json:"content_type"` // 依檔案內容偵測的 MIME 類型
+	Size           int64     `gorm:"not null" json:"size"`                           // 位元組
+	StorageKey     string    `gorm:"type:varchar(255);not null" json:"-"`            // 檔案在 FileStorage 中的位置, 不對外公開
+	UploadedByID   uuid.UUID `gorm:"type:char(36);not null" json:"uploaded_by_id"`
+	CreatedAt      time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveAttachment) TableName() string {
+	return "leave_attachments"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (a *LeaveAttachment) BeforeCreate(tx *gorm.DB) (err error) {
+	if a.ID == uuid.Nil {
+		a.ID = uuid.New()
+	}
+	return
+}
+
+// LeaveAttachmentRule 定義某假別超過指定天數時必須附上佐證文件
+type LeaveAttachmentRule struct {
+	ID        uuid.UUID       `gorm:"type:char(36);primaryKey" json:"id"`
+	LeaveType string          `gorm:"type:varchar(50);not null;uniqueIndex" json:"leave_type"`
+	MinDays   decimal.Decimal `gorm:"type:decimal(6,2);not null" json:"min_days"` // 假單天數超過此值 (不含) 時需附件
+	Active    bool            `gorm:"not null;default:true" json:"active"`
+	CreatedAt time.Time       `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time       `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveAttachmentRule) TableName() string {
+	return "leave_attachment_rules"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (r *LeaveAttachmentRule) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return
+}
+
+// Requires 判斷指定天數的假單是否必須附上佐證文件
+func (r LeaveAttachmentRule) Requires(days decimal.Decimal) bool {
+	return r.Active && days.GreaterThan(r.MinDays)
+}
+
+// AttachmentUpload 定義上傳的附件內容 (非資料表)
+type AttachmentUpload struct {
+	FileName string
+	Data     []byte
+}
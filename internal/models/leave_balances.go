@@ -0,0 +1,150 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// --- 假期帳本分錄類型 ---
+const (
+	LeaveEntryTypeAccrual    = "accrual"    // 依規則累積 (正數)
+	LeaveEntryTypeDebit      = "debit"      // 假單核准後扣除 (負數)
+	LeaveEntryTypeAdjustment = "adjustment" // HR 手動調整 (正負皆可)
+	LeaveEntryTypeReversal   = "reversal"   // 已核准假單取消後退回天數 (正數)
+	LeaveEntryTypeExpiry     = "expiry"     // 年底結算轉出或結轉天數逾期失效 (負數)
+	LeaveEntryTypeCarryOver  = "carry_over" // 年底結算後結轉至隔年的天數 (正數)
+	LeaveEntryTypeEarned     = "earned"     // 加班核准後換得的補休 (正數, 於 ExpiresOn 後失效)
+)
+
+// --- 累積頻率 ---
+const (
+	AccrualFrequencyMonthly = "monthly" // 每月 1 日累積一次
+	AccrualFrequencyAnnual  = "annual"  // 每年 1 月 1 日累積一次 (到職首年依剩餘月份比例)
+)
+
+// LeaveBalanceEntry 假期帳本的一筆分錄 (credit 為正數, debit 為負數)
+// 某帳戶某假別的餘額 = 所有分錄 Amount 的加總
+type LeaveBalanceEntry struct {
+	ID             uuid.UUID       `gorm:"type:char(36);primaryKey" json:"id"`
+	AccountID      uuid.UUID       `gorm:"type:char(36);not null;index:idx_balance_account_type" json:"account_id"`
+	LeaveType      string          `gorm:"type:varchar(50);not null;index:idx_balance_account_type" json:"leave_type"`
+	EntryType      string          `gorm:"type:varchar(20);not null;index" json:"entry_type"`
+	Amount         decimal.Decimal `gorm:"type:decimal(6,2);not null" json:"amount"`              // 天數, 正數為增加, 負數為扣除
+	EffectiveDate  time.Time       `gorm:"type:date;not null;index" json:"effective_date"`        // 分錄生效日
+	LeaveRequestID *uuid.UUID      `gorm:"type:char(36);index" json:"leave_request_id,omitempty"` // 關聯的請假單 (debit 時)
+	IdempotencyKey *string         `gorm:"type:varchar(191);uniqueIndex" json:"-"`                // 防止重複入帳
+	Note           string          `gorm:"type:varchar(255)" json:"note,omitempty"`
+	ExpiresOn      *time.Time      `gorm:"type:date" json:"expires_on,omitempty"` // 最後可用日, 僅 earned 分錄使用
+	CreatedAt      time.Time       `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveBalanceEntry) TableName() string {
+	return "leave_balance_entries"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (e *LeaveBalanceEntry) BeforeCreate(tx *gorm.DB) (err error) {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return
+}
+
+// LeaveAccrualRule 定義某假別的累積規則與年底結轉政策 (每個假別一條)
+type LeaveAccrualRule struct {
+	ID        uuid.UUID       `gorm:"type:char(36);primaryKey" json:"id"`
+	LeaveType string          `gorm:"type:varchar(50);not null;uniqueIndex" json:"leave_type"`
+	Frequency string          `gorm:"type:varchar(20);not null" json:"frequency"` // monthly / annual
+	Amount    decimal.Decimal `gorm:"type:decimal(6,2);not null" json:"amount"`   // 每期累積天數
+	// CarryOverCap 年底最多可結轉至隔年的天數，超過的部分失效; NULL 表示此假別不做年底結算 (餘額全數保留)
+	CarryOverCap *decimal.Decimal `gorm:"type:decimal(6,2)" json:"carry_over_cap,omitempty"`
+	// CarryOverExpiryMonths 結轉天數於隔年第 N 個月底前未用完即失效; 0 表示結轉天數不會失效
+	CarryOverExpiryMonths int       `gorm:"not null;default:0" json:"carry_over_expiry_months"`
+	Active                bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt             time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt             time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveAccrualRule) TableName() string {
+	return "leave_accrual_rules"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (r *LeaveAccrualRule) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return
+}
+
+// CarryOverExpiresOn 返回於 carriedOn (隔年 1/1) 結轉的天數的最後可用日; 結轉天數不會失效時返回 nil
+func (r LeaveAccrualRule) CarryOverExpiresOn(carriedOn time.Time) *time.Time {
+	if r.CarryOverExpiryMonths <= 0 {
+		return nil
+	}
+	// 隔月 1 日的前一天即為第 N 個月的月底
+	expiresOn := time.Date(carriedOn.Year(), time.Month(r.CarryOverExpiryMonths)+1, 1, 0, 0, 0, 0, carriedOn.Location()).AddDate(0, 0, -1)
+	return &expiresOn
+}
+
+// LeaveCarryOverRecord 記錄某帳戶某假別某一年的年底結算結果 (同一年只會結算一次)
+type LeaveCarryOverRecord struct {
+	ID             uuid.UUID       `gorm:"type:char(36);primaryKey" json:"id"`
+	Year           int             `gorm:"not null;uniqueIndex:idx_carry_over_year_account_type" json:"year"` // 結算的年度
+	AccountID      uuid.UUID       `gorm:"type:char(36);not null;uniqueIndex:idx_carry_over_year_account_type" json:"account_id"`
+	LeaveType      string          `gorm:"type:varchar(50);not null;uniqueIndex:idx_carry_over_year_account_type" json:"leave_type"`
+	ClosingBalance decimal.Decimal `gorm:"type:decimal(6,2);not null" json:"closing_balance"` // 12/31 的餘額
+	CarriedOver    decimal.Decimal `gorm:"type:decimal(6,2);not null" json:"carried_over"`    // 結轉至隔年的天數
+	Forfeited      decimal.Decimal `gorm:"type:decimal(6,2);not null" json:"forfeited"`       // 超過上限而失效的天數
+	ExpiresOn      *time.Time      `gorm:"type:date" json:"expires_on,omitempty"`             // 結轉天數的最後可用日
+	CreatedAt      time.Time       `gorm:"autoCreateTime" json:"created_at"`
+
+	Account *Account `gorm:"foreignKey:AccountID" json:"account,omitempty"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveCarryOverRecord) TableName() string {
+	return "leave_carry_over_records"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (r *LeaveCarryOverRecord) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return
+}
+
+// LeaveYearEndTotal 年底結算報表中某假別的合計 (非資料表)
+type LeaveYearEndTotal struct {
+	LeaveType      string          `json:"leave_type"`
+	Accounts       int             `json:"accounts"`
+	ClosingBalance decimal.Decimal `json:"closing_balance"`
+	CarriedOver    decimal.Decimal `json:"carried_over"`
+	Forfeited      decimal.Decimal `json:"forfeited"`
+}
+
+// LeaveYearEndSummary 年底結算報表 (非資料表)
+type LeaveYearEndSummary struct {
+	Year    int                    `json:"year"`
+	Totals  []LeaveYearEndTotal    `json:"totals"`
+	Records []LeaveCarryOverRecord `json:"records"`
+}
+
+// LeaveBalanceSettlement 離職時某假別的結算結果 (非資料表)
+type LeaveBalanceSettlement struct {
+	LeaveType          string          `json:"leave_type"`
+	ProRatedAdjustment decimal.Decimal `json:"pro_rated_adjustment"` // 離職日後尚未賺得而扣回的累積天數 (0 或負數)
+	Remaining          decimal.Decimal `json:"remaining"`            // 結算後的剩餘天數
+}
+
+// LeaveBalance 某假別目前的餘額 (由帳本加總而來, 非資料表)
+type LeaveBalance struct {
+	LeaveType string          `gorm:"column:leave_type" json:"leave_type"`
+	Balance   decimal.Decimal `gorm:"column:balance" json:"balance"`
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxLeaveCalendarDays 單次查詢團隊請假行事曆的最長天數
+const MaxLeaveCalendarDays = 93
+
+// LeaveCalendarQuery 定義團隊請假行事曆的查詢條件 (非資料表)
+type LeaveCalendarQuery struct {
+	From           time.Time
+	To             time.Time
+	IncludePending bool       // 是否一併列出待審核的假單
+	ManagerID      *uuid.UUID // 指定團隊 (主管本人與其直屬部屬); nil 時 HR 查看全公司, 其他人查看自己所屬的團隊
+}
+
+// LeaveCalendarDay 行事曆中某一天有請假的假單 (非資料表)
+type LeaveCalendarDay struct {
+	Date     time.Time
+	Requests []LeaveRequest
+}
+
+// 訂閱行事曆的種類
+const (
+	CalendarFeedAccount = "account" // 個人行事曆, SubjectID 為帳戶
+	CalendarFeedTeam    = "team"    // 團隊行事曆, SubjectID 為團隊主管
+)
+
+// CalendarFeedTokenPurpose 標記 feed token 的用途, 避免與其他簽署連結 (例如 Email 審核連結) 互換使用
+const CalendarFeedTokenPurpose = "calendar_feed"
+
+// CalendarFeedToken 是 iCalendar 訂閱網址中經簽署的內容 (非資料表)
+// 只能讀取簽發時指定的一個行事曆, 不能作為登入 token; 每次讀取時仍會重新檢查訂閱者的權限
+type CalendarFeedToken struct {
+	Purpose   string    `json:"purpose"`
+	Feed      string    `json:"feed"`       // CalendarFeedAccount 或 CalendarFeedTeam
+	SubjectID uuid.UUID `json:"subject_id"` // 個人行事曆的帳戶或團隊主管
+	ViewerID  uuid.UUID `json:"viewer_id"`  // 訂閱者
+	Version   uint      `json:"version"`    // 簽發時訂閱者的 Account.FeedVersion, 不一致時視為已撤銷
+	IssuedAt  time.Time `json:"issued_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// DefaultCalendarFeedTTLDays 訂閱網址的預設有效天數, 到期後需重新簽發
+const DefaultCalendarFeedTTLDays = 180
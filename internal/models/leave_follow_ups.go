@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// 逾時未審假單的預設處理門檻
+const (
+	DefaultLeaveReminderAfterHours = 48  // 待審超過此時數提醒審核人, 之後每隔相同時數再提醒一次
+	DefaultLeaveEscalateAfterHours = 120 // 待審超過此時數升級通知 Super Admin (只通知一次)
+)
+
+// LeaveFollowUpSettings 排程處理逾時未審假單的設定 (非資料表)
+type LeaveFollowUpSettings struct {
+	ReminderAfter time.Duration // 提醒門檻, 0 表示不提醒
+	EscalateAfter time.Duration // 升級門檻, 0 表示不升級
+
+	// AutoApproveSickMaxDays 待審超過提醒門檻、且天數不超過此值的病假由系統自動核准; 0 表示停用
+	AutoApproveSickMaxDays decimal.Decimal
+}
+
+// LeaveFollowUpReport 單次排程執行的處理結果 (非資料表)
+type LeaveFollowUpReport struct {
+	Checked      int `json:"checked"`       // 超過提醒門檻的待審假單數
+	Reminded     int `json:"reminded"`      // 已提醒審核人的假單數
+	Escalated    int `json:"escalated"`     // 已升級給 Super Admin 的假單數
+	AutoApproved int `json:"auto_approved"` // 自動核准的病假數
+	Failed       int `json:"failed"`        // 處理失敗的假單數 (下次排程重試)
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// --- 假單事件類型 ---
+const (
+	LeaveEventSubmitted             = "submitted"              // 員工提交申請
+	LeaveEventStepApproved          = "step_approved"          // 多段審核中的某一步驟通過
+	LeaveEventApproved              = "approved"               // 最後一個步驟通過, 假單核准
+	LeaveEventRejected              = "rejected"               // 任一步驟拒絕
+	LeaveEventCancellationRequested = "cancellation_requested" // 已核准假單申請取消
+	LeaveEventCancelled             = "cancelled"              // 員工撤回或 HR 確認取消
+	LeaveEventCancellationDeclined  = "cancellation_declined"  // HR 駁回取消申請
+	LeaveEventRuleOverridden        = "rule_overridden"        // HR 覆寫封鎖期間或人力規則 (Comment 記錄理由)
+	LeaveEventEdited                = "edited"                 // 申請人修改待審假單 (Comment 記錄修改前後的欄位)
+	LeaveEventReminderSent          = "reminder_sent"          // 排程提醒逾時未審的審核人 (Comment 記錄收件人)
+	LeaveEventEscalated             = "escalated"              // 逾時過久, 排程升級通知 Super Admin
+	LeaveEventShortened             = "shortened"              // 跨越離職日的假單截短至離職日 (Comment 記錄原訖日與天數)
+)
+
+// LeaveRequestEvent 記錄假單的每一次狀態變化 (只新增, 不修改)
+type LeaveRequestEvent struct {
+	ID             uuid.UUID  `gorm:"type:char(36);primaryKey" json:"id"`
+	LeaveRequestID uuid.UUID  `gorm:"type:char(36);not null;index" json:"leave_request_id"`
+	EventType      string     `gorm:"type:varchar(30);not null" json:"event_type"`
+	FromStatus     string     `gorm:"type:varchar(30)" json:"from_status,omitempty"` // 提交事件為空
+	ToStatus       string     `gorm:"type:varchar(30);not null" json:"to_status"`
+	ActorID        *uuid.UUID `gorm:"type:char(36);index" json:"actor_id,omitempty"`  // 執行此動作的帳戶
+	OnBehalfOfID   *uuid.UUID `gorm:"type:char(36)" json:"on_behalf_of_id,omitempty"` // 代理審核時的委託人
+	Comment        string     `gorm:"type:text" json:"comment,omitempty"`
+	CreatedAt      time.Time  `gorm:"autoCreateTime;index" json:"created_at"`
+
+	// --- GORM 關聯 ---
+	Actor *Account `gorm:"foreignKey:ActorID" json:"actor,omitempty"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveRequestEvent) TableName() string {
+	return "leave_request_events"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (e *LeaveRequestEvent) BeforeCreate(tx *gorm.DB) (err error) {
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return
+}
@@ -4,42 +4,73 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
 // --- 請假狀態常量 (保持不變) ---
 const (
-	LeaveStatusPending  = "pending"
-	LeaveStatusApproved = "approved"
-	LeaveStatusRejected = "rejected"
-	// LeaveStatusCancelled = "cancelled" // 如果將來需要
+	LeaveStatusPending               = "pending"
+	LeaveStatusApproved              = "approved"
+	LeaveStatusRejected              = "rejected"
+	LeaveStatusCancelled             = "cancelled"              // 員工撤回 (待審核) 或 HR 確認取消 (已核准)
+	LeaveStatusCancellationRequested = "cancellation_requested" // 已核准的未來假單, 員工申請取消, 等待 HR 確認
 )
 
-// --- 請假類型常量 (修正可能的拼寫錯誤) ---
+// --- 預設假別代碼 (由 seeds 植入 leave_types 表; 實際可申請的假別以 leave_types 為準) ---
 const (
 	LeaveTypeAnnual   = "annual"   // 年假
 	LeaveTypeSick     = "sick"     // 病假
 	LeaveTypePersonal = "personal" // 事假
 	LeaveTypeVacation = "vacation" // 渡假
+	LeaveTypeCompOff  = "comp_off" // 補休 (由核准的加班時數換得, 到期未用完失效)
 )
 
+// --- 請假時長單位常量 ---
+const (
+	LeaveDurationFullDay   = "full_day"    // 整天 (可跨多日)
+	LeaveDurationHalfDayAM = "half_day_am" // 上午半天
+	LeaveDurationHalfDayPM = "half_day_pm" // 下午半天
+	LeaveDurationHours     = "hours"       // 依小時 (需提供起訖時間)
+)
+
+// StandardWorkingHoursPerDay 一個工作日的標準工時, 用於將小時換算為天數
+const StandardWorkingHoursPerDay = 8
+
 // LeaveRequest 定義了請假申請的模型 (已更新以適應 Account 拆分)
 type LeaveRequest struct {
 	ID uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
 
-	AccountID uuid.UUID `gorm:"type:char(36);not null;index" json:"account_id"` // 申請人帳戶 ID (原 EmployeeID)
+	AccountID   uuid.UUID  `gorm:"type:char(36);not null;index" json:"account_id"`     // 申請人帳戶 ID (原 EmployeeID)
+	CreatedByID *uuid.UUID `gorm:"type:char(36);index" json:"created_by_id,omitempty"` // 由 HR 代為提交時的建立者帳戶 ID; 申請人自行提交時為空
 
-	LeaveType string    `gorm:"type:varchar(50);not null;index" json:"leave_type"` // 假別
-	StartDate time.Time `gorm:"type:date;not null;index" json:"start_date"`
-	EndDate   time.Time `gorm:"type:date;not null;index" json:"end_date"`
-	Reason    string    `gorm:"type:text" json:"reason,omitempty"`
-	Status    string    `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	LeaveType string          `gorm:"type:varchar(50);not null;index" json:"leave_type"` // 假別
+	StartDate time.Time       `gorm:"type:date;not null;index" json:"start_date"`
+	EndDate   time.Time       `gorm:"type:date;not null;index" json:"end_date"`
+	Days      decimal.Decimal `gorm:"type:decimal(6,2);not null;default:0" json:"days"` // 實際占用的工作日數 (扣除週末與假日)
+	Reason    string          `gorm:"type:text" json:"reason,omitempty"`                // 員工填寫的請假原因
+	Status    string          `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	Unpaid    bool            `gorm:"not null;default:false" json:"unpaid"` // 申請時假別為無薪假: 不檢查、不扣除假期餘額
 
-	ApproverID *uuid.UUID `gorm:"type:char(36);index" json:"approver_id,omitempty"` // 審核人帳戶 ID ( nullable )
+	DurationUnit string `gorm:"type:varchar(20);not null;default:'full_day'" json:"duration_unit"` // 時長單位
+	StartTime    string `gorm:"type:varchar(5)" json:"start_time,omitempty"`                       // HH:MM, 僅 hours 單位使用
+	EndTime      string `gorm:"type:varchar(5)" json:"end_time,omitempty"`                         // HH:MM, 僅 hours 單位使用
+
+	ApproverID   *uuid.UUID `gorm:"type:char(36);index" json:"approver_id,omitempty"`     // 審核人帳戶 ID ( nullable )
+	OnBehalfOfID *uuid.UUID `gorm:"type:char(36);index" json:"on_behalf_of_id,omitempty"` // 代理審核時, 原審核人 (委託人) 帳戶 ID
+	DecisionNote string     `gorm:"type:text" json:"decision_note,omitempty"`             // 審核人的決定說明 (例如拒絕原因)
+
+	OverriddenRuleID *uuid.UUID `gorm:"type:char(36)" json:"overridden_rule_id,omitempty"` // HR 提交時覆寫的封鎖期間 / 人力規則, 審核時不再以此規則阻擋
+	RuleOverrideNote string     `gorm:"type:text" json:"rule_override_note,omitempty"`     // 覆寫規則的理由
 
 	RequestedAt time.Time  `gorm:"column:requested_at;not null;autoCreateTime" json:"requested_at"`
 	ApprovedAt  *time.Time `gorm:"index" json:"approved_at,omitempty"`
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"` // 取消生效時間
 	UpdatedAt   time.Time  `gorm:"autoUpdateTime" json:"updated_at"`
+	Version     int        `gorm:"not null;default:1" json:"version"` // 樂觀鎖版本號, 每次更新加一 (提醒 / 升級時間的更新不計)
+
+	LastRemindedAt *time.Time `json:"last_reminded_at,omitempty"` // 排程最近一次提醒審核人的時間
+	EscalatedAt    *time.Time `json:"escalated_at,omitempty"`     // 逾時升級給 Super Admin 的時間
 
 	// --- GORM 關聯 ( 為關聯到 Account) ---
 	Account  Account  `gorm:"foreignKey:AccountID" json:"account,omitempty"`   // 關聯到申請人帳戶
@@ -52,10 +83,78 @@ func (LeaveRequest) TableName() string {
 	return "leave_requests"
 }
 
-// BeforeCreate GORM Hook (保持不變)
+// BeforeCreate GORM Hook: 自動產生 UUID 並設定初始版本號
 func (lr *LeaveRequest) BeforeCreate(tx *gorm.DB) (err error) {
 	if lr.ID == uuid.Nil {
 		lr.ID = uuid.New()
 	}
+	if lr.Version == 0 {
+		lr.Version = 1
+	}
 	return
 }
+
+// LeaveRequestInput 定義提交請假申請時的輸入資料 (非資料表)
+type LeaveRequestInput struct {
+	LeaveType    string
+	Reason       string
+	StartDate    time.Time
+	EndDate      time.Time
+	DurationUnit string             // 空字串視為 full_day
+	StartTime    string             // HH:MM, 僅 hours 單位使用
+	EndTime      string             // HH:MM, 僅 hours 單位使用
+	Attachments  []AttachmentUpload // 申請時一併上傳的佐證文件, 可為空
+
+	OverrideJustification string // 違反封鎖期間或人力規則時的覆寫理由, 僅 HR / Super Admin 可填寫
+}
+
+// --- 批次審核 ---
+const (
+	LeaveDecisionApprove = "approve"
+	LeaveDecisionReject  = "reject"
+
+	MaxBulkLeaveDecisions = 100 // 單次批次審核的假單數上限
+)
+
+// 批次審核中單張假單的處理結果
+const (
+	BulkLeaveResultSucceeded    = "succeeded"
+	BulkLeaveResultNotFound     = "not_found"
+	BulkLeaveResultInvalidID    = "invalid_id"    // 假單 ID 不是合法的 UUID
+	BulkLeaveResultInvalidState = "invalid_state" // 假單已不是待審狀態
+	BulkLeaveResultFailed       = "failed"        // 其他原因 (餘額不足、規則限制、權限等)，原因見 Message
+)
+
+// BulkLeaveDecisionResult 批次審核中單張假單的處理結果 (非資料表)
+type BulkLeaveDecisionResult struct {
+	LeaveRequestID string `json:"leave_request_id"`
+	Result         string `json:"result"`
+	Message        string `json:"message,omitempty"`
+}
+
+// HR 假單列表可排序的欄位
+const (
+	LeaveSortRequestedAt = "requested_at"
+	LeaveSortStartDate   = "start_date"
+	LeaveSortDays        = "days"
+	LeaveSortStatus      = "status"
+)
+
+// HR 假單列表的分頁預設值與上限
+const (
+	DefaultLeaveRequestPageSize = 20
+	MaxLeaveRequestPageSize     = 100
+)
+
+// LeaveRequestFilter 定義 HR 查詢假單列表的過濾、排序與分頁條件 (非資料表)
+type LeaveRequestFilter struct {
+	Statuses  []string   // 空值表示不限狀態
+	LeaveType string     // 空值表示不限假別
+	From      *time.Time // 假期與 [From, To] 有交集即符合
+	To        *time.Time
+	Applicant string // 以申請人 email 或姓名模糊搜尋
+	SortBy    string // LeaveSort* 之一, 空值為 requested_at
+	SortDesc  bool
+	Page      int // 從 1 開始
+	PageSize  int
+}
@@ -0,0 +1,94 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// --- 阻擋假單的規則類型 ---
+const (
+	LeaveRuleBlackout    = "blackout"     // 封鎖期間內不可請假
+	LeaveRuleMinStaffing = "min_staffing" // 部門每日最少在班人數
+)
+
+// LeaveBlackoutPeriod 定義不可請假的期間
+// Department 為空表示全公司適用，LeaveType 為空表示所有假別適用
+type LeaveBlackoutPeriod struct {
+	ID         uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Name       string    `gorm:"type:varchar(100);not null" json:"name"` // 例如 "Q2 closing"
+	Department string    `gorm:"type:varchar(50);index" json:"department,omitempty"`
+	LeaveType  string    `gorm:"type:varchar(50);index" json:"leave_type,omitempty"`
+	StartDate  time.Time `gorm:"type:date;not null;index" json:"start_date"`
+	EndDate    time.Time `gorm:"type:date;not null;index" json:"end_date"`
+	Active     bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveBlackoutPeriod) TableName() string {
+	return "leave_blackout_periods"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (b *LeaveBlackoutPeriod) BeforeCreate(tx *gorm.DB) (err error) {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return
+}
+
+// Blocks 判斷此封鎖期間是否擋下指定部門、假別在 [start, end] 的請假
+func (b LeaveBlackoutPeriod) Blocks(department, leaveType string, start, end time.Time) bool {
+	if !b.Active {
+		return false
+	}
+	if b.Department != "" && b.Department != department {
+		return false
+	}
+	if b.LeaveType != "" && b.LeaveType != leaveType {
+		return false
+	}
+	return !start.After(b.EndDate) && !end.Before(b.StartDate)
+}
+
+// LeaveStaffingRule 定義部門每個工作日至少需要的在班人數
+type LeaveStaffingRule struct {
+	ID         uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Name       string    `gorm:"type:varchar(100);not null" json:"name"`
+	Department string    `gorm:"type:varchar(50);not null;index" json:"department"`
+	MinOnDuty  int       `gorm:"not null" json:"min_on_duty"`
+	Active     bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveStaffingRule) TableName() string {
+	return "leave_staffing_rules"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (r *LeaveStaffingRule) BeforeCreate(tx *gorm.DB) (err error) {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return
+}
+
+// LeaveRuleViolation 說明假單被哪一條規則擋下 (返回給客戶端)
+type LeaveRuleViolation struct {
+	Rule       string     `json:"rule"` // blackout / min_staffing
+	RuleID     uuid.UUID  `json:"rule_id"`
+	RuleName   string     `json:"rule_name"`
+	Department string     `json:"department,omitempty"`
+	LeaveType  string     `json:"leave_type,omitempty"`
+	StartDate  *time.Time `json:"start_date,omitempty"` // 封鎖期間
+	EndDate    *time.Time `json:"end_date,omitempty"`
+	Date       *time.Time `json:"date,omitempty"` // 人力不足的第一個工作日
+	MinOnDuty  int        `json:"min_on_duty,omitempty"`
+	OnDuty     *int       `json:"on_duty,omitempty"` // 核准此假單後當日的在班人數 (可能為 0)
+	Message    string     `json:"message"`
+}
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// LeaveType 定義一種可申請的假別，由 HR 維護 (LeaveTypeAnnual 等常量為預設植入的假別代碼)
+type LeaveType struct {
+	ID                 uuid.UUID `gorm:"type:char(36);primaryKey" json:"id"`
+	Code               string    `gorm:"type:varchar(50);not null;uniqueIndex" json:"code"`          // 假單 leave_type 欄位存放的代碼, 建立後不可修改
+	Name               string    `gorm:"type:varchar(100);not null" json:"name"`                     // 顯示名稱, 例如 "年假"
+	Paid               bool      `gorm:"not null" json:"paid"`                                       // 無薪假不檢查也不扣除假期餘額
+	RequiresAttachment bool      `gorm:"not null;default:false" json:"requires_attachment"`          // 不論天數一律需附佐證文件
+	MaxConsecutiveDays int       `gorm:"not null;default:0" json:"max_consecutive_days"`             // 單張假單最多連續的日曆天數, 0 表示不限
+	EligibleRoles      string    `gorm:"type:varchar(20);not null;default:''" json:"eligible_roles"` // 以逗號分隔的可申請角色, 空字串表示所有角色
+	Active             bool      `gorm:"not null;default:true" json:"active"`
+	CreatedAt          time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt          time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (LeaveType) TableName() string {
+	return "leave_types"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (lt *LeaveType) BeforeCreate(tx *gorm.DB) (err error) {
+	if lt.ID == uuid.Nil {
+		lt.ID = uuid.New()
+	}
+	return
+}
+
+// Roles 解析 EligibleRoles 欄位，空值 (或格式錯誤) 返回 nil 表示所有角色
+func (lt LeaveType) Roles() []uint8 {
+	roles, err := ParseEligibleRoles(lt.EligibleRoles)
+	if err != nil {
+		return nil
+	}
+	return roles
+}
+
+// AllowsRole 判斷指定角色是否可申請此假別
+func (lt LeaveType) AllowsRole(role uint8) bool {
+	roles := lt.Roles()
+	if len(roles) == 0 {
+		return true
+	}
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseEligibleRoles 將 "1,2" 格式的字串解析為角色列表
+func ParseEligibleRoles(s string) ([]uint8, error) {
+	var roles []uint8
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n < int(RoleSuperAdmin) || n > int(RoleEmployee) {
+			return nil, fmt.Errorf("invalid role value '%s'", part)
+		}
+		roles = append(roles, uint8(n))
+	}
+	return roles, nil
+}
+
+// FormatEligibleRoles 將角色列表格式化為排序後、去重的 "1,2" 字串
+func FormatEligibleRoles(roles []uint8) string {
+	seen := make(map[uint8]bool, len(roles))
+	values := make([]int, 0, len(roles))
+	for _, r := range roles {
+		if !seen[r] {
+			seen[r] = true
+			values = append(values, int(r))
+		}
+	}
+	sort.Ints(values)
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		parts = append(parts, strconv.Itoa(v))
+	}
+	return strings.Join(parts, ",")
+}
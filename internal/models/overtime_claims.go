@@ -0,0 +1,64 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// --- 加班申請狀態常量 ---
+const (
+	OvertimeStatusPending  = "pending"
+	OvertimeStatusApproved = "approved"
+	OvertimeStatusRejected = "rejected"
+)
+
+// MaxOvertimeHoursPerClaim 單筆加班申請的時數上限
+const MaxOvertimeHoursPerClaim = 24
+
+// DefaultCompOffExpiryDays 補休自加班日起的預設有效天數
+const DefaultCompOffExpiryDays = 90
+
+// OvertimeClaim 定義了加班申請的模型; HR 核准後依時數換算為補休 (comp_off) 存入假期帳本
+type OvertimeClaim struct {
+	ID        uuid.UUID       `gorm:"type:char(36);primaryKey" json:"id"`
+	AccountID uuid.UUID       `gorm:"type:char(36);not null;index" json:"account_id"` // 申請人帳戶 ID
+	WorkDate  time.Time       `gorm:"type:date;not null;index" json:"work_date"`      // 加班日期
+	Hours     decimal.Decimal `gorm:"type:decimal(5,2);not null" json:"hours"`        // 加班時數
+	Reason    string          `gorm:"type:text" json:"reason"`                        // 加班原因, 例如處理的事故
+	Status    string          `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+
+	ProcessedByID *uuid.UUID `gorm:"type:char(36);index" json:"processed_by_id,omitempty"` // 核准或拒絕的 HR 帳戶 ID
+	ProcessedAt   *time.Time `json:"processed_at,omitempty"`
+	DecisionNote  string     `gorm:"type:text" json:"decision_note,omitempty"` // 拒絕原因
+
+	CompOffDays      decimal.Decimal `gorm:"type:decimal(6,2);not null;default:0" json:"comp_off_days"` // 核准後換得的補休天數
+	CompOffExpiresOn *time.Time      `gorm:"type:date" json:"comp_off_expires_on,omitempty"`            // 補休的最後可用日
+
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+
+	Account Account `gorm:"foreignKey:AccountID" json:"account,omitempty"` // 關聯到申請人帳戶
+}
+
+// TableName 指定 GORM 對應的表格名稱
+func (OvertimeClaim) TableName() string {
+	return "overtime_claims"
+}
+
+// BeforeCreate GORM Hook: 在建立記錄前自動產生 UUID
+func (oc *OvertimeClaim) BeforeCreate(tx *gorm.DB) (err error) {
+	if oc.ID == uuid.Nil {
+		oc.ID = uuid.New()
+	}
+	return
+}
+
+// OvertimeClaimInput 定義提交加班申請時的輸入資料 (非資料表)
+type OvertimeClaimInput struct {
+	WorkDate time.Time
+	Hours    decimal.Decimal
+	Reason   string
+}
@@ -0,0 +1,18 @@
+package models
+
+import "context"
+
+// expectedVersionKey 是 context 中客戶端預期版本號的 key
+type expectedVersionKey struct{}
+
+// WithExpectedVersion 將客戶端 If-Match 標頭帶來的版本號放入 ctx
+// Service 在更新假單、僱傭記錄或職等前比對記錄目前的版本號，不同時返回 ErrConcurrentModification
+func WithExpectedVersion(ctx context.Context, version int) context.Context {
+	return context.WithValue(ctx, expectedVersionKey{}, version)
+}
+
+// ExpectedVersionFromContext 返回 ctx 中客戶端預期的版本號; 未設定時 ok 為 false
+func ExpectedVersionFromContext(ctx context.Context) (version int, ok bool) {
+	version, ok = ctx.Value(expectedVersionKey{}).(int)
+	return version, ok
+}
@@ -0,0 +1,88 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+)
+
+// lockKeyPrefix 排程鎖在 Redis 中的 key 前綴
+const lockKeyPrefix = "scheduler:lock:"
+
+// Job 定義一個定期執行的背景工作
+type Job struct {
+	Name       string                          // 工作名稱, 同時作為鎖的 key
+	Interval   time.Duration                   // 執行間隔
+	RunOnStart bool                            // 啟動時先執行一次 (仍需取得鎖), 避免間隔較長的工作因重啟而遲遲不執行
+	Run        func(ctx context.Context) error // 工作內容
+}
+
+// Scheduler 在伺服器內定期執行背景工作
+// 多副本部署時以 CacheRepository.SetNX 取得鎖: 每個間隔內只有取得鎖的副本會執行工作。
+// 鎖不主動釋放，而是在一個間隔後過期，避免其他副本在同一間隔內稍晚觸發時重複執行
+type Scheduler struct {
+	cache interfaces.CacheRepository
+	owner string // 寫入鎖的值, 便於從 Redis 得知由哪個副本執行
+	jobs  []Job
+}
+
+// NewScheduler 構造函數
+func NewScheduler(cache interfaces.CacheRepository) *Scheduler {
+	hostname, _ := os.Hostname()
+	return &Scheduler{cache: cache, owner: fmt.Sprintf("%s:%d", hostname, os.Getpid())}
+}
+
+// Register 註冊一個背景工作; Interval 不大於 0 的工作視為停用
+func (s *Scheduler) Register(job Job) {
+	if job.Interval <= 0 {
+		log.Printf("Scheduler: job %s disabled (interval %s)", job.Name, job.Interval)
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// Start 為每個工作啟動一個 goroutine，ctx 取消時停止
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.loop(ctx, job)
+		log.Printf("Scheduler: job %s started (every %s)", job.Name, job.Interval)
+	}
+}
+
+// loop 依間隔觸發工作直到 ctx 取消
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	if job.RunOnStart {
+		if _, err := s.RunOnce(ctx, job); err != nil {
+			log.Printf("Scheduler: job %s failed: %v", job.Name, err)
+		}
+	}
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.RunOnce(ctx, job); err != nil {
+				log.Printf("Scheduler: job %s failed: %v", job.Name, err)
+			}
+		}
+	}
+}
+
+// RunOnce 嘗試取得鎖並執行一次工作，返回是否實際執行
+// 鎖已被其他副本持有時不執行; 無法連線 Redis 時也不執行，以免多個副本同時執行
+func (s *Scheduler) RunOnce(ctx context.Context, job Job) (bool, error) {
+	acquired, err := s.cache.SetNX(ctx, lockKeyPrefix+job.Name, s.owner, job.Interval)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock: %w", err)
+	}
+	if !acquired {
+		return false, nil
+	}
+	return true, job.Run(ctx)
+}
@@ -0,0 +1,97 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler_RunOnce(t *testing.T) {
+	ctx := context.Background()
+	jobErr := errors.New("job error")
+
+	testCases := []struct {
+		name          string
+		lockAcquired  bool
+		lockErr       error
+		runErr        error
+		expectRun     bool
+		expectedError error
+	}{
+		{name: "Success - Lock Acquired Runs Job", lockAcquired: true, expectRun: true},
+		{name: "Skipped - Lock Held By Another Replica", lockAcquired: false},
+		{name: "Failure - Lock Error Does Not Run Job", lockErr: errors.New("redis down")},
+		{name: "Failure - Job Error", lockAcquired: true, runErr: jobErr, expectRun: true, expectedError: jobErr},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCache := mocks.NewMockCacheRepository(ctrl)
+			s := NewScheduler(mockCache)
+
+			ran := false
+			job := Job{Name: "leave-follow-up", Interval: 15 * time.Minute, Run: func(ctx context.Context) error {
+				ran = true
+				return tc.runErr
+			}}
+			mockCache.EXPECT().SetNX(gomock.Any(), "scheduler:lock:leave-follow-up", gomock.Any(), 15*time.Minute).
+				Return(tc.lockAcquired, tc.lockErr).Times(1)
+
+			executed, err := s.RunOnce(ctx, job)
+
+			assert.Equal(t, tc.expectRun, ran)
+			assert.Equal(t, tc.expectRun, executed)
+			switch {
+			case tc.expectedError != nil:
+				assert.ErrorIs(t, err, tc.expectedError)
+			case tc.lockErr != nil:
+				assert.ErrorIs(t, err, tc.lockErr)
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheduler_Register(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	s := NewScheduler(mocks.NewMockCacheRepository(ctrl))
+
+	s.Register(Job{Name: "disabled", Interval: 0, Run: func(ctx context.Context) error { return nil }})
+	s.Register(Job{Name: "enabled", Interval: time.Minute, Run: func(ctx context.Context) error { return nil }})
+
+	assert.Len(t, s.jobs, 1)
+	assert.Equal(t, "enabled", s.jobs[0].Name)
+}
+
+func TestScheduler_StartRunOnStart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockCache := mocks.NewMockCacheRepository(ctrl)
+	s := NewScheduler(mockCache)
+
+	ran := make(chan struct{}, 1)
+	s.Register(Job{Name: "long-leave-status", Interval: 24 * time.Hour, RunOnStart: true, Run: func(ctx context.Context) error {
+		ran <- struct{}{}
+		return nil
+	}})
+	mockCache.EXPECT().SetNX(gomock.Any(), "scheduler:lock:long-leave-status", gomock.Any(), 24*time.Hour).Return(true, nil).Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		t.Fatal("job with RunOnStart was not run at start")
+	}
+}
@@ -18,6 +18,22 @@ func Run(db *gorm.DB) error {
 		log.Printf("Failed to seed employees: %v", err)
 	}
 
+	if err := SeedLeaveTypes(db); err != nil {
+		log.Printf("Failed to seed leave types: %v", err)
+	}
+
+	if err := SeedLeaveAccrualRules(db); err != nil {
+		log.Printf("Failed to seed leave accrual rules: %v", err)
+	}
+
+	if err := SeedLeaveApprovalPolicies(db); err != nil {
+		log.Printf("Failed to seed leave approval policies: %v", err)
+	}
+
+	if err := SeedLeaveAttachmentRules(db); err != nil {
+		log.Printf("Failed to seed leave attachment rules: %v", err)
+	}
+
 	if err := SeedLeaveRequests(db); err != nil {
 		log.Printf("Failed to seed leave requests: %v", err)
 	}
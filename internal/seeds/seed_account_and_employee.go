@@ -71,45 +71,45 @@ func SeedAccountsAndEmployments(db *gorm.DB) (err error) { // Named return err f
 		// === Super User ===
 		{
 			Account:    models.Account{FirstName: "Super", LastName: "Admin", Email: "super@example.com", Password: hashedPassword, Role: models.RoleSuperAdmin},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("System Administrator"), JobGradeID: nil}, // Super Admin 可能沒有職等
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("System Administrator"), Department: "IT", JobGradeID: nil}, // Super Admin 可能沒有職等
 		},
 		// === HR Users (分配 M1 或 M2 職等) ===
 		{
 			Account:    models.Account{FirstName: "HR", LastName: "Manager", Email: "hr@example.com", Password: hashedPassword, Role: models.RoleHR},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("HR Manager"), JobGradeID: getGradeIDPtr("M2")}, // <<< 分配 M2
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("HR Manager"), Department: "HR", JobGradeID: getGradeIDPtr("M2")}, // <<< 分配 M2
 		},
 		{
 			Account:    models.Account{FirstName: "Helen", LastName: "Resource", Email: "helen.r@example.com", Password: hashedPassword, Role: models.RoleHR},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("HR Specialist"), JobGradeID: getGradeIDPtr("M1")}, // <<< 分配 M1
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("HR Specialist"), Department: "HR", JobGradeID: getGradeIDPtr("M1")}, // <<< 分配 M1
 		},
 		{
 			Account:    models.Account{FirstName: "Harry", LastName: "Rules", Email: "harry.r@example.com", Password: hashedPassword, Role: models.RoleHR},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("HR Assistant"), JobGradeID: getGradeIDPtr("P3")}, // <<< 分配 P3 (假設)
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("HR Assistant"), Department: "HR", JobGradeID: getGradeIDPtr("P3")}, // <<< 分配 P3 (假設)
 		},
 		// === Regular Employees (分配 P1, P2, P3 職等) ===
 		{
 			Account:    models.Account{FirstName: "John", LastName: "Doe", Email: "john.doe@example.com", Password: hashedPassword, Role: models.RoleEmployee},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("Software Engineer"), JobGradeID: getGradeIDPtr("P2")}, // <<< 分配 P2
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("Software Engineer"), Department: "Engineering", JobGradeID: getGradeIDPtr("P2")}, // <<< 分配 P2
 		},
 		{
 			Account:    models.Account{FirstName: "Alice", LastName: "Chiang", Email: "alice.chiang@example.com", Password: hashedPassword, Role: models.RoleEmployee},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("Frontend Developer"), JobGradeID: getGradeIDPtr("P2")}, // <<< 分配 P2
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("Frontend Developer"), Department: "Engineering", JobGradeID: getGradeIDPtr("P2")}, // <<< 分配 P2
 		},
 		{
 			Account:    models.Account{FirstName: "Danny", LastName: "Paul", Email: "danny.paul@example.com", Password: hashedPassword, Role: models.RoleEmployee},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("Backend Developer"), JobGradeID: getGradeIDPtr("P3")}, // <<< 分配 P3
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("Backend Developer"), Department: "Engineering", JobGradeID: getGradeIDPtr("P3")}, // <<< 分配 P3
 		},
 		{
 			Account:    models.Account{FirstName: "Frank", LastName: "Bessle", Email: "frank.bessle@example.com", Password: hashedPassword, Role: models.RoleEmployee},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("QA Engineer"), JobGradeID: getGradeIDPtr("P1")}, // <<< 分配 P1
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("QA Engineer"), Department: "Engineering", JobGradeID: getGradeIDPtr("P1")}, // <<< 分配 P1
 		},
 		{
 			Account:    models.Account{FirstName: "Grace", LastName: "Hopper", Email: "grace.hopper@example.com", Password: hashedPassword, Role: models.RoleEmployee},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("System Analyst"), JobGradeID: getGradeIDPtr("P3")}, // <<< 分配 P3
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("System Analyst"), Department: "Engineering", JobGradeID: getGradeIDPtr("P3")}, // <<< 分配 P3
 		},
 		{
 			Account:    models.Account{FirstName: "Alan", LastName: "Turing", Email: "alan.turing@example.com", Password: hashedPassword, Role: models.RoleEmployee},
-			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("Data Scientist"), JobGradeID: getGradeIDPtr("P3")}, // <<< 分配 P3
+			Employment: models.Employment{HireDate: &hireDate, Status: models.EmploymentStatusActive, PositionTitle: ("Data Scientist"), Department: "Engineering", JobGradeID: getGradeIDPtr("P3")}, // <<< 分配 P3
 		},
 	}
 
@@ -3,6 +3,7 @@ package seeds
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/erinchen11/hr-system/internal/models"
 	"gorm.io/gorm"
@@ -22,3 +23,15 @@ func findAccountByEmail(db *gorm.DB, email string) (*models.Account, error) {
 	// ***  : 返回 *models.Account ***
 	return &account, nil
 }
+
+// weekdaysBetween 計算區間內 (含頭尾) 的週一至週五天數
+// Seed 時尚未設定假日行事曆，因此只扣除預設週末
+func weekdaysBetween(start, end time.Time) int64 {
+	count := int64(0)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,68 @@
+package seeds
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// SeedLeaveAccrualRules 負責向 leave_accrual_rules 表植入各假別的預設累積規則
+func SeedLeaveAccrualRules(db *gorm.DB) (err error) {
+	annualCarryOverCap := decimal.NewFromInt(5)
+	rules := []models.LeaveAccrualRule{
+		// 每年 15 天; 年底最多結轉 5 天，須於隔年 3 月底前用完
+		{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly, Amount: decimal.RequireFromString("1.25"),
+			CarryOverCap: &annualCarryOverCap, CarryOverExpiryMonths: 3, Active: true},
+		{LeaveType: models.LeaveTypeSick, Frequency: models.AccrualFrequencyAnnual, Amount: decimal.NewFromInt(30), Active: true},
+		{LeaveType: models.LeaveTypePersonal, Frequency: models.AccrualFrequencyAnnual, Amount: decimal.NewFromInt(14), Active: true},
+		{LeaveType: models.LeaveTypeVacation, Frequency: models.AccrualFrequencyAnnual, Amount: decimal.NewFromInt(5), Active: true},
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin seed transaction for leave accrual rules: %w", tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			log.Printf("Rolling back leave accrual rule seed transaction due to error: %v", err)
+			tx.Rollback()
+		}
+	}()
+
+	createdCount := 0
+	skippedCount := 0
+	for _, rule := range rules {
+		var existing models.LeaveAccrualRule
+		findErr := tx.Where("leave_type = ?", rule.LeaveType).First(&existing).Error
+		if findErr == nil {
+			skippedCount++
+			continue
+		}
+		if !errors.Is(findErr, gorm.ErrRecordNotFound) {
+			err = fmt.Errorf("database error checking accrual rule for %s: %w", rule.LeaveType, findErr)
+			log.Println(err)
+			return err
+		}
+		if createErr := tx.Create(&rule).Error; createErr != nil {
+			err = fmt.Errorf("failed to create accrual rule for %s: %w", rule.LeaveType, createErr)
+			log.Println(err)
+			return err
+		}
+		createdCount++
+	}
+
+	log.Printf("Leave accrual rule seeding finished. Created: %d, Skipped: %d.", createdCount, skippedCount)
+
+	err = tx.Commit().Error
+	if err != nil {
+		return fmt.Errorf("failed to commit leave accrual rule seed transaction: %w", err)
+	}
+	return nil
+}
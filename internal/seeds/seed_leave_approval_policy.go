@@ -0,0 +1,64 @@
+package seeds
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// SeedLeaveApprovalPolicies 負責向 leave_approval_policies 表植入預設審核流程
+// 長假 (5 天以上的特休 / 事假) 需主管核准後再由 HR 覆核，其餘假單沿用單一主管審核
+func SeedLeaveApprovalPolicies(db *gorm.DB) (err error) {
+	policies := []models.LeaveApprovalPolicy{
+		{LeaveType: models.LeaveTypeAnnual, MinDays: decimal.NewFromInt(5), Steps: "manager,hr", Active: true},
+		{LeaveType: models.LeaveTypePersonal, MinDays: decimal.NewFromInt(5), Steps: "manager,hr", Active: true},
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin seed transaction for leave approval policies: %w", tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			log.Printf("Rolling back leave approval policy seed transaction due to error: %v", err)
+			tx.Rollback()
+		}
+	}()
+
+	createdCount := 0
+	skippedCount := 0
+	for _, policy := range policies {
+		var existing models.LeaveApprovalPolicy
+		findErr := tx.Where("leave_type = ? AND min_days = ?", policy.LeaveType, policy.MinDays).First(&existing).Error
+		if findErr == nil {
+			skippedCount++
+			continue
+		}
+		if !errors.Is(findErr, gorm.ErrRecordNotFound) {
+			err = fmt.Errorf("database error checking approval policy for %s: %w", policy.LeaveType, findErr)
+			log.Println(err)
+			return err
+		}
+		if createErr := tx.Create(&policy).Error; createErr != nil {
+			err = fmt.Errorf("failed to create approval policy for %s: %w", policy.LeaveType, createErr)
+			log.Println(err)
+			return err
+		}
+		createdCount++
+	}
+
+	log.Printf("Leave approval policy seeding finished. Created: %d, Skipped: %d.", createdCount, skippedCount)
+
+	err = tx.Commit().Error
+	if err != nil {
+		return fmt.Errorf("failed to commit leave approval policy seed transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package seeds
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// SeedLeaveAttachmentRules 負責向 leave_attachment_rules 表植入預設附件規則
+// 依公司規定，超過兩天的病假須附上醫生證明
+func SeedLeaveAttachmentRules(db *gorm.DB) (err error) {
+	rules := []models.LeaveAttachmentRule{
+		{LeaveType: models.LeaveTypeSick, MinDays: decimal.NewFromInt(2), Active: true},
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin seed transaction for leave attachment rules: %w", tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			log.Printf("Rolling back leave attachment rule seed transaction due to error: %v", err)
+			tx.Rollback()
+		}
+	}()
+
+	createdCount := 0
+	skippedCount := 0
+	for _, rule := range rules {
+		var existing models.LeaveAttachmentRule
+		findErr := tx.Where("leave_type = ?", rule.LeaveType).First(&existing).Error
+		if findErr == nil {
+			skippedCount++
+			continue
+		}
+		if !errors.Is(findErr, gorm.ErrRecordNotFound) {
+			err = fmt.Errorf("database error checking attachment rule for %s: %w", rule.LeaveType, findErr)
+			log.Println(err)
+			return err
+		}
+		if createErr := tx.Create(&rule).Error; createErr != nil {
+			err = fmt.Errorf("failed to create attachment rule for %s: %w", rule.LeaveType, createErr)
+			log.Println(err)
+			return err
+		}
+		createdCount++
+	}
+
+	log.Printf("Leave attachment rule seeding finished. Created: %d, Skipped: %d.", createdCount, skippedCount)
+
+	err = tx.Commit().Error
+	if err != nil {
+		return fmt.Errorf("failed to commit leave attachment rule seed transaction: %w", err)
+	}
+	return nil
+}
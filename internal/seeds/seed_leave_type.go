@@ -0,0 +1,65 @@
+package seeds
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/erinchen11/hr-system/internal/models"
+	"gorm.io/gorm"
+)
+
+// SeedLeaveTypes 負責向 leave_types 表植入預設的五種假別 (皆為有薪假、所有角色可申請)
+func SeedLeaveTypes(db *gorm.DB) (err error) {
+	leaveTypes := []models.LeaveType{
+		{Code: models.LeaveTypeAnnual, Name: "年假", Paid: true, Active: true},
+		{Code: models.LeaveTypeSick, Name: "病假", Paid: true, Active: true},
+		{Code: models.LeaveTypePersonal, Name: "事假", Paid: true, Active: true},
+		{Code: models.LeaveTypeVacation, Name: "渡假", Paid: true, Active: true},
+		{Code: models.LeaveTypeCompOff, Name: "補休", Paid: true, Active: true},
+	}
+
+	tx := db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin seed transaction for leave types: %w", tx.Error)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			log.Printf("Rolling back leave type seed transaction due to error: %v", err)
+			tx.Rollback()
+		}
+	}()
+
+	createdCount := 0
+	skippedCount := 0
+	for _, leaveType := range leaveTypes {
+		var existing models.LeaveType
+		findErr := tx.Where("code = ?", leaveType.Code).First(&existing).Error
+		if findErr == nil {
+			skippedCount++
+			continue
+		}
+		if !errors.Is(findErr, gorm.ErrRecordNotFound) {
+			err = fmt.Errorf("database error checking leave type %s: %w", leaveType.Code, findErr)
+			log.Println(err)
+			return err
+		}
+		if createErr := tx.Create(&leaveType).Error; createErr != nil {
+			err = fmt.Errorf("failed to create leave type %s: %w", leaveType.Code, createErr)
+			log.Println(err)
+			return err
+		}
+		createdCount++
+	}
+
+	log.Printf("Leave type seeding finished. Created: %d, Skipped: %d.", createdCount, skippedCount)
+
+	err = tx.Commit().Error
+	if err != nil {
+		return fmt.Errorf("failed to commit leave type seed transaction: %w", err)
+	}
+	return nil
+}
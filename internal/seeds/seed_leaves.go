@@ -9,6 +9,7 @@ import (
 
 	"github.com/erinchen11/hr-system/internal/models"
 	"github.com/erinchen11/hr-system/internal/utils"
+	"github.com/shopspring/decimal"
 
 	// "github.com/erinchen11/hr-system/internal/utils" // 如果 utils.Ptr 不在 utils 中，則不需要導入
 	"gorm.io/gorm"
@@ -112,6 +113,7 @@ func SeedLeaveRequests(db *gorm.DB) (err error) { // Named return err
 
 	createdCount := 0
 	for _, req := range requests {
+		req.Days = decimal.NewFromInt(weekdaysBetween(req.StartDate, req.EndDate))
 		// BeforeCreate handles ID. autoCreateTime handles RequestedAt.
 		if createErr := tx.Create(&req).Error; createErr != nil {
 			// ***  : 使用 req.AccountID ***
@@ -120,6 +122,25 @@ func SeedLeaveRequests(db *gorm.DB) (err error) { // Named return err
 			return err // 觸發 Rollback
 		}
 		createdCount++
+
+		// 已核准的假單同步在帳本上扣除天數，保持餘額一致
+		if req.Status == models.LeaveStatusApproved {
+			key := fmt.Sprintf("debit:%s", req.ID)
+			debit := models.LeaveBalanceEntry{
+				AccountID:      req.AccountID,
+				LeaveType:      req.LeaveType,
+				EntryType:      models.LeaveEntryTypeDebit,
+				Amount:         req.Days.Neg(),
+				EffectiveDate:  *req.ApprovedAt,
+				LeaveRequestID: utils.Ptr(req.ID),
+				IdempotencyKey: &key,
+			}
+			if createErr := tx.Create(&debit).Error; createErr != nil {
+				err = fmt.Errorf("failed to create balance debit for leave request %s: %w", req.ID, createErr)
+				log.Println(err)
+				return err
+			}
+		}
 	}
 
 	log.Printf("Leave request seeding finished. Created: %d.", createdCount)
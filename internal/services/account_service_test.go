@@ -214,8 +214,8 @@ func TestAccountServiceImpl_CreateAccountWithEmployment_WithGoMock(t *testing.T)
 	employmentInput := &models.Employment{PositionTitle: "Final Dev", Status: models.EmploymentStatusActive, JobGradeID: nil, Salary: nil, HireDate: Ptr(time.Now().Truncate(24 * time.Hour)), TerminationDate: nil}
 
 	// Use exact SQL strings (User needs to verify with GORM logs)
-	accInsertQuery := "INSERT INTO `accounts` (`id`,`first_name`,`last_name`,`email`,`password`,`role`,`phone_number`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?)"
-	empInsertQuery := "INSERT INTO `employments` (`id`,`account_id`,`job_grade_id`,`position_title`,`salary`,`hire_date`,`termination_date`,`status`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?)"
+	accInsertQuery := "INSERT INTO `accounts` (`id`,`first_name`,`last_name`,`email`,`password`,`role`,`phone_number`,`feed_version`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?)"
+	empInsertQuery := "INSERT INTO `employments` (`id`,`account_id`,`job_grade_id`,`position_title`,`department`,`salary`,`hire_date`,`termination_date`,`status`,`manager_id`,`version`,`created_at`,`updated_at`) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)"
 
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -236,11 +236,11 @@ func TestAccountServiceImpl_CreateAccountWithEmployment_WithGoMock(t *testing.T)
 		mockPwHasher.EXPECT().HashPassword(gomock.Eq(defaultPassword)).Return(hashedDefaultPassword, nil).Times(1)
 		// Account Insert (sqlmock - AnyArg 會匹配 GORM 生成的任何 UUID)
 		mockSql.ExpectExec(accInsertQuery).
-			WithArgs(sqlmock.AnyArg(), localAccountInput.FirstName, localAccountInput.LastName, localAccountInput.Email, hashedDefaultPassword, localAccountInput.Role, localAccountInput.PhoneNumber, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WithArgs(sqlmock.AnyArg(), localAccountInput.FirstName, localAccountInput.LastName, localAccountInput.Email, hashedDefaultPassword, localAccountInput.Role, localAccountInput.PhoneNumber, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 		// Employment Insert (sqlmock - AnyArg 會匹配 GORM 生成的任何 UUID)
 		mockSql.ExpectExec(empInsertQuery).
-			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), localEmploymentInput.JobGradeID, localEmploymentInput.PositionTitle, localEmploymentInput.Salary, localEmploymentInput.HireDate, localEmploymentInput.TerminationDate, localEmploymentInput.Status, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), localEmploymentInput.JobGradeID, localEmploymentInput.PositionTitle, localEmploymentInput.Department, localEmploymentInput.Salary, localEmploymentInput.HireDate, localEmploymentInput.TerminationDate, localEmploymentInput.Status, localEmploymentInput.ManagerID, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 		mockSql.ExpectCommit()
 
@@ -318,7 +318,7 @@ func TestAccountServiceImpl_CreateAccountWithEmployment_WithGoMock(t *testing.T)
 		mockAccountRepo.EXPECT().GetAccountByEmail(gomock.Any(), gomock.Eq(localAccountInput.Email)).Return(nil, gorm.ErrRecordNotFound).Times(1)
 		mockPwHasher.EXPECT().HashPassword(gomock.Eq(defaultPassword)).Return(hashedDefaultPassword, nil).Times(1)
 		mockSql.ExpectExec(accInsertQuery).
-			WithArgs(sqlmock.AnyArg(), localAccountInput.FirstName, localAccountInput.LastName, localAccountInput.Email, hashedDefaultPassword, localAccountInput.Role, localAccountInput.PhoneNumber, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WithArgs(sqlmock.AnyArg(), localAccountInput.FirstName, localAccountInput.LastName, localAccountInput.Email, hashedDefaultPassword, localAccountInput.Role, localAccountInput.PhoneNumber, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnError(dbError) // Simulate DB error on account insert
 		mockSql.ExpectRollback()
 
@@ -347,11 +347,11 @@ func TestAccountServiceImpl_CreateAccountWithEmployment_WithGoMock(t *testing.T)
 		mockPwHasher.EXPECT().HashPassword(gomock.Eq(defaultPassword)).Return(hashedDefaultPassword, nil).Times(1)
 		// Account Insert succeeds
 		mockSql.ExpectExec(accInsertQuery).
-			WithArgs(sqlmock.AnyArg(), localAccountInput.FirstName, localAccountInput.LastName, localAccountInput.Email, hashedDefaultPassword, localAccountInput.Role, localAccountInput.PhoneNumber, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WithArgs(sqlmock.AnyArg(), localAccountInput.FirstName, localAccountInput.LastName, localAccountInput.Email, hashedDefaultPassword, localAccountInput.Role, localAccountInput.PhoneNumber, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 		// Employment Insert fails
 		mockSql.ExpectExec(empInsertQuery).
-			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), localEmploymentInput.JobGradeID, localEmploymentInput.PositionTitle, localEmploymentInput.Salary, localEmploymentInput.HireDate, localEmploymentInput.TerminationDate, localEmploymentInput.Status, sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), localEmploymentInput.JobGradeID, localEmploymentInput.PositionTitle, localEmploymentInput.Department, localEmploymentInput.Salary, localEmploymentInput.HireDate, localEmploymentInput.TerminationDate, localEmploymentInput.Status, localEmploymentInput.ManagerID, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).
 			WillReturnError(dbError)
 		mockSql.ExpectRollback()
 
@@ -378,8 +378,8 @@ func TestAccountServiceImpl_CreateAccountWithEmployment_WithGoMock(t *testing.T)
 		mockSql.ExpectBegin()
 		mockAccountRepo.EXPECT().GetAccountByEmail(gomock.Any(), gomock.Eq(localAccountInput.Email)).Return(nil, gorm.ErrRecordNotFound).Times(1)
 		mockPwHasher.EXPECT().HashPassword(gomock.Eq(defaultPassword)).Return(hashedDefaultPassword, nil).Times(1)
-		mockSql.ExpectExec(accInsertQuery).WithArgs(sqlmock.AnyArg(), localAccountInput.FirstName, localAccountInput.LastName, localAccountInput.Email, hashedDefaultPassword, localAccountInput.Role, localAccountInput.PhoneNumber, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
-		mockSql.ExpectExec(empInsertQuery).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), localEmploymentInput.JobGradeID, localEmploymentInput.PositionTitle, localEmploymentInput.Salary, localEmploymentInput.HireDate, localEmploymentInput.TerminationDate, localEmploymentInput.Status, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mockSql.ExpectExec(accInsertQuery).WithArgs(sqlmock.AnyArg(), localAccountInput.FirstName, localAccountInput.LastName, localAccountInput.Email, hashedDefaultPassword, localAccountInput.Role, localAccountInput.PhoneNumber, 0, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mockSql.ExpectExec(empInsertQuery).WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), localEmploymentInput.JobGradeID, localEmploymentInput.PositionTitle, localEmploymentInput.Department, localEmploymentInput.Salary, localEmploymentInput.HireDate, localEmploymentInput.TerminationDate, localEmploymentInput.Status, localEmploymentInput.ManagerID, 1, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
 		mockSql.ExpectCommit().WillReturnError(commitError) // Commit fails
 		// *** REMOVED ExpectRollback here ***
 
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// approvalDelegationServiceImpl 實現了 ApprovalDelegationService 介面
+type approvalDelegationServiceImpl struct {
+	delegationRepo interfaces.ApprovalDelegationRepository
+	accountRepo    interfaces.AccountRepository
+}
+
+// NewApprovalDelegationServiceImpl 構造函數
+func NewApprovalDelegationServiceImpl(
+	delegationRepo interfaces.ApprovalDelegationRepository,
+	accountRepo interfaces.AccountRepository,
+) interfaces.ApprovalDelegationService {
+	return &approvalDelegationServiceImpl{
+		delegationRepo: delegationRepo,
+		accountRepo:    accountRepo,
+	}
+}
+
+// CreateDelegation 建立代理: 代理人必須是其他有效帳戶，期間不可早於今天
+func (s *approvalDelegationServiceImpl) CreateDelegation(ctx context.Context, delegatorIDStr string, input models.ApprovalDelegationInput) (*models.ApprovalDelegation, error) {
+	delegatorUUID, err := uuid.Parse(delegatorIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+
+	scope := input.Scope
+	if scope == "" {
+		scope = models.DelegationScopeAll
+	}
+	switch scope {
+	case models.DelegationScopeAll, models.DelegationScopeManager, models.DelegationScopeHR:
+	default:
+		return nil, fmt.Errorf("%w: unsupported scope %q", ErrInvalidDelegation, scope)
+	}
+	if input.DelegateID == delegatorUUID {
+		return nil, fmt.Errorf("%w: cannot delegate to yourself", ErrInvalidDelegation)
+	}
+	startDate, endDate := dateOf(input.StartDate), dateOf(input.EndDate)
+	if endDate.Before(startDate) {
+		return nil, ErrInvalidDateRange
+	}
+	if endDate.Before(dateOf(time.Now())) {
+		return nil, fmt.Errorf("%w: delegation period has already ended", ErrInvalidDelegation)
+	}
+
+	if _, err := s.accountRepo.GetAccountByID(ctx, input.DelegateID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		log.Printf("Error verifying delegate account %s: %v", input.DelegateID, err)
+		return nil, fmt.Errorf("failed to verify delegate account")
+	}
+
+	delegation := &models.ApprovalDelegation{
+		DelegatorID: delegatorUUID,
+		DelegateID:  input.DelegateID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Scope:       scope,
+	}
+	if err := s.delegationRepo.Create(ctx, delegation); err != nil {
+		log.Printf("Error creating delegation from %s to %s: %v", delegatorUUID, input.DelegateID, err)
+		return nil, ErrDelegationCreateFailed
+	}
+	return delegation, nil
+}
+
+// ListDelegations 列出帳戶委託出去及被委託的代理
+func (s *approvalDelegationServiceImpl) ListDelegations(ctx context.Context, accountIDStr string) ([]models.ApprovalDelegation, error) {
+	accountUUID, err := uuid.Parse(accountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+
+	delegations, err := s.delegationRepo.ListByAccountID(ctx, accountUUID)
+	if err != nil {
+		log.Printf("Error listing delegations for account %s: %v", accountUUID, err)
+		return nil, fmt.Errorf("failed to retrieve approval delegations")
+	}
+	for i := range delegations {
+		delegations[i].Delegator.Password = ""
+		delegations[i].Delegate.Password = ""
+	}
+	return delegations, nil
+}
+
+// RevokeDelegation 撤銷代理，僅委託人本人或 HR / Super Admin 可操作
+func (s *approvalDelegationServiceImpl) RevokeDelegation(ctx context.Context, delegationIDStr string, processorAccountIDStr string) error {
+	delegationUUID, err := uuid.Parse(delegationIDStr)
+	if err != nil {
+		return errors.New("invalid delegation identifier format")
+	}
+	processorUUID, err := uuid.Parse(processorAccountIDStr)
+	if err != nil {
+		return errors.New("invalid processor account identifier format")
+	}
+
+	delegation, err := s.delegationRepo.GetByID(ctx, delegationUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrDelegationNotFound
+		}
+		log.Printf("Error fetching delegation %s: %v", delegationUUID, err)
+		return fmt.Errorf("failed to retrieve approval delegation")
+	}
+
+	if delegation.DelegatorID != processorUUID {
+		processor, err := s.accountRepo.GetAccountByID(ctx, processorUUID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrInvalidProcessor
+			}
+			log.Printf("Error fetching processor account %s: %v", processorUUID, err)
+			return fmt.Errorf("failed to verify processor account")
+		}
+		if processor.Role != models.RoleHR && processor.Role != models.RoleSuperAdmin {
+			log.Printf("Account %s attempted to revoke delegation %s of %s", processorUUID, delegationUUID, delegation.DelegatorID)
+			return ErrInvalidProcessor
+		}
+	}
+
+	if err := s.delegationRepo.Delete(ctx, delegationUUID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrDelegationNotFound
+		}
+		log.Printf("Error deleting delegation %s: %v", delegationUUID, err)
+		return fmt.Errorf("failed to revoke approval delegation")
+	}
+	return nil
+}
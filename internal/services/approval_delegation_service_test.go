@@ -0,0 +1,174 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestApprovalDelegationServiceImpl_CreateDelegation(t *testing.T) {
+	ctx := context.Background()
+	delegatorID := uuid.New()
+	delegateID := uuid.New()
+	today := dateOf(time.Now())
+	validInput := models.ApprovalDelegationInput{DelegateID: delegateID, StartDate: today, EndDate: today.AddDate(0, 0, 7)}
+
+	t.Run("Success - Defaults Scope To All", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDelegationRepo := mocks.NewMockApprovalDelegationRepository(ctrl)
+		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
+		service := NewApprovalDelegationServiceImpl(mockDelegationRepo, mockAccountRepo)
+
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateID).Return(&models.Account{ID: delegateID}, nil).Times(1)
+		mockDelegationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, d *models.ApprovalDelegation) error {
+				assert.Equal(t, delegatorID, d.DelegatorID)
+				assert.Equal(t, delegateID, d.DelegateID)
+				assert.Equal(t, models.DelegationScopeAll, d.Scope)
+				return nil
+			}).Times(1)
+
+		delegation, err := service.CreateDelegation(ctx, delegatorID.String(), validInput)
+		require.NoError(t, err)
+		require.NotNil(t, delegation)
+	})
+
+	t.Run("Failure - Invalid Input", func(t *testing.T) {
+		invalidCases := []struct {
+			name        string
+			input       models.ApprovalDelegationInput
+			expectedErr error
+		}{
+			{"Delegate To Self", models.ApprovalDelegationInput{DelegateID: delegatorID, StartDate: today, EndDate: today}, ErrInvalidDelegation},
+			{"Unknown Scope", models.ApprovalDelegationInput{DelegateID: delegateID, StartDate: today, EndDate: today, Scope: "payroll"}, ErrInvalidDelegation},
+			{"End Before Start", models.ApprovalDelegationInput{DelegateID: delegateID, StartDate: today, EndDate: today.AddDate(0, 0, -1)}, ErrInvalidDateRange},
+			{"Period Already Ended", models.ApprovalDelegationInput{DelegateID: delegateID, StartDate: today.AddDate(0, 0, -5), EndDate: today.AddDate(0, 0, -1)}, ErrInvalidDelegation},
+		}
+		for _, ic := range invalidCases {
+			t.Run(ic.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+				service := NewApprovalDelegationServiceImpl(mocks.NewMockApprovalDelegationRepository(ctrl), mocks.NewMockAccountRepository(ctrl))
+
+				_, err := service.CreateDelegation(ctx, delegatorID.String(), ic.input)
+				assert.ErrorIs(t, err, ic.expectedErr)
+			})
+		}
+	})
+
+	t.Run("Failure - Delegate Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
+		service := NewApprovalDelegationServiceImpl(mocks.NewMockApprovalDelegationRepository(ctrl), mockAccountRepo)
+
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		_, err := service.CreateDelegation(ctx, delegatorID.String(), validInput)
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+	})
+
+	t.Run("Failure - Create Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDelegationRepo := mocks.NewMockApprovalDelegationRepository(ctrl)
+		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
+		service := NewApprovalDelegationServiceImpl(mockDelegationRepo, mockAccountRepo)
+
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateID).Return(&models.Account{ID: delegateID}, nil).Times(1)
+		mockDelegationRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		_, err := service.CreateDelegation(ctx, delegatorID.String(), validInput)
+		assert.ErrorIs(t, err, ErrDelegationCreateFailed)
+	})
+}
+
+func TestApprovalDelegationServiceImpl_ListDelegations(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+
+	t.Run("Success - Clears Passwords", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDelegationRepo := mocks.NewMockApprovalDelegationRepository(ctrl)
+		service := NewApprovalDelegationServiceImpl(mockDelegationRepo, mocks.NewMockAccountRepository(ctrl))
+
+		mockDelegationRepo.EXPECT().ListByAccountID(gomock.Any(), accountID).Return([]models.ApprovalDelegation{
+			{ID: uuid.New(), DelegatorID: accountID, Delegator: models.Account{Password: "hash1"}, Delegate: models.Account{Password: "hash2"}},
+		}, nil).Times(1)
+
+		delegations, err := service.ListDelegations(ctx, accountID.String())
+		require.NoError(t, err)
+		require.Len(t, delegations, 1)
+		assert.Empty(t, delegations[0].Delegator.Password)
+		assert.Empty(t, delegations[0].Delegate.Password)
+	})
+}
+
+func TestApprovalDelegationServiceImpl_RevokeDelegation(t *testing.T) {
+	ctx := context.Background()
+	delegationID := uuid.New()
+	delegatorID := uuid.New()
+	otherID := uuid.New()
+	delegation := &models.ApprovalDelegation{ID: delegationID, DelegatorID: delegatorID, DelegateID: uuid.New()}
+
+	t.Run("Success - Delegator Revokes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDelegationRepo := mocks.NewMockApprovalDelegationRepository(ctrl)
+		service := NewApprovalDelegationServiceImpl(mockDelegationRepo, mocks.NewMockAccountRepository(ctrl))
+
+		mockDelegationRepo.EXPECT().GetByID(gomock.Any(), delegationID).Return(delegation, nil).Times(1)
+		mockDelegationRepo.EXPECT().Delete(gomock.Any(), delegationID).Return(nil).Times(1)
+
+		assert.NoError(t, service.RevokeDelegation(ctx, delegationID.String(), delegatorID.String()))
+	})
+
+	t.Run("Success - HR Revokes Another Account's Delegation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDelegationRepo := mocks.NewMockApprovalDelegationRepository(ctrl)
+		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
+		service := NewApprovalDelegationServiceImpl(mockDelegationRepo, mockAccountRepo)
+
+		mockDelegationRepo.EXPECT().GetByID(gomock.Any(), delegationID).Return(delegation, nil).Times(1)
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), otherID).Return(&models.Account{ID: otherID, Role: models.RoleHR}, nil).Times(1)
+		mockDelegationRepo.EXPECT().Delete(gomock.Any(), delegationID).Return(nil).Times(1)
+
+		assert.NoError(t, service.RevokeDelegation(ctx, delegationID.String(), otherID.String()))
+	})
+
+	t.Run("Failure - Other Employee Cannot Revoke", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDelegationRepo := mocks.NewMockApprovalDelegationRepository(ctrl)
+		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
+		service := NewApprovalDelegationServiceImpl(mockDelegationRepo, mockAccountRepo)
+
+		mockDelegationRepo.EXPECT().GetByID(gomock.Any(), delegationID).Return(delegation, nil).Times(1)
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), otherID).Return(&models.Account{ID: otherID, Role: models.RoleEmployee}, nil).Times(1)
+
+		assert.ErrorIs(t, service.RevokeDelegation(ctx, delegationID.String(), otherID.String()), ErrInvalidProcessor)
+	})
+
+	t.Run("Failure - Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockDelegationRepo := mocks.NewMockApprovalDelegationRepository(ctrl)
+		service := NewApprovalDelegationServiceImpl(mockDelegationRepo, mocks.NewMockAccountRepository(ctrl))
+
+		mockDelegationRepo.EXPECT().GetByID(gomock.Any(), delegationID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		assert.ErrorIs(t, service.RevokeDelegation(ctx, delegationID.String(), delegatorID.String()), ErrDelegationNotFound)
+	})
+}
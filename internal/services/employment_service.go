@@ -10,25 +10,36 @@ import (
 	"github.com/erinchen11/hr-system/internal/interfaces"
 	"github.com/erinchen11/hr-system/internal/models" 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
 // employmentServiceImpl 實現了 EmploymentService 介面
 type employmentServiceImpl struct {
-	employmentRepo interfaces.EmploymentRepository
-	accountRepo    interfaces.AccountRepository // 可能需要用來驗證 Account 狀態
-	
+	employmentRepo   interfaces.EmploymentRepository
+	accountRepo      interfaces.AccountRepository                // 可能需要用來驗證 Account 狀態
+	statusChangeRepo interfaces.EmploymentStatusChangeRepository // 記錄離職的狀態變更
+	leaveRequestSvc  interfaces.LeaveRequestService              // 離職時取消離職日後的假單
+	leaveBalanceSvc  interfaces.LeaveBalanceService              // 離職時結算剩餘年假
+	txManager        interfaces.TransactionManager               // 離職狀態、變更記錄與結算在同一事務中寫入
 }
 
 // NewEmploymentServiceImpl 構造函數
 func NewEmploymentServiceImpl(
 	employmentRepo interfaces.EmploymentRepository,
 	accountRepo interfaces.AccountRepository, // 注入依賴
-	
+	statusChangeRepo interfaces.EmploymentStatusChangeRepository,
+	leaveRequestSvc interfaces.LeaveRequestService,
+	leaveBalanceSvc interfaces.LeaveBalanceService,
+	txManager interfaces.TransactionManager,
 ) interfaces.EmploymentService {
 	return &employmentServiceImpl{
-		employmentRepo: employmentRepo,
-		accountRepo:    accountRepo,
+		employmentRepo:   employmentRepo,
+		accountRepo:      accountRepo,
+		statusChangeRepo: statusChangeRepo,
+		leaveRequestSvc:  leaveRequestSvc,
+		leaveBalanceSvc:  leaveBalanceSvc,
+		txManager:        txManager,
 	}
 }
 
@@ -72,6 +83,9 @@ func (s *employmentServiceImpl) UpdateEmploymentDetails(ctx context.Context, emp
 		log.Printf("Error fetching employment %s for update: %v", employmentID, err)
 		return nil, fmt.Errorf("failed to retrieve employment record for update")
 	}
+	if err := checkExpectedVersion(ctx, existingEmp.Version); err != nil {
+		return nil, err
+	}
 
 	// 2. 檢查是否允許更新 (例如，不能更新已離職的記錄)
 	if existingEmp.Status == models.EmploymentStatusTerminated {
@@ -90,6 +104,10 @@ func (s *employmentServiceImpl) UpdateEmploymentDetails(ctx context.Context, emp
 		existingEmp.PositionTitle = updates.PositionTitle
 		updated = true
 	}
+	if updates.Department != "" && existingEmp.Department != updates.Department {
+		existingEmp.Department = updates.Department
+		updated = true
+	}
 	if updates.Salary != nil && (existingEmp.Salary == nil || !existingEmp.Salary.Equals(*updates.Salary)) {
 		// 可選：驗證 Salary 是否在 JobGrade 的範圍內 (需要 jobGradeRepo)
 		existingEmp.Salary = updates.Salary
@@ -111,6 +129,9 @@ func (s *employmentServiceImpl) UpdateEmploymentDetails(ctx context.Context, emp
 	if err != nil {
 		// UpdateEmployment 內部應處理 NotFound 的情況
 		log.Printf("Error updating employment %s in repository: %v", employmentID, err)
+		if errors.Is(err, ErrConcurrentModification) {
+			return nil, ErrConcurrentModification
+		}
 		return nil, ErrUpdateFailed
 	}
 
@@ -118,38 +139,133 @@ func (s *employmentServiceImpl) UpdateEmploymentDetails(ctx context.Context, emp
 	return existingEmp, nil
 }
 
-// TerminateEmployment 處理員工離職
-func (s *employmentServiceImpl) TerminateEmployment(ctx context.Context, employmentID uuid.UUID, terminationDate time.Time) error {
+// TerminateEmployment 處理員工離職，並返回離職結算摘要
+// 離職狀態、狀態變更記錄與結算在同一事務中寫入，任一步驟失敗時全部回滾，僱傭記錄維持原狀態
+// 已於同一天離職的記錄只重新計算結算，不重複變更狀態
+func (s *employmentServiceImpl) TerminateEmployment(ctx context.Context, employmentID uuid.UUID, terminationDate time.Time, processorAccountID uuid.UUID) (*models.TerminationSettlement, error) {
 	// 1. 獲取記錄
 	employment, err := s.employmentRepo.GetEmploymentByID(ctx, employmentID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return ErrEmploymentNotFound
+			return nil, ErrEmploymentNotFound
 		}
 		log.Printf("Error fetching employment %s for termination: %v", employmentID, err)
-		return fmt.Errorf("failed to retrieve employment record for termination")
+		return nil, fmt.Errorf("failed to retrieve employment record for termination")
 	}
+	terminationDate = dateOf(terminationDate)
 
 	// 2. 檢查狀態
 	if employment.Status == models.EmploymentStatusTerminated {
-		log.Printf("Employment record %s is already terminated.", employmentID)
-		return ErrAlreadyTerminated // 或者直接返回 nil 表示操作已完成
+		if employment.TerminationDate == nil || employment.TerminationDate.Format("2006-01-02") != terminationDate.Format("2006-01-02") {
+			log.Printf("Employment record %s is already terminated.", employmentID)
+			return nil, ErrAlreadyTerminated
+		}
+		log.Printf("Employment record %s already terminated on %s, recalculating settlement.", employmentID, terminationDate.Format("2006-01-02"))
+		var settlement *models.TerminationSettlement
+		err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			settlement, err = s.settleTermination(ctx, employment, terminationDate, processorAccountID)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return settlement, nil
+	}
+	if err := checkExpectedVersion(ctx, employment.Version); err != nil {
+		return nil, err
+	}
+	if employment.HireDate != nil && terminationDate.Before(dateOf(*employment.HireDate)) {
+		return nil, ErrInvalidTerminationDate
 	}
 
 	// 3. 更新狀態和離職日期
+	fromStatus := employment.Status
 	employment.Status = models.EmploymentStatusTerminated
 	employment.TerminationDate = &terminationDate // 設置離職日期
 
-	// 4. 調用 Repository 更新
-	err = s.employmentRepo.UpdateEmployment(ctx, employment)
+	var settlement *models.TerminationSettlement
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		// 4. 調用 Repository 更新
+		if err := s.employmentRepo.UpdateEmployment(ctx, employment); err != nil {
+			// UpdateEmployment 內部應處理 NotFound
+			log.Printf("Error terminating employment %s in repository: %v", employmentID, err)
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrTerminationFailed
+		}
+
+		// 5. 記錄狀態變更
+		change := &models.EmploymentStatusChange{
+			EmploymentID: employment.ID,
+			AccountID:    employment.AccountID,
+			FromStatus:   fromStatus,
+			ToStatus:     models.EmploymentStatusTerminated,
+			Reason:       fmt.Sprintf("terminated effective %s", terminationDate.Format("2006-01-02")),
+			ChangedByID:  &processorAccountID,
+		}
+		if err := s.statusChangeRepo.Create(ctx, change); err != nil {
+			log.Printf("Error recording termination of employment %s, rolling back: %v", employmentID, err)
+			return ErrTerminationFailed
+		}
+
+		// 6. 取消離職日後的假單並結算剩餘年假
+		var err error
+		settlement, err = s.settleTermination(ctx, employment, terminationDate, processorAccountID)
+		return err
+	})
 	if err != nil {
-		// UpdateEmployment 內部應處理 NotFound
-		log.Printf("Error terminating employment %s in repository: %v", employmentID, err)
-		return ErrTerminationFailed
+		return nil, err
 	}
 
 	log.Printf("Employment record %s terminated successfully.", employmentID)
-	return nil
+	return settlement, nil
+}
+
+// settleTermination 取消開始日晚於離職日的假單、截短跨越離職日的假單、結算剩餘年假並以日薪折算金額
+// 須在 TerminateEmployment 的事務中呼叫; 任一步驟失敗時返回 ErrTerminationSettlementFailed，由事務回滾離職與已處理的假單
+func (s *employmentServiceImpl) settleTermination(ctx context.Context, employment *models.Employment, terminationDate time.Time, processorAccountID uuid.UUID) (*models.TerminationSettlement, error) {
+	account, err := s.accountRepo.GetAccountByID(ctx, employment.AccountID)
+	if err != nil {
+		log.Printf("Error fetching account %s for termination settlement: %v", employment.AccountID, err)
+		return nil, ErrTerminationSettlementFailed
+	}
+
+	cancelled, shortened, err := s.leaveRequestSvc.CancelForTermination(ctx, employment.AccountID, terminationDate, processorAccountID)
+	if err != nil {
+		log.Printf("Error cancelling leave after termination of employment %s: %v", employment.ID, err)
+		return nil, ErrTerminationSettlementFailed
+	}
+
+	annual, err := s.leaveBalanceSvc.SettleForTermination(ctx, employment.AccountID, models.LeaveTypeAnnual, terminationDate)
+	if err != nil {
+		log.Printf("Error settling annual leave for employment %s: %v", employment.ID, err)
+		return nil, ErrTerminationSettlementFailed
+	}
+
+	settlement := &models.TerminationSettlement{
+		EmploymentID:           employment.ID,
+		AccountID:              employment.AccountID,
+		FirstName:              account.FirstName,
+		LastName:               account.LastName,
+		Email:                  account.Email,
+		Department:             employment.Department,
+		PositionTitle:          employment.PositionTitle,
+		HireDate:               employment.HireDate,
+		TerminationDate:        terminationDate,
+		AnnualLeave:            *annual,
+		MonthlySalary:          employment.Salary,
+		CancelledLeaveRequests: cancelled,
+		ShortenedLeaveRequests: shortened,
+	}
+	if employment.Salary != nil {
+		dailyRate := employment.Salary.Div(decimal.NewFromInt(models.SettlementDaysPerMonth)).Round(2)
+		encashment := annual.Remaining.Mul(dailyRate).Round(2)
+		settlement.DailyRate = &dailyRate
+		settlement.EncashmentAmount = &encashment
+	}
+	return settlement, nil
 }
 
 // ListEmployments 列出僱傭記錄
@@ -163,3 +279,69 @@ func (s *employmentServiceImpl) ListEmployments(ctx context.Context /*, filters,
 	// 可選: 在這裡 Preload 相關資訊，或應用過濾/分頁邏輯 (如果 Repo 沒做)
 	return employments, nil
 }
+
+// SetManager 設定員工的直屬主管，並檢查不會形成回報循環
+func (s *employmentServiceImpl) SetManager(ctx context.Context, accountID uuid.UUID, managerAccountID *uuid.UUID) (*models.Employment, error) {
+	employment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrEmploymentNotFound
+		}
+		log.Printf("Error fetching employment for account %s: %v", accountID, err)
+		return nil, fmt.Errorf("database error fetching employment record")
+	}
+	if err := checkExpectedVersion(ctx, employment.Version); err != nil {
+		return nil, err
+	}
+
+	if managerAccountID != nil {
+		if *managerAccountID == accountID {
+			return nil, fmt.Errorf("%w: an employee cannot be their own manager", ErrManagerCycle)
+		}
+		if _, err := s.accountRepo.GetAccountByID(ctx, *managerAccountID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("%w: manager account not found", ErrInvalidManager)
+			}
+			log.Printf("Error fetching manager account %s: %v", *managerAccountID, err)
+			return nil, fmt.Errorf("failed to verify manager account")
+		}
+		if err := s.checkReportingCycle(ctx, accountID, *managerAccountID); err != nil {
+			return nil, err
+		}
+	}
+
+	employment.ManagerID = managerAccountID
+	if err := s.employmentRepo.UpdateEmployment(ctx, employment); err != nil {
+		log.Printf("Error updating manager for employment %s: %v", employment.ID, err)
+		if errors.Is(err, ErrConcurrentModification) {
+			return nil, ErrConcurrentModification
+		}
+		return nil, ErrUpdateFailed
+	}
+	return employment, nil
+}
+
+// checkReportingCycle 沿著新主管往上追溯回報鏈，若遇到 accountID 本身即表示會形成循環
+func (s *employmentServiceImpl) checkReportingCycle(ctx context.Context, accountID, managerAccountID uuid.UUID) error {
+	visited := map[uuid.UUID]bool{}
+	current := managerAccountID
+	for !visited[current] {
+		visited[current] = true
+		managerEmployment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, current)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil // 主管沒有僱傭記錄 (例如 Super Admin)，回報鏈到此為止
+			}
+			log.Printf("Error walking reporting line at account %s: %v", current, err)
+			return fmt.Errorf("failed to verify reporting line")
+		}
+		if managerEmployment.ManagerID == nil {
+			return nil
+		}
+		if *managerEmployment.ManagerID == accountID {
+			return ErrManagerCycle
+		}
+		current = *managerEmployment.ManagerID
+	}
+	return nil
+}
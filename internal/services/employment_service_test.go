@@ -26,7 +26,7 @@ func TestEmploymentServiceImpl_GetEmploymentByAccountID(t *testing.T) {
 	mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
 	mockAccountRepo := mocks.NewMockAccountRepository(ctrl) // 雖然未使用，但 New 需要
 	// *** service 在函數頂層宣告並在子測試中使用 ***
-	service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo)
+	service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	testAccountID := uuid.New()
@@ -71,7 +71,7 @@ func TestEmploymentServiceImpl_GetEmploymentByID(t *testing.T) {
 	mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
 	mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
 	// *** service 在函數頂層宣告並在子測試中使用 ***
-	service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo)
+	service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	testEmploymentID := uuid.New()
@@ -135,7 +135,7 @@ func TestEmploymentServiceImpl_UpdateEmploymentDetails(t *testing.T) {
 		defer ctrl.Finish() // 在子測試內宣告
 		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
 		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)                  // 雖然未使用，但 New 需要
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil) // 在子測試內宣告
 		localExistingEmp := *existingEmp                                         // Create copy
 
 		mockEmploymentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(&localExistingEmp, nil).Times(1)
@@ -169,7 +169,7 @@ func TestEmploymentServiceImpl_UpdateEmploymentDetails(t *testing.T) {
 		defer ctrl.Finish() // 在子測試內宣告
 		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
 		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil) // 在子測試內宣告
 		localExistingEmp := *existingEmp
 		noChangeUpdates := &models.Employment{
 			JobGradeID:    localExistingEmp.JobGradeID,
@@ -192,7 +192,7 @@ func TestEmploymentServiceImpl_UpdateEmploymentDetails(t *testing.T) {
 		defer ctrl.Finish() // 在子測試內宣告
 		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
 		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil) // 在子測試內宣告
 
 		mockEmploymentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
 
@@ -208,7 +208,7 @@ func TestEmploymentServiceImpl_UpdateEmploymentDetails(t *testing.T) {
 		defer ctrl.Finish() // 在子測試內宣告
 		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
 		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil) // 在子測試內宣告
 		terminatedEmp := *existingEmp
 		terminatedEmp.Status = models.EmploymentStatusTerminated
 
@@ -227,7 +227,7 @@ func TestEmploymentServiceImpl_UpdateEmploymentDetails(t *testing.T) {
 		defer ctrl.Finish() // 在子測試內宣告
 		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
 		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil) // 在子測試內宣告
 		localExistingEmp := *existingEmp
 		updateError := errors.New("repo update failed")
 
@@ -242,90 +242,205 @@ func TestEmploymentServiceImpl_UpdateEmploymentDetails(t *testing.T) {
 	})
 }
 
+type terminationMocks struct {
+	employmentRepo *mocks.MockEmploymentRepository
+	accountRepo    *mocks.MockAccountRepository
+	changeRepo     *mocks.MockEmploymentStatusChangeRepository
+	leaveSvc       *mocks.MockLeaveRequestService
+	balanceSvc     *mocks.MockLeaveBalanceService
+	txManager      *mocks.MockTransactionManager
+}
+
 func TestEmploymentServiceImpl_TerminateEmployment(t *testing.T) {
 
 	ctx := context.Background()
 	employmentID := uuid.New()
 	accountID := uuid.New()
-	terminationDate := time.Now().Truncate(24 * time.Hour)
-
-	activeEmp := &models.Employment{
-		ID:        employmentID,
-		AccountID: accountID,
-		Status:    models.EmploymentStatusActive,
+	hrID := uuid.New()
+	hireDate := time.Date(2023, 6, 1, 0, 0, 0, 0, time.Local)
+	terminationDate := time.Date(2025, 3, 14, 0, 0, 0, 0, time.Local)
+	salary := decimal.NewFromInt(45000)
+
+	activeEmp := func() *models.Employment {
+		return &models.Employment{ID: employmentID, AccountID: accountID, Status: models.EmploymentStatusActive,
+			Department: "Finance", HireDate: &hireDate, Salary: &salary}
+	}
+	account := &models.Account{ID: accountID, FirstName: "Amy", LastName: "Lin", Email: "amy@example.com"}
+	cancelledLeave := []models.LeaveRequest{{ID: uuid.New(), AccountID: accountID, Status: models.LeaveStatusCancelled}}
+	annual := &models.LeaveBalanceSettlement{LeaveType: models.LeaveTypeAnnual,
+		ProRatedAdjustment: decimal.RequireFromString("-0.73"), Remaining: decimal.RequireFromString("6.52")}
+	expectSettlement := func(m *terminationMocks) {
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), accountID).Return(account, nil).Times(1)
+		m.leaveSvc.EXPECT().CancelForTermination(gomock.Any(), accountID, terminationDate, hrID).Return(cancelledLeave, nil, nil).Times(1)
+		m.balanceSvc.EXPECT().SettleForTermination(gomock.Any(), accountID, models.LeaveTypeAnnual, terminationDate).Return(annual, nil).Times(1)
 	}
 
-	t.Run("Success", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish() // 在子測試內宣告
-		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
-		localActiveEmp := *activeEmp
-
-		mockEmploymentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(&localActiveEmp, nil).Times(1)
-		mockEmploymentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, emp *models.Employment) error {
-				assert.Equal(t, models.EmploymentStatusTerminated, emp.Status)
-				require.NotNil(t, emp.TerminationDate)
-				assert.Equal(t, terminationDate, emp.TerminationDate.Truncate(24*time.Hour))
-				return nil
-			}).Times(1)
-
-		err := service.TerminateEmployment(ctx, employmentID, terminationDate)
-
-		require.NoError(t, err)
-	})
-
-	t.Run("Failure - Not Found", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish() // 在子測試內宣告
-		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
-
-		mockEmploymentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
-
-		err := service.TerminateEmployment(ctx, employmentID, terminationDate)
-
-		require.Error(t, err)
-		assert.ErrorIs(t, err, ErrEmploymentNotFound)
-	})
-
-	t.Run("Failure - Already Terminated", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish() // 在子測試內宣告
-		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
-		terminatedEmp := *activeEmp
-		terminatedEmp.Status = models.EmploymentStatusTerminated
-
-		mockEmploymentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(&terminatedEmp, nil).Times(1)
-
-		err := service.TerminateEmployment(ctx, employmentID, terminationDate)
-
-		require.Error(t, err)
-		assert.ErrorIs(t, err, ErrAlreadyTerminated)
-	})
-
-	t.Run("Failure - Update Repo Error", func(t *testing.T) {
-		ctrl := gomock.NewController(t)
-		defer ctrl.Finish() // 在子測試內宣告
-		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo) // 在子測試內宣告
-		localActiveEmp := *activeEmp
-		updateError := errors.New("repo terminate failed")
-
-		mockEmploymentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(&localActiveEmp, nil).Times(1)
-		mockEmploymentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).Return(updateError).Times(1)
-
-		err := service.TerminateEmployment(ctx, employmentID, terminationDate)
+	testCases := []struct {
+		name        string
+		date        time.Time
+		setupMocks  func(m *terminationMocks)
+		expectedErr error
+		checkResult func(t *testing.T, settlement *models.TerminationSettlement)
+	}{
+		{
+			name: "Success",
+			date: terminationDate.Add(15 * time.Hour),
+			setupMocks: func(m *terminationMocks) {
+				m.employmentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(activeEmp(), nil).Times(1)
+				m.employmentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, emp *models.Employment) error {
+						assert.Equal(t, models.EmploymentStatusTerminated, emp.Status)
+						require.NotNil(t, emp.TerminationDate)
+						assert.Equal(t, terminationDate, *emp.TerminationDate)
+						return nil
+					}).Times(1)
+				m.changeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, change *models.EmploymentStatusChange) error {
+						assert.Equal(t, models.EmploymentStatusActive, change.FromStatus)
+						assert.Equal(t, models.EmploymentStatusTerminated, change.ToStatus)
+						assert.Equal(t, "terminated effective 2025-03-14", change.Reason)
+						require.NotNil(t, change.ChangedByID)
+						assert.Equal(t, hrID, *change.ChangedByID)
+						return nil
+					}).Times(1)
+				expectSettlement(m)
+			},
+			checkResult: func(t *testing.T, settlement *models.TerminationSettlement) {
+				assert.Equal(t, "Amy", settlement.FirstName)
+				assert.Equal(t, terminationDate, settlement.TerminationDate)
+				assert.Equal(t, *annual, settlement.AnnualLeave)
+				assert.Equal(t, cancelledLeave, settlement.CancelledLeaveRequests)
+				require.NotNil(t, settlement.DailyRate)
+				assert.Equal(t, "1500", settlement.DailyRate.String(), "45000 / 30")
+				require.NotNil(t, settlement.EncashmentAmount)
+				assert.Equal(t, "9780", settlement.EncashmentAmount.String(), "6.52 days x 1500")
+			},
+		},
+		{
+			name: "Success - Recalculates Settlement For Same Termination Date",
+			date: terminationDate,
+			setupMocks: func(m *terminationMocks) {
+				terminated := activeEmp()
+				terminated.Status = models.EmploymentStatusTerminated
+				terminated.TerminationDate = &terminationDate
+				terminated.Salary = nil
+				m.employmentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(terminated, nil).Times(1)
+				// UpdateEmployment and changeRepo.Create should NOT be called
+				expectSettlement(m)
+			},
+			checkResult: func(t *testing.T, settlement *models.TerminationSettlement) {
+				assert.True(t, annual.Remaining.Equal(settlement.AnnualLeave.Remaining))
+				assert.Nil(t, settlement.DailyRate, "no salary on record")
+				assert.Nil(t, settlement.EncashmentAmount)
+			},
+		},
+		{
+			name: "Failure - Not Found",
+			date: terminationDate,
+			setupMocks: func(m *terminationMocks) {
+				m.employmentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
+			},
+			expectedErr: ErrEmploymentNotFound,
+		},
+		{
+			name: "Failure - Already Terminated",
+			date: terminationDate,
+			setupMocks: func(m *terminationMocks) {
+				terminated := activeEmp()
+				terminated.Status = models.EmploymentStatusTerminated
+				m.employmentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(terminated, nil).Times(1)
+			},
+			expectedErr: ErrAlreadyTerminated,
+		},
+		{
+			name: "Failure - Before Hire Date",
+			date: hireDate.AddDate(0, 0, -1),
+			setupMocks: func(m *terminationMocks) {
+				m.employmentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(activeEmp(), nil).Times(1)
+			},
+			expectedErr: ErrInvalidTerminationDate,
+		},
+		{
+			name: "Failure - Update Repo Error",
+			date: terminationDate,
+			setupMocks: func(m *terminationMocks) {
+				m.employmentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(activeEmp(), nil).Times(1)
+				m.employmentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).Return(errors.New("repo terminate failed")).Times(1)
+			},
+			expectedErr: ErrTerminationFailed,
+		},
+		{
+			name: "Failure - Change Log Error Rolls Back Termination",
+			date: terminationDate,
+			setupMocks: func(m *terminationMocks) {
+				m.employmentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(activeEmp(), nil).Times(1)
+				// 兩筆寫入在同一事務中; 記錄失敗由事務回滾，不再另外寫回原狀態
+				gomock.InOrder(
+					m.employmentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, emp *models.Employment) error {
+							assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+							return nil
+						}).Times(1),
+					m.changeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, change *models.EmploymentStatusChange) error {
+							assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+							return errors.New("db error")
+						}).Times(1),
+				)
+			},
+			expectedErr: ErrTerminationFailed,
+		},
+		{
+			name: "Failure - Settlement Error",
+			date: terminationDate,
+			setupMocks: func(m *terminationMocks) {
+				m.employmentRepo.EXPECT().GetEmploymentByID(gomock.Any(), gomock.Eq(employmentID)).Return(activeEmp(), nil).Times(1)
+				m.employmentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				m.changeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), accountID).Return(account, nil).Times(1)
+				m.leaveSvc.EXPECT().CancelForTermination(gomock.Any(), accountID, terminationDate, hrID).Return(cancelledLeave, nil, nil).Times(1)
+				// 結算與離職在同一事務中, 失敗時一併回滾
+				m.balanceSvc.EXPECT().SettleForTermination(gomock.Any(), accountID, models.LeaveTypeAnnual, terminationDate).
+					DoAndReturn(func(ctx context.Context, accountID uuid.UUID, leaveType string, date time.Time) (*models.LeaveBalanceSettlement, error) {
+						assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+						return nil, ErrLeaveBalanceUpdateFailed
+					}).Times(1)
+			},
+			expectedErr: ErrTerminationSettlementFailed,
+		},
+	}
 
-		require.Error(t, err)
-		assert.ErrorIs(t, err, ErrTerminationFailed)
-	})
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish() // 在子測試內宣告
+			m := &terminationMocks{
+				employmentRepo: mocks.NewMockEmploymentRepository(ctrl),
+				accountRepo:    mocks.NewMockAccountRepository(ctrl),
+				changeRepo:     mocks.NewMockEmploymentStatusChangeRepository(ctrl),
+				leaveSvc:       mocks.NewMockLeaveRequestService(ctrl),
+				balanceSvc:     mocks.NewMockLeaveBalanceService(ctrl),
+				txManager:      mocks.NewMockTransactionManager(ctrl),
+			}
+			// 事務直接執行 fn，並在 ctx 中標記事務
+			m.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(context.WithValue(ctx, inTransactionKey{}, true))
+				}).AnyTimes()
+			service := NewEmploymentServiceImpl(m.employmentRepo, m.accountRepo, m.changeRepo, m.leaveSvc, m.balanceSvc, m.txManager)
+			tc.setupMocks(m)
+
+			settlement, err := service.TerminateEmployment(ctx, employmentID, tc.date, hrID)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, settlement)
+				return
+			}
+			require.NoError(t, err)
+			tc.checkResult(t, settlement)
+		})
+	}
 }
 
 func TestEmploymentServiceImpl_ListEmployments(t *testing.T) {
@@ -334,7 +449,7 @@ func TestEmploymentServiceImpl_ListEmployments(t *testing.T) {
 	mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
 	mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
 	// *** service 在函數頂層宣告並在子測試中使用 ***
-	service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo)
+	service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil)
 
 	ctx := context.Background()
 	mockEmployments := []models.Employment{
@@ -367,3 +482,137 @@ func TestEmploymentServiceImpl_ListEmployments(t *testing.T) {
 		assert.Nil(t, employments)
 	})
 }
+
+func TestEmploymentServiceImpl_SetManager(t *testing.T) {
+	ctx := context.Background()
+	employeeID := uuid.New()
+	managerID := uuid.New()
+
+	t.Run("Success - Assigns Manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil)
+
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).
+			Return(&models.Employment{ID: uuid.New(), AccountID: employeeID}, nil).Times(1)
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(&models.Account{ID: managerID}, nil).Times(1)
+		// 主管本身沒有上級, 回報鏈到此結束
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), managerID).
+			Return(&models.Employment{ID: uuid.New(), AccountID: managerID}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, emp *models.Employment) error {
+				require.NotNil(t, emp.ManagerID)
+				assert.Equal(t, managerID, *emp.ManagerID)
+				return nil
+			}).Times(1)
+
+		employment, err := service.SetManager(ctx, employeeID, &managerID)
+		require.NoError(t, err)
+		assert.Equal(t, managerID, *employment.ManagerID)
+	})
+
+	t.Run("Success - Clears Manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mocks.NewMockAccountRepository(ctrl), nil, nil, nil, nil)
+
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).
+			Return(&models.Employment{ID: uuid.New(), AccountID: employeeID, ManagerID: &managerID}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		employment, err := service.SetManager(ctx, employeeID, nil)
+		require.NoError(t, err)
+		assert.Nil(t, employment.ManagerID)
+	})
+
+	t.Run("Failure - If-Match Version Is Stale", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mocks.NewMockAccountRepository(ctrl), nil, nil, nil, nil)
+
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).
+			Return(&models.Employment{ID: uuid.New(), AccountID: employeeID, Version: 5}, nil).Times(1)
+
+		_, err := service.SetManager(models.WithExpectedVersion(ctx, 4), employeeID, nil)
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+	})
+
+	t.Run("Failure - Concurrent Update Loses Race", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mocks.NewMockAccountRepository(ctrl), nil, nil, nil, nil)
+
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).
+			Return(&models.Employment{ID: uuid.New(), AccountID: employeeID, Version: 5}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().UpdateEmployment(gomock.Any(), gomock.Any()).Return(ErrConcurrentModification).Times(1)
+
+		_, err := service.SetManager(ctx, employeeID, nil)
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+	})
+
+	t.Run("Failure - Self As Manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mocks.NewMockAccountRepository(ctrl), nil, nil, nil, nil)
+
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).
+			Return(&models.Employment{ID: uuid.New(), AccountID: employeeID}, nil).Times(1)
+
+		_, err := service.SetManager(ctx, employeeID, &employeeID)
+		assert.ErrorIs(t, err, ErrManagerCycle)
+	})
+
+	t.Run("Failure - Reporting Cycle", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil)
+		middleID := uuid.New()
+
+		// employee -> manager -> middle -> employee 形成循環
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).
+			Return(&models.Employment{ID: uuid.New(), AccountID: employeeID}, nil).Times(1)
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(&models.Account{ID: managerID}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), managerID).
+			Return(&models.Employment{AccountID: managerID, ManagerID: &middleID}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), middleID).
+			Return(&models.Employment{AccountID: middleID, ManagerID: &employeeID}, nil).Times(1)
+
+		_, err := service.SetManager(ctx, employeeID, &managerID)
+		assert.ErrorIs(t, err, ErrManagerCycle)
+	})
+
+	t.Run("Failure - Manager Account Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mockAccountRepo, nil, nil, nil, nil)
+
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).
+			Return(&models.Employment{ID: uuid.New(), AccountID: employeeID}, nil).Times(1)
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		_, err := service.SetManager(ctx, employeeID, &managerID)
+		assert.ErrorIs(t, err, ErrInvalidManager)
+	})
+
+	t.Run("Failure - Employment Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewEmploymentServiceImpl(mockEmploymentRepo, mocks.NewMockAccountRepository(ctrl), nil, nil, nil, nil)
+
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		_, err := service.SetManager(ctx, employeeID, &managerID)
+		assert.ErrorIs(t, err, ErrEmploymentNotFound)
+	})
+}
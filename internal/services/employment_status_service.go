@@ -0,0 +1,166 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// employmentStatusServiceImpl 實現了 EmploymentStatusService 介面
+type employmentStatusServiceImpl struct {
+	employmentRepo interfaces.EmploymentRepository
+	leaveRepo      interfaces.LeaveRequestRepository
+	changeRepo     interfaces.EmploymentStatusChangeRepository
+	txManager      interfaces.TransactionManager // 狀態與變更記錄在同一事務中寫入
+	thresholdDays  int                           // 假期日曆天超過此值視為長假, 0 表示停用
+}
+
+// NewEmploymentStatusServiceImpl 構造函數
+func NewEmploymentStatusServiceImpl(
+	employmentRepo interfaces.EmploymentRepository,
+	leaveRepo interfaces.LeaveRequestRepository,
+	changeRepo interfaces.EmploymentStatusChangeRepository,
+	txManager interfaces.TransactionManager,
+	thresholdDays int,
+) interfaces.EmploymentStatusService {
+	return &employmentStatusServiceImpl{
+		employmentRepo: employmentRepo,
+		leaveRepo:      leaveRepo,
+		changeRepo:     changeRepo,
+		txManager:      txManager,
+		thresholdDays:  thresholdDays,
+	}
+}
+
+// SyncLongLeaveStatuses 依今日的長假同步僱傭狀態
+func (s *employmentStatusServiceImpl) SyncLongLeaveStatuses(ctx context.Context, now time.Time) (*models.EmploymentStatusSyncReport, error) {
+	report := &models.EmploymentStatusSyncReport{}
+	if s.thresholdDays <= 0 {
+		return report, nil
+	}
+	today := dateOf(now)
+
+	requests, err := s.leaveRepo.ListApprovedCoveringDate(ctx, today)
+	if err != nil {
+		log.Printf("Error fetching approved leave requests covering %s: %v", today.Format("2006-01-02"), err)
+		return nil, fmt.Errorf("failed to retrieve approved leave requests")
+	}
+	longLeaves := make(map[uuid.UUID]*models.LeaveRequest)
+	for i := range requests {
+		request := &requests[i]
+		if calendarDays(request.StartDate, request.EndDate) <= s.thresholdDays {
+			continue
+		}
+		if _, exists := longLeaves[request.AccountID]; !exists {
+			longLeaves[request.AccountID] = request
+		}
+	}
+	report.OnLongLeave = len(longLeaves)
+
+	// 1. 長假中的在職員工: active -> on_leave (已離職或已是 on_leave 的不處理)
+	for accountID, leave := range longLeaves {
+		employment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, accountID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue // 沒有僱傭記錄 (例如 Super Admin)
+			}
+			log.Printf("Error fetching employment of account %s: %v", accountID, err)
+			report.Failed++
+			continue
+		}
+		if employment.Status != models.EmploymentStatusActive {
+			continue
+		}
+		reason := fmt.Sprintf("%s leave %s to %s (%d days)", leave.LeaveType,
+			leave.StartDate.Format("2006-01-02"), leave.EndDate.Format("2006-01-02"), calendarDays(leave.StartDate, leave.EndDate))
+		leaveID := leave.ID
+		if err := s.changeStatus(ctx, employment, models.EmploymentStatusOnLeave, reason, &leaveID); err != nil {
+			report.Failed++
+			continue
+		}
+		report.SetOnLeave++
+	}
+
+	// 2. 長假已結束 (或已取消): 由長假設定的 on_leave -> active
+	onLeave, err := s.employmentRepo.ListEmploymentsByStatus(ctx, models.EmploymentStatusOnLeave)
+	if err != nil {
+		log.Printf("Error fetching employments on leave: %v", err)
+		return report, fmt.Errorf("failed to retrieve employments on leave")
+	}
+	for i := range onLeave {
+		employment := &onLeave[i]
+		if _, stillOnLeave := longLeaves[employment.AccountID]; stillOnLeave {
+			continue
+		}
+		latest, err := s.changeRepo.GetLatestByEmploymentID(ctx, employment.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue // 沒有變更記錄: 由 HR 手動設定
+			}
+			log.Printf("Error fetching latest status change of employment %s: %v", employment.ID, err)
+			report.Failed++
+			continue
+		}
+		if latest.ToStatus != models.EmploymentStatusOnLeave || latest.LeaveRequestID == nil {
+			continue
+		}
+		if err := s.changeStatus(ctx, employment, models.EmploymentStatusActive, "long leave ended", latest.LeaveRequestID); err != nil {
+			report.Failed++
+			continue
+		}
+		report.Restored++
+	}
+
+	if report.SetOnLeave+report.Restored+report.Failed > 0 {
+		log.Printf("Long leave status sync for %s: %+v", today.Format("2006-01-02"), *report)
+	}
+	return report, nil
+}
+
+// changeStatus 變更僱傭狀態並寫入變更記錄 (由系統執行, 沒有執行人)
+// 兩筆寫入在同一事務中，任一失敗時全部回滾，讓下次排程重試，避免出現無法追溯的狀態變更
+func (s *employmentStatusServiceImpl) changeStatus(ctx context.Context, employment *models.Employment, toStatus, reason string, leaveRequestID *uuid.UUID) error {
+	fromStatus := employment.Status
+	err := s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.employmentRepo.UpdateEmploymentStatus(ctx, employment.ID, fromStatus, toStatus); err != nil {
+			log.Printf("Error changing status of employment %s from %s to %s: %v", employment.ID, fromStatus, toStatus, err)
+			return err
+		}
+
+		change := &models.EmploymentStatusChange{
+			EmploymentID:   employment.ID,
+			AccountID:      employment.AccountID,
+			FromStatus:     fromStatus,
+			ToStatus:       toStatus,
+			Reason:         reason,
+			LeaveRequestID: leaveRequestID,
+		}
+		if err := s.changeRepo.Create(ctx, change); err != nil {
+			log.Printf("Error recording status change of employment %s, rolling back to %s: %v", employment.ID, fromStatus, err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	employment.Status = toStatus
+	return nil
+}
+
+// ListStatusChanges 依時間先後列出員工的僱傭狀態變更記錄
+func (s *employmentStatusServiceImpl) ListStatusChanges(ctx context.Context, accountID uuid.UUID) ([]models.EmploymentStatusChange, error) {
+	changes, err := s.changeRepo.ListByAccountID(ctx, accountID)
+	if err != nil {
+		log.Printf("Error fetching employment status changes for account %s: %v", accountID, err)
+		return nil, fmt.Errorf("failed to retrieve employment status changes")
+	}
+	return changes, nil
+}
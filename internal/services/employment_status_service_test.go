@@ -0,0 +1,224 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type employmentStatusMocks struct {
+	employmentRepo *mocks.MockEmploymentRepository
+	leaveRepo      *mocks.MockLeaveRequestRepository
+	changeRepo     *mocks.MockEmploymentStatusChangeRepository
+	txManager      *mocks.MockTransactionManager
+}
+
+func TestEmploymentStatusServiceImpl_SyncLongLeaveStatuses(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 14, 2, 0, 0, 0, time.UTC)
+	today := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	accountID := uuid.New()
+	employmentID := uuid.New()
+	leaveID := uuid.New()
+
+	// 2025-03-01 ~ 2025-04-30 共 61 個日曆天, 超過 30 天門檻
+	parentalLeave := models.LeaveRequest{ID: leaveID, AccountID: accountID, LeaveType: "parental", Status: models.LeaveStatusApproved,
+		StartDate: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)}
+	shortLeave := models.LeaveRequest{ID: uuid.New(), AccountID: accountID, LeaveType: models.LeaveTypeAnnual, Status: models.LeaveStatusApproved,
+		StartDate: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 3, 21, 0, 0, 0, 0, time.UTC)}
+	activeEmployment := models.Employment{ID: employmentID, AccountID: accountID, Status: models.EmploymentStatusActive}
+	onLeaveEmployment := models.Employment{ID: employmentID, AccountID: accountID, Status: models.EmploymentStatusOnLeave}
+
+	testCases := []struct {
+		name           string
+		setupMocks     func(m *employmentStatusMocks)
+		expectedReport models.EmploymentStatusSyncReport
+		expectError    bool
+	}{
+		{
+			name: "Success - Long Leave Starts",
+			setupMocks: func(m *employmentStatusMocks) {
+				m.leaveRepo.EXPECT().ListApprovedCoveringDate(gomock.Any(), today).Return([]models.LeaveRequest{parentalLeave}, nil).Times(1)
+				employment := activeEmployment
+				m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(&employment, nil).Times(1)
+				m.employmentRepo.EXPECT().UpdateEmploymentStatus(gomock.Any(), employmentID, models.EmploymentStatusActive, models.EmploymentStatusOnLeave).Return(nil).Times(1)
+				m.changeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, change *models.EmploymentStatusChange) error {
+						assert.Equal(t, accountID, change.AccountID)
+						assert.Equal(t, models.EmploymentStatusActive, change.FromStatus)
+						assert.Equal(t, models.EmploymentStatusOnLeave, change.ToStatus)
+						assert.Equal(t, "parental leave 2025-03-01 to 2025-04-30 (61 days)", change.Reason)
+						require.NotNil(t, change.LeaveRequestID)
+						assert.Equal(t, leaveID, *change.LeaveRequestID)
+						assert.Nil(t, change.ChangedByID)
+						return nil
+					}).Times(1)
+				m.employmentRepo.EXPECT().ListEmploymentsByStatus(gomock.Any(), models.EmploymentStatusOnLeave).
+					Return([]models.Employment{onLeaveEmployment}, nil).Times(1)
+			},
+			expectedReport: models.EmploymentStatusSyncReport{OnLongLeave: 1, SetOnLeave: 1},
+		},
+		{
+			name: "Success - Short Leave Does Not Change Status",
+			setupMocks: func(m *employmentStatusMocks) {
+				m.leaveRepo.EXPECT().ListApprovedCoveringDate(gomock.Any(), today).Return([]models.LeaveRequest{shortLeave}, nil).Times(1)
+				m.employmentRepo.EXPECT().ListEmploymentsByStatus(gomock.Any(), models.EmploymentStatusOnLeave).Return(nil, nil).Times(1)
+			},
+			expectedReport: models.EmploymentStatusSyncReport{},
+		},
+		{
+			name: "Success - Already On Leave Is Left Alone",
+			setupMocks: func(m *employmentStatusMocks) {
+				m.leaveRepo.EXPECT().ListApprovedCoveringDate(gomock.Any(), today).Return([]models.LeaveRequest{parentalLeave}, nil).Times(1)
+				employment := onLeaveEmployment
+				m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(&employment, nil).Times(1)
+				m.employmentRepo.EXPECT().ListEmploymentsByStatus(gomock.Any(), models.EmploymentStatusOnLeave).
+					Return([]models.Employment{onLeaveEmployment}, nil).Times(1)
+			},
+			expectedReport: models.EmploymentStatusSyncReport{OnLongLeave: 1},
+		},
+		{
+			name: "Success - Long Leave Ended Restores Active",
+			setupMocks: func(m *employmentStatusMocks) {
+				m.leaveRepo.EXPECT().ListApprovedCoveringDate(gomock.Any(), today).Return(nil, nil).Times(1)
+				m.employmentRepo.EXPECT().ListEmploymentsByStatus(gomock.Any(), models.EmploymentStatusOnLeave).
+					Return([]models.Employment{onLeaveEmployment}, nil).Times(1)
+				m.changeRepo.EXPECT().GetLatestByEmploymentID(gomock.Any(), employmentID).
+					Return(&models.EmploymentStatusChange{ToStatus: models.EmploymentStatusOnLeave, LeaveRequestID: &leaveID}, nil).Times(1)
+				m.employmentRepo.EXPECT().UpdateEmploymentStatus(gomock.Any(), employmentID, models.EmploymentStatusOnLeave, models.EmploymentStatusActive).Return(nil).Times(1)
+				m.changeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, change *models.EmploymentStatusChange) error {
+						assert.Equal(t, models.EmploymentStatusActive, change.ToStatus)
+						assert.Equal(t, "long leave ended", change.Reason)
+						return nil
+					}).Times(1)
+			},
+			expectedReport: models.EmploymentStatusSyncReport{Restored: 1},
+		},
+		{
+			name: "Success - Manually Set On Leave Is Not Restored",
+			setupMocks: func(m *employmentStatusMocks) {
+				m.leaveRepo.EXPECT().ListApprovedCoveringDate(gomock.Any(), today).Return(nil, nil).Times(1)
+				m.employmentRepo.EXPECT().ListEmploymentsByStatus(gomock.Any(), models.EmploymentStatusOnLeave).
+					Return([]models.Employment{onLeaveEmployment}, nil).Times(1)
+				m.changeRepo.EXPECT().GetLatestByEmploymentID(gomock.Any(), employmentID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+			},
+			expectedReport: models.EmploymentStatusSyncReport{},
+		},
+		{
+			name: "Partial - Change Log Failure Rolls Back Status",
+			setupMocks: func(m *employmentStatusMocks) {
+				m.leaveRepo.EXPECT().ListApprovedCoveringDate(gomock.Any(), today).Return([]models.LeaveRequest{parentalLeave}, nil).Times(1)
+				employment := activeEmployment
+				m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(&employment, nil).Times(1)
+				// 兩筆寫入在同一事務中; 記錄失敗由事務回滾，不再另外寫回原狀態
+				gomock.InOrder(
+					m.employmentRepo.EXPECT().UpdateEmploymentStatus(gomock.Any(), employmentID, models.EmploymentStatusActive, models.EmploymentStatusOnLeave).
+						DoAndReturn(func(ctx context.Context, id uuid.UUID, from, to string) error {
+							assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+							return nil
+						}),
+					m.changeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, change *models.EmploymentStatusChange) error {
+							assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+							return errors.New("db error")
+						}),
+				)
+				m.employmentRepo.EXPECT().ListEmploymentsByStatus(gomock.Any(), models.EmploymentStatusOnLeave).Return(nil, nil).Times(1)
+			},
+			expectedReport: models.EmploymentStatusSyncReport{OnLongLeave: 1, Failed: 1},
+		},
+		{
+			name: "Failure - Cannot List Leave Requests",
+			setupMocks: func(m *employmentStatusMocks) {
+				m.leaveRepo.EXPECT().ListApprovedCoveringDate(gomock.Any(), today).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m := &employmentStatusMocks{
+				employmentRepo: mocks.NewMockEmploymentRepository(ctrl),
+				leaveRepo:      mocks.NewMockLeaveRequestRepository(ctrl),
+				changeRepo:     mocks.NewMockEmploymentStatusChangeRepository(ctrl),
+				txManager:      mocks.NewMockTransactionManager(ctrl),
+			}
+			// 事務直接執行 fn，並在 ctx 中標記事務
+			m.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+					return fn(context.WithValue(ctx, inTransactionKey{}, true))
+				}).AnyTimes()
+			service := NewEmploymentStatusServiceImpl(m.employmentRepo, m.leaveRepo, m.changeRepo, m.txManager, 30)
+			tc.setupMocks(m)
+
+			report, err := service.SyncLongLeaveStatuses(ctx, now)
+
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReport, *report)
+		})
+	}
+
+	t.Run("Disabled - Zero Threshold", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service := NewEmploymentStatusServiceImpl(mocks.NewMockEmploymentRepository(ctrl), mocks.NewMockLeaveRequestRepository(ctrl),
+			mocks.NewMockEmploymentStatusChangeRepository(ctrl), nil, 0)
+		// No repository calls expected
+
+		report, err := service.SyncLongLeaveStatuses(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.EmploymentStatusSyncReport{}, *report)
+	})
+}
+
+func TestEmploymentStatusServiceImpl_ListStatusChanges(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		changeRepo := mocks.NewMockEmploymentStatusChangeRepository(ctrl)
+		service := NewEmploymentStatusServiceImpl(mocks.NewMockEmploymentRepository(ctrl), mocks.NewMockLeaveRequestRepository(ctrl), changeRepo, nil, 30)
+		stored := []models.EmploymentStatusChange{{AccountID: accountID, FromStatus: models.EmploymentStatusActive, ToStatus: models.EmploymentStatusOnLeave}}
+
+		changeRepo.EXPECT().ListByAccountID(gomock.Any(), accountID).Return(stored, nil).Times(1)
+
+		changes, err := service.ListStatusChanges(ctx, accountID)
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, changes)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		changeRepo := mocks.NewMockEmploymentStatusChangeRepository(ctrl)
+		service := NewEmploymentStatusServiceImpl(mocks.NewMockEmploymentRepository(ctrl), mocks.NewMockLeaveRequestRepository(ctrl), changeRepo, nil, 30)
+
+		changeRepo.EXPECT().ListByAccountID(gomock.Any(), accountID).Return(nil, errors.New("db error")).Times(1)
+
+		changes, err := service.ListStatusChanges(ctx, accountID)
+
+		require.Error(t, err)
+		assert.Nil(t, changes)
+	})
+}
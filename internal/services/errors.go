@@ -1,6 +1,11 @@
 package services
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+)
 
 // ==================== Account Service 錯誤 ====================
 
@@ -17,10 +22,14 @@ var (
 // ==================== Employment Service 錯誤 ====================
 
 var (
-	ErrEmploymentNotFound  = errors.New("employment record not found")
-	ErrUpdateFailed        = errors.New("failed to update employment details")
-	ErrTerminationFailed   = errors.New("failed to terminate employment")
-	ErrAlreadyTerminated   = errors.New("employment record is already terminated")
+	ErrEmploymentNotFound          = errors.New("employment record not found")
+	ErrUpdateFailed                = errors.New("failed to update employment details")
+	ErrTerminationFailed           = errors.New("failed to terminate employment")
+	ErrAlreadyTerminated           = errors.New("employment record is already terminated")
+	ErrInvalidTerminationDate      = errors.New("termination date cannot be before hire date")
+	ErrTerminationSettlementFailed = errors.New("employment terminated but final settlement could not be completed")
+	ErrInvalidManager              = errors.New("invalid manager")
+	ErrManagerCycle                = errors.New("manager assignment would create a reporting cycle")
 )
 
 // ==================== Leave Request 錯誤 ====================
@@ -32,9 +41,118 @@ var (
 	ErrInvalidDateRange         = errors.New("invalid date range: end date cannot be before start date")
 	ErrLeaveApplyFailed         = errors.New("failed to apply for leave")
 	ErrInvalidProcessor         = errors.New("invalid processor account or insufficient permissions")
+	ErrNoWorkingDaysInRange     = errors.New("leave request does not cover any working days")
+	ErrInvalidLeaveDuration     = errors.New("invalid leave duration")
+	ErrNotLeaveRequestOwner     = errors.New("leave request does not belong to this account")
+	ErrLeaveAlreadyStarted      = errors.New("leave has already started and can no longer be cancelled")
+	ErrOverlappingLeave         = errors.New("leave request overlaps with an existing pending or approved request")
+	ErrInvalidLeaveFilter       = errors.New("invalid leave request filter")
+	ErrLeavePreApprovalFailed   = errors.New("leave request was submitted but could not be pre-approved")
 	// 可以未來新增 ErrForbidden 等權限不足錯誤
 )
 
+// ==================== Leave Type 錯誤 ====================
+
+var (
+	ErrUnknownLeaveType         = errors.New("unknown leave type")
+	ErrLeaveTypeInactive        = errors.New("leave type is no longer available")
+	ErrLeaveTypeNotEligible     = errors.New("leave type is not available for this role")
+	ErrLeaveTypeMaxDaysExceeded = errors.New("leave request exceeds the maximum consecutive days for this leave type")
+	ErrLeaveTypeNotFound        = errors.New("leave type not found")
+	ErrInvalidLeaveType         = errors.New("invalid leave type")
+	ErrLeaveTypeExists          = errors.New("a leave type with this code already exists")
+	ErrLeaveTypeInUse           = errors.New("leave type is used by existing leave requests; deactivate it instead")
+	ErrLeaveTypeSaveFailed      = errors.New("failed to save leave type")
+)
+
+// ==================== Leave Balance 錯誤 ====================
+
+var (
+	ErrInsufficientLeaveBalance = errors.New("insufficient leave balance for this leave type")
+	ErrLeaveBalanceUpdateFailed = errors.New("failed to update leave balance")
+	ErrYearNotEnded             = errors.New("year-end processing can only run after the year has ended")
+	ErrYearEndNotProcessed      = errors.New("year-end processing has not been run for this year")
+)
+
+// ==================== Overtime Claim 錯誤 ====================
+
+var (
+	ErrOvertimeClaimNotFound     = errors.New("overtime claim not found")
+	ErrInvalidOvertimeClaim      = errors.New("invalid overtime claim")
+	ErrInvalidOvertimeClaimState = errors.New("overtime claim is not in pending state for this operation")
+	ErrOvertimeClaimSaveFailed   = errors.New("failed to save overtime claim")
+	ErrOvertimeClaimExists       = errors.New("an overtime claim already exists for this work date")
+	ErrOvertimeClaimExpired      = errors.New("overtime claim is past the comp-off expiry period")
+)
+
+// ==================== Holiday Calendar 錯誤 ====================
+
+var (
+	ErrHolidayNotFound             = errors.New("holiday not found")
+	ErrHolidayExists               = errors.New("a holiday already exists on this date")
+	ErrHolidayCreateFailed         = errors.New("failed to create holiday")
+	ErrInvalidWeekendDays          = errors.New("invalid weekend days: values must be 0 (Sunday) to 6 (Saturday) and leave at least one working day")
+	ErrHolidayCalendarUpdateFailed = errors.New("failed to update holiday calendar")
+)
+
+// ==================== Approval Delegation 錯誤 ====================
+
+var (
+	ErrDelegationNotFound     = errors.New("approval delegation not found")
+	ErrInvalidDelegation      = errors.New("invalid approval delegation")
+	ErrDelegationCreateFailed = errors.New("failed to create approval delegation")
+)
+
+// ==================== Leave Calendar 錯誤 ====================
+
+var (
+	ErrCalendarAccessDenied     = errors.New("not allowed to view this leave calendar")
+	ErrCalendarRangeTooLong     = errors.New("leave calendar range is too long")
+	ErrCalendarFeedInvalid      = errors.New("unknown calendar feed")
+	ErrCalendarFeedTokenInvalid = errors.New("invalid or tampered calendar feed token")
+)
+
+// ==================== Leave Analytics 錯誤 ====================
+
+var (
+	ErrInvalidAnalyticsQuery = errors.New("invalid leave analytics query")
+	ErrAnalyticsFailed       = errors.New("failed to calculate leave analytics")
+)
+
+// ==================== Leave Attachment 錯誤 ====================
+
+var (
+	ErrAttachmentRequired        = errors.New("a supporting document is required for this leave request")
+	ErrAttachmentTooLarge        = errors.New("attachment exceeds the maximum allowed size")
+	ErrUnsupportedAttachmentType = errors.New("unsupported attachment type: only PDF, JPEG and PNG files are allowed")
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrAttachmentSaveFailed      = errors.New("failed to save attachment")
+)
+
+// ==================== Leave Rule 錯誤 ====================
+
+var (
+	ErrLeaveRuleViolation     = errors.New("leave request is blocked by a leave rule")
+	ErrLeaveRuleNotFound      = errors.New("leave rule not found")
+	ErrInvalidLeaveRule       = errors.New("invalid leave rule")
+	ErrRuleOverrideNotAllowed = errors.New("only HR or Super Admin can override leave rules")
+	ErrLeaveRuleCreateFailed  = errors.New("failed to create leave rule")
+)
+
+// LeaveRuleViolationError 帶有違反規則的詳細資訊，errors.Is(err, ErrLeaveRuleViolation) 成立
+// Handler 以 errors.As 取出 Violation 返回給客戶端
+type LeaveRuleViolationError struct {
+	Violation models.LeaveRuleViolation
+}
+
+func (e *LeaveRuleViolationError) Error() string {
+	return ErrLeaveRuleViolation.Error() + ": " + e.Violation.Message
+}
+
+func (e *LeaveRuleViolationError) Unwrap() error {
+	return ErrLeaveRuleViolation
+}
+
 // ==================== Token Service 錯誤 ====================
 
 var (
@@ -45,3 +163,19 @@ var (
 	ErrTokenCacheCheckFailed = errors.New("cache error validating token")
 	ErrTokenMismatch         = errors.New("token mismatch")
 )
+
+// ==================== 並行更新 錯誤 ====================
+
+var (
+	// ErrConcurrentModification 記錄已被其他請求更新: 樂觀鎖版本號不符，或 If-Match 帶來的版本號不是目前版本
+	// 與 Repository 返回的 interfaces.ErrConcurrentModification 為同一個錯誤，Service 可直接往上返回
+	ErrConcurrentModification = interfaces.ErrConcurrentModification
+)
+
+// ==================== Email 審核連結 錯誤 ====================
+
+var (
+	ErrActionLinkInvalid = errors.New("invalid or tampered action link")
+	ErrActionLinkExpired = errors.New("action link has expired")
+	ErrActionLinkUsed    = errors.New("action link has already been used")
+)
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// holidayServiceImpl 實現了 HolidayService 介面
+type holidayServiceImpl struct {
+	holidayRepo interfaces.HolidayRepository
+}
+
+// NewHolidayServiceImpl 構造函數
+func NewHolidayServiceImpl(holidayRepo interfaces.HolidayRepository) interfaces.HolidayService {
+	return &holidayServiceImpl{holidayRepo: holidayRepo}
+}
+
+// GetCalendar 取得年度工作週設定，未設定時返回預設值 (不寫入資料庫)
+func (s *holidayServiceImpl) GetCalendar(ctx context.Context, year int) (*models.HolidayCalendar, error) {
+	calendar, err := s.holidayRepo.GetCalendarByYear(ctx, year)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &models.HolidayCalendar{Year: year, WeekendDays: models.FormatWeekendDays(models.DefaultWeekendDays)}, nil
+		}
+		log.Printf("Error fetching holiday calendar for year %d: %v", year, err)
+		return nil, fmt.Errorf("failed to retrieve holiday calendar")
+	}
+	return calendar, nil
+}
+
+// SetWeekendDays 設定年度週末
+func (s *holidayServiceImpl) SetWeekendDays(ctx context.Context, year int, weekendDays []time.Weekday) (*models.HolidayCalendar, error) {
+	if year < 1 {
+		return nil, fmt.Errorf("%w: year must be positive", ErrInvalidInput)
+	}
+	if len(weekendDays) >= 7 {
+		return nil, ErrInvalidWeekendDays
+	}
+	for _, d := range weekendDays {
+		if d < time.Sunday || d > time.Saturday {
+			return nil, ErrInvalidWeekendDays
+		}
+	}
+
+	calendar, err := s.GetCalendar(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+	calendar.WeekendDays = models.FormatWeekendDays(weekendDays)
+	if err := s.holidayRepo.SaveCalendar(ctx, calendar); err != nil {
+		log.Printf("Error saving holiday calendar for year %d: %v", year, err)
+		return nil, ErrHolidayCalendarUpdateFailed
+	}
+	return calendar, nil
+}
+
+// ListHolidays 列出年度假日
+func (s *holidayServiceImpl) ListHolidays(ctx context.Context, year int) ([]models.Holiday, error) {
+	holidays, err := s.holidayRepo.ListHolidaysByYear(ctx, year)
+	if err != nil {
+		log.Printf("Error listing holidays for year %d: %v", year, err)
+		return nil, fmt.Errorf("failed to retrieve holidays")
+	}
+	return holidays, nil
+}
+
+// AddHoliday 新增假日
+func (s *holidayServiceImpl) AddHoliday(ctx context.Context, date time.Time, name string) (*models.Holiday, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || date.IsZero() {
+		return nil, fmt.Errorf("%w: holiday date and name cannot be empty", ErrInvalidInput)
+	}
+	date = dateOf(date)
+
+	existing, err := s.holidayRepo.GetHolidayByDate(ctx, date)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Printf("Error checking holiday existence for %s: %v", date.Format("2006-01-02"), err)
+		return nil, fmt.Errorf("database error checking holiday date: %w", err)
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("%w: %s", ErrHolidayExists, date.Format("2006-01-02"))
+	}
+
+	holiday := &models.Holiday{Date: date, Year: date.Year(), Name: name}
+	if err := s.holidayRepo.CreateHoliday(ctx, holiday); err != nil {
+		log.Printf("Error creating holiday on %s: %v", date.Format("2006-01-02"), err)
+		return nil, fmt.Errorf("%w: %w", ErrHolidayCreateFailed, err)
+	}
+	return holiday, nil
+}
+
+// DeleteHoliday 刪除假日
+func (s *holidayServiceImpl) DeleteHoliday(ctx context.Context, id uuid.UUID) error {
+	if err := s.holidayRepo.DeleteHoliday(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrHolidayNotFound
+		}
+		log.Printf("Error deleting holiday %s: %v", id, err)
+		return fmt.Errorf("failed to delete holiday")
+	}
+	return nil
+}
+
+// CountWorkingDays 計算區間內的工作日數
+func (s *holidayServiceImpl) CountWorkingDays(ctx context.Context, start, end time.Time) (decimal.Decimal, error) {
+	days, err := s.ListWorkingDays(ctx, start, end)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return decimal.NewFromInt(int64(len(days))), nil
+}
+
+// ListWorkingDays 列出區間內扣除週末與假日後的工作日，區間跨年度時各年度分別套用其週末設定
+func (s *holidayServiceImpl) ListWorkingDays(ctx context.Context, start, end time.Time) ([]time.Time, error) {
+	start, end = dateOf(start), dateOf(end)
+	if end.Before(start) {
+		return nil, ErrInvalidDateRange
+	}
+
+	weekendsByYear := make(map[int]map[time.Weekday]bool)
+	for year := start.Year(); year <= end.Year(); year++ {
+		calendar, err := s.GetCalendar(ctx, year)
+		if err != nil {
+			return nil, err
+		}
+		weekends := make(map[time.Weekday]bool)
+		for _, d := range calendar.Weekends() {
+			weekends[d] = true
+		}
+		weekendsByYear[year] = weekends
+	}
+
+	holidays, err := s.holidayRepo.ListHolidaysBetween(ctx, start, end)
+	if err != nil {
+		log.Printf("Error fetching holidays between %s and %s: %v", start.Format("2006-01-02"), end.Format("2006-01-02"), err)
+		return nil, fmt.Errorf("failed to retrieve holidays")
+	}
+	holidayDates := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		holidayDates[h.Date.Format("2006-01-02")] = true
+	}
+
+	var days []time.Time
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		if weekendsByYear[day.Year()][day.Weekday()] || holidayDates[day.Format("2006-01-02")] {
+			continue
+		}
+		days = append(days, day)
+	}
+	return days, nil
+}
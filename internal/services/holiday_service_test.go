@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestHolidayServiceImpl_CountWorkingDays(t *testing.T) {
+	ctx := context.Background()
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.Local) }
+
+	t.Run("Success - Excludes default weekend and holidays", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		// 2025-07-04 (Fri) ~ 2025-07-08 (Tue): Fri, Mon, Tue 為工作日, Mon 7/7 設為假日
+		start, end := date(2025, time.July, 4), date(2025, time.July, 8)
+		mockRepo.EXPECT().GetCalendarByYear(gomock.Any(), 2025).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockRepo.EXPECT().ListHolidaysBetween(gomock.Any(), start, end).
+			Return([]models.Holiday{{Date: date(2025, time.July, 7), Name: "Company Day"}}, nil).Times(1)
+
+		days, err := service.CountWorkingDays(ctx, start, end)
+
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(2).Equal(days), "expected 2 working days, got %s", days)
+	})
+
+	t.Run("Success - Applies per-year weekend settings across years", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		// 2025-12-26 (Fri) ~ 2026-01-03 (Sat); 2026 只有週日為週末
+		start, end := date(2025, time.December, 26), date(2026, time.January, 3)
+		mockRepo.EXPECT().GetCalendarByYear(gomock.Any(), 2025).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockRepo.EXPECT().GetCalendarByYear(gomock.Any(), 2026).Return(&models.HolidayCalendar{Year: 2026, WeekendDays: "0"}, nil).Times(1)
+		mockRepo.EXPECT().ListHolidaysBetween(gomock.Any(), start, end).
+			Return([]models.Holiday{{Date: date(2026, time.January, 1), Name: "New Year"}}, nil).Times(1)
+
+		days, err := service.CountWorkingDays(ctx, start, end)
+
+		// 2025: 12/26, 12/29, 12/30, 12/31 = 4; 2026: 1/2, 1/3 = 2 (1/1 假日)
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(6).Equal(days), "expected 6 working days, got %s", days)
+	})
+
+	t.Run("Failure - Invalid Date Range", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service := NewHolidayServiceImpl(mocks.NewMockHolidayRepository(ctrl))
+
+		_, err := service.CountWorkingDays(ctx, date(2025, time.July, 8), date(2025, time.July, 4))
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+	})
+
+	t.Run("Failure - Holiday Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetCalendarByYear(gomock.Any(), 2025).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockRepo.EXPECT().ListHolidaysBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+
+		_, err := service.CountWorkingDays(ctx, date(2025, time.July, 4), date(2025, time.July, 8))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to retrieve holidays")
+	})
+}
+
+func TestHolidayServiceImpl_ListWorkingDays(t *testing.T) {
+	ctx := context.Background()
+	date := func(y int, m time.Month, d int) time.Time { return time.Date(y, m, d, 0, 0, 0, 0, time.Local) }
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRepo := mocks.NewMockHolidayRepository(ctrl)
+	service := NewHolidayServiceImpl(mockRepo)
+
+	start, end := date(2025, time.July, 4), date(2025, time.July, 8)
+	mockRepo.EXPECT().GetCalendarByYear(gomock.Any(), 2025).Return(nil, gorm.ErrRecordNotFound).Times(1)
+	mockRepo.EXPECT().ListHolidaysBetween(gomock.Any(), start, end).
+		Return([]models.Holiday{{Date: date(2025, time.July, 7), Name: "Company Day"}}, nil).Times(1)
+
+	days, err := service.ListWorkingDays(ctx, start, end)
+
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{date(2025, time.July, 4), date(2025, time.July, 8)}, days)
+}
+
+func TestHolidayServiceImpl_AddHoliday(t *testing.T) {
+	ctx := context.Background()
+	holidayDate := time.Date(2025, time.October, 10, 15, 30, 0, 0, time.Local)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetHolidayByDate(gomock.Any(), dateOf(holidayDate)).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockRepo.EXPECT().CreateHoliday(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, h *models.Holiday) error {
+				assert.Equal(t, dateOf(holidayDate), h.Date, "time part should be stripped")
+				assert.Equal(t, 2025, h.Year)
+				assert.Equal(t, "National Day", h.Name)
+				return nil
+			}).Times(1)
+
+		holiday, err := service.AddHoliday(ctx, holidayDate, "  National Day ")
+		require.NoError(t, err)
+		require.NotNil(t, holiday)
+	})
+
+	t.Run("Failure - Already Exists", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetHolidayByDate(gomock.Any(), gomock.Any()).Return(&models.Holiday{ID: uuid.New()}, nil).Times(1)
+
+		holiday, err := service.AddHoliday(ctx, holidayDate, "National Day")
+		assert.ErrorIs(t, err, ErrHolidayExists)
+		assert.Nil(t, holiday)
+	})
+
+	t.Run("Failure - Empty Name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service := NewHolidayServiceImpl(mocks.NewMockHolidayRepository(ctrl))
+
+		holiday, err := service.AddHoliday(ctx, holidayDate, "   ")
+		assert.ErrorIs(t, err, ErrInvalidInput)
+		assert.Nil(t, holiday)
+	})
+}
+
+func TestHolidayServiceImpl_DeleteHoliday(t *testing.T) {
+	ctx := context.Background()
+	holidayID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().DeleteHoliday(gomock.Any(), holidayID).Return(nil).Times(1)
+		assert.NoError(t, service.DeleteHoliday(ctx, holidayID))
+	})
+
+	t.Run("Failure - Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().DeleteHoliday(gomock.Any(), holidayID).Return(gorm.ErrRecordNotFound).Times(1)
+		assert.ErrorIs(t, service.DeleteHoliday(ctx, holidayID), ErrHolidayNotFound)
+	})
+}
+
+func TestHolidayServiceImpl_SetWeekendDays(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Creates calendar for new year", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetCalendarByYear(gomock.Any(), 2026).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockRepo.EXPECT().SaveCalendar(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, c *models.HolidayCalendar) error {
+				assert.Equal(t, 2026, c.Year)
+				assert.Equal(t, "5,6", c.WeekendDays)
+				return nil
+			}).Times(1)
+
+		calendar, err := service.SetWeekendDays(ctx, 2026, []time.Weekday{time.Saturday, time.Friday, time.Saturday})
+		require.NoError(t, err)
+		assert.Equal(t, []time.Weekday{time.Friday, time.Saturday}, calendar.Weekends())
+	})
+
+	t.Run("Failure - Every day is a weekend", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service := NewHolidayServiceImpl(mocks.NewMockHolidayRepository(ctrl))
+
+		all := []time.Weekday{0, 1, 2, 3, 4, 5, 6}
+		_, err := service.SetWeekendDays(ctx, 2026, all)
+		assert.ErrorIs(t, err, ErrInvalidWeekendDays)
+	})
+
+	t.Run("Failure - Save Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockHolidayRepository(ctrl)
+		service := NewHolidayServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetCalendarByYear(gomock.Any(), 2026).Return(&models.HolidayCalendar{ID: uuid.New(), Year: 2026, WeekendDays: "0,6"}, nil).Times(1)
+		mockRepo.EXPECT().SaveCalendar(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		_, err := service.SetWeekendDays(ctx, 2026, []time.Weekday{time.Sunday})
+		assert.ErrorIs(t, err, ErrHolidayCalendarUpdateFailed)
+	})
+}
@@ -256,6 +256,21 @@ func TestJobGradeServiceImpl_UpdateJobGrade(t *testing.T) {
 		assert.Equal(t, newName, updatedGrade.Name)
 	})
 
+	t.Run("Failure - Concurrent Update Loses Race", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockJobGradeRepo := mocks.NewMockJobGradeRepository(ctrl)
+		service := NewJobGradeServiceImpl(mockJobGradeRepo, nil)
+		localExistingGrade := *existingGrade
+
+		mockJobGradeRepo.EXPECT().GetJobGradeByID(gomock.Any(), gomock.Eq(testID)).Return(&localExistingGrade, nil).Times(1)
+		mockJobGradeRepo.EXPECT().UpdateJobGrade(gomock.Any(), gomock.Any()).Return(ErrConcurrentModification).Times(1)
+
+		updatedGrade, err := service.UpdateJobGrade(ctx, testID, updatesOnlyName)
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+		assert.Nil(t, updatedGrade)
+	})
+
 }
 
 // --- Test ListJobGrades ---
@@ -105,6 +105,9 @@ func (s *jobGradeServiceImpl) UpdateJobGrade(ctx context.Context, id uuid.UUID,
 		log.Printf("Error fetching job grade %s for update: %v", id, err)
 		return nil, fmt.Errorf("failed to retrieve job grade for update: %w", err)
 	}
+	if err := checkExpectedVersion(ctx, existingGrade.Version); err != nil {
+		return nil, err
+	}
 
 	needsUpdate := false
 	if updates.Code != "" && updates.Code != existingGrade.Code {
@@ -146,6 +149,9 @@ func (s *jobGradeServiceImpl) UpdateJobGrade(ctx context.Context, id uuid.UUID,
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrJobGradeNotFound
 		}
+		if errors.Is(err, ErrConcurrentModification) {
+			return nil, ErrConcurrentModification
+		}
 		return nil, fmt.Errorf("%w: %w", ErrJobGradeUpdateFailed, err)
 	}
 	return existingGrade, nil
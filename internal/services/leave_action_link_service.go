@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+)
+
+// leaveActionLinkKeyPrefix 是已使用連結在 Redis 中的 key 前綴 (後接 nonce)
+const leaveActionLinkKeyPrefix = "leave_action_link:"
+
+// leaveActionLinkServiceImpl 實現了 LeaveActionLinkService 介面
+type leaveActionLinkServiceImpl struct {
+	signer          interfaces.MessageSigner
+	cacheRepo       interfaces.CacheRepository     // 記錄已使用的 nonce
+	leaveRequestSvc interfaces.LeaveRequestService // 沿用登入後核准 / 拒絕的權限與規則檢查
+	baseURL         string                         // 連結前綴, 後接 token
+	ttl             time.Duration
+}
+
+// NewLeaveActionLinkServiceImpl 構造函數
+func NewLeaveActionLinkServiceImpl(
+	signer interfaces.MessageSigner,
+	cacheRepo interfaces.CacheRepository,
+	leaveRequestSvc interfaces.LeaveRequestService,
+	baseURL string,
+	ttlHours int,
+) interfaces.LeaveActionLinkService {
+	return &leaveActionLinkServiceImpl{
+		signer:          signer,
+		cacheRepo:       cacheRepo,
+		leaveRequestSvc: leaveRequestSvc,
+		baseURL:         strings.TrimRight(baseURL, "/"),
+		ttl:             time.Hour * time.Duration(ttlHours),
+	}
+}
+
+// NewLeaveActionLinkGeneratorImpl 構造只產生連結的實例 (不能執行連結), 供通知審核人時使用
+// 與 NewLeaveActionLinkServiceImpl 使用相同的簽章與參數時, 產生的連結可由後者執行
+func NewLeaveActionLinkGeneratorImpl(signer interfaces.MessageSigner, baseURL string, ttlHours int) interfaces.LeaveActionLinkGenerator {
+	return &leaveActionLinkServiceImpl{
+		signer:  signer,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		ttl:     time.Hour * time.Duration(ttlHours),
+	}
+}
+
+// GenerateLinks 為審核人產生核准與拒絕連結
+func (s *leaveActionLinkServiceImpl) GenerateLinks(ctx context.Context, leaveRequestID uuid.UUID, approverID uuid.UUID) (*models.LeaveActionLinks, error) {
+	token := models.LeaveActionToken{
+		LeaveRequestID: leaveRequestID,
+		ApproverID:     approverID,
+		Nonce:          uuid.NewString(),
+		ExpiresAt:      time.Now().Add(s.ttl).UTC().Truncate(time.Second),
+	}
+
+	links := &models.LeaveActionLinks{ExpiresAt: token.ExpiresAt}
+	for _, action := range []string{models.LeaveActionApprove, models.LeaveActionReject} {
+		token.Action = action
+		encoded, err := s.encode(token)
+		if err != nil {
+			log.Printf("Error signing %s link for leave request %s: %v", action, leaveRequestID, err)
+			return nil, fmt.Errorf("failed to generate action link")
+		}
+		if action == models.LeaveActionApprove {
+			links.ApproveURL = s.baseURL + "/" + encoded
+		} else {
+			links.RejectURL = s.baseURL + "/" + encoded
+		}
+	}
+	return links, nil
+}
+
+// InspectLink 驗證連結並檢查是否已使用, 不消耗連結
+func (s *leaveActionLinkServiceImpl) InspectLink(ctx context.Context, tokenStr string) (*models.LeaveActionToken, error) {
+	token, err := s.decode(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	var usedAction string
+	err = s.cacheRepo.Get(ctx, leaveActionLinkKeyPrefix+token.Nonce, &usedAction)
+	if err == nil {
+		return nil, ErrActionLinkUsed
+	}
+	if !errors.Is(err, interfaces.ErrCacheMiss) {
+		log.Printf("Error checking action link for leave request %s: %v", token.LeaveRequestID, err)
+		return nil, fmt.Errorf("failed to check action link")
+	}
+	return token, nil
+}
+
+// ExecuteLink 驗證並消耗連結後核准或拒絕假單
+func (s *leaveActionLinkServiceImpl) ExecuteLink(ctx context.Context, tokenStr string, reason string) (*models.LeaveActionToken, error) {
+	// 1. 驗證簽章與期限
+	token, err := s.decode(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. 以 SetNX 消耗 nonce, 同時點擊的兩個請求只有一個會成功
+	key := leaveActionLinkKeyPrefix + token.Nonce
+	consumed, err := s.cacheRepo.SetNX(ctx, key, token.Action, time.Until(token.ExpiresAt)+time.Minute)
+	if err != nil {
+		log.Printf("Error consuming action link for leave request %s: %v", token.LeaveRequestID, err)
+		return nil, fmt.Errorf("failed to consume action link")
+	}
+	if !consumed {
+		return nil, ErrActionLinkUsed
+	}
+
+	// 3. 以連結中的審核人身分處理假單
+	leaveRequestID := token.LeaveRequestID.String()
+	approverID := token.ApproverID.String()
+	if token.Action == models.LeaveActionApprove {
+		err = s.leaveRequestSvc.ApproveRequest(ctx, leaveRequestID, approverID)
+	} else {
+		err = s.leaveRequestSvc.RejectRequest(ctx, leaveRequestID, approverID, reason)
+	}
+	if err != nil {
+		// 失敗時釋放 nonce, 讓審核人可以重試或改用另一個連結
+		if delErr := s.cacheRepo.Delete(ctx, key); delErr != nil {
+			log.Printf("Error releasing action link for leave request %s: %v", token.LeaveRequestID, delErr)
+		}
+		return nil, err
+	}
+
+	log.Printf("Leave request %s %sd by %s via email action link", token.LeaveRequestID, token.Action, token.ApproverID)
+	return token, nil
+}
+
+// encode 簽署 token 並編碼為連結中的字串
+func (s *leaveActionLinkServiceImpl) encode(token models.LeaveActionToken) (string, error) {
+	return encodeSignedToken(s.signer, token)
+}
+
+// decode 驗證簽章與期限後解出 token
+func (s *leaveActionLinkServiceImpl) decode(tokenStr string) (*models.LeaveActionToken, error) {
+	var token models.LeaveActionToken
+	if !decodeSignedToken(s.signer, tokenStr, &token) {
+		return nil, ErrActionLinkInvalid
+	}
+	if (token.Action != models.LeaveActionApprove && token.Action != models.LeaveActionReject) || token.Nonce == "" {
+		return nil, ErrActionLinkInvalid
+	}
+	if !time.Now().Before(token.ExpiresAt) {
+		return nil, ErrActionLinkExpired
+	}
+	return &token, nil
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testActionLinkBaseURL = "https://hr.example.com/hr-system-api/v1/leave-actions"
+
+// newLeaveActionLinkServiceWithMocks 建立使用真實簽章與 mock 快取 / 假單服務的 LeaveActionLinkService
+func newLeaveActionLinkServiceWithMocks(t *testing.T, ctrl *gomock.Controller, ttlHours int) (interfaces.LeaveActionLinkService, *mocks.MockCacheRepository, *mocks.MockLeaveRequestService) {
+	signer, err := utils.NewJwtUtils("test-secret", "hr-system", "1")
+	require.NoError(t, err)
+	cacheRepo := mocks.NewMockCacheRepository(ctrl)
+	leaveRequestSvc := mocks.NewMockLeaveRequestService(ctrl)
+	return NewLeaveActionLinkServiceImpl(signer, cacheRepo, leaveRequestSvc, testActionLinkBaseURL+"/", ttlHours), cacheRepo, leaveRequestSvc
+}
+
+// actionLinkToken 取出連結中的 token
+func actionLinkToken(t *testing.T, link string) string {
+	token, found := strings.CutPrefix(link, testActionLinkBaseURL+"/")
+	require.True(t, found, "unexpected link %s", link)
+	return token
+}
+
+func TestLeaveActionLinkServiceImpl_GenerateLinks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, cacheRepo, _ := newLeaveActionLinkServiceWithMocks(t, ctrl, 72)
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	approverID := uuid.New()
+
+	links, err := service.GenerateLinks(ctx, leaveRequestID, approverID)
+
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(72*time.Hour), links.ExpiresAt, time.Minute)
+
+	cacheRepo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(interfaces.ErrCacheMiss).Times(2)
+	approve, err := service.InspectLink(ctx, actionLinkToken(t, links.ApproveURL))
+	require.NoError(t, err)
+	reject, err := service.InspectLink(ctx, actionLinkToken(t, links.RejectURL))
+	require.NoError(t, err)
+
+	assert.Equal(t, models.LeaveActionApprove, approve.Action)
+	assert.Equal(t, models.LeaveActionReject, reject.Action)
+	assert.Equal(t, leaveRequestID, approve.LeaveRequestID)
+	assert.Equal(t, approverID, approve.ApproverID)
+	assert.Equal(t, approve.Nonce, reject.Nonce, "approve and reject links should share a nonce")
+}
+
+func TestLeaveActionLinkServiceImpl_InspectLink(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name        string
+		ttlHours    int
+		tamper      func(token string) string
+		setupMocks  func(cacheRepo *mocks.MockCacheRepository)
+		expectedErr error
+	}{
+		{
+			name:     "Success - Unused Link",
+			ttlHours: 72,
+			setupMocks: func(cacheRepo *mocks.MockCacheRepository) {
+				cacheRepo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(interfaces.ErrCacheMiss).Times(1)
+			},
+		},
+		{
+			name:     "Failure - Already Used",
+			ttlHours: 72,
+			setupMocks: func(cacheRepo *mocks.MockCacheRepository) {
+				cacheRepo.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: ErrActionLinkUsed,
+		},
+		{
+			name:     "Failure - Tampered Payload",
+			ttlHours: 72,
+			tamper: func(token string) string {
+				payload, signature, _ := strings.Cut(token, ".")
+				return payload[:len(payload)-2] + "AA." + signature
+			},
+			setupMocks:  func(cacheRepo *mocks.MockCacheRepository) {},
+			expectedErr: ErrActionLinkInvalid,
+		},
+		{
+			name:        "Failure - Malformed Token",
+			ttlHours:    72,
+			tamper:      func(token string) string { return "not-a-token" },
+			setupMocks:  func(cacheRepo *mocks.MockCacheRepository) {},
+			expectedErr: ErrActionLinkInvalid,
+		},
+		{
+			name:        "Failure - Expired",
+			ttlHours:    -1,
+			setupMocks:  func(cacheRepo *mocks.MockCacheRepository) {},
+			expectedErr: ErrActionLinkExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, cacheRepo, _ := newLeaveActionLinkServiceWithMocks(t, ctrl, tc.ttlHours)
+			links, err := service.GenerateLinks(ctx, uuid.New(), uuid.New())
+			require.NoError(t, err)
+			token := actionLinkToken(t, links.ApproveURL)
+			if tc.tamper != nil {
+				token = tc.tamper(token)
+			}
+			tc.setupMocks(cacheRepo)
+
+			result, err := service.InspectLink(ctx, token)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, models.LeaveActionApprove, result.Action)
+			}
+		})
+	}
+}
+
+func TestLeaveActionLinkServiceImpl_ExecuteLink(t *testing.T) {
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	approverID := uuid.New()
+
+	testCases := []struct {
+		name        string
+		action      string
+		reason      string
+		setupMocks  func(cacheRepo *mocks.MockCacheRepository, leaveRequestSvc *mocks.MockLeaveRequestService)
+		expectedErr error
+	}{
+		{
+			name:   "Success - Approve As Linked Approver",
+			action: models.LeaveActionApprove,
+			setupMocks: func(cacheRepo *mocks.MockCacheRepository, leaveRequestSvc *mocks.MockLeaveRequestService) {
+				cacheRepo.EXPECT().SetNX(gomock.Any(), gomock.Any(), models.LeaveActionApprove, gomock.Any()).Return(true, nil).Times(1)
+				leaveRequestSvc.EXPECT().ApproveRequest(gomock.Any(), leaveRequestID.String(), approverID.String()).Return(nil).Times(1)
+			},
+		},
+		{
+			name:   "Success - Reject With Reason",
+			action: models.LeaveActionReject,
+			reason: "Team is short-staffed",
+			setupMocks: func(cacheRepo *mocks.MockCacheRepository, leaveRequestSvc *mocks.MockLeaveRequestService) {
+				cacheRepo.EXPECT().SetNX(gomock.Any(), gomock.Any(), models.LeaveActionReject, gomock.Any()).Return(true, nil).Times(1)
+				leaveRequestSvc.EXPECT().RejectRequest(gomock.Any(), leaveRequestID.String(), approverID.String(), "Team is short-staffed").Return(nil).Times(1)
+			},
+		},
+		{
+			name:   "Failure - Already Used",
+			action: models.LeaveActionApprove,
+			setupMocks: func(cacheRepo *mocks.MockCacheRepository, leaveRequestSvc *mocks.MockLeaveRequestService) {
+				cacheRepo.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+				// ApproveRequest should NOT be called
+			},
+			expectedErr: ErrActionLinkUsed,
+		},
+		{
+			name:   "Failure - Approval Error Releases Link",
+			action: models.LeaveActionApprove,
+			setupMocks: func(cacheRepo *mocks.MockCacheRepository, leaveRequestSvc *mocks.MockLeaveRequestService) {
+				cacheRepo.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+				leaveRequestSvc.EXPECT().ApproveRequest(gomock.Any(), gomock.Any(), gomock.Any()).Return(ErrInvalidLeaveRequestState).Times(1)
+				cacheRepo.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedErr: ErrInvalidLeaveRequestState,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, cacheRepo, leaveRequestSvc := newLeaveActionLinkServiceWithMocks(t, ctrl, 72)
+			links, err := service.GenerateLinks(ctx, leaveRequestID, approverID)
+			require.NoError(t, err)
+			link := links.ApproveURL
+			if tc.action == models.LeaveActionReject {
+				link = links.RejectURL
+			}
+			tc.setupMocks(cacheRepo, leaveRequestSvc)
+
+			result, err := service.ExecuteLink(ctx, actionLinkToken(t, link), tc.reason)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.action, result.Action)
+				assert.Equal(t, leaveRequestID, result.LeaveRequestID)
+			}
+		})
+	}
+
+	t.Run("Failure - Cache Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, cacheRepo, _ := newLeaveActionLinkServiceWithMocks(t, ctrl, 72)
+		links, err := service.GenerateLinks(ctx, leaveRequestID, approverID)
+		require.NoError(t, err)
+		cacheRepo.EXPECT().SetNX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("redis down")).Times(1)
+
+		result, err := service.ExecuteLink(ctx, actionLinkToken(t, links.ApproveURL), "")
+
+		assert.EqualError(t, err, "failed to consume action link")
+		assert.Nil(t, result)
+	})
+
+	t.Run("Failure - Expired Link Is Not Consumed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _, _ := newLeaveActionLinkServiceWithMocks(t, ctrl, -1)
+		links, err := service.GenerateLinks(ctx, leaveRequestID, approverID)
+		require.NoError(t, err)
+		// SetNX / ApproveRequest should NOT be called
+
+		result, err := service.ExecuteLink(ctx, actionLinkToken(t, links.ApproveURL), "")
+
+		assert.ErrorIs(t, err, ErrActionLinkExpired)
+		assert.Nil(t, result)
+	})
+}
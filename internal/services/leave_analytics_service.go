@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// absenceStatuses 未指定狀態時視為實際缺勤的假單狀態 (取消申請待確認前仍視為請假)
+var absenceStatuses = []string{models.LeaveStatusApproved, models.LeaveStatusCancellationRequested}
+
+// leaveAnalyticsServiceImpl 實現了 LeaveAnalyticsService 介面
+type leaveAnalyticsServiceImpl struct {
+	analyticsRepo interfaces.LeaveAnalyticsRepository
+}
+
+// NewLeaveAnalyticsServiceImpl 構造函數
+func NewLeaveAnalyticsServiceImpl(analyticsRepo interfaces.LeaveAnalyticsRepository) interfaces.LeaveAnalyticsService {
+	return &leaveAnalyticsServiceImpl{analyticsRepo: analyticsRepo}
+}
+
+// GetAbsenceTotals 檢查分組維度與查詢條件後交由資料庫聚合
+func (s *leaveAnalyticsServiceImpl) GetAbsenceTotals(ctx context.Context, groupBy string, filter models.LeaveAnalyticsFilter) ([]models.LeaveAbsenceTotal, error) {
+	switch groupBy {
+	case models.LeaveAnalyticsByMonth, models.LeaveAnalyticsByLeaveType, models.LeaveAnalyticsByJobGrade,
+		models.LeaveAnalyticsByDepartment, models.LeaveAnalyticsByStatus:
+	default:
+		return nil, fmt.Errorf("%w: unknown group_by %q", ErrInvalidAnalyticsQuery, groupBy)
+	}
+	if err := normalizeAnalyticsFilter(&filter); err != nil {
+		return nil, err
+	}
+	if len(filter.Statuses) == 0 && groupBy != models.LeaveAnalyticsByStatus {
+		filter.Statuses = absenceStatuses
+	}
+
+	totals, err := s.analyticsRepo.AbsenceTotals(ctx, groupBy, filter)
+	if err != nil {
+		log.Printf("Error aggregating leave absences by %s: %v", groupBy, err)
+		return nil, ErrAnalyticsFailed
+	}
+	return totals, nil
+}
+
+// GetBradfordFactors 計算 Bradford factor; limit 超出範圍時使用預設值或上限
+func (s *leaveAnalyticsServiceImpl) GetBradfordFactors(ctx context.Context, filter models.LeaveAnalyticsFilter, limit int) ([]models.BradfordFactorScore, error) {
+	if err := normalizeAnalyticsFilter(&filter); err != nil {
+		return nil, err
+	}
+	if filter.LeaveType == "" {
+		filter.LeaveType = models.LeaveTypeSick // Bradford factor 用於衡量非計畫性缺勤
+	}
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = absenceStatuses
+	}
+	if limit < 1 {
+		limit = models.DefaultBradfordFactorLimit
+	}
+	if limit > models.MaxBradfordFactorLimit {
+		limit = models.MaxBradfordFactorLimit
+	}
+
+	scores, err := s.analyticsRepo.BradfordFactors(ctx, filter, limit)
+	if err != nil {
+		log.Printf("Error calculating bradford factors: %v", err)
+		return nil, ErrAnalyticsFailed
+	}
+	return scores, nil
+}
+
+// GetApprovalTurnaround 取得各假別的核准時效，並以各假別的結果合併出整體統計
+func (s *leaveAnalyticsServiceImpl) GetApprovalTurnaround(ctx context.Context, filter models.LeaveAnalyticsFilter) (*models.LeaveTurnaroundReport, error) {
+	if err := normalizeAnalyticsFilter(&filter); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.analyticsRepo.ApprovalTurnaroundByLeaveType(ctx, filter)
+	if err != nil {
+		log.Printf("Error aggregating leave approval turnaround: %v", err)
+		return nil, ErrAnalyticsFailed
+	}
+	if stats == nil {
+		stats = []models.LeaveTurnaroundStat{}
+	}
+	return &models.LeaveTurnaroundReport{Overall: combineTurnaroundStats(stats), ByLeaveType: stats}, nil
+}
+
+// combineTurnaroundStats 以決定筆數加權平均各假別的平均時效，最短 / 最長取各假別的極值
+func combineTurnaroundStats(stats []models.LeaveTurnaroundStat) models.LeaveTurnaroundStat {
+	var overall models.LeaveTurnaroundStat
+	var totalSeconds float64
+	for _, stat := range stats {
+		if stat.Decisions == 0 {
+			continue
+		}
+		if overall.Decisions == 0 || stat.MinSeconds < overall.MinSeconds {
+			overall.MinSeconds = stat.MinSeconds
+		}
+		if stat.MaxSeconds > overall.MaxSeconds {
+			overall.MaxSeconds = stat.MaxSeconds
+		}
+		overall.Decisions += stat.Decisions
+		totalSeconds += stat.AvgSeconds * float64(stat.Decisions)
+	}
+	if overall.Decisions > 0 {
+		overall.AvgSeconds = totalSeconds / float64(overall.Decisions)
+	}
+	return overall
+}
+
+// normalizeAnalyticsFilter 去除時間部分並檢查日期區間與狀態
+func normalizeAnalyticsFilter(filter *models.LeaveAnalyticsFilter) error {
+	filter.From, filter.To = dateOf(filter.From), dateOf(filter.To)
+	if filter.To.Before(filter.From) {
+		return ErrInvalidDateRange
+	}
+	if filter.To.Sub(filter.From) >= models.MaxLeaveAnalyticsDays*24*time.Hour {
+		return fmt.Errorf("%w: the period can span at most %d days", ErrInvalidAnalyticsQuery, models.MaxLeaveAnalyticsDays)
+	}
+	for _, status := range filter.Statuses {
+		switch status {
+		case models.LeaveStatusPending, models.LeaveStatusApproved, models.LeaveStatusRejected,
+			models.LeaveStatusCancelled, models.LeaveStatusCancellationRequested:
+		default:
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidAnalyticsQuery, status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaveAnalyticsServiceImpl_GetAbsenceTotals(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		groupBy     string
+		filter      models.LeaveAnalyticsFilter
+		setupMocks  func(mockRepo *mocks.MockLeaveAnalyticsRepository)
+		expectedErr error
+	}{
+		{
+			name:    "Success - Defaults To Taken Absences",
+			groupBy: models.LeaveAnalyticsByLeaveType,
+			filter:  models.LeaveAnalyticsFilter{From: from.Add(9 * time.Hour), To: to, Department: "Engineering"},
+			setupMocks: func(mockRepo *mocks.MockLeaveAnalyticsRepository) {
+				mockRepo.EXPECT().AbsenceTotals(gomock.Any(), models.LeaveAnalyticsByLeaveType, models.LeaveAnalyticsFilter{
+					From: from, To: to, Department: "Engineering",
+					Statuses: []string{models.LeaveStatusApproved, models.LeaveStatusCancellationRequested},
+				}).Return([]models.LeaveAbsenceTotal{{Key: models.LeaveTypeSick, Requests: 4, Days: decimal.NewFromInt(6)}}, nil).Times(1)
+			},
+		},
+		{
+			name:    "Success - Group By Status Keeps All Statuses",
+			groupBy: models.LeaveAnalyticsByStatus,
+			filter:  models.LeaveAnalyticsFilter{From: from, To: to},
+			setupMocks: func(mockRepo *mocks.MockLeaveAnalyticsRepository) {
+				mockRepo.EXPECT().AbsenceTotals(gomock.Any(), models.LeaveAnalyticsByStatus, models.LeaveAnalyticsFilter{From: from, To: to}).
+					Return(nil, nil).Times(1)
+			},
+		},
+		{
+			name:        "Failure - Unknown Dimension",
+			groupBy:     "weekday",
+			filter:      models.LeaveAnalyticsFilter{From: from, To: to},
+			expectedErr: ErrInvalidAnalyticsQuery,
+		},
+		{
+			name:        "Failure - Reversed Date Range",
+			groupBy:     models.LeaveAnalyticsByMonth,
+			filter:      models.LeaveAnalyticsFilter{From: to, To: from},
+			expectedErr: ErrInvalidDateRange,
+		},
+		{
+			name:        "Failure - Period Too Long",
+			groupBy:     models.LeaveAnalyticsByMonth,
+			filter:      models.LeaveAnalyticsFilter{From: from, To: from.AddDate(1, 1, 0)},
+			expectedErr: ErrInvalidAnalyticsQuery,
+		},
+		{
+			name:        "Failure - Unknown Status",
+			groupBy:     models.LeaveAnalyticsByMonth,
+			filter:      models.LeaveAnalyticsFilter{From: from, To: to, Statuses: []string{"taken"}},
+			expectedErr: ErrInvalidAnalyticsQuery,
+		},
+		{
+			name:    "Failure - Repository Error",
+			groupBy: models.LeaveAnalyticsByJobGrade,
+			filter:  models.LeaveAnalyticsFilter{From: from, To: to},
+			setupMocks: func(mockRepo *mocks.MockLeaveAnalyticsRepository) {
+				mockRepo.EXPECT().AbsenceTotals(gomock.Any(), models.LeaveAnalyticsByJobGrade, gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+			},
+			expectedErr: ErrAnalyticsFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRepo := mocks.NewMockLeaveAnalyticsRepository(ctrl)
+			service := NewLeaveAnalyticsServiceImpl(mockRepo)
+			if tc.setupMocks != nil {
+				tc.setupMocks(mockRepo)
+			}
+
+			_, err := service.GetAbsenceTotals(ctx, tc.groupBy, tc.filter)
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLeaveAnalyticsServiceImpl_GetBradfordFactors(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	takenStatuses := []string{models.LeaveStatusApproved, models.LeaveStatusCancellationRequested}
+
+	t.Run("Success - Defaults To Sick Leave And Default Limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveAnalyticsRepository(ctrl)
+		service := NewLeaveAnalyticsServiceImpl(mockRepo)
+		stored := []models.BradfordFactorScore{{AccountID: uuid.New(), Spells: 3, Days: decimal.NewFromInt(4), Score: decimal.NewFromInt(36)}}
+
+		mockRepo.EXPECT().BradfordFactors(gomock.Any(),
+			models.LeaveAnalyticsFilter{From: from, To: to, LeaveType: models.LeaveTypeSick, Statuses: takenStatuses},
+			models.DefaultBradfordFactorLimit).Return(stored, nil).Times(1)
+
+		scores, err := service.GetBradfordFactors(ctx, models.LeaveAnalyticsFilter{From: from, To: to}, 0)
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, scores)
+	})
+
+	t.Run("Success - Limit Is Capped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveAnalyticsRepository(ctrl)
+		service := NewLeaveAnalyticsServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().BradfordFactors(gomock.Any(),
+			models.LeaveAnalyticsFilter{From: from, To: to, LeaveType: models.LeaveTypePersonal, Statuses: takenStatuses},
+			models.MaxBradfordFactorLimit).Return(nil, nil).Times(1)
+
+		_, err := service.GetBradfordFactors(ctx, models.LeaveAnalyticsFilter{From: from, To: to, LeaveType: models.LeaveTypePersonal}, 1000)
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveAnalyticsRepository(ctrl)
+		service := NewLeaveAnalyticsServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().BradfordFactors(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+
+		_, err := service.GetBradfordFactors(ctx, models.LeaveAnalyticsFilter{From: from, To: to}, 10)
+		assert.ErrorIs(t, err, ErrAnalyticsFailed)
+	})
+}
+
+func TestLeaveAnalyticsServiceImpl_GetApprovalTurnaround(t *testing.T) {
+	ctx := context.Background()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success - Overall Is Weighted By Decisions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveAnalyticsRepository(ctrl)
+		service := NewLeaveAnalyticsServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().ApprovalTurnaroundByLeaveType(gomock.Any(), models.LeaveAnalyticsFilter{From: from, To: to}).Return([]models.LeaveTurnaroundStat{
+			{LeaveType: models.LeaveTypeAnnual, Decisions: 3, AvgSeconds: 7200, MinSeconds: 600, MaxSeconds: 18000},
+			{LeaveType: models.LeaveTypeSick, Decisions: 1, AvgSeconds: 3600, MinSeconds: 3600, MaxSeconds: 3600},
+		}, nil).Times(1)
+
+		report, err := service.GetApprovalTurnaround(ctx, models.LeaveAnalyticsFilter{From: from, To: to})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveTurnaroundStat{Decisions: 4, AvgSeconds: 6300, MinSeconds: 600, MaxSeconds: 18000}, report.Overall)
+		assert.Len(t, report.ByLeaveType, 2)
+	})
+
+	t.Run("Success - No Decisions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveAnalyticsRepository(ctrl)
+		service := NewLeaveAnalyticsServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().ApprovalTurnaroundByLeaveType(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		report, err := service.GetApprovalTurnaround(ctx, models.LeaveAnalyticsFilter{From: from, To: to})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveTurnaroundStat{}, report.Overall)
+		assert.NotNil(t, report.ByLeaveType)
+		assert.Empty(t, report.ByLeaveType)
+	})
+
+	t.Run("Failure - Invalid Status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service := NewLeaveAnalyticsServiceImpl(mocks.NewMockLeaveAnalyticsRepository(ctrl))
+
+		_, err := service.GetApprovalTurnaround(ctx, models.LeaveAnalyticsFilter{From: from, To: to, Statuses: []string{"done"}})
+		assert.ErrorIs(t, err, ErrInvalidAnalyticsQuery)
+	})
+}
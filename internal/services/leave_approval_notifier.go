@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// leaveApprovalNotifierImpl 實現了 LeaveApprovalNotifier 介面
+type leaveApprovalNotifierImpl struct {
+	accountRepo    interfaces.AccountRepository
+	employmentRepo interfaces.EmploymentRepository         // 查詢申請人的直屬主管
+	delegationRepo interfaces.ApprovalDelegationRepository // 審核人不在時一併通知代理人
+	notifier       interfaces.Notifier
+	linkGenerator  interfaces.LeaveActionLinkGenerator // 通知中附上 Email 審核連結, nil 或 notifier 不是 Private 時不附連結
+}
+
+// NewLeaveApprovalNotifierImpl 構造函數
+func NewLeaveApprovalNotifierImpl(
+	accountRepo interfaces.AccountRepository,
+	employmentRepo interfaces.EmploymentRepository,
+	delegationRepo interfaces.ApprovalDelegationRepository,
+	notifier interfaces.Notifier,
+	linkGenerator interfaces.LeaveActionLinkGenerator,
+) interfaces.LeaveApprovalNotifier {
+	return &leaveApprovalNotifierImpl{
+		accountRepo:    accountRepo,
+		employmentRepo: employmentRepo,
+		delegationRepo: delegationRepo,
+		notifier:       notifier,
+		linkGenerator:  linkGenerator,
+	}
+}
+
+// NotifyApprovers 找出步驟的審核人並逐一通知; 產生連結失敗時仍寄出不含連結的通知
+// 連結等同審核人的憑證，只在 notifier 為 Private (只送達收件人) 時附上，避免寫入 log 後被他人使用
+// 任一通知失敗時返回錯誤
+func (s *leaveApprovalNotifierImpl) NotifyApprovers(ctx context.Context, request *models.LeaveRequest, approverKind string, subject string) ([]models.Account, error) {
+	approvers, err := s.resolveApprovers(ctx, request, approverKind)
+	if err != nil {
+		return nil, err
+	}
+	if len(approvers) == 0 {
+		return nil, errors.New("no recipient to notify")
+	}
+	withLinks := s.linkGenerator != nil && s.notifier.Private()
+	for _, approver := range approvers {
+		body := describeLeaveRequest(request)
+		if withLinks {
+			links, err := s.linkGenerator.GenerateLinks(ctx, request.ID, approver.ID)
+			if err != nil {
+				log.Printf("Error generating action links for leave request %s and approver %s: %v", request.ID, approver.ID, err)
+			} else {
+				body += fmt.Sprintf("\n\nApprove: %s\nReject: %s\nThese links expire at %s and stop working once either has been used.",
+					links.ApproveURL, links.RejectURL, links.ExpiresAt.Format(time.RFC3339))
+			}
+		}
+		if err := s.notifier.Notify(ctx, approver, subject, body); err != nil {
+			return nil, fmt.Errorf("failed to notify %s: %w", approver.Email, err)
+		}
+	}
+	return approvers, nil
+}
+
+// resolveApprovers 找出步驟的審核人: manager 步驟為直屬主管 (沒有主管時改通知 HR)，hr 步驟為所有 HR 與 Super Admin
+// 申請人不能審核自己的假單，不列入收件人; 審核人有涵蓋此步驟的有效代理時，一併通知代理人
+func (s *leaveApprovalNotifierImpl) resolveApprovers(ctx context.Context, request *models.LeaveRequest, kind string) ([]models.Account, error) {
+	approvers, err := s.stepApprovers(ctx, request, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]models.Account, 0, len(approvers))
+	seen := make(map[uuid.UUID]bool, len(approvers))
+	delegatorIDs := make([]uuid.UUID, 0, len(approvers))
+	for _, approver := range approvers {
+		if approver.ID == request.AccountID || seen[approver.ID] {
+			continue
+		}
+		seen[approver.ID] = true
+		recipients = append(recipients, approver)
+		delegatorIDs = append(delegatorIDs, approver.ID)
+	}
+
+	delegations, err := s.delegationRepo.ListActiveForDelegators(ctx, delegatorIDs, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve approval delegations: %w", err)
+	}
+	for _, delegation := range delegations {
+		if !delegation.Covers(kind) || delegation.DelegateID == request.AccountID || seen[delegation.DelegateID] {
+			continue
+		}
+		seen[delegation.DelegateID] = true
+		recipients = append(recipients, delegation.Delegate)
+	}
+	return recipients, nil
+}
+
+// stepApprovers 找出步驟本身的審核人 (不含代理人)
+func (s *leaveApprovalNotifierImpl) stepApprovers(ctx context.Context, request *models.LeaveRequest, kind string) ([]models.Account, error) {
+	if kind == models.ApprovalStepManager {
+		employment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, request.AccountID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to retrieve applicant employment: %w", err)
+		}
+		if employment != nil && employment.ManagerID != nil {
+			manager, err := s.accountRepo.GetAccountByID(ctx, *employment.ManagerID)
+			if err == nil {
+				return []models.Account{*manager}, nil
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("failed to retrieve manager account: %w", err)
+			}
+		}
+	}
+	// HR 步驟可由 HR 或 Super Admin 審核，兩者都通知
+	hrAccounts, err := s.accountRepo.ListAccountsByRoles(ctx, models.RoleHR, models.RoleSuperAdmin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve HR accounts: %w", err)
+	}
+	return hrAccounts, nil
+}
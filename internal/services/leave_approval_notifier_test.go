@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestLeaveApprovalNotifierImpl_NotifyApprovers(t *testing.T) {
+	ctx := context.Background()
+	applicantID := uuid.New()
+	managerID := uuid.New()
+	manager := &models.Account{ID: managerID, Email: "manager@example.com"}
+	delegateID := uuid.New()
+	delegate := models.Account{ID: delegateID, Email: "delegate@example.com"}
+	hrAccounts := []models.Account{
+		{ID: uuid.New(), Email: "hr1@example.com", Role: models.RoleHR},
+		{ID: uuid.New(), Email: "hr2@example.com", Role: models.RoleHR},
+	}
+	request := &models.LeaveRequest{ID: uuid.New(), AccountID: applicantID, LeaveType: models.LeaveTypeAnnual,
+		Account: models.Account{ID: applicantID, Email: "employee@example.com"}}
+	links := &models.LeaveActionLinks{ApproveURL: "https://hr.example.com/a", RejectURL: "https://hr.example.com/r", ExpiresAt: time.Now().Add(72 * time.Hour)}
+
+	testCases := []struct {
+		name           string
+		kind           string
+		withLinks      bool
+		delegations    []models.ApprovalDelegation // 審核人目前有效的代理
+		setupMocks     func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator)
+		expectedEmails []string
+		expectErr      bool
+	}{
+		{
+			name:      "Success - Manager Step Notifies Manager With Links",
+			kind:      models.ApprovalStepManager,
+			withLinks: true,
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).
+					Return(&models.Employment{AccountID: applicantID, ManagerID: &managerID}, nil).Times(1)
+				accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(manager, nil).Times(1)
+				linkGen.EXPECT().GenerateLinks(gomock.Any(), request.ID, managerID).Return(links, nil).Times(1)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), "Leave request pending your approval", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, recipient models.Account, subject, body string) error {
+						assert.Equal(t, manager.Email, recipient.Email)
+						assert.Contains(t, body, "employee@example.com")
+						assert.Contains(t, body, "Approve: https://hr.example.com/a")
+						assert.Contains(t, body, "Reject: https://hr.example.com/r")
+						return nil
+					}).Times(1)
+			},
+			expectedEmails: []string{"manager@example.com"},
+		},
+		{
+			name: "Success - Manager Step Without Manager Falls Back To HR",
+			kind: models.ApprovalStepManager,
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+				accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleHR, models.RoleSuperAdmin).Return(hrAccounts, nil).Times(1)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, recipient models.Account, subject, body string) error {
+						assert.NotContains(t, body, "Approve:", "links are disabled")
+						return nil
+					}).Times(2)
+			},
+			expectedEmails: []string{"hr1@example.com", "hr2@example.com"},
+		},
+		{
+			name: "Success - HR Applicant Is Not Notified Of Own Request",
+			kind: models.ApprovalStepHR,
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				hrApplicant := models.Account{ID: applicantID, Email: "employee@example.com", Role: models.RoleHR}
+				accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleHR, models.RoleSuperAdmin).Return(append([]models.Account{hrApplicant}, hrAccounts...), nil).Times(1)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, recipient models.Account, subject, body string) error {
+						assert.NotEqual(t, applicantID, recipient.ID)
+						return nil
+					}).Times(2)
+			},
+			expectedEmails: []string{"hr1@example.com", "hr2@example.com"},
+		},
+		{
+			name:      "Success - Delegate Of Away Manager Is Also Notified",
+			kind:      models.ApprovalStepManager,
+			withLinks: true,
+			delegations: []models.ApprovalDelegation{
+				{DelegatorID: managerID, DelegateID: delegateID, Scope: models.DelegationScopeManager, Delegate: delegate},
+				{DelegatorID: managerID, DelegateID: hrAccounts[0].ID, Scope: models.DelegationScopeHR, Delegate: hrAccounts[0]}, // 不涵蓋主管步驟
+				{DelegatorID: managerID, DelegateID: applicantID, Scope: models.DelegationScopeAll, Delegate: request.Account},   // 申請人不能審核自己的假單
+			},
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).
+					Return(&models.Employment{AccountID: applicantID, ManagerID: &managerID}, nil).Times(1)
+				accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(manager, nil).Times(1)
+				linkGen.EXPECT().GenerateLinks(gomock.Any(), request.ID, managerID).Return(links, nil).Times(1)
+				linkGen.EXPECT().GenerateLinks(gomock.Any(), request.ID, delegateID).Return(links, nil).Times(1)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			expectedEmails: []string{"manager@example.com", "delegate@example.com"},
+		},
+		{
+			name:      "Success - Link Error Still Notifies Without Links",
+			kind:      models.ApprovalStepHR,
+			withLinks: true,
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleHR, models.RoleSuperAdmin).Return(hrAccounts[:1], nil).Times(1)
+				linkGen.EXPECT().GenerateLinks(gomock.Any(), request.ID, hrAccounts[0].ID).Return(nil, errors.New("sign failed")).Times(1)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, recipient models.Account, subject, body string) error {
+						assert.NotContains(t, body, "Approve:")
+						return nil
+					}).Times(1)
+			},
+			expectedEmails: []string{"hr1@example.com"},
+		},
+		{
+			name:      "Success - Links Withheld When Notifier Is Not Private",
+			kind:      models.ApprovalStepHR,
+			withLinks: true,
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				notifier.EXPECT().Private().Return(false).Times(1)
+				accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleHR, models.RoleSuperAdmin).Return(hrAccounts[:1], nil).Times(1)
+				// GenerateLinks should NOT be called
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, recipient models.Account, subject, body string) error {
+						assert.NotContains(t, body, "Approve:")
+						return nil
+					}).Times(1)
+			},
+			expectedEmails: []string{"hr1@example.com"},
+		},
+		{
+			name: "Success - HR Step Also Notifies Super Admins",
+			kind: models.ApprovalStepHR,
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				superAdmin := models.Account{ID: uuid.New(), Email: "admin@example.com", Role: models.RoleSuperAdmin}
+				accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleHR, models.RoleSuperAdmin).Return(append(hrAccounts[:1:1], superAdmin), nil).Times(1)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+			},
+			expectedEmails: []string{"hr1@example.com", "admin@example.com"},
+		},
+		{
+			name: "Failure - No Approver To Notify",
+			kind: models.ApprovalStepHR,
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleHR, models.RoleSuperAdmin).Return(nil, nil).Times(1)
+			},
+			expectErr: true,
+		},
+		{
+			name: "Failure - Notifier Error",
+			kind: models.ApprovalStepHR,
+			setupMocks: func(accountRepo *mocks.MockAccountRepository, employmentRepo *mocks.MockEmploymentRepository, notifier *mocks.MockNotifier, linkGen *mocks.MockLeaveActionLinkGenerator) {
+				accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleHR, models.RoleSuperAdmin).Return(hrAccounts, nil).Times(1)
+				notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("smtp down")).Times(1)
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			accountRepo := mocks.NewMockAccountRepository(ctrl)
+			employmentRepo := mocks.NewMockEmploymentRepository(ctrl)
+			notifier := mocks.NewMockNotifier(ctrl)
+			linkGen := mocks.NewMockLeaveActionLinkGenerator(ctrl)
+			delegationRepo := mocks.NewMockApprovalDelegationRepository(ctrl)
+			delegationRepo.EXPECT().ListActiveForDelegators(gomock.Any(), gomock.Any(), gomock.Any()).Return(tc.delegations, nil).AnyTimes()
+			service := NewLeaveApprovalNotifierImpl(accountRepo, employmentRepo, delegationRepo, notifier, nil)
+			if tc.withLinks {
+				service = NewLeaveApprovalNotifierImpl(accountRepo, employmentRepo, delegationRepo, notifier, linkGen)
+			}
+			tc.setupMocks(accountRepo, employmentRepo, notifier, linkGen)
+			if tc.withLinks {
+				// 未另外設定時視為 Private 的 notifier (例如 Email)
+				notifier.EXPECT().Private().Return(true).AnyTimes()
+			}
+
+			approvers, err := service.NotifyApprovers(ctx, request, tc.kind, "Leave request pending your approval")
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedEmails, accountEmailList(approvers))
+		})
+	}
+}
+
+// accountEmailList 取出帳戶 Email 以便比對
+func accountEmailList(accounts []models.Account) []string {
+	emails := make([]string, 0, len(accounts))
+	for _, a := range accounts {
+		emails = append(emails, a.Email)
+	}
+	return emails
+}
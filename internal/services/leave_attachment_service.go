@@ -0,0 +1,327 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// leaveAttachmentServiceImpl 實現了 LeaveAttachmentService 介面
+type leaveAttachmentServiceImpl struct {
+	attachmentRepo interfaces.LeaveAttachmentRepository
+	leaveRepo      interfaces.LeaveRequestRepository
+	accountRepo    interfaces.AccountRepository
+	employmentRepo interfaces.EmploymentRepository
+	approvalRepo   interfaces.LeaveApprovalRepository
+	delegationRepo interfaces.ApprovalDelegationRepository // 目前步驟的審核人 (含代理人) 可查看附件
+	storage        interfaces.FileStorage                  // 附件檔案的存放位置 (本機或物件儲存)
+	maxSizeBytes   int64
+}
+
+// NewLeaveAttachmentServiceImpl 構造函數, maxSizeMB 非正數時使用預設上限
+func NewLeaveAttachmentServiceImpl(
+	attachmentRepo interfaces.LeaveAttachmentRepository,
+	leaveRepo interfaces.LeaveRequestRepository,
+	accountRepo interfaces.AccountRepository,
+	employmentRepo interfaces.EmploymentRepository,
+	approvalRepo interfaces.LeaveApprovalRepository,
+	delegationRepo interfaces.ApprovalDelegationRepository,
+	storage interfaces.FileStorage,
+	maxSizeMB int,
+) interfaces.LeaveAttachmentService {
+	if maxSizeMB <= 0 {
+		maxSizeMB = models.DefaultMaxAttachmentSizeMB
+	}
+	return &leaveAttachmentServiceImpl{
+		attachmentRepo: attachmentRepo,
+		leaveRepo:      leaveRepo,
+		accountRepo:    accountRepo,
+		employmentRepo: employmentRepo,
+		approvalRepo:   approvalRepo,
+		delegationRepo: delegationRepo,
+		storage:        storage,
+		maxSizeBytes:   int64(maxSizeMB) << 20,
+	}
+}
+
+// IsAttachmentRequired 沒有規則的假別不需附件
+func (s *leaveAttachmentServiceImpl) IsAttachmentRequired(ctx context.Context, leaveType string, days decimal.Decimal) (bool, error) {
+	rule, err := s.attachmentRepo.GetRuleByLeaveType(ctx, leaveType)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return false, nil
+		}
+		log.Printf("Error fetching attachment rule for %s: %v", leaveType, err)
+		return false, fmt.Errorf("failed to retrieve attachment rule")
+	}
+	return rule.Requires(days), nil
+}
+
+// HasAttachments 判斷假單是否已有附件
+func (s *leaveAttachmentServiceImpl) HasAttachments(ctx context.Context, leaveRequestID uuid.UUID) (bool, error) {
+	count, err := s.attachmentRepo.CountByRequestID(ctx, leaveRequestID)
+	if err != nil {
+		log.Printf("Error counting attachments of leave request %s: %v", leaveRequestID, err)
+		return false, fmt.Errorf("failed to retrieve attachments")
+	}
+	return count > 0, nil
+}
+
+// ValidateUpload 檢查大小上限，並以檔案內容偵測類型 (客戶端宣告的 Content-Type 不可信)
+func (s *leaveAttachmentServiceImpl) ValidateUpload(upload models.AttachmentUpload) error {
+	_, err := s.detectContentType(upload)
+	return err
+}
+
+// SaveAttachment 先寫入檔案再建立記錄; 建立記錄失敗時刪除已寫入的檔案
+func (s *leaveAttachmentServiceImpl) SaveAttachment(ctx context.Context, leaveRequestID uuid.UUID, uploaderID uuid.UUID, upload models.AttachmentUpload) (*models.LeaveAttachment, error) {
+	contentType, err := s.detectContentType(upload)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := &models.LeaveAttachment{
+		ID:             uuid.New(),
+		LeaveRequestID: leaveRequestID,
+		FileName:       filepath.Base(upload.FileName),
+		ContentType:    contentType,
+		Size:           int64(len(upload.Data)),
+		UploadedByID:   uploaderID,
+	}
+	// 存放位置只由 ID 組成，不使用客戶端提供的檔名
+	attachment.StorageKey = fmt.Sprintf("leave-requests/%s/%s", leaveRequestID, attachment.ID)
+
+	if err := s.storage.Save(ctx, attachment.StorageKey, bytes.NewReader(upload.Data)); err != nil {
+		log.Printf("Error storing attachment for leave request %s: %v", leaveRequestID, err)
+		return nil, ErrAttachmentSaveFailed
+	}
+	if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+		log.Printf("Error creating attachment record for leave request %s: %v", leaveRequestID, err)
+		if delErr := s.storage.Delete(ctx, attachment.StorageKey); delErr != nil {
+			log.Printf("Error removing orphaned attachment file %s: %v", attachment.StorageKey, delErr)
+		}
+		return nil, ErrAttachmentSaveFailed
+	}
+	return attachment, nil
+}
+
+// UploadAttachment 為待審核或已核准的假單補上附件
+func (s *leaveAttachmentServiceImpl) UploadAttachment(ctx context.Context, leaveRequestIDStr string, uploaderAccountIDStr string, upload models.AttachmentUpload) (*models.LeaveAttachment, error) {
+	request, uploaderUUID, err := s.getAuthorizedRequest(ctx, leaveRequestIDStr, uploaderAccountIDStr, false)
+	if err != nil {
+		return nil, err
+	}
+	if request.Status != models.LeaveStatusPending && request.Status != models.LeaveStatusApproved {
+		return nil, ErrInvalidLeaveRequestState
+	}
+	return s.SaveAttachment(ctx, request.ID, uploaderUUID, upload)
+}
+
+// ListAttachments 列出假單的附件
+func (s *leaveAttachmentServiceImpl) ListAttachments(ctx context.Context, leaveRequestIDStr string, viewerAccountIDStr string) ([]models.LeaveAttachment, error) {
+	request, _, err := s.getAuthorizedRequest(ctx, leaveRequestIDStr, viewerAccountIDStr, true)
+	if err != nil {
+		return nil, err
+	}
+	attachments, err := s.attachmentRepo.ListByRequestID(ctx, request.ID)
+	if err != nil {
+		log.Printf("Error fetching attachments of leave request %s: %v", request.ID, err)
+		return nil, fmt.Errorf("failed to retrieve attachments")
+	}
+	return attachments, nil
+}
+
+// OpenAttachment 確認附件屬於該假單後開啟檔案
+func (s *leaveAttachmentServiceImpl) OpenAttachment(ctx context.Context, leaveRequestIDStr string, attachmentIDStr string, viewerAccountIDStr string) (*models.LeaveAttachment, io.ReadCloser, error) {
+	attachmentUUID, err := uuid.Parse(attachmentIDStr)
+	if err != nil {
+		return nil, nil, ErrAttachmentNotFound
+	}
+	request, _, err := s.getAuthorizedRequest(ctx, leaveRequestIDStr, viewerAccountIDStr, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, attachmentUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, ErrAttachmentNotFound
+		}
+		log.Printf("Error fetching attachment %s: %v", attachmentUUID, err)
+		return nil, nil, fmt.Errorf("failed to retrieve attachment")
+	}
+	if attachment.LeaveRequestID != request.ID {
+		return nil, nil, ErrAttachmentNotFound
+	}
+
+	content, err := s.storage.Open(ctx, attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, interfaces.ErrFileNotFound) {
+			log.Printf("Attachment %s has a record but its file is missing", attachment.ID)
+			return nil, nil, ErrAttachmentNotFound
+		}
+		log.Printf("Error opening attachment %s: %v", attachment.ID, err)
+		return nil, nil, fmt.Errorf("failed to open attachment")
+	}
+	return attachment, content, nil
+}
+
+// getAuthorizedRequest 取得假單並確認操作者為申請人本人或 HR / Super Admin
+// allowApprover 為 true 時 (僅限讀取), 目前待審步驟的審核人與其代理人亦可存取
+func (s *leaveAttachmentServiceImpl) getAuthorizedRequest(ctx context.Context, leaveRequestIDStr string, accountIDStr string, allowApprover bool) (*models.LeaveRequest, uuid.UUID, error) {
+	leaveRequestUUID, err := uuid.Parse(leaveRequestIDStr)
+	if err != nil {
+		return nil, uuid.Nil, errors.New("invalid leave request ID format")
+	}
+	accountUUID, err := uuid.Parse(accountIDStr)
+	if err != nil {
+		return nil, uuid.Nil, errors.New("invalid user identifier format")
+	}
+
+	request, err := s.leaveRepo.GetByID(ctx, leaveRequestUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, uuid.Nil, ErrLeaveRequestNotFound
+		}
+		log.Printf("Error fetching leave request %s: %v", leaveRequestUUID, err)
+		return nil, uuid.Nil, fmt.Errorf("failed to retrieve leave request")
+	}
+	if request.AccountID == accountUUID {
+		return request, accountUUID, nil
+	}
+
+	account, err := s.accountRepo.GetAccountByID(ctx, accountUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, uuid.Nil, ErrAccountNotFound
+		}
+		log.Printf("Error fetching account %s: %v", accountUUID, err)
+		return nil, uuid.Nil, fmt.Errorf("failed to verify account")
+	}
+	if isHRAccount(account) {
+		return request, accountUUID, nil
+	}
+	if !allowApprover {
+		return nil, uuid.Nil, ErrNotLeaveRequestOwner
+	}
+	isApprover, err := s.isCurrentApprover(ctx, request, accountUUID)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+	if !isApprover {
+		return nil, uuid.Nil, ErrNotLeaveRequestOwner
+	}
+	return request, accountUUID, nil
+}
+
+// isCurrentApprover 判斷非 HR 帳戶是否為待審假單目前步驟的審核人: manager 步驟的直屬主管,
+// 或持有涵蓋該步驟之有效代理的代理人 (委託人需有權審核該步驟, 且不可為申請人)
+func (s *leaveAttachmentServiceImpl) isCurrentApprover(ctx context.Context, request *models.LeaveRequest, accountID uuid.UUID) (bool, error) {
+	if request.Status != models.LeaveStatusPending {
+		return false, nil
+	}
+	stepKind, ok, err := s.currentStepKind(ctx, request.ID)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	var managerID *uuid.UUID
+	if stepKind == models.ApprovalStepManager {
+		managerID, err = s.applicantManagerID(ctx, request.AccountID)
+		if err != nil {
+			return false, err
+		}
+		if managerID != nil && *managerID == accountID {
+			return true, nil
+		}
+	}
+
+	delegations, err := s.delegationRepo.ListActiveForDelegate(ctx, accountID, time.Now())
+	if err != nil {
+		log.Printf("Error fetching active delegations for account %s: %v", accountID, err)
+		return false, fmt.Errorf("failed to verify approval delegation")
+	}
+	for _, delegation := range delegations {
+		if !delegation.Covers(stepKind) || delegation.DelegatorID == request.AccountID {
+			continue
+		}
+		if stepKind == models.ApprovalStepManager {
+			if managerID != nil && *managerID == delegation.DelegatorID {
+				return true, nil
+			}
+			continue
+		}
+		delegator, err := s.accountRepo.GetAccountByID(ctx, delegation.DelegatorID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			log.Printf("Error fetching delegator account %s: %v", delegation.DelegatorID, err)
+			return false, fmt.Errorf("failed to verify approval delegation")
+		}
+		if isHRAccount(delegator) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// currentStepKind 返回假單第一個待審步驟的類型; 尚未建立步驟的舊假單視為待主管審核
+func (s *leaveAttachmentServiceImpl) currentStepKind(ctx context.Context, leaveRequestID uuid.UUID) (string, bool, error) {
+	steps, err := s.approvalRepo.ListStepsByRequestID(ctx, leaveRequestID)
+	if err != nil {
+		log.Printf("Error fetching approval steps of leave request %s: %v", leaveRequestID, err)
+		return "", false, fmt.Errorf("failed to retrieve approval steps")
+	}
+	if len(steps) == 0 {
+		return models.ApprovalStepManager, true, nil
+	}
+	for _, step := range steps {
+		if step.Status == models.ApprovalStepStatusPending {
+			return step.ApproverKind, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// applicantManagerID 返回申請人的直屬主管 ID, 沒有任職資料或主管時為 nil
+func (s *leaveAttachmentServiceImpl) applicantManagerID(ctx context.Context, applicantID uuid.UUID) (*uuid.UUID, error) {
+	employment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, applicantID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		log.Printf("Error fetching employment of applicant %s: %v", applicantID, err)
+		return nil, fmt.Errorf("failed to verify reporting line")
+	}
+	return employment.ManagerID, nil
+}
+
+// detectContentType 檢查附件大小並偵測類型，僅接受 AllowedAttachmentContentTypes
+func (s *leaveAttachmentServiceImpl) detectContentType(upload models.AttachmentUpload) (string, error) {
+	if int64(len(upload.Data)) > s.maxSizeBytes {
+		return "", fmt.Errorf("%w: limit is %d MB", ErrAttachmentTooLarge, s.maxSizeBytes>>20)
+	}
+	if len(upload.Data) == 0 {
+		return "", fmt.Errorf("%w: file is empty", ErrUnsupportedAttachmentType)
+	}
+	contentType := http.DetectContentType(upload.Data)
+	for _, allowed := range models.AllowedAttachmentContentTypes {
+		if contentType == allowed {
+			return contentType, nil
+		}
+	}
+	return "", fmt.Errorf("%w (detected %s)", ErrUnsupportedAttachmentType, contentType)
+}
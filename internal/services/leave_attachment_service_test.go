@@ -0,0 +1,430 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// leaveAttachmentMocks 集中 LeaveAttachmentService 測試用的 mock 依賴
+type leaveAttachmentMocks struct {
+	attachmentRepo *mocks.MockLeaveAttachmentRepository
+	leaveRepo      *mocks.MockLeaveRequestRepository
+	accountRepo    *mocks.MockAccountRepository
+	employmentRepo *mocks.MockEmploymentRepository
+	approvalRepo   *mocks.MockLeaveApprovalRepository
+	delegationRepo *mocks.MockApprovalDelegationRepository
+	storage        *mocks.MockFileStorage
+}
+
+func newLeaveAttachmentServiceWithMocks(ctrl *gomock.Controller, maxSizeMB int) (interfaces.LeaveAttachmentService, leaveAttachmentMocks) {
+	m := leaveAttachmentMocks{
+		attachmentRepo: mocks.NewMockLeaveAttachmentRepository(ctrl),
+		leaveRepo:      mocks.NewMockLeaveRequestRepository(ctrl),
+		accountRepo:    mocks.NewMockAccountRepository(ctrl),
+		employmentRepo: mocks.NewMockEmploymentRepository(ctrl),
+		approvalRepo:   mocks.NewMockLeaveApprovalRepository(ctrl),
+		delegationRepo: mocks.NewMockApprovalDelegationRepository(ctrl),
+		storage:        mocks.NewMockFileStorage(ctrl),
+	}
+	return NewLeaveAttachmentServiceImpl(m.attachmentRepo, m.leaveRepo, m.accountRepo, m.employmentRepo, m.approvalRepo, m.delegationRepo, m.storage, maxSizeMB), m
+}
+
+var (
+	pdfContent = []byte("%PDF-1.4\n%test document\n")
+	pngContent = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+)
+
+func TestLeaveAttachmentServiceImpl_IsAttachmentRequired(t *testing.T) {
+	ctx := context.Background()
+	rule := &models.LeaveAttachmentRule{LeaveType: models.LeaveTypeSick, MinDays: decimal.NewFromInt(2), Active: true}
+
+	testCases := []struct {
+		name     string
+		rule     *models.LeaveAttachmentRule
+		repoErr  error
+		days     decimal.Decimal
+		expected bool
+		wantErr  bool
+	}{
+		{"Within Threshold", rule, nil, decimal.NewFromInt(2), false, false},
+		{"Above Threshold", rule, nil, decimal.NewFromFloat(2.5), true, false},
+		{"Inactive Rule", &models.LeaveAttachmentRule{MinDays: decimal.Zero, Active: false}, nil, decimal.NewFromInt(5), false, false},
+		{"No Rule", nil, gorm.ErrRecordNotFound, decimal.NewFromInt(5), false, false},
+		{"Repo Error", nil, errors.New("db down"), decimal.NewFromInt(5), false, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+			m.attachmentRepo.EXPECT().GetRuleByLeaveType(gomock.Any(), models.LeaveTypeSick).Return(tc.rule, tc.repoErr).Times(1)
+
+			required, err := service.IsAttachmentRequired(ctx, models.LeaveTypeSick, tc.days)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, required)
+		})
+	}
+}
+
+func TestLeaveAttachmentServiceImpl_ValidateUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, _ := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+	assert.NoError(t, service.ValidateUpload(models.AttachmentUpload{FileName: "note.pdf", Data: pdfContent}))
+	assert.NoError(t, service.ValidateUpload(models.AttachmentUpload{FileName: "scan.png", Data: pngContent}))
+
+	// 類型以內容判斷，副檔名不影響結果
+	err := service.ValidateUpload(models.AttachmentUpload{FileName: "note.pdf", Data: []byte("plain text pretending to be a pdf")})
+	assert.ErrorIs(t, err, ErrUnsupportedAttachmentType)
+
+	err = service.ValidateUpload(models.AttachmentUpload{FileName: "empty.pdf"})
+	assert.ErrorIs(t, err, ErrUnsupportedAttachmentType)
+
+	tooLarge := append(append([]byte{}, pdfContent...), make([]byte, 1<<20)...)
+	err = service.ValidateUpload(models.AttachmentUpload{FileName: "big.pdf", Data: tooLarge})
+	assert.ErrorIs(t, err, ErrAttachmentTooLarge)
+}
+
+func TestLeaveAttachmentServiceImpl_SaveAttachment(t *testing.T) {
+	ctx := context.Background()
+	requestID := uuid.New()
+	uploaderID := uuid.New()
+	upload := models.AttachmentUpload{FileName: "../../etc/certificate.pdf", Data: pdfContent}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+		var storedKey string
+
+		m.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key string, r io.Reader) error {
+				storedKey = key
+				data, err := io.ReadAll(r)
+				require.NoError(t, err)
+				assert.Equal(t, pdfContent, data)
+				return nil
+			}).Times(1)
+		m.attachmentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		attachment, err := service.SaveAttachment(ctx, requestID, uploaderID, upload)
+
+		require.NoError(t, err)
+		assert.Equal(t, "certificate.pdf", attachment.FileName, "directory components are stripped")
+		assert.Equal(t, "application/pdf", attachment.ContentType)
+		assert.Equal(t, int64(len(pdfContent)), attachment.Size)
+		assert.Equal(t, uploaderID, attachment.UploadedByID)
+		assert.Equal(t, storedKey, attachment.StorageKey)
+		assert.True(t, strings.HasPrefix(storedKey, "leave-requests/"+requestID.String()+"/"))
+	})
+
+	t.Run("Failure - Storage Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+		m.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("disk full")).Times(1)
+		// Create should NOT be called
+
+		attachment, err := service.SaveAttachment(ctx, requestID, uploaderID, upload)
+
+		assert.ErrorIs(t, err, ErrAttachmentSaveFailed)
+		assert.Nil(t, attachment)
+	})
+
+	t.Run("Failure - Record Error Removes File", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+		var storedKey string
+
+		m.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key string, r io.Reader) error {
+				storedKey = key
+				return nil
+			}).Times(1)
+		m.attachmentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db down")).Times(1)
+		m.storage.EXPECT().Delete(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key string) error {
+				assert.Equal(t, storedKey, key)
+				return nil
+			}).Times(1)
+
+		attachment, err := service.SaveAttachment(ctx, requestID, uploaderID, upload)
+
+		assert.ErrorIs(t, err, ErrAttachmentSaveFailed)
+		assert.Nil(t, attachment)
+	})
+}
+
+func TestLeaveAttachmentServiceImpl_UploadAttachment(t *testing.T) {
+	ctx := context.Background()
+	applicantID := uuid.New()
+	otherID := uuid.New()
+	requestID := uuid.New()
+	upload := models.AttachmentUpload{FileName: "certificate.pdf", Data: pdfContent}
+
+	t.Run("Success - Applicant Uploads To Pending Request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), requestID).
+			Return(&models.LeaveRequest{ID: requestID, AccountID: applicantID, Status: models.LeaveStatusPending}, nil).Times(1)
+		m.storage.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.attachmentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		attachment, err := service.UploadAttachment(ctx, requestID.String(), applicantID.String(), upload)
+
+		require.NoError(t, err)
+		assert.Equal(t, requestID, attachment.LeaveRequestID)
+		assert.Equal(t, applicantID, attachment.UploadedByID)
+	})
+
+	t.Run("Failure - Request Already Rejected", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), requestID).
+			Return(&models.LeaveRequest{ID: requestID, AccountID: applicantID, Status: models.LeaveStatusRejected}, nil).Times(1)
+
+		attachment, err := service.UploadAttachment(ctx, requestID.String(), applicantID.String(), upload)
+
+		assert.ErrorIs(t, err, ErrInvalidLeaveRequestState)
+		assert.Nil(t, attachment)
+	})
+
+	t.Run("Failure - Not Owner Nor HR", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), requestID).
+			Return(&models.LeaveRequest{ID: requestID, AccountID: applicantID, Status: models.LeaveStatusPending}, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), otherID).
+			Return(&models.Account{ID: otherID, Role: models.RoleEmployee}, nil).Times(1)
+
+		attachment, err := service.UploadAttachment(ctx, requestID.String(), otherID.String(), upload)
+
+		assert.ErrorIs(t, err, ErrNotLeaveRequestOwner)
+		assert.Nil(t, attachment)
+	})
+
+	t.Run("Failure - Request Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), requestID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		_, err := service.UploadAttachment(ctx, requestID.String(), applicantID.String(), upload)
+
+		assert.ErrorIs(t, err, ErrLeaveRequestNotFound)
+	})
+}
+
+func TestLeaveAttachmentServiceImpl_OpenAttachment(t *testing.T) {
+	ctx := context.Background()
+	applicantID := uuid.New()
+	hrID := uuid.New()
+	requestID := uuid.New()
+	attachmentID := uuid.New()
+	request := &models.LeaveRequest{ID: requestID, AccountID: applicantID, Status: models.LeaveStatusApproved}
+	attachment := &models.LeaveAttachment{ID: attachmentID, LeaveRequestID: requestID, StorageKey: "leave-requests/x/y", ContentType: "application/pdf"}
+
+	t.Run("Success - HR Downloads", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), requestID).Return(request, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(&models.Account{ID: hrID, Role: models.RoleHR}, nil).Times(1)
+		m.attachmentRepo.EXPECT().GetByID(gomock.Any(), attachmentID).Return(attachment, nil).Times(1)
+		m.storage.EXPECT().Open(gomock.Any(), attachment.StorageKey).Return(io.NopCloser(strings.NewReader("%PDF-1.4")), nil).Times(1)
+
+		got, content, err := service.OpenAttachment(ctx, requestID.String(), attachmentID.String(), hrID.String())
+
+		require.NoError(t, err)
+		defer content.Close()
+		assert.Equal(t, attachmentID, got.ID)
+		data, _ := io.ReadAll(content)
+		assert.Equal(t, "%PDF-1.4", string(data))
+	})
+
+	t.Run("Failure - Attachment Belongs To Another Request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+		foreign := *attachment
+		foreign.LeaveRequestID = uuid.New()
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), requestID).Return(request, nil).Times(1)
+		m.attachmentRepo.EXPECT().GetByID(gomock.Any(), attachmentID).Return(&foreign, nil).Times(1)
+		// Open should NOT be called
+
+		_, _, err := service.OpenAttachment(ctx, requestID.String(), attachmentID.String(), applicantID.String())
+
+		assert.ErrorIs(t, err, ErrAttachmentNotFound)
+	})
+
+	t.Run("Failure - File Missing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), requestID).Return(request, nil).Times(1)
+		m.attachmentRepo.EXPECT().GetByID(gomock.Any(), attachmentID).Return(attachment, nil).Times(1)
+		m.storage.EXPECT().Open(gomock.Any(), attachment.StorageKey).Return(nil, interfaces.ErrFileNotFound).Times(1)
+
+		_, _, err := service.OpenAttachment(ctx, requestID.String(), attachmentID.String(), applicantID.String())
+
+		assert.ErrorIs(t, err, ErrAttachmentNotFound)
+	})
+
+	t.Run("Failure - Invalid Attachment ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+		_, _, err := service.OpenAttachment(ctx, requestID.String(), "not-a-uuid", applicantID.String())
+
+		assert.ErrorIs(t, err, ErrAttachmentNotFound)
+	})
+}
+
+func TestLeaveAttachmentServiceImpl_ListAttachments(t *testing.T) {
+	ctx := context.Background()
+	applicantID := uuid.New()
+	managerID := uuid.New()
+	delegateID := uuid.New()
+	hrID := uuid.New()
+	requestID := uuid.New()
+	pending := &models.LeaveRequest{ID: requestID, AccountID: applicantID, Status: models.LeaveStatusPending}
+	attachments := []models.LeaveAttachment{{ID: uuid.New(), LeaveRequestID: requestID}}
+	managerStepPending := []models.LeaveApprovalStep{
+		{StepOrder: 1, ApproverKind: models.ApprovalStepManager, Status: models.ApprovalStepStatusPending},
+		{StepOrder: 2, ApproverKind: models.ApprovalStepHR, Status: models.ApprovalStepStatusPending},
+	}
+	hrStepPending := []models.LeaveApprovalStep{
+		{StepOrder: 1, ApproverKind: models.ApprovalStepManager, Status: models.ApprovalStepStatusApproved},
+		{StepOrder: 2, ApproverKind: models.ApprovalStepHR, Status: models.ApprovalStepStatusPending},
+	}
+	employment := &models.Employment{AccountID: applicantID, ManagerID: &managerID}
+
+	testCases := []struct {
+		name       string
+		request    *models.LeaveRequest
+		viewerID   uuid.UUID
+		setupMocks func(m leaveAttachmentMocks)
+		wantErr    error
+	}{
+		{
+			name:     "Success - Manager On Current Manager Step",
+			request:  pending,
+			viewerID: managerID,
+			setupMocks: func(m leaveAttachmentMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(&models.Account{ID: managerID, Role: models.RoleEmployee}, nil).Times(1)
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requestID).Return(managerStepPending, nil).Times(1)
+				m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(employment, nil).Times(1)
+			},
+		},
+		{
+			name:     "Success - Delegate Of Manager",
+			request:  pending,
+			viewerID: delegateID,
+			setupMocks: func(m leaveAttachmentMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateID).Return(&models.Account{ID: delegateID, Role: models.RoleEmployee}, nil).Times(1)
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requestID).Return(managerStepPending, nil).Times(1)
+				m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(employment, nil).Times(1)
+				m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), delegateID, gomock.Any()).
+					Return([]models.ApprovalDelegation{{DelegatorID: managerID, DelegateID: delegateID, Scope: models.DelegationScopeAll}}, nil).Times(1)
+			},
+		},
+		{
+			name:     "Success - Delegate Of HR On HR Step",
+			request:  pending,
+			viewerID: delegateID,
+			setupMocks: func(m leaveAttachmentMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateID).Return(&models.Account{ID: delegateID, Role: models.RoleEmployee}, nil).Times(1)
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requestID).Return(hrStepPending, nil).Times(1)
+				m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), delegateID, gomock.Any()).
+					Return([]models.ApprovalDelegation{{DelegatorID: hrID, DelegateID: delegateID, Scope: models.DelegationScopeHR}}, nil).Times(1)
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(&models.Account{ID: hrID, Role: models.RoleHR}, nil).Times(1)
+			},
+		},
+		{
+			name:     "Failure - Manager After Step Moved To HR",
+			request:  pending,
+			viewerID: managerID,
+			setupMocks: func(m leaveAttachmentMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(&models.Account{ID: managerID, Role: models.RoleEmployee}, nil).Times(1)
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requestID).Return(hrStepPending, nil).Times(1)
+				m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), managerID, gomock.Any()).Return(nil, nil).Times(1)
+			},
+			wantErr: ErrNotLeaveRequestOwner,
+		},
+		{
+			name:     "Failure - Manager Of Decided Request",
+			request:  &models.LeaveRequest{ID: requestID, AccountID: applicantID, Status: models.LeaveStatusApproved},
+			viewerID: managerID,
+			setupMocks: func(m leaveAttachmentMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(&models.Account{ID: managerID, Role: models.RoleEmployee}, nil).Times(1)
+			},
+			wantErr: ErrNotLeaveRequestOwner,
+		},
+		{
+			name:     "Failure - Delegation Scoped To Another Step",
+			request:  pending,
+			viewerID: delegateID,
+			setupMocks: func(m leaveAttachmentMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateID).Return(&models.Account{ID: delegateID, Role: models.RoleEmployee}, nil).Times(1)
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requestID).Return(managerStepPending, nil).Times(1)
+				m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(employment, nil).Times(1)
+				m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), delegateID, gomock.Any()).
+					Return([]models.ApprovalDelegation{{DelegatorID: managerID, DelegateID: delegateID, Scope: models.DelegationScopeHR}}, nil).Times(1)
+			},
+			wantErr: ErrNotLeaveRequestOwner,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, m := newLeaveAttachmentServiceWithMocks(ctrl, 1)
+
+			m.leaveRepo.EXPECT().GetByID(gomock.Any(), requestID).Return(tc.request, nil).Times(1)
+			tc.setupMocks(m)
+			if tc.wantErr == nil {
+				m.attachmentRepo.EXPECT().ListByRequestID(gomock.Any(), requestID).Return(attachments, nil).Times(1)
+			}
+
+			got, err := service.ListAttachments(ctx, requestID.String(), tc.viewerID.String())
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, attachments, got)
+		})
+	}
+}
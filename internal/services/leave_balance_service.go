@@ -0,0 +1,761 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// leaveBalanceServiceImpl 實現了 LeaveBalanceService 介面
+type leaveBalanceServiceImpl struct {
+	balanceRepo    interfaces.LeaveBalanceRepository
+	employmentRepo interfaces.EmploymentRepository // 用於取得到職/離職日期以決定累積區間
+}
+
+// NewLeaveBalanceServiceImpl 構造函數
+func NewLeaveBalanceServiceImpl(
+	balanceRepo interfaces.LeaveBalanceRepository,
+	employmentRepo interfaces.EmploymentRepository,
+) interfaces.LeaveBalanceService {
+	return &leaveBalanceServiceImpl{
+		balanceRepo:    balanceRepo,
+		employmentRepo: employmentRepo,
+	}
+}
+
+// GetBalances 獲取帳戶各假別目前的餘額 (只加總帳本分錄, 不會寫入)
+// 有累積規則但尚無任何分錄的假別也會以 0 列出
+func (s *leaveBalanceServiceImpl) GetBalances(ctx context.Context, accountID uuid.UUID) ([]models.LeaveBalance, error) {
+	rules, err := s.listAccrualRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.sumBalances(ctx, accountID, rules)
+}
+
+// PostAccruals 為所有帳戶補入 asOf (含) 以前尚未入帳的累積分錄，並讓已過期的結轉天數與補休失效
+// 由背景排程定期執行; 分錄皆有冪等 key, 重複執行不會重複入帳。單一帳戶失敗時記錄後繼續處理其他帳戶
+func (s *leaveBalanceServiceImpl) PostAccruals(ctx context.Context, asOf time.Time) error {
+	asOf = dateOf(asOf)
+	employments, err := s.employmentRepo.ListEmployments(ctx)
+	if err != nil {
+		log.Printf("Error fetching employments for leave accruals: %v", err)
+		return fmt.Errorf("failed to retrieve employment records")
+	}
+
+	failed := 0
+	for _, employment := range employments {
+		if employment.HireDate != nil && employment.HireDate.After(asOf) {
+			continue // 尚未到職
+		}
+		if err := s.postPendingEntries(ctx, employment.AccountID, asOf); err != nil {
+			log.Printf("Error posting leave accruals for account %s: %v", employment.AccountID, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to post leave accruals for %d of %d account(s)", failed, len(employments))
+	}
+	log.Printf("Leave accruals posted as of %s for %d account(s)", asOf.Format("2006-01-02"), len(employments))
+	return nil
+}
+
+// postPendingEntries 補入單一帳戶的累積分錄與結轉 / 補休失效分錄
+func (s *leaveBalanceServiceImpl) postPendingEntries(ctx context.Context, accountID uuid.UUID, asOf time.Time) error {
+	rules, err := s.postPendingAccruals(ctx, accountID, asOf)
+	if err != nil {
+		return err
+	}
+	if err := s.postPendingCarryOverExpiries(ctx, accountID, rules, asOf); err != nil {
+		return err
+	}
+	return s.postPendingCompOffExpiries(ctx, accountID, asOf)
+}
+
+// CheckSufficientBalance 檢查有額度的假別餘額是否足夠
+// 只有設定累積規則的假別與補休 (由加班換得) 有額度; HR 新增但未設定累積規則的假別不限天數
+func (s *leaveBalanceServiceImpl) CheckSufficientBalance(ctx context.Context, accountID uuid.UUID, leaveType string, days decimal.Decimal) error {
+	rules, err := s.listAccrualRules(ctx)
+	if err != nil {
+		return err
+	}
+	if !hasLeaveQuota(rules, leaveType) {
+		return nil
+	}
+
+	balances, err := s.sumBalances(ctx, accountID, rules)
+	if err != nil {
+		return err
+	}
+	available := decimal.Zero
+	for _, b := range balances {
+		if b.LeaveType == leaveType {
+			available = b.Balance
+			break
+		}
+	}
+	if available.LessThan(days) {
+		log.Printf("Account %s has %s day(s) of %s leave, %s requested", accountID, available, leaveType, days)
+		return ErrInsufficientLeaveBalance
+	}
+	return nil
+}
+
+// hasLeaveQuota 判斷假別是否有額度限制: 有累積規則的假別或補休
+func hasLeaveQuota(rules []models.LeaveAccrualRule, leaveType string) bool {
+	if leaveType == models.LeaveTypeCompOff {
+		return true
+	}
+	for _, rule := range rules {
+		if rule.LeaveType == leaveType {
+			return true
+		}
+	}
+	return false
+}
+
+// listAccrualRules 取得所有啟用中的累積規則
+func (s *leaveBalanceServiceImpl) listAccrualRules(ctx context.Context) ([]models.LeaveAccrualRule, error) {
+	rules, err := s.balanceRepo.ListActiveAccrualRules(ctx)
+	if err != nil {
+		log.Printf("Error fetching accrual rules: %v", err)
+		return nil, fmt.Errorf("failed to retrieve leave accrual rules")
+	}
+	return rules, nil
+}
+
+// sumBalances 加總帳戶今日 (含) 以前的分錄; rules 中的假別即使沒有分錄也以 0 列出
+func (s *leaveBalanceServiceImpl) sumBalances(ctx context.Context, accountID uuid.UUID, rules []models.LeaveAccrualRule) ([]models.LeaveBalance, error) {
+	sums, err := s.balanceRepo.SumByAccount(ctx, accountID, dateOf(time.Now()))
+	if err != nil {
+		log.Printf("Error summing leave balances for account %s: %v", accountID, err)
+		return nil, fmt.Errorf("failed to retrieve leave balances")
+	}
+
+	byType := make(map[string]decimal.Decimal, len(sums)+len(rules))
+	for _, rule := range rules {
+		byType[rule.LeaveType] = decimal.Zero
+	}
+	for _, sum := range sums {
+		byType[sum.LeaveType] = sum.Balance
+	}
+
+	balances := make([]models.LeaveBalance, 0, len(byType))
+	for leaveType, balance := range byType {
+		balances = append(balances, models.LeaveBalance{LeaveType: leaveType, Balance: balance})
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].LeaveType < balances[j].LeaveType })
+	return balances, nil
+}
+
+// DebitForLeave 為已核准的假單扣除天數; 須在核准事務中呼叫
+// 先鎖定帳戶再重新檢查餘額，避免同一帳戶的兩張假單同時通過核准前的檢查而超扣
+func (s *leaveBalanceServiceImpl) DebitForLeave(ctx context.Context, request *models.LeaveRequest, days decimal.Decimal) error {
+	if err := s.balanceRepo.LockAccount(ctx, request.AccountID); err != nil {
+		log.Printf("Error locking leave balance of account %s for request %s: %v", request.AccountID, request.ID, err)
+		return ErrLeaveBalanceUpdateFailed
+	}
+	if err := s.CheckSufficientBalance(ctx, request.AccountID, request.LeaveType, days); err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("debit:%s", request.ID)
+	entry := &models.LeaveBalanceEntry{
+		AccountID:      request.AccountID,
+		LeaveType:      request.LeaveType,
+		EntryType:      models.LeaveEntryTypeDebit,
+		Amount:         days.Neg(),
+		EffectiveDate:  dateOf(time.Now()),
+		LeaveRequestID: &request.ID,
+		IdempotencyKey: &key,
+		Note:           fmt.Sprintf("Leave %s to %s", request.StartDate.Format("2006-01-02"), request.EndDate.Format("2006-01-02")),
+	}
+	if err := s.balanceRepo.CreateEntry(ctx, entry); err != nil {
+		log.Printf("Error posting leave debit for request %s: %v", request.ID, err)
+		return ErrLeaveBalanceUpdateFailed
+	}
+	return nil
+}
+
+// RestoreForLeave 為取消的已核准假單退回天數
+func (s *leaveBalanceServiceImpl) RestoreForLeave(ctx context.Context, request *models.LeaveRequest) error {
+	key := fmt.Sprintf("restore:%s", request.ID)
+	entry := &models.LeaveBalanceEntry{
+		AccountID:      request.AccountID,
+		LeaveType:      request.LeaveType,
+		EntryType:      models.LeaveEntryTypeReversal,
+		Amount:         request.Days,
+		EffectiveDate:  dateOf(time.Now()),
+		LeaveRequestID: &request.ID,
+		IdempotencyKey: &key,
+		Note:           fmt.Sprintf("Cancelled leave %s to %s", request.StartDate.Format("2006-01-02"), request.EndDate.Format("2006-01-02")),
+	}
+	if err := s.balanceRepo.CreateEntry(ctx, entry); err != nil {
+		log.Printf("Error posting leave reversal for request %s: %v", request.ID, err)
+		return ErrLeaveBalanceUpdateFailed
+	}
+	return nil
+}
+
+// RestoreForShortenedLeave 為截短的已核准假單退回縮減的天數
+func (s *leaveBalanceServiceImpl) RestoreForShortenedLeave(ctx context.Context, request *models.LeaveRequest, days decimal.Decimal) error {
+	key := fmt.Sprintf("shorten:%s", request.ID)
+	entry := &models.LeaveBalanceEntry{
+		AccountID:      request.AccountID,
+		LeaveType:      request.LeaveType,
+		EntryType:      models.LeaveEntryTypeReversal,
+		Amount:         days,
+		EffectiveDate:  dateOf(time.Now()),
+		LeaveRequestID: &request.ID,
+		IdempotencyKey: &key,
+		Note:           fmt.Sprintf("Leave from %s shortened to end on %s", request.StartDate.Format("2006-01-02"), request.EndDate.Format("2006-01-02")),
+	}
+	if err := s.balanceRepo.CreateEntry(ctx, entry); err != nil {
+		log.Printf("Error posting leave reversal for shortened request %s: %v", request.ID, err)
+		return ErrLeaveBalanceUpdateFailed
+	}
+	return nil
+}
+
+// CreditCompOff 為核准的加班申請存入補休天數
+func (s *leaveBalanceServiceImpl) CreditCompOff(ctx context.Context, claim *models.OvertimeClaim) error {
+	key := fmt.Sprintf("comp-off:%s", claim.ID)
+	entry := &models.LeaveBalanceEntry{
+		AccountID:      claim.AccountID,
+		LeaveType:      models.LeaveTypeCompOff,
+		EntryType:      models.LeaveEntryTypeEarned,
+		Amount:         claim.CompOffDays,
+		EffectiveDate:  dateOf(time.Now()),
+		IdempotencyKey: &key,
+		Note:           fmt.Sprintf("Overtime on %s (%s hours)", claim.WorkDate.Format("2006-01-02"), claim.Hours),
+		ExpiresOn:      claim.CompOffExpiresOn,
+	}
+	if err := s.balanceRepo.CreateEntry(ctx, entry); err != nil {
+		log.Printf("Error posting comp-off credit for overtime claim %s: %v", claim.ID, err)
+		return ErrLeaveBalanceUpdateFailed
+	}
+	return nil
+}
+
+// RunYearEnd 對 year 年做年底結算: 依各假別的結轉政策將 12/31 的餘額結轉至隔年 (最多 CarryOverCap 天)，
+// 超過上限的部分失效。每個帳戶、假別同一年只會結算一次，重複執行只會補上尚未結算的帳戶。
+// 結算前離職或尚未到職的帳戶不做結算。
+func (s *leaveBalanceServiceImpl) RunYearEnd(ctx context.Context, year int) (*models.LeaveYearEndSummary, error) {
+	closingDate := time.Date(year, time.December, 31, 0, 0, 0, 0, time.Local)
+	if !dateOf(time.Now()).After(closingDate) {
+		return nil, ErrYearNotEnded
+	}
+	carriedOn := closingDate.AddDate(0, 0, 1)
+
+	rules, err := s.balanceRepo.ListActiveAccrualRules(ctx)
+	if err != nil {
+		log.Printf("Error fetching accrual rules for %d year-end: %v", year, err)
+		return nil, fmt.Errorf("failed to retrieve leave accrual rules")
+	}
+	var policies []models.LeaveAccrualRule
+	for _, rule := range rules {
+		if rule.CarryOverCap != nil {
+			policies = append(policies, rule)
+		}
+	}
+
+	existing, err := s.balanceRepo.ListCarryOverRecords(ctx, year)
+	if err != nil {
+		log.Printf("Error fetching %d carry-over records: %v", year, err)
+		return nil, fmt.Errorf("failed to retrieve year-end records")
+	}
+	processed := make(map[string]bool, len(existing))
+	for _, record := range existing {
+		processed[record.AccountID.String()+":"+record.LeaveType] = true
+	}
+
+	employments, err := s.employmentRepo.ListEmployments(ctx)
+	if err != nil {
+		log.Printf("Error fetching employments for %d year-end: %v", year, err)
+		return nil, fmt.Errorf("failed to retrieve employment records")
+	}
+
+	closedAccounts := 0
+	for _, employment := range employments {
+		if employment.HireDate != nil && employment.HireDate.After(closingDate) {
+			continue
+		}
+		if employment.TerminationDate != nil && !employment.TerminationDate.After(closingDate) {
+			continue
+		}
+		var pending []models.LeaveAccrualRule
+		for _, policy := range policies {
+			if !processed[employment.AccountID.String()+":"+policy.LeaveType] {
+				pending = append(pending, policy)
+			}
+		}
+		if len(pending) == 0 {
+			continue
+		}
+
+		// 先補齊到 12/31 為止的累積與逾期的結轉，結算的餘額才正確
+		if _, err := s.postPendingAccruals(ctx, employment.AccountID, closingDate); err != nil {
+			return nil, err
+		}
+		if err := s.postPendingCarryOverExpiries(ctx, employment.AccountID, rules, closingDate); err != nil {
+			return nil, err
+		}
+		sums, err := s.balanceRepo.SumByAccount(ctx, employment.AccountID, closingDate)
+		if err != nil {
+			log.Printf("Error summing %d closing balances for account %s: %v", year, employment.AccountID, err)
+			return nil, fmt.Errorf("failed to retrieve leave balances")
+		}
+		closing := make(map[string]decimal.Decimal, len(sums))
+		for _, sum := range sums {
+			closing[sum.LeaveType] = sum.Balance
+		}
+
+		for _, policy := range pending {
+			record, entries := yearEndEntries(employment.AccountID, policy, year, closing[policy.LeaveType], carriedOn)
+			if err := s.balanceRepo.PostYearEnd(ctx, record, entries); err != nil {
+				log.Printf("Error posting %d year-end for account %s (%s): %v", year, employment.AccountID, policy.LeaveType, err)
+				return nil, ErrLeaveBalanceUpdateFailed
+			}
+		}
+		closedAccounts++
+	}
+	log.Printf("Year-end %d: closed %d account(s) for %d leave type(s) with a carry-over policy", year, closedAccounts, len(policies))
+
+	records, err := s.balanceRepo.ListCarryOverRecords(ctx, year)
+	if err != nil {
+		log.Printf("Error fetching %d carry-over records: %v", year, err)
+		return nil, fmt.Errorf("failed to retrieve year-end records")
+	}
+	return buildYearEndSummary(year, records), nil
+}
+
+// GetYearEndSummary 獲取 year 年的年底結算報表，尚未結算時返回 ErrYearEndNotProcessed
+func (s *leaveBalanceServiceImpl) GetYearEndSummary(ctx context.Context, year int) (*models.LeaveYearEndSummary, error) {
+	records, err := s.balanceRepo.ListCarryOverRecords(ctx, year)
+	if err != nil {
+		log.Printf("Error fetching %d carry-over records: %v", year, err)
+		return nil, fmt.Errorf("failed to retrieve year-end records")
+	}
+	if len(records) == 0 {
+		return nil, ErrYearEndNotProcessed
+	}
+	return buildYearEndSummary(year, records), nil
+}
+
+// SettleForTermination 離職結算: 補入至離職日 (含) 的累積分錄，並扣回離職日所在期別中離職日之後尚未賺得的部分
+// 離職日早於已入帳的累積期別 (追溯離職) 時，該期的累積全數扣回。返回結算後的剩餘天數 (可能為負數，表示預支超休)
+func (s *leaveBalanceServiceImpl) SettleForTermination(ctx context.Context, accountID uuid.UUID, leaveType string, terminationDate time.Time) (*models.LeaveBalanceSettlement, error) {
+	terminationDate = dateOf(terminationDate)
+	rules, err := s.postPendingAccruals(ctx, accountID, terminationDate)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.postPendingCarryOverExpiries(ctx, accountID, rules, terminationDate); err != nil {
+		return nil, err
+	}
+
+	settlement := &models.LeaveBalanceSettlement{LeaveType: leaveType}
+	for _, rule := range rules {
+		if rule.LeaveType != leaveType {
+			continue
+		}
+		entries, err := s.balanceRepo.ListEntries(ctx, accountID, leaveType)
+		if err != nil {
+			log.Printf("Error fetching %s entries for account %s: %v", leaveType, accountID, err)
+			return nil, fmt.Errorf("failed to retrieve leave balance history")
+		}
+		entry := terminationProrationEntry(accountID, rule, entries, terminationDate)
+		if entry == nil {
+			break
+		}
+		if err := s.balanceRepo.CreateEntry(ctx, entry); err != nil {
+			log.Printf("Error posting termination pro-ration for account %s (%s): %v", accountID, leaveType, err)
+			return nil, ErrLeaveBalanceUpdateFailed
+		}
+		settlement.ProRatedAdjustment = entry.Amount
+		break
+	}
+
+	// 離職日在未來時, 累積與扣回分錄的生效日也在未來, 需一併計入
+	asOf := dateOf(time.Now())
+	if terminationDate.After(asOf) {
+		asOf = terminationDate
+	}
+	sums, err := s.balanceRepo.SumByAccount(ctx, accountID, asOf)
+	if err != nil {
+		log.Printf("Error summing leave balances for account %s: %v", accountID, err)
+		return nil, fmt.Errorf("failed to retrieve leave balances")
+	}
+	for _, sum := range sums {
+		if sum.LeaveType == leaveType {
+			settlement.Remaining = sum.Balance
+			break
+		}
+	}
+	return settlement, nil
+}
+
+// postPendingCarryOverExpiries 將已過最後可用日仍未用完的結轉天數轉為失效
+// 假單扣除時優先使用結轉天數，因此結轉後到最後可用日之間的扣除 (扣掉取消退回) 都算用掉結轉天數
+func (s *leaveBalanceServiceImpl) postPendingCarryOverExpiries(ctx context.Context, accountID uuid.UUID, rules []models.LeaveAccrualRule, asOf time.Time) error {
+	for _, rule := range rules {
+		if rule.CarryOverCap == nil || rule.CarryOverExpiryMonths <= 0 {
+			continue
+		}
+		carry, err := s.balanceRepo.GetLatestEntry(ctx, accountID, rule.LeaveType, models.LeaveEntryTypeCarryOver)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			log.Printf("Error fetching latest carry-over for account %s (%s): %v", accountID, rule.LeaveType, err)
+			return fmt.Errorf("failed to retrieve leave carry-over history")
+		}
+		expiresOn := rule.CarryOverExpiresOn(carry.EffectiveDate)
+		if !asOf.After(*expiresOn) {
+			continue
+		}
+		latestExpiry, err := s.balanceRepo.GetLatestEntry(ctx, accountID, rule.LeaveType, models.LeaveEntryTypeExpiry)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("Error fetching latest expiry for account %s (%s): %v", accountID, rule.LeaveType, err)
+			return fmt.Errorf("failed to retrieve leave carry-over history")
+		}
+		if latestExpiry != nil && latestExpiry.EffectiveDate.After(*expiresOn) {
+			continue // 已處理過
+		}
+
+		used, err := s.balanceRepo.SumEntriesBetween(ctx, accountID, rule.LeaveType,
+			[]string{models.LeaveEntryTypeDebit, models.LeaveEntryTypeReversal}, carry.EffectiveDate, *expiresOn)
+		if err != nil {
+			log.Printf("Error summing leave used from carry-over for account %s (%s): %v", accountID, rule.LeaveType, err)
+			return fmt.Errorf("failed to retrieve leave carry-over history")
+		}
+		unused := carry.Amount.Add(used) // used 為負數
+		if !unused.IsPositive() {
+			continue
+		}
+		key := fmt.Sprintf("carry-over-expiry:%s:%s:%d", accountID, rule.LeaveType, carry.EffectiveDate.Year())
+		entry := &models.LeaveBalanceEntry{
+			AccountID:      accountID,
+			LeaveType:      rule.LeaveType,
+			EntryType:      models.LeaveEntryTypeExpiry,
+			Amount:         unused.Neg(),
+			EffectiveDate:  expiresOn.AddDate(0, 0, 1),
+			IdempotencyKey: &key,
+			Note:           fmt.Sprintf("Unused carry-over expired after %s", expiresOn.Format("2006-01-02")),
+		}
+		if err := s.balanceRepo.CreateEntry(ctx, entry); err != nil {
+			log.Printf("Error posting carry-over expiry for account %s (%s): %v", accountID, rule.LeaveType, err)
+			return ErrLeaveBalanceUpdateFailed
+		}
+	}
+	return nil
+}
+
+// postPendingCompOffExpiries 將已過最後可用日仍未用完的補休轉為失效
+func (s *leaveBalanceServiceImpl) postPendingCompOffExpiries(ctx context.Context, accountID uuid.UUID, asOf time.Time) error {
+	entries, err := s.balanceRepo.ListEntries(ctx, accountID, models.LeaveTypeCompOff)
+	if err != nil {
+		log.Printf("Error fetching comp-off entries for account %s: %v", accountID, err)
+		return fmt.Errorf("failed to retrieve comp-off history")
+	}
+	for _, entry := range compOffExpiryEntries(accountID, entries, asOf) {
+		if err := s.balanceRepo.CreateEntry(ctx, entry); err != nil {
+			log.Printf("Error posting comp-off expiry for account %s: %v", accountID, err)
+			return ErrLeaveBalanceUpdateFailed
+		}
+	}
+	return nil
+}
+
+// compOffExpiryEntries 依帳本分錄計算每筆補休尚未用完的天數，為已過最後可用日且尚未處理的補休產生失效分錄
+// 扣除時優先使用最早換得且仍有效的補休; 取消退回的天數歸還給最近被使用且仍有效的補休
+func compOffExpiryEntries(accountID uuid.UUID, entries []models.LeaveBalanceEntry, asOf time.Time) []*models.LeaveBalanceEntry {
+	type grant struct {
+		entry     models.LeaveBalanceEntry
+		remaining decimal.Decimal
+	}
+	var grants []*grant
+	expired := make(map[string]bool) // 已有失效分錄的 key
+	validOn := func(g *grant, day time.Time) bool {
+		return g.entry.ExpiresOn == nil || !day.After(*g.entry.ExpiresOn)
+	}
+
+	for _, entry := range entries {
+		switch {
+		case entry.EntryType == models.LeaveEntryTypeEarned:
+			grants = append(grants, &grant{entry: entry, remaining: entry.Amount})
+		case entry.EntryType == models.LeaveEntryTypeExpiry:
+			if entry.IdempotencyKey != nil {
+				expired[*entry.IdempotencyKey] = true
+			}
+		case entry.Amount.IsNegative(): // 扣除 (debit 或負數調整)
+			need := entry.Amount.Neg()
+			for _, g := range grants {
+				if !need.IsPositive() {
+					break
+				}
+				if !validOn(g, entry.EffectiveDate) || !g.remaining.IsPositive() {
+					continue
+				}
+				used := decimal.Min(need, g.remaining)
+				g.remaining = g.remaining.Sub(used)
+				need = need.Sub(used)
+			}
+		case entry.EntryType == models.LeaveEntryTypeReversal:
+			back := entry.Amount
+			for i := len(grants) - 1; i >= 0 && back.IsPositive(); i-- {
+				g := grants[i]
+				if !validOn(g, entry.EffectiveDate) {
+					continue
+				}
+				restored := decimal.Min(back, g.entry.Amount.Sub(g.remaining))
+				g.remaining = g.remaining.Add(restored)
+				back = back.Sub(restored)
+			}
+		}
+	}
+
+	var result []*models.LeaveBalanceEntry
+	for _, g := range grants {
+		if validOn(g, asOf) || !g.remaining.IsPositive() {
+			continue
+		}
+		key := fmt.Sprintf("comp-off-expiry:%s", g.entry.ID)
+		if expired[key] {
+			continue // 已處理過
+		}
+		result = append(result, &models.LeaveBalanceEntry{
+			AccountID:      accountID,
+			LeaveType:      models.LeaveTypeCompOff,
+			EntryType:      models.LeaveEntryTypeExpiry,
+			Amount:         g.remaining.Neg(),
+			EffectiveDate:  g.entry.ExpiresOn.AddDate(0, 0, 1),
+			IdempotencyKey: &key,
+			Note:           fmt.Sprintf("Unused comp-off expired after %s", g.entry.ExpiresOn.Format("2006-01-02")),
+		})
+	}
+	return result
+}
+
+// yearEndEntries 依結轉政策產生某帳戶某假別的年底結算記錄與分錄
+// 正餘額整筆轉出 (12/31)，再將不超過上限的部分結轉至隔年 (1/1); 負餘額不做處理，直接延續至隔年
+func yearEndEntries(accountID uuid.UUID, policy models.LeaveAccrualRule, year int, closing decimal.Decimal, carriedOn time.Time) (*models.LeaveCarryOverRecord, []*models.LeaveBalanceEntry) {
+	record := &models.LeaveCarryOverRecord{
+		Year:           year,
+		AccountID:      accountID,
+		LeaveType:      policy.LeaveType,
+		ClosingBalance: closing,
+		CarriedOver:    decimal.Zero,
+		Forfeited:      decimal.Zero,
+	}
+	if !closing.IsPositive() {
+		return record, nil
+	}
+
+	carried := decimal.Max(decimal.Min(closing, *policy.CarryOverCap), decimal.Zero)
+	record.CarriedOver = carried
+	record.Forfeited = closing.Sub(carried)
+
+	closeKey := fmt.Sprintf("year-end:%s:%s:%d", accountID, policy.LeaveType, year)
+	entries := []*models.LeaveBalanceEntry{{
+		AccountID:      accountID,
+		LeaveType:      policy.LeaveType,
+		EntryType:      models.LeaveEntryTypeExpiry,
+		Amount:         closing.Neg(),
+		EffectiveDate:  carriedOn.AddDate(0, 0, -1),
+		IdempotencyKey: &closeKey,
+		Note:           fmt.Sprintf("%d year-end closing balance", year),
+	}}
+	if carried.IsPositive() {
+		record.ExpiresOn = policy.CarryOverExpiresOn(carriedOn)
+		carryKey := fmt.Sprintf("carry-over:%s:%s:%d", accountID, policy.LeaveType, year)
+		entries = append(entries, &models.LeaveBalanceEntry{
+			AccountID:      accountID,
+			LeaveType:      policy.LeaveType,
+			EntryType:      models.LeaveEntryTypeCarryOver,
+			Amount:         carried,
+			EffectiveDate:  carriedOn,
+			IdempotencyKey: &carryKey,
+			Note:           fmt.Sprintf("Carried over from %d", year),
+		})
+	}
+	return record, entries
+}
+
+// buildYearEndSummary 彙總結算記錄為報表 (依假別合計)
+func buildYearEndSummary(year int, records []models.LeaveCarryOverRecord) *models.LeaveYearEndSummary {
+	totals := make([]models.LeaveYearEndTotal, 0)
+	index := make(map[string]int)
+	for i := range records {
+		if records[i].Account != nil {
+			records[i].Account.Password = ""
+		}
+		record := records[i]
+		pos, ok := index[record.LeaveType]
+		if !ok {
+			pos = len(totals)
+			index[record.LeaveType] = pos
+			totals = append(totals, models.LeaveYearEndTotal{LeaveType: record.LeaveType})
+		}
+		totals[pos].Accounts++
+		totals[pos].ClosingBalance = totals[pos].ClosingBalance.Add(record.ClosingBalance)
+		totals[pos].CarriedOver = totals[pos].CarriedOver.Add(record.CarriedOver)
+		totals[pos].Forfeited = totals[pos].Forfeited.Add(record.Forfeited)
+	}
+	if records == nil {
+		records = []models.LeaveCarryOverRecord{}
+	}
+	return &models.LeaveYearEndSummary{Year: year, Totals: totals, Records: records}
+}
+
+// postPendingAccruals 依累積規則補入 asOf (含) 以前尚未入帳的累積分錄
+// 累積區間從 max(今年 1/1, 到職日) 或最後一次累積的下一期開始，離職後不再累積
+func (s *leaveBalanceServiceImpl) postPendingAccruals(ctx context.Context, accountID uuid.UUID, asOf time.Time) ([]models.LeaveAccrualRule, error) {
+	rules, err := s.balanceRepo.ListActiveAccrualRules(ctx)
+	if err != nil {
+		log.Printf("Error fetching accrual rules: %v", err)
+		return nil, fmt.Errorf("failed to retrieve leave accrual rules")
+	}
+
+	accrualStart := time.Date(asOf.Year(), time.January, 1, 0, 0, 0, 0, asOf.Location())
+	employment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Printf("Error fetching employment for account %s while accruing leave: %v", accountID, err)
+		return nil, fmt.Errorf("failed to retrieve employment record")
+	}
+	if employment != nil {
+		if employment.HireDate != nil && employment.HireDate.After(accrualStart) {
+			accrualStart = dateOf(*employment.HireDate)
+		}
+		if employment.TerminationDate != nil && employment.TerminationDate.Before(asOf) {
+			asOf = dateOf(*employment.TerminationDate)
+		}
+	}
+
+	for _, rule := range rules {
+		from := accrualStart
+		latest, err := s.balanceRepo.GetLatestEntry(ctx, accountID, rule.LeaveType, models.LeaveEntryTypeAccrual)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("Error fetching latest accrual for account %s (%s): %v", accountID, rule.LeaveType, err)
+			return nil, fmt.Errorf("failed to retrieve leave accrual history")
+		}
+		if latest != nil {
+			from = nextAccrualPeriodStart(rule.Frequency, latest.EffectiveDate)
+		}
+
+		for _, entry := range accrualEntries(accountID, rule, from, asOf) {
+			if err := s.balanceRepo.CreateEntry(ctx, entry); err != nil {
+				log.Printf("Error posting accrual for account %s (%s): %v", accountID, rule.LeaveType, err)
+				return nil, ErrLeaveBalanceUpdateFailed
+			}
+		}
+	}
+	return rules, nil
+}
+
+// accrualEntries 產生 [from, asOf] 區間內每一期的累積分錄
+func accrualEntries(accountID uuid.UUID, rule models.LeaveAccrualRule, from, asOf time.Time) []*models.LeaveBalanceEntry {
+	var entries []*models.LeaveBalanceEntry
+	newEntry := func(effective time.Time, period string, amount decimal.Decimal) *models.LeaveBalanceEntry {
+		key := fmt.Sprintf("accrual:%s:%s:%s", accountID, rule.LeaveType, period)
+		return &models.LeaveBalanceEntry{
+			AccountID:      accountID,
+			LeaveType:      rule.LeaveType,
+			EntryType:      models.LeaveEntryTypeAccrual,
+			Amount:         amount,
+			EffectiveDate:  effective,
+			IdempotencyKey: &key,
+			Note:           fmt.Sprintf("%s accrual for %s", rule.Frequency, period),
+		}
+	}
+
+	switch rule.Frequency {
+	case models.AccrualFrequencyMonthly:
+		for period := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location()); !period.After(asOf); period = period.AddDate(0, 1, 0) {
+			effective := period
+			if from.After(effective) {
+				effective = from
+			}
+			entries = append(entries, newEntry(effective, period.Format("2006-01"), rule.Amount))
+		}
+	case models.AccrualFrequencyAnnual:
+		for period := time.Date(from.Year(), time.January, 1, 0, 0, 0, 0, from.Location()); !period.After(asOf); period = period.AddDate(1, 0, 0) {
+			effective := period
+			amount := rule.Amount
+			if from.After(effective) {
+				// 到職首年依剩餘月份 (含到職當月) 比例給假
+				effective = from
+				remainingMonths := decimal.NewFromInt(int64(12 - int(from.Month()) + 1))
+				amount = rule.Amount.Mul(remainingMonths).Div(decimal.NewFromInt(12)).Round(2)
+			}
+			entries = append(entries, newEntry(effective, period.Format("2006"), amount))
+		}
+	default:
+		log.Printf("Warning: unknown accrual frequency '%s' for leave type %s, skipping", rule.Frequency, rule.LeaveType)
+	}
+	return entries
+}
+
+// terminationProrationEntry 依各累積分錄所屬期別計算離職日之後尚未賺得的天數，產生一筆扣回分錄 (生效日為離職日)
+// 每月累積的期別為該月; 每年累積的期別為該年 (到職首年從到職當月開始)。沒有需要扣回的天數時返回 nil
+func terminationProrationEntry(accountID uuid.UUID, rule models.LeaveAccrualRule, entries []models.LeaveBalanceEntry, terminationDate time.Time) *models.LeaveBalanceEntry {
+	unearned := decimal.Zero
+	for _, entry := range entries {
+		if entry.EntryType != models.LeaveEntryTypeAccrual {
+			continue
+		}
+		effective := dateOf(entry.EffectiveDate)
+		periodStart := time.Date(effective.Year(), effective.Month(), 1, 0, 0, 0, 0, effective.Location())
+		periodEnd := periodStart.AddDate(0, 1, -1)
+		if rule.Frequency == models.AccrualFrequencyAnnual {
+			periodEnd = time.Date(effective.Year(), time.December, 31, 0, 0, 0, 0, effective.Location())
+		}
+		if !terminationDate.Before(periodEnd) {
+			continue
+		}
+
+		periodDays := calendarDays(periodStart, periodEnd)
+		daysAfter := periodDays
+		if !terminationDate.Before(periodStart) {
+			daysAfter = calendarDays(terminationDate, periodEnd) - 1
+		}
+		unearned = unearned.Add(entry.Amount.Mul(decimal.NewFromInt(int64(daysAfter))).Div(decimal.NewFromInt(int64(periodDays))))
+	}
+	unearned = unearned.Round(2)
+	if !unearned.IsPositive() {
+		return nil
+	}
+
+	key := fmt.Sprintf("termination-proration:%s:%s:%s", accountID, rule.LeaveType, terminationDate.Format("2006-01-02"))
+	return &models.LeaveBalanceEntry{
+		AccountID:      accountID,
+		LeaveType:      rule.LeaveType,
+		EntryType:      models.LeaveEntryTypeAdjustment,
+		Amount:         unearned.Neg(),
+		EffectiveDate:  terminationDate,
+		IdempotencyKey: &key,
+		Note:           fmt.Sprintf("Unearned accrual after termination on %s", terminationDate.Format("2006-01-02")),
+	}
+}
+
+// nextAccrualPeriodStart 回傳某次累積之後下一期的開始日
+func nextAccrualPeriodStart(frequency string, last time.Time) time.Time {
+	if frequency == models.AccrualFrequencyAnnual {
+		return time.Date(last.Year()+1, time.January, 1, 0, 0, 0, 0, last.Location())
+	}
+	return time.Date(last.Year(), last.Month()+1, 1, 0, 0, 0, 0, last.Location())
+}
+
+// dateOf 去除時間部分，只保留日期
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
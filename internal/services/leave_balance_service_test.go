@@ -0,0 +1,931 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestLeaveBalanceServiceImpl_GetBalances(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	today := dateOf(time.Now())
+	monthlyRule := models.LeaveAccrualRule{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly, Amount: decimal.RequireFromString("1.25"), Active: true}
+	annualRule := models.LeaveAccrualRule{LeaveType: models.LeaveTypeSick, Frequency: models.AccrualFrequencyAnnual, Amount: decimal.NewFromInt(30), Active: true}
+
+	t.Run("Success - Sums ledger and lists zero balances without posting", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return([]models.LeaveAccrualRule{monthlyRule, annualRule}, nil).Times(1)
+		mockBalanceRepo.EXPECT().SumByAccount(gomock.Any(), accountID, today).
+			Return([]models.LeaveBalance{{LeaveType: models.LeaveTypeAnnual, Balance: decimal.RequireFromString("1.25")}}, nil).Times(1)
+		// CreateEntry / GetEmploymentByAccountID should NOT be called
+
+		balances, err := service.GetBalances(ctx, accountID)
+
+		require.NoError(t, err)
+		require.Len(t, balances, 2)
+		assert.Equal(t, models.LeaveTypeAnnual, balances[0].LeaveType)
+		assert.True(t, decimal.RequireFromString("1.25").Equal(balances[0].Balance))
+		assert.Equal(t, models.LeaveTypeSick, balances[1].LeaveType)
+		assert.True(t, balances[1].Balance.IsZero(), "sick leave has no entries yet in the mocked sum")
+	})
+
+	t.Run("Failure - Rules Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+
+		balances, err := service.GetBalances(ctx, accountID)
+
+		require.Error(t, err)
+		assert.Nil(t, balances)
+	})
+}
+
+func TestLeaveBalanceServiceImpl_PostAccruals(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	today := dateOf(time.Now())
+	monthlyRule := models.LeaveAccrualRule{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly, Amount: decimal.RequireFromString("1.25"), Active: true}
+	annualRule := models.LeaveAccrualRule{LeaveType: models.LeaveTypeSick, Frequency: models.AccrualFrequencyAnnual, Amount: decimal.NewFromInt(30), Active: true}
+	employments := []models.Employment{{AccountID: accountID}}
+
+	t.Run("Success - Posts accruals from hire date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		// 本月到職 → 月假只累積本月一期, 年假 (病假) 依剩餘月份比例
+		hireDate := today
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return([]models.Employment{{AccountID: accountID, HireDate: &hireDate}}, nil).Times(1)
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return([]models.LeaveAccrualRule{monthlyRule, annualRule}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(&models.Employment{AccountID: accountID, HireDate: &hireDate}, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, gomock.Any(), models.LeaveEntryTypeAccrual).Return(nil, gorm.ErrRecordNotFound).Times(2)
+
+		var posted []*models.LeaveBalanceEntry
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+				posted = append(posted, entry)
+				return nil
+			}).Times(2)
+		mockBalanceRepo.EXPECT().ListEntries(gomock.Any(), accountID, models.LeaveTypeCompOff).Return(nil, nil).Times(1)
+
+		err := service.PostAccruals(ctx, time.Now())
+
+		require.NoError(t, err)
+		require.Len(t, posted, 2)
+		for _, entry := range posted {
+			require.NotNil(t, entry.IdempotencyKey)
+			assert.Equal(t, models.LeaveEntryTypeAccrual, entry.EntryType)
+			assert.Equal(t, hireDate, entry.EffectiveDate)
+		}
+		expectedProRata := decimal.NewFromInt(30).Mul(decimal.NewFromInt(int64(12 - int(today.Month()) + 1))).Div(decimal.NewFromInt(12)).Round(2)
+		assert.True(t, expectedProRata.Equal(posted[1].Amount), "annual accrual should be pro-rated in the hire year")
+	})
+
+	t.Run("Success - Resumes after latest accrual", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		// 本月已累積過 → 不應再新增分錄
+		latest := &models.LeaveBalanceEntry{EffectiveDate: time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())}
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return(employments, nil).Times(1)
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return([]models.LeaveAccrualRule{monthlyRule}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, models.LeaveTypeAnnual, models.LeaveEntryTypeAccrual).Return(latest, nil).Times(1)
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+		mockBalanceRepo.EXPECT().ListEntries(gomock.Any(), accountID, models.LeaveTypeCompOff).Return(nil, nil).Times(1)
+
+		err := service.PostAccruals(ctx, time.Now())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Skips accounts not yet hired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		hireDate := today.AddDate(0, 1, 0)
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return([]models.Employment{{AccountID: accountID, HireDate: &hireDate}}, nil).Times(1)
+		// ListActiveAccrualRules / CreateEntry should NOT be called
+
+		err := service.PostAccruals(ctx, time.Now())
+
+		require.NoError(t, err)
+	})
+
+	carryOverCap := decimal.NewFromInt(5)
+	carryOverRule := monthlyRule
+	carryOverRule.CarryOverCap = &carryOverCap
+	carryOverRule.CarryOverExpiryMonths = 3
+	thisMonth := &models.LeaveBalanceEntry{EffectiveDate: time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())}
+	// 去年 1/1 結轉的天數已於去年 3/31 到期
+	carriedOn := time.Date(today.Year()-1, time.January, 1, 0, 0, 0, 0, today.Location())
+	carryOver := &models.LeaveBalanceEntry{EntryType: models.LeaveEntryTypeCarryOver, Amount: decimal.NewFromInt(5), EffectiveDate: carriedOn}
+	expiresOn := time.Date(today.Year()-1, time.March, 31, 0, 0, 0, 0, today.Location())
+
+	t.Run("Success - Expires unused carry-over after the expiry month", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return(employments, nil).Times(1)
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return([]models.LeaveAccrualRule{carryOverRule}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, models.LeaveTypeAnnual, models.LeaveEntryTypeAccrual).Return(thisMonth, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, models.LeaveTypeAnnual, models.LeaveEntryTypeCarryOver).Return(carryOver, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, models.LeaveTypeAnnual, models.LeaveEntryTypeExpiry).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		// 1/1 ~ 3/31 用掉 2 天 → 剩 3 天失效
+		mockBalanceRepo.EXPECT().SumEntriesBetween(gomock.Any(), accountID, models.LeaveTypeAnnual,
+			[]string{models.LeaveEntryTypeDebit, models.LeaveEntryTypeReversal}, carriedOn, expiresOn).Return(decimal.NewFromInt(-2), nil).Times(1)
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+				assert.Equal(t, models.LeaveEntryTypeExpiry, entry.EntryType)
+				assert.True(t, decimal.NewFromInt(-3).Equal(entry.Amount))
+				assert.Equal(t, expiresOn.AddDate(0, 0, 1), entry.EffectiveDate)
+				require.NotNil(t, entry.IdempotencyKey)
+				return nil
+			}).Times(1)
+		mockBalanceRepo.EXPECT().ListEntries(gomock.Any(), accountID, models.LeaveTypeCompOff).Return(nil, nil).Times(1)
+
+		err := service.PostAccruals(ctx, time.Now())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Carry-over already expired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+		expired := &models.LeaveBalanceEntry{EntryType: models.LeaveEntryTypeExpiry, EffectiveDate: expiresOn.AddDate(0, 0, 1)}
+
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return(employments, nil).Times(1)
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return([]models.LeaveAccrualRule{carryOverRule}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, models.LeaveTypeAnnual, models.LeaveEntryTypeAccrual).Return(thisMonth, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, models.LeaveTypeAnnual, models.LeaveEntryTypeCarryOver).Return(carryOver, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, models.LeaveTypeAnnual, models.LeaveEntryTypeExpiry).Return(expired, nil).Times(1)
+		// SumEntriesBetween and CreateEntry should NOT be called
+		mockBalanceRepo.EXPECT().ListEntries(gomock.Any(), accountID, models.LeaveTypeCompOff).Return(nil, nil).Times(1)
+
+		err := service.PostAccruals(ctx, time.Now())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Account Error Does Not Stop Other Accounts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+		otherID := uuid.New()
+
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return([]models.Employment{{AccountID: accountID}, {AccountID: otherID}}, nil).Times(1)
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return(nil, nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), otherID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockBalanceRepo.EXPECT().ListEntries(gomock.Any(), otherID, models.LeaveTypeCompOff).Return(nil, nil).Times(1)
+
+		err := service.PostAccruals(ctx, time.Now())
+
+		assert.EqualError(t, err, "failed to post leave accruals for 1 of 2 account(s)")
+	})
+
+	t.Run("Failure - Employment Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+
+		err := service.PostAccruals(ctx, time.Now())
+
+		require.Error(t, err)
+	})
+}
+
+func TestLeaveBalanceServiceImpl_CheckSufficientBalance(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	rules := []models.LeaveAccrualRule{
+		{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly, Amount: decimal.RequireFromString("1.25"), Active: true},
+		{LeaveType: models.LeaveTypeSick, Frequency: models.AccrualFrequencyAnnual, Amount: decimal.NewFromInt(30), Active: true},
+	}
+
+	testCases := []struct {
+		name        string
+		leaveType   string
+		annual      string // 帳本中年假的餘額
+		expectSum   bool   // 沒有額度的假別不需加總帳本
+		expectedErr error
+	}{
+		{name: "Success - Enough balance", leaveType: models.LeaveTypeAnnual, annual: "3", expectSum: true},
+		{name: "Failure - Not enough balance", leaveType: models.LeaveTypeAnnual, annual: "2.5", expectSum: true, expectedErr: ErrInsufficientLeaveBalance},
+		{name: "Failure - Accrual rule without any entries", leaveType: models.LeaveTypeSick, annual: "10", expectSum: true, expectedErr: ErrInsufficientLeaveBalance},
+		{name: "Failure - Comp-off without credits", leaveType: models.LeaveTypeCompOff, annual: "10", expectSum: true, expectedErr: ErrInsufficientLeaveBalance},
+		{name: "Success - Leave type without accrual rule has no quota", leaveType: "bereavement", annual: "0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+			service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+			mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return(rules, nil).Times(1)
+			if tc.expectSum {
+				mockBalanceRepo.EXPECT().SumByAccount(gomock.Any(), accountID, gomock.Any()).
+					Return([]models.LeaveBalance{{LeaveType: models.LeaveTypeAnnual, Balance: decimal.RequireFromString(tc.annual)}}, nil).Times(1)
+			}
+
+			err := service.CheckSufficientBalance(ctx, accountID, tc.leaveType, decimal.NewFromInt(3))
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestLeaveBalanceServiceImpl_DebitForLeave(t *testing.T) {
+	ctx := context.Background()
+	request := &models.LeaveRequest{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		LeaveType: models.LeaveTypeAnnual,
+		StartDate: time.Now().AddDate(0, 0, 3),
+		EndDate:   time.Now().AddDate(0, 0, 4),
+	}
+	rules := []models.LeaveAccrualRule{
+		{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly, Amount: decimal.RequireFromString("1.25"), Active: true},
+	}
+
+	// expectLockedBalance 預期先鎖定帳戶，再以 annual 天的年假餘額重新檢查
+	expectLockedBalance := func(mockBalanceRepo *mocks.MockLeaveBalanceRepository, annual string) {
+		gomock.InOrder(
+			mockBalanceRepo.EXPECT().LockAccount(gomock.Any(), request.AccountID).Return(nil).Times(1),
+			mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return(rules, nil).Times(1),
+			mockBalanceRepo.EXPECT().SumByAccount(gomock.Any(), request.AccountID, gomock.Any()).
+				Return([]models.LeaveBalance{{LeaveType: models.LeaveTypeAnnual, Balance: decimal.RequireFromString(annual)}}, nil).Times(1),
+		)
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		expectLockedBalance(mockBalanceRepo, "5")
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+				assert.Equal(t, request.AccountID, entry.AccountID)
+				assert.Equal(t, models.LeaveEntryTypeDebit, entry.EntryType)
+				assert.True(t, decimal.NewFromInt(-2).Equal(entry.Amount))
+				require.NotNil(t, entry.LeaveRequestID)
+				assert.Equal(t, request.ID, *entry.LeaveRequestID)
+				require.NotNil(t, entry.IdempotencyKey)
+				assert.Equal(t, "debit:"+request.ID.String(), *entry.IdempotencyKey)
+				return nil
+			}).Times(1)
+
+		err := service.DebitForLeave(ctx, request, decimal.NewFromInt(2))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure - Balance Used Up Since Approval Check", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		// 另一張假單已在鎖定前扣除，剩餘天數不足; 不可寫入扣除分錄
+		expectLockedBalance(mockBalanceRepo, "1")
+
+		err := service.DebitForLeave(ctx, request, decimal.NewFromInt(2))
+		assert.ErrorIs(t, err, ErrInsufficientLeaveBalance)
+	})
+
+	t.Run("Failure - Lock Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().LockAccount(gomock.Any(), request.AccountID).Return(errors.New("lock timeout")).Times(1)
+
+		err := service.DebitForLeave(ctx, request, decimal.NewFromInt(2))
+		assert.ErrorIs(t, err, ErrLeaveBalanceUpdateFailed)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		expectLockedBalance(mockBalanceRepo, "5")
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		err := service.DebitForLeave(ctx, request, decimal.NewFromInt(2))
+		assert.ErrorIs(t, err, ErrLeaveBalanceUpdateFailed)
+	})
+}
+
+func TestLeaveBalanceServiceImpl_RestoreForLeave(t *testing.T) {
+	ctx := context.Background()
+	request := &models.LeaveRequest{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		LeaveType: models.LeaveTypeAnnual,
+		Days:      decimal.RequireFromString("1.5"),
+		StartDate: time.Now().AddDate(0, 0, 3),
+		EndDate:   time.Now().AddDate(0, 0, 4),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+				assert.Equal(t, models.LeaveEntryTypeReversal, entry.EntryType)
+				assert.True(t, request.Days.Equal(entry.Amount), "reversal should credit back the debited days")
+				require.NotNil(t, entry.IdempotencyKey)
+				assert.Equal(t, "restore:"+request.ID.String(), *entry.IdempotencyKey)
+				return nil
+			}).Times(1)
+
+		assert.NoError(t, service.RestoreForLeave(ctx, request))
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		assert.ErrorIs(t, service.RestoreForLeave(ctx, request), ErrLeaveBalanceUpdateFailed)
+	})
+}
+
+func TestLeaveBalanceServiceImpl_RestoreForShortenedLeave(t *testing.T) {
+	ctx := context.Background()
+	request := &models.LeaveRequest{
+		ID:        uuid.New(),
+		AccountID: uuid.New(),
+		LeaveType: models.LeaveTypeAnnual,
+		Days:      decimal.NewFromInt(3),
+		StartDate: time.Now().AddDate(0, 0, -2),
+		EndDate:   time.Now(),
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+				assert.Equal(t, models.LeaveEntryTypeReversal, entry.EntryType)
+				assert.True(t, decimal.NewFromInt(2).Equal(entry.Amount), "reversal should credit back only the removed days")
+				require.NotNil(t, entry.IdempotencyKey)
+				assert.Equal(t, "shorten:"+request.ID.String(), *entry.IdempotencyKey)
+				return nil
+			}).Times(1)
+
+		assert.NoError(t, service.RestoreForShortenedLeave(ctx, request, decimal.NewFromInt(2)))
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		assert.ErrorIs(t, service.RestoreForShortenedLeave(ctx, request, decimal.NewFromInt(2)), ErrLeaveBalanceUpdateFailed)
+	})
+}
+
+func TestLeaveBalanceServiceImpl_CreditCompOff(t *testing.T) {
+	ctx := context.Background()
+	expiresOn := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	claim := &models.OvertimeClaim{
+		ID:               uuid.New(),
+		AccountID:        uuid.New(),
+		WorkDate:         time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+		Hours:            decimal.NewFromInt(12),
+		CompOffDays:      decimal.RequireFromString("1.5"),
+		CompOffExpiresOn: &expiresOn,
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+				assert.Equal(t, claim.AccountID, entry.AccountID)
+				assert.Equal(t, models.LeaveTypeCompOff, entry.LeaveType)
+				assert.Equal(t, models.LeaveEntryTypeEarned, entry.EntryType)
+				assert.True(t, claim.CompOffDays.Equal(entry.Amount))
+				assert.Equal(t, &expiresOn, entry.ExpiresOn)
+				require.NotNil(t, entry.IdempotencyKey)
+				assert.Equal(t, "comp-off:"+claim.ID.String(), *entry.IdempotencyKey)
+				return nil
+			}).Times(1)
+
+		assert.NoError(t, service.CreditCompOff(ctx, claim))
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		assert.ErrorIs(t, service.CreditCompOff(ctx, claim), ErrLeaveBalanceUpdateFailed)
+	})
+}
+
+func TestCompOffExpiryEntries(t *testing.T) {
+	accountID := uuid.New()
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+	date := func(month time.Month, d int) *time.Time { v := day(month, d); return &v }
+	earned := func(id uuid.UUID, on time.Time, amount string, expiresOn *time.Time) models.LeaveBalanceEntry {
+		return models.LeaveBalanceEntry{ID: id, EntryType: models.LeaveEntryTypeEarned, Amount: decimal.RequireFromString(amount), EffectiveDate: on, ExpiresOn: expiresOn}
+	}
+	entry := func(entryType string, on time.Time, amount string) models.LeaveBalanceEntry {
+		return models.LeaveBalanceEntry{EntryType: entryType, Amount: decimal.RequireFromString(amount), EffectiveDate: on}
+	}
+	first, second := uuid.New(), uuid.New()
+	firstKey := "comp-off-expiry:" + first.String()
+
+	testCases := []struct {
+		name     string
+		entries  []models.LeaveBalanceEntry
+		asOf     time.Time
+		expected map[uuid.UUID]string // 失效的補休 -> 失效天數
+	}{
+		{
+			name:     "Unused comp-off expires in full",
+			entries:  []models.LeaveBalanceEntry{earned(first, day(1, 10), "1", date(3, 31))},
+			asOf:     day(4, 1),
+			expected: map[uuid.UUID]string{first: "-1"},
+		},
+		{
+			name:     "Not yet expired on the last day",
+			entries:  []models.LeaveBalanceEntry{earned(first, day(1, 10), "1", date(3, 31))},
+			asOf:     day(3, 31),
+			expected: map[uuid.UUID]string{},
+		},
+		{
+			name: "Debits use the oldest valid comp-off first",
+			entries: []models.LeaveBalanceEntry{
+				earned(first, day(1, 10), "1", date(3, 31)),
+				earned(second, day(2, 10), "1", date(4, 30)),
+				entry(models.LeaveEntryTypeDebit, day(3, 1), "-1.5"),
+			},
+			asOf:     day(5, 1),
+			expected: map[uuid.UUID]string{second: "-0.5"},
+		},
+		{
+			name: "Debit after expiry cannot use the expired comp-off",
+			entries: []models.LeaveBalanceEntry{
+				earned(first, day(1, 10), "1", date(3, 31)),
+				earned(second, day(2, 10), "1", date(4, 30)),
+				entry(models.LeaveEntryTypeDebit, day(4, 2), "-1"),
+			},
+			asOf:     day(4, 2),
+			expected: map[uuid.UUID]string{first: "-1"},
+		},
+		{
+			name: "Reversal restores the consumed comp-off",
+			entries: []models.LeaveBalanceEntry{
+				earned(first, day(1, 10), "1", date(3, 31)),
+				entry(models.LeaveEntryTypeDebit, day(2, 1), "-1"),
+				entry(models.LeaveEntryTypeReversal, day(2, 5), "0.5"),
+			},
+			asOf:     day(4, 1),
+			expected: map[uuid.UUID]string{first: "-0.5"},
+		},
+		{
+			name: "Already expired comp-off is skipped",
+			entries: []models.LeaveBalanceEntry{
+				earned(first, day(1, 10), "1", date(3, 31)),
+				{EntryType: models.LeaveEntryTypeExpiry, Amount: decimal.NewFromInt(-1), EffectiveDate: day(4, 1), IdempotencyKey: &firstKey},
+			},
+			asOf:     day(5, 1),
+			expected: map[uuid.UUID]string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := compOffExpiryEntries(accountID, tc.entries, tc.asOf)
+
+			require.Len(t, result, len(tc.expected))
+			for _, e := range result {
+				assert.Equal(t, models.LeaveEntryTypeExpiry, e.EntryType)
+				assert.Equal(t, models.LeaveTypeCompOff, e.LeaveType)
+				require.NotNil(t, e.IdempotencyKey)
+				var grantID uuid.UUID
+				for id := range tc.expected {
+					if *e.IdempotencyKey == "comp-off-expiry:"+id.String() {
+						grantID = id
+					}
+				}
+				require.NotEqual(t, uuid.Nil, grantID, "unexpected expiry entry %s", *e.IdempotencyKey)
+				assert.True(t, decimal.RequireFromString(tc.expected[grantID]).Equal(e.Amount), "expected %s, got %s", tc.expected[grantID], e.Amount)
+			}
+		})
+	}
+}
+
+func TestLeaveBalanceServiceImpl_RunYearEnd(t *testing.T) {
+	ctx := context.Background()
+	year := time.Now().Year() - 1
+	closingDate := time.Date(year, time.December, 31, 0, 0, 0, 0, time.Local)
+	carriedOn := closingDate.AddDate(0, 0, 1)
+
+	carryOverCap := decimal.NewFromInt(5)
+	annualPolicy := models.LeaveAccrualRule{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly, Amount: decimal.RequireFromString("1.25"),
+		CarryOverCap: &carryOverCap, CarryOverExpiryMonths: 3, Active: true}
+	sickRule := models.LeaveAccrualRule{LeaveType: models.LeaveTypeSick, Frequency: models.AccrualFrequencyAnnual, Amount: decimal.NewFromInt(30), Active: true}
+	rules := []models.LeaveAccrualRule{annualPolicy, sickRule}
+
+	activeID, leaverID, newHireID := uuid.New(), uuid.New(), uuid.New()
+	hireDate := time.Date(year-3, time.March, 1, 0, 0, 0, 0, time.Local)
+	leftOn := time.Date(year, time.June, 30, 0, 0, 0, 0, time.Local)
+	startsOn := carriedOn.AddDate(0, 1, 0)
+	employments := []models.Employment{
+		{AccountID: activeID, HireDate: &hireDate},
+		{AccountID: leaverID, HireDate: &hireDate, TerminationDate: &leftOn}, // 年中離職 → 不結算
+		{AccountID: newHireID, HireDate: &startsOn},                          // 隔年才到職 → 不結算
+	}
+	activeRecord := models.LeaveCarryOverRecord{Year: year, AccountID: activeID, LeaveType: models.LeaveTypeAnnual,
+		ClosingBalance: decimal.NewFromInt(12), CarriedOver: decimal.NewFromInt(5), Forfeited: decimal.NewFromInt(7),
+		Account: &models.Account{ID: activeID, Password: "hashed"}}
+
+	t.Run("Success - Carries over up to the cap and forfeits the rest", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		// 1. 規則 (RunYearEnd 與補入累積各讀一次)
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return(rules, nil).Times(2)
+		// 2. 尚無結算記錄; 結算後再讀一次做報表
+		gomock.InOrder(
+			mockBalanceRepo.EXPECT().ListCarryOverRecords(gomock.Any(), year).Return(nil, nil),
+			mockBalanceRepo.EXPECT().ListCarryOverRecords(gomock.Any(), year).Return([]models.LeaveCarryOverRecord{activeRecord}, nil),
+		)
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return(employments, nil).Times(1)
+		// 3. 只有在職員工補入累積到 12/31 (已累積過) 並結算
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), activeID).Return(&employments[0], nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), activeID, models.LeaveTypeAnnual, models.LeaveEntryTypeAccrual).
+			Return(&models.LeaveBalanceEntry{EffectiveDate: time.Date(year, time.December, 1, 0, 0, 0, 0, time.Local)}, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), activeID, models.LeaveTypeSick, models.LeaveEntryTypeAccrual).
+			Return(&models.LeaveBalanceEntry{EffectiveDate: time.Date(year, time.January, 1, 0, 0, 0, 0, time.Local)}, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), activeID, models.LeaveTypeAnnual, models.LeaveEntryTypeCarryOver).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockBalanceRepo.EXPECT().SumByAccount(gomock.Any(), activeID, closingDate).Return([]models.LeaveBalance{
+			{LeaveType: models.LeaveTypeAnnual, Balance: decimal.NewFromInt(12)},
+			{LeaveType: models.LeaveTypeSick, Balance: decimal.NewFromInt(20)},
+		}, nil).Times(1)
+		// 4. 只有設定結轉政策的假別會結算
+		mockBalanceRepo.EXPECT().PostYearEnd(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, record *models.LeaveCarryOverRecord, entries []*models.LeaveBalanceEntry) error {
+				assert.Equal(t, activeID, record.AccountID)
+				assert.Equal(t, models.LeaveTypeAnnual, record.LeaveType)
+				assert.True(t, decimal.NewFromInt(12).Equal(record.ClosingBalance))
+				assert.True(t, decimal.NewFromInt(5).Equal(record.CarriedOver))
+				assert.True(t, decimal.NewFromInt(7).Equal(record.Forfeited))
+				require.NotNil(t, record.ExpiresOn)
+				assert.Equal(t, time.Date(year+1, time.March, 31, 0, 0, 0, 0, time.Local), *record.ExpiresOn)
+
+				require.Len(t, entries, 2)
+				assert.Equal(t, models.LeaveEntryTypeExpiry, entries[0].EntryType)
+				assert.True(t, decimal.NewFromInt(-12).Equal(entries[0].Amount))
+				assert.Equal(t, closingDate, entries[0].EffectiveDate)
+				assert.Equal(t, models.LeaveEntryTypeCarryOver, entries[1].EntryType)
+				assert.True(t, decimal.NewFromInt(5).Equal(entries[1].Amount))
+				assert.Equal(t, carriedOn, entries[1].EffectiveDate)
+				return nil
+			}).Times(1)
+
+		summary, err := service.RunYearEnd(ctx, year)
+
+		require.NoError(t, err)
+		assert.Equal(t, year, summary.Year)
+		require.Len(t, summary.Totals, 1)
+		assert.Equal(t, 1, summary.Totals[0].Accounts)
+		assert.True(t, decimal.NewFromInt(7).Equal(summary.Totals[0].Forfeited))
+		require.Len(t, summary.Records, 1)
+		assert.Empty(t, summary.Records[0].Account.Password)
+	})
+
+	t.Run("Success - Rerun skips accounts already closed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return(rules, nil).Times(1)
+		mockBalanceRepo.EXPECT().ListCarryOverRecords(gomock.Any(), year).Return([]models.LeaveCarryOverRecord{activeRecord}, nil).Times(2)
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return(employments, nil).Times(1)
+		// Accruals, SumByAccount and PostYearEnd should NOT be called
+
+		summary, err := service.RunYearEnd(ctx, year)
+
+		require.NoError(t, err)
+		require.Len(t, summary.Records, 1)
+	})
+
+	t.Run("Failure - Year Not Ended", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service := NewLeaveBalanceServiceImpl(mocks.NewMockLeaveBalanceRepository(ctrl), mocks.NewMockEmploymentRepository(ctrl))
+
+		summary, err := service.RunYearEnd(ctx, time.Now().Year())
+
+		assert.ErrorIs(t, err, ErrYearNotEnded)
+		assert.Nil(t, summary)
+	})
+
+	t.Run("Failure - Post Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return([]models.LeaveAccrualRule{annualPolicy}, nil).Times(2)
+		mockBalanceRepo.EXPECT().ListCarryOverRecords(gomock.Any(), year).Return(nil, nil).Times(1)
+		mockEmploymentRepo.EXPECT().ListEmployments(gomock.Any()).Return(employments[:1], nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), activeID).Return(&employments[0], nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), activeID, models.LeaveTypeAnnual, models.LeaveEntryTypeAccrual).
+			Return(&models.LeaveBalanceEntry{EffectiveDate: time.Date(year, time.December, 1, 0, 0, 0, 0, time.Local)}, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), activeID, models.LeaveTypeAnnual, models.LeaveEntryTypeCarryOver).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockBalanceRepo.EXPECT().SumByAccount(gomock.Any(), activeID, closingDate).Return(nil, nil).Times(1)
+		mockBalanceRepo.EXPECT().PostYearEnd(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		summary, err := service.RunYearEnd(ctx, year)
+
+		assert.ErrorIs(t, err, ErrLeaveBalanceUpdateFailed)
+		assert.Nil(t, summary)
+	})
+}
+
+func TestYearEndEntries(t *testing.T) {
+	accountID := uuid.New()
+	carriedOn := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	carryOverCap := decimal.NewFromInt(5)
+	policy := models.LeaveAccrualRule{LeaveType: models.LeaveTypeAnnual, CarryOverCap: &carryOverCap}
+
+	testCases := []struct {
+		name              string
+		closing           decimal.Decimal
+		expectedCarried   decimal.Decimal
+		expectedForfeited decimal.Decimal
+		expectedEntries   int
+	}{
+		{"Below cap carries everything", decimal.RequireFromString("3.5"), decimal.RequireFromString("3.5"), decimal.Zero, 2},
+		{"Above cap forfeits the excess", decimal.NewFromInt(9), decimal.NewFromInt(5), decimal.NewFromInt(4), 2},
+		{"Zero balance posts nothing", decimal.Zero, decimal.Zero, decimal.Zero, 0},
+		{"Negative balance posts nothing", decimal.NewFromInt(-2), decimal.Zero, decimal.Zero, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			record, entries := yearEndEntries(accountID, policy, 2025, tc.closing, carriedOn)
+
+			assert.True(t, tc.closing.Equal(record.ClosingBalance))
+			assert.True(t, tc.expectedCarried.Equal(record.CarriedOver), "carried over %s", record.CarriedOver)
+			assert.True(t, tc.expectedForfeited.Equal(record.Forfeited), "forfeited %s", record.Forfeited)
+			assert.Len(t, entries, tc.expectedEntries)
+			assert.Nil(t, record.ExpiresOn, "policy without expiry months never expires")
+		})
+	}
+}
+
+func TestLeaveBalanceServiceImpl_GetYearEndSummary(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Totals per leave type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+		records := []models.LeaveCarryOverRecord{
+			{LeaveType: models.LeaveTypeAnnual, ClosingBalance: decimal.NewFromInt(8), CarriedOver: decimal.NewFromInt(5), Forfeited: decimal.NewFromInt(3)},
+			{LeaveType: models.LeaveTypeAnnual, ClosingBalance: decimal.NewFromInt(2), CarriedOver: decimal.NewFromInt(2), Forfeited: decimal.Zero},
+		}
+
+		mockBalanceRepo.EXPECT().ListCarryOverRecords(gomock.Any(), 2025).Return(records, nil).Times(1)
+
+		summary, err := service.GetYearEndSummary(ctx, 2025)
+
+		require.NoError(t, err)
+		require.Len(t, summary.Totals, 1)
+		assert.Equal(t, 2, summary.Totals[0].Accounts)
+		assert.True(t, decimal.NewFromInt(10).Equal(summary.Totals[0].ClosingBalance))
+		assert.True(t, decimal.NewFromInt(7).Equal(summary.Totals[0].CarriedOver))
+		assert.True(t, decimal.NewFromInt(3).Equal(summary.Totals[0].Forfeited))
+	})
+
+	t.Run("Failure - Not Processed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().ListCarryOverRecords(gomock.Any(), 2025).Return(nil, nil).Times(1)
+
+		summary, err := service.GetYearEndSummary(ctx, 2025)
+
+		assert.ErrorIs(t, err, ErrYearEndNotProcessed)
+		assert.Nil(t, summary)
+	})
+}
+
+func TestLeaveBalanceServiceImpl_SettleForTermination(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	hireDate := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.Local)
+	terminationDate := time.Date(2025, time.March, 14, 0, 0, 0, 0, time.Local)
+	monthlyRule := models.LeaveAccrualRule{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly, Amount: decimal.RequireFromString("1.25"), Active: true}
+	accrual := func(month time.Month) models.LeaveBalanceEntry {
+		return models.LeaveBalanceEntry{EntryType: models.LeaveEntryTypeAccrual, Amount: monthlyRule.Amount, EffectiveDate: time.Date(2025, month, 1, 0, 0, 0, 0, time.Local)}
+	}
+
+	t.Run("Success - Accrues termination month and claws back unearned days", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		mockEmploymentRepo := mocks.NewMockEmploymentRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mockEmploymentRepo)
+
+		latest := accrual(time.February)
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return([]models.LeaveAccrualRule{monthlyRule}, nil).Times(1)
+		mockEmploymentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), accountID).
+			Return(&models.Employment{AccountID: accountID, HireDate: &hireDate, TerminationDate: &terminationDate}, nil).Times(1)
+		mockBalanceRepo.EXPECT().GetLatestEntry(gomock.Any(), accountID, models.LeaveTypeAnnual, models.LeaveEntryTypeAccrual).Return(&latest, nil).Times(1)
+		mockBalanceRepo.EXPECT().ListEntries(gomock.Any(), accountID, models.LeaveTypeAnnual).
+			Return([]models.LeaveBalanceEntry{accrual(time.January), accrual(time.February), accrual(time.March)}, nil).Times(1)
+
+		var posted []*models.LeaveBalanceEntry
+		mockBalanceRepo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, entry *models.LeaveBalanceEntry) error {
+				posted = append(posted, entry)
+				return nil
+			}).Times(2)
+		mockBalanceRepo.EXPECT().SumByAccount(gomock.Any(), accountID, dateOf(time.Now())).
+			Return([]models.LeaveBalance{{LeaveType: models.LeaveTypeAnnual, Balance: decimal.RequireFromString("4.06")}}, nil).Times(1)
+
+		settlement, err := service.SettleForTermination(ctx, accountID, models.LeaveTypeAnnual, terminationDate)
+
+		require.NoError(t, err)
+		require.Len(t, posted, 2)
+		assert.Equal(t, models.LeaveEntryTypeAccrual, posted[0].EntryType, "March accrual is posted first")
+		assert.Equal(t, models.LeaveEntryTypeAdjustment, posted[1].EntryType)
+		assert.Equal(t, terminationDate, posted[1].EffectiveDate)
+		assert.Equal(t, "-0.69", settlement.ProRatedAdjustment.String(), "17 of 31 March days are unearned")
+		assert.Equal(t, "4.06", settlement.Remaining.String())
+	})
+
+	t.Run("Failure - Accrual Rules Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+		service := NewLeaveBalanceServiceImpl(mockBalanceRepo, mocks.NewMockEmploymentRepository(ctrl))
+
+		mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+
+		settlement, err := service.SettleForTermination(ctx, accountID, models.LeaveTypeAnnual, terminationDate)
+
+		require.Error(t, err)
+		assert.Nil(t, settlement)
+	})
+}
+
+func TestTerminationProrationEntry(t *testing.T) {
+	accountID := uuid.New()
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+	entry := func(entryType string, on time.Time, amount string) models.LeaveBalanceEntry {
+		return models.LeaveBalanceEntry{EntryType: entryType, Amount: decimal.RequireFromString(amount), EffectiveDate: on}
+	}
+	monthlyRule := models.LeaveAccrualRule{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly}
+	annualRule := models.LeaveAccrualRule{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyAnnual}
+
+	testCases := []struct {
+		name            string
+		rule            models.LeaveAccrualRule
+		entries         []models.LeaveBalanceEntry
+		terminationDate time.Time
+		expected        string // 扣回天數, 空字串表示不產生分錄
+	}{
+		{
+			name:            "Monthly accrual pro-rated within termination month",
+			rule:            monthlyRule,
+			entries:         []models.LeaveBalanceEntry{entry(models.LeaveEntryTypeAccrual, day(3, 1), "1.25")},
+			terminationDate: day(3, 14),
+			expected:        "-0.69",
+		},
+		{
+			name:            "Termination on last day of period keeps the full accrual",
+			rule:            monthlyRule,
+			entries:         []models.LeaveBalanceEntry{entry(models.LeaveEntryTypeAccrual, day(3, 1), "1.25")},
+			terminationDate: day(3, 31),
+		},
+		{
+			name: "Backdated termination claws back later periods in full",
+			rule: monthlyRule,
+			entries: []models.LeaveBalanceEntry{
+				entry(models.LeaveEntryTypeAccrual, day(2, 1), "1.25"),
+				entry(models.LeaveEntryTypeAccrual, day(3, 1), "1.25"),
+				entry(models.LeaveEntryTypeDebit, day(3, 5), "-2"),
+			},
+			terminationDate: day(2, 10),
+			expected:        "-2.05", // 1.25 × 18/28 + 1.25
+		},
+		{
+			name:            "Annual accrual pro-rated over the year",
+			rule:            annualRule,
+			entries:         []models.LeaveBalanceEntry{entry(models.LeaveEntryTypeAccrual, day(1, 1), "12")},
+			terminationDate: day(6, 30),
+			expected:        "-6.05", // 12 × 184/365
+		},
+		{
+			name:            "Hire-year annual accrual counts from the hire month",
+			rule:            annualRule,
+			entries:         []models.LeaveBalanceEntry{entry(models.LeaveEntryTypeAccrual, day(4, 15), "6.75")},
+			terminationDate: day(6, 30),
+			expected:        "-4.52", // 6.75 × 184/275
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := terminationProrationEntry(accountID, tc.rule, tc.entries, tc.terminationDate)
+
+			if tc.expected == "" {
+				assert.Nil(t, result)
+				return
+			}
+			require.NotNil(t, result)
+			assert.Equal(t, tc.expected, result.Amount.String())
+			assert.Equal(t, models.LeaveEntryTypeAdjustment, result.EntryType)
+			require.NotNil(t, result.IdempotencyKey)
+			assert.Equal(t, "termination-proration:"+accountID.String()+":annual:"+tc.terminationDate.Format("2006-01-02"), *result.IdempotencyKey)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 訂閱行事曆涵蓋的期間: 過去 90 天至未來一年
+const (
+	leaveFeedLookbackDays  = 90
+	leaveFeedLookaheadDays = 365
+)
+
+// calendarStatuses 行事曆上視為請假中的狀態 (取消申請待確認前仍視為請假)
+var calendarStatuses = []string{models.LeaveStatusApproved, models.LeaveStatusCancellationRequested}
+
+// feedStatuses 訂閱行事曆輸出的狀態; 已拒絕 / 已取消的假單以 CANCELLED 輸出，讓行事曆軟體移除原本的事件
+var feedStatuses = []string{
+	models.LeaveStatusPending,
+	models.LeaveStatusApproved,
+	models.LeaveStatusCancellationRequested,
+	models.LeaveStatusRejected,
+	models.LeaveStatusCancelled,
+}
+
+// leaveCalendarServiceImpl 實現了 LeaveCalendarService 介面
+type leaveCalendarServiceImpl struct {
+	leaveRepo      interfaces.LeaveRequestRepository
+	accountRepo    interfaces.AccountRepository
+	employmentRepo interfaces.EmploymentRepository // 依匯報關係決定團隊成員
+	signer         interfaces.MessageSigner        // 簽署訂閱網址中的 feed token
+	feedTTL        time.Duration                   // feed token 有效期限
+}
+
+// NewLeaveCalendarServiceImpl 構造函數
+func NewLeaveCalendarServiceImpl(
+	leaveRepo interfaces.LeaveRequestRepository,
+	accountRepo interfaces.AccountRepository,
+	employmentRepo interfaces.EmploymentRepository,
+	signer interfaces.MessageSigner,
+	feedTTLDays int,
+) interfaces.LeaveCalendarService {
+	return &leaveCalendarServiceImpl{
+		leaveRepo:      leaveRepo,
+		accountRepo:    accountRepo,
+		employmentRepo: employmentRepo,
+		signer:         signer,
+		feedTTL:        24 * time.Hour * time.Duration(feedTTLDays),
+	}
+}
+
+// GetTeamCalendar 查詢區間內的假單並依日期分組，沒有人請假的日期不列出
+func (s *leaveCalendarServiceImpl) GetTeamCalendar(ctx context.Context, viewerAccountIDStr string, query models.LeaveCalendarQuery) ([]models.LeaveCalendarDay, error) {
+	viewer, err := s.getViewer(ctx, viewerAccountIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to := dateOf(query.From), dateOf(query.To)
+	if to.Before(from) {
+		return nil, ErrInvalidDateRange
+	}
+	if to.Sub(from) >= models.MaxLeaveCalendarDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: at most %d days", ErrCalendarRangeTooLong, models.MaxLeaveCalendarDays)
+	}
+
+	var accountIDs []uuid.UUID
+	if query.ManagerID != nil {
+		if err := s.authorizeTeam(ctx, viewer, *query.ManagerID); err != nil {
+			return nil, err
+		}
+		accountIDs, err = s.teamMembers(ctx, *query.ManagerID)
+	} else {
+		accountIDs, err = s.defaultTeamMembers(ctx, viewer)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := calendarStatuses
+	if query.IncludePending {
+		statuses = append([]string{models.LeaveStatusPending}, calendarStatuses...)
+	}
+	requests, err := s.leaveRepo.ListInRange(ctx, accountIDs, from, to, statuses)
+	if err != nil {
+		log.Printf("Error fetching leave calendar between %s and %s: %v", from.Format("2006-01-02"), to.Format("2006-01-02"), err)
+		return nil, fmt.Errorf("failed to retrieve leave calendar")
+	}
+	clearApplicantPasswords(requests)
+	return groupLeaveByDay(requests, from, to), nil
+}
+
+// ListAccountFeed 列出個人訂閱行事曆的假單
+func (s *leaveCalendarServiceImpl) ListAccountFeed(ctx context.Context, viewerAccountIDStr string, accountIDStr string) ([]models.LeaveRequest, error) {
+	viewer, err := s.getViewer(ctx, viewerAccountIDStr)
+	if err != nil {
+		return nil, err
+	}
+	accountUUID, err := uuid.Parse(accountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid account identifier format")
+	}
+
+	if err := s.authorizeAccount(ctx, viewer, accountUUID); err != nil {
+		return nil, err
+	}
+	return s.listFeed(ctx, []uuid.UUID{accountUUID})
+}
+
+// ListTeamFeed 列出團隊訂閱行事曆的假單
+func (s *leaveCalendarServiceImpl) ListTeamFeed(ctx context.Context, viewerAccountIDStr string, managerAccountIDStr string) ([]models.LeaveRequest, error) {
+	viewer, err := s.getViewer(ctx, viewerAccountIDStr)
+	if err != nil {
+		return nil, err
+	}
+	managerUUID, err := uuid.Parse(managerAccountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid account identifier format")
+	}
+	if err := s.authorizeTeam(ctx, viewer, managerUUID); err != nil {
+		return nil, err
+	}
+	accountIDs, err := s.teamMembers(ctx, managerUUID)
+	if err != nil {
+		return nil, err
+	}
+	return s.listFeed(ctx, accountIDs)
+}
+
+// IssueFeedToken 確認帳戶可讀取指定的訂閱行事曆後簽發 feed token
+func (s *leaveCalendarServiceImpl) IssueFeedToken(ctx context.Context, viewerAccountIDStr string, feed string, subjectIDStr string) (string, error) {
+	viewer, err := s.getViewer(ctx, viewerAccountIDStr)
+	if err != nil {
+		return "", err
+	}
+	subjectUUID, err := uuid.Parse(subjectIDStr)
+	if err != nil {
+		return "", errors.New("invalid account identifier format")
+	}
+	switch feed {
+	case models.CalendarFeedAccount:
+		err = s.authorizeAccount(ctx, viewer, subjectUUID)
+	case models.CalendarFeedTeam:
+		err = s.authorizeTeam(ctx, viewer, subjectUUID)
+	default:
+		return "", fmt.Errorf("%w: %q", ErrCalendarFeedInvalid, feed)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	issuedAt := time.Now().UTC().Truncate(time.Second)
+	token, err := encodeSignedToken(s.signer, models.CalendarFeedToken{
+		Purpose:   models.CalendarFeedTokenPurpose,
+		Feed:      feed,
+		SubjectID: subjectUUID,
+		ViewerID:  viewer.ID,
+		Version:   viewer.FeedVersion,
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(s.feedTTL),
+	})
+	if err != nil {
+		log.Printf("Error signing %s calendar feed token for %s: %v", feed, viewer.ID, err)
+		return "", fmt.Errorf("failed to issue calendar feed token")
+	}
+	return token, nil
+}
+
+// VerifyFeedToken 驗證 feed token 的簽章、用途與期限，並確認簽發後訂閱者未撤銷 (版本一致)
+func (s *leaveCalendarServiceImpl) VerifyFeedToken(ctx context.Context, tokenStr string) (*models.CalendarFeedToken, error) {
+	var token models.CalendarFeedToken
+	if !decodeSignedToken(s.signer, tokenStr, &token) || token.Purpose != models.CalendarFeedTokenPurpose {
+		return nil, ErrCalendarFeedTokenInvalid
+	}
+	if token.Feed != models.CalendarFeedAccount && token.Feed != models.CalendarFeedTeam {
+		return nil, ErrCalendarFeedTokenInvalid
+	}
+	if !time.Now().Before(token.ExpiresAt) {
+		return nil, ErrCalendarFeedTokenInvalid
+	}
+
+	viewer, err := s.accountRepo.GetAccountByID(ctx, token.ViewerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrCalendarFeedTokenInvalid
+		}
+		log.Printf("Error fetching feed token viewer %s: %v", token.ViewerID, err)
+		return nil, fmt.Errorf("failed to verify calendar feed token")
+	}
+	if viewer.FeedVersion != token.Version {
+		return nil, ErrCalendarFeedTokenInvalid
+	}
+	return &token, nil
+}
+
+// RevokeFeedTokens 遞增帳戶的訂閱行事曆版本，使先前簽發的 feed token 全部失效
+func (s *leaveCalendarServiceImpl) RevokeFeedTokens(ctx context.Context, viewerAccountIDStr string) error {
+	viewerUUID, err := uuid.Parse(viewerAccountIDStr)
+	if err != nil {
+		return errors.New("invalid user identifier format")
+	}
+	if err := s.accountRepo.IncrementFeedVersion(ctx, viewerUUID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrAccountNotFound
+		}
+		log.Printf("Error revoking calendar feed tokens for account %s: %v", viewerUUID, err)
+		return fmt.Errorf("failed to revoke calendar feed tokens")
+	}
+	return nil
+}
+
+// listFeed 查詢訂閱期間內指定帳戶的假單
+func (s *leaveCalendarServiceImpl) listFeed(ctx context.Context, accountIDs []uuid.UUID) ([]models.LeaveRequest, error) {
+	today := dateOf(time.Now())
+	requests, err := s.leaveRepo.ListInRange(ctx, accountIDs,
+		today.AddDate(0, 0, -leaveFeedLookbackDays), today.AddDate(0, 0, leaveFeedLookaheadDays), feedStatuses)
+	if err != nil {
+		log.Printf("Error fetching leave feed for %d accounts: %v", len(accountIDs), err)
+		return nil, fmt.Errorf("failed to retrieve leave calendar feed")
+	}
+	clearApplicantPasswords(requests)
+	return requests, nil
+}
+
+// getViewer 解析並取得查看行事曆的帳戶
+func (s *leaveCalendarServiceImpl) getViewer(ctx context.Context, viewerAccountIDStr string) (*models.Account, error) {
+	viewerUUID, err := uuid.Parse(viewerAccountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+	viewer, err := s.accountRepo.GetAccountByID(ctx, viewerUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		log.Printf("Error fetching viewer account %s: %v", viewerUUID, err)
+		return nil, fmt.Errorf("failed to verify viewer account")
+	}
+	return viewer, nil
+}
+
+// authorizeAccount 確認帳戶可查看指定帳戶的假單: 本人、其直屬主管或 HR / Super Admin
+func (s *leaveCalendarServiceImpl) authorizeAccount(ctx context.Context, viewer *models.Account, accountID uuid.UUID) error {
+	if viewer.ID == accountID || isHRAccount(viewer) {
+		return nil
+	}
+	managerID, err := s.managerOf(ctx, accountID)
+	if err != nil {
+		return err
+	}
+	if managerID == nil || *managerID != viewer.ID {
+		return ErrCalendarAccessDenied
+	}
+	return nil
+}
+
+// authorizeTeam 確認帳戶可查看指定主管的團隊: HR / Super Admin、主管本人或該團隊成員
+func (s *leaveCalendarServiceImpl) authorizeTeam(ctx context.Context, viewer *models.Account, managerID uuid.UUID) error {
+	if isHRAccount(viewer) || viewer.ID == managerID {
+		return nil
+	}
+	viewerManagerID, err := s.managerOf(ctx, viewer.ID)
+	if err != nil {
+		return err
+	}
+	if viewerManagerID == nil || *viewerManagerID != managerID {
+		return ErrCalendarAccessDenied
+	}
+	return nil
+}
+
+// defaultTeamMembers 未指定團隊時的查詢範圍: HR 為全公司 (nil)，有主管者為主管的團隊，否則為自己管理的團隊
+func (s *leaveCalendarServiceImpl) defaultTeamMembers(ctx context.Context, viewer *models.Account) ([]uuid.UUID, error) {
+	if isHRAccount(viewer) {
+		return nil, nil
+	}
+	managerID, err := s.managerOf(ctx, viewer.ID)
+	if err != nil {
+		return nil, err
+	}
+	if managerID != nil {
+		return s.teamMembers(ctx, *managerID)
+	}
+	return s.teamMembers(ctx, viewer.ID)
+}
+
+// teamMembers 返回主管本人與其直屬部屬的帳戶 ID
+func (s *leaveCalendarServiceImpl) teamMembers(ctx context.Context, managerID uuid.UUID) ([]uuid.UUID, error) {
+	reports, err := s.employmentRepo.ListEmploymentsByManagerID(ctx, managerID)
+	if err != nil {
+		log.Printf("Error fetching direct reports of manager %s: %v", managerID, err)
+		return nil, fmt.Errorf("failed to retrieve team members")
+	}
+	accountIDs := make([]uuid.UUID, 0, len(reports)+1)
+	accountIDs = append(accountIDs, managerID)
+	for _, report := range reports {
+		accountIDs = append(accountIDs, report.AccountID)
+	}
+	return accountIDs, nil
+}
+
+// managerOf 返回帳戶的直屬主管 ID，沒有僱傭記錄或未設定主管時返回 nil
+func (s *leaveCalendarServiceImpl) managerOf(ctx context.Context, accountID uuid.UUID) (*uuid.UUID, error) {
+	employment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		log.Printf("Error fetching employment of account %s: %v", accountID, err)
+		return nil, fmt.Errorf("failed to verify reporting line")
+	}
+	return employment.ManagerID, nil
+}
+
+// isHRAccount 判斷帳戶是否為 HR 或 Super Admin
+func isHRAccount(account *models.Account) bool {
+	return account.Role == models.RoleHR || account.Role == models.RoleSuperAdmin
+}
+
+// clearApplicantPasswords 清除預加載的申請人密碼
+func clearApplicantPasswords(requests []models.LeaveRequest) {
+	for i := range requests {
+		requests[i].Account.Password = ""
+	}
+}
+
+// groupLeaveByDay 將假單依 [from, to] 內的每一天分組，僅返回有假單的日期
+func groupLeaveByDay(requests []models.LeaveRequest, from, to time.Time) []models.LeaveCalendarDay {
+	days := make([]models.LeaveCalendarDay, 0)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		var onLeave []models.LeaveRequest
+		for _, request := range requests {
+			if !day.Before(dateOf(request.StartDate)) && !day.After(dateOf(request.EndDate)) {
+				onLeave = append(onLeave, request)
+			}
+		}
+		if len(onLeave) > 0 {
+			days = append(days, models.LeaveCalendarDay{Date: day, Requests: onLeave})
+		}
+	}
+	return days
+}
@@ -0,0 +1,429 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/erinchen11/hr-system/internal/utils"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// leaveCalendarMocks 集中 LeaveCalendarService 測試用的 mock 依賴
+type leaveCalendarMocks struct {
+	leaveRepo      *mocks.MockLeaveRequestRepository
+	accountRepo    *mocks.MockAccountRepository
+	employmentRepo *mocks.MockEmploymentRepository
+}
+
+// newLeaveCalendarServiceWithMocks 建立使用真實簽章與 mock 資料層的 LeaveCalendarService
+func newLeaveCalendarServiceWithMocks(t *testing.T, ctrl *gomock.Controller) (interfaces.LeaveCalendarService, leaveCalendarMocks) {
+	signer, err := utils.NewJwtUtils("test-secret", "hr-system", "1")
+	require.NoError(t, err)
+	m := leaveCalendarMocks{
+		leaveRepo:      mocks.NewMockLeaveRequestRepository(ctrl),
+		accountRepo:    mocks.NewMockAccountRepository(ctrl),
+		employmentRepo: mocks.NewMockEmploymentRepository(ctrl),
+	}
+	return NewLeaveCalendarServiceImpl(m.leaveRepo, m.accountRepo, m.employmentRepo, signer, 30), m
+}
+
+func TestLeaveCalendarServiceImpl_GetTeamCalendar(t *testing.T) {
+	ctx := context.Background()
+	hrID := uuid.New()
+	managerID := uuid.New()
+	employeeID := uuid.New()
+	peerID := uuid.New()
+	outsiderID := uuid.New()
+
+	from := time.Date(2025, 5, 5, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 5, 9, 0, 0, 0, 0, time.UTC)
+	peerLeave := models.LeaveRequest{
+		ID: uuid.New(), AccountID: peerID, Status: models.LeaveStatusApproved,
+		StartDate: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC),
+		Account: models.Account{ID: peerID, Password: "hash"},
+	}
+	managerLeave := models.LeaveRequest{
+		ID: uuid.New(), AccountID: managerID, Status: models.LeaveStatusApproved,
+		StartDate: time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2025, 5, 6, 0, 0, 0, 0, time.UTC),
+		Account: models.Account{ID: managerID},
+	}
+	reports := []models.Employment{{AccountID: employeeID, ManagerID: &managerID}, {AccountID: peerID, ManagerID: &managerID}}
+
+	t.Run("Success - Employee Sees Own Team Grouped By Day", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, Role: models.RoleEmployee}, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).Return(&models.Employment{AccountID: employeeID, ManagerID: &managerID}, nil).Times(1)
+		m.employmentRepo.EXPECT().ListEmploymentsByManagerID(gomock.Any(), managerID).Return(reports, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), []uuid.UUID{managerID, employeeID, peerID}, from, to,
+			[]string{models.LeaveStatusApproved, models.LeaveStatusCancellationRequested}).
+			Return([]models.LeaveRequest{peerLeave, managerLeave}, nil).Times(1)
+
+		days, err := service.GetTeamCalendar(ctx, employeeID.String(), models.LeaveCalendarQuery{From: from, To: to})
+
+		require.NoError(t, err)
+		require.Len(t, days, 2) // 5/5 與 5/6 有人請假
+		assert.Equal(t, from, days[0].Date)
+		require.Len(t, days[0].Requests, 1)
+		assert.Equal(t, peerLeave.ID, days[0].Requests[0].ID)
+		assert.Equal(t, "", days[0].Requests[0].Account.Password)
+		assert.Len(t, days[1].Requests, 2)
+	})
+
+	t.Run("Success - HR Sees Everyone Including Pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(&models.Account{ID: hrID, Role: models.RoleHR}, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), nil, from, to,
+			[]string{models.LeaveStatusPending, models.LeaveStatusApproved, models.LeaveStatusCancellationRequested}).
+			Return([]models.LeaveRequest{}, nil).Times(1)
+
+		days, err := service.GetTeamCalendar(ctx, hrID.String(), models.LeaveCalendarQuery{From: from, To: to, IncludePending: true})
+
+		require.NoError(t, err)
+		assert.Empty(t, days)
+	})
+
+	t.Run("Success - Manager Without Manager Sees Own Reports", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(&models.Account{ID: managerID, Role: models.RoleEmployee}, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), managerID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		m.employmentRepo.EXPECT().ListEmploymentsByManagerID(gomock.Any(), managerID).Return(reports, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), []uuid.UUID{managerID, employeeID, peerID}, from, to, gomock.Any()).Return(nil, nil).Times(1)
+
+		_, err := service.GetTeamCalendar(ctx, managerID.String(), models.LeaveCalendarQuery{From: from, To: to})
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Other Team Denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), outsiderID).Return(&models.Account{ID: outsiderID, Role: models.RoleEmployee}, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), outsiderID).Return(&models.Employment{AccountID: outsiderID}, nil).Times(1)
+
+		_, err := service.GetTeamCalendar(ctx, outsiderID.String(), models.LeaveCalendarQuery{From: from, To: to, ManagerID: &managerID})
+		assert.ErrorIs(t, err, ErrCalendarAccessDenied)
+	})
+
+	t.Run("Failure - Invalid Range", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(&models.Account{ID: hrID, Role: models.RoleHR}, nil).Times(2)
+
+		_, err := service.GetTeamCalendar(ctx, hrID.String(), models.LeaveCalendarQuery{From: to, To: from})
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+
+		_, err = service.GetTeamCalendar(ctx, hrID.String(), models.LeaveCalendarQuery{From: from, To: from.AddDate(0, 0, models.MaxLeaveCalendarDays)})
+		assert.ErrorIs(t, err, ErrCalendarRangeTooLong)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(&models.Account{ID: hrID, Role: models.RoleHR}, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down")).Times(1)
+
+		_, err := service.GetTeamCalendar(ctx, hrID.String(), models.LeaveCalendarQuery{From: from, To: to})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to retrieve leave calendar")
+	})
+}
+
+func TestLeaveCalendarServiceImpl_ListAccountFeed(t *testing.T) {
+	ctx := context.Background()
+	managerID := uuid.New()
+	employeeID := uuid.New()
+	outsiderID := uuid.New()
+
+	t.Run("Success - Own Feed Includes Cancelled Requests", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, Role: models.RoleEmployee}, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), []uuid.UUID{employeeID}, gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ []uuid.UUID, start, end time.Time, statuses []string) ([]models.LeaveRequest, error) {
+				assert.True(t, start.Before(end))
+				assert.Contains(t, statuses, models.LeaveStatusCancelled)
+				assert.Contains(t, statuses, models.LeaveStatusRejected)
+				return []models.LeaveRequest{{ID: uuid.New(), AccountID: employeeID, Account: models.Account{Password: "hash"}}}, nil
+			}).Times(1)
+
+		requests, err := service.ListAccountFeed(ctx, employeeID.String(), employeeID.String())
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		assert.Equal(t, "", requests[0].Account.Password)
+	})
+
+	t.Run("Success - Direct Manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(&models.Account{ID: managerID, Role: models.RoleEmployee}, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).Return(&models.Employment{AccountID: employeeID, ManagerID: &managerID}, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), []uuid.UUID{employeeID}, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		_, err := service.ListAccountFeed(ctx, managerID.String(), employeeID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Unrelated Account Denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), outsiderID).Return(&models.Account{ID: outsiderID, Role: models.RoleEmployee}, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).Return(&models.Employment{AccountID: employeeID, ManagerID: &managerID}, nil).Times(1)
+
+		_, err := service.ListAccountFeed(ctx, outsiderID.String(), employeeID.String())
+		assert.ErrorIs(t, err, ErrCalendarAccessDenied)
+	})
+
+	t.Run("Failure - Viewer Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), outsiderID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		_, err := service.ListAccountFeed(ctx, outsiderID.String(), employeeID.String())
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+	})
+}
+
+func TestLeaveCalendarServiceImpl_ListTeamFeed(t *testing.T) {
+	ctx := context.Background()
+	managerID := uuid.New()
+	employeeID := uuid.New()
+
+	t.Run("Success - Team Member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, Role: models.RoleEmployee}, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).Return(&models.Employment{AccountID: employeeID, ManagerID: &managerID}, nil).Times(1)
+		m.employmentRepo.EXPECT().ListEmploymentsByManagerID(gomock.Any(), managerID).Return([]models.Employment{{AccountID: employeeID}}, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), []uuid.UUID{managerID, employeeID}, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		_, err := service.ListTeamFeed(ctx, employeeID.String(), managerID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Invalid Manager ID", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID}, nil).Times(1)
+
+		_, err := service.ListTeamFeed(ctx, employeeID.String(), "not-a-uuid")
+		require.Error(t, err)
+	})
+}
+
+func TestLeaveCalendarServiceImpl_IssueFeedToken(t *testing.T) {
+	ctx := context.Background()
+	managerID := uuid.New()
+	employeeID := uuid.New()
+	outsiderID := uuid.New()
+
+	t.Run("Success - Own Account Feed Round Trips", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		// 簽發與驗證時各讀取一次訂閱者帳戶
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, Role: models.RoleEmployee}, nil).Times(2)
+
+		tokenStr, err := service.IssueFeedToken(ctx, employeeID.String(), models.CalendarFeedAccount, employeeID.String())
+		require.NoError(t, err)
+
+		token, err := service.VerifyFeedToken(ctx, tokenStr)
+		require.NoError(t, err)
+		assert.Equal(t, models.CalendarFeedAccount, token.Feed)
+		assert.Equal(t, employeeID, token.SubjectID)
+		assert.Equal(t, employeeID, token.ViewerID)
+		assert.WithinDuration(t, time.Now().Add(30*24*time.Hour), token.ExpiresAt, time.Minute)
+	})
+
+	t.Run("Success - Team Member Gets Team Feed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, Role: models.RoleEmployee}, nil).Times(2)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).Return(&models.Employment{AccountID: employeeID, ManagerID: &managerID}, nil).Times(1)
+
+		tokenStr, err := service.IssueFeedToken(ctx, employeeID.String(), models.CalendarFeedTeam, managerID.String())
+		require.NoError(t, err)
+
+		token, err := service.VerifyFeedToken(ctx, tokenStr)
+		require.NoError(t, err)
+		assert.Equal(t, models.CalendarFeedTeam, token.Feed)
+		assert.Equal(t, managerID, token.SubjectID)
+	})
+
+	t.Run("Failure - Unrelated Account Denied", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), outsiderID).Return(&models.Account{ID: outsiderID, Role: models.RoleEmployee}, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), employeeID).Return(&models.Employment{AccountID: employeeID, ManagerID: &managerID}, nil).Times(1)
+
+		_, err := service.IssueFeedToken(ctx, outsiderID.String(), models.CalendarFeedAccount, employeeID.String())
+		assert.ErrorIs(t, err, ErrCalendarAccessDenied)
+	})
+
+	t.Run("Failure - Unknown Feed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, Role: models.RoleEmployee}, nil).Times(1)
+
+		_, err := service.IssueFeedToken(ctx, employeeID.String(), "company", employeeID.String())
+		assert.ErrorIs(t, err, ErrCalendarFeedInvalid)
+	})
+}
+
+func TestLeaveCalendarServiceImpl_VerifyFeedToken(t *testing.T) {
+	ctx := context.Background()
+	employeeID := uuid.New()
+	signer, err := utils.NewJwtUtils("test-secret", "hr-system", "1")
+	require.NoError(t, err)
+
+	// signFeedToken 直接簽署 feed token, 以便產生過期或舊版本的 token
+	signFeedToken := func(version uint, expiresAt time.Time) string {
+		tokenStr, err := encodeSignedToken(signer, models.CalendarFeedToken{
+			Purpose: models.CalendarFeedTokenPurpose, Feed: models.CalendarFeedAccount, SubjectID: employeeID, ViewerID: employeeID,
+			Version: version, IssuedAt: time.Now().Add(-time.Hour), ExpiresAt: expiresAt,
+		})
+		require.NoError(t, err)
+		return tokenStr
+	}
+	validToken := signFeedToken(1, time.Now().Add(time.Hour))
+
+	loginJWT, err := signer.GenerateJWT(employeeID.String(), "employee@example.com", models.RoleEmployee)
+	require.NoError(t, err)
+	actionToken, err := encodeSignedToken(signer, models.LeaveActionToken{
+		LeaveRequestID: uuid.New(), ApproverID: employeeID, Action: models.LeaveActionApprove, Nonce: uuid.NewString(),
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		token      string
+		setupMocks func(m leaveCalendarMocks)
+		expectErr  error
+	}{
+		{
+			name:  "Success - Current Version",
+			token: validToken,
+			setupMocks: func(m leaveCalendarMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, FeedVersion: 1}, nil).Times(1)
+			},
+		},
+		{name: "Failure - Empty", token: "", expectErr: ErrCalendarFeedTokenInvalid},
+		{name: "Failure - Tampered Signature", token: validToken[:len(validToken)-2] + "xx", expectErr: ErrCalendarFeedTokenInvalid},
+		{name: "Failure - Login JWT", token: loginJWT, expectErr: ErrCalendarFeedTokenInvalid},
+		{name: "Failure - Action Link Token", token: actionToken, expectErr: ErrCalendarFeedTokenInvalid},
+		{name: "Failure - Expired", token: signFeedToken(1, time.Now().Add(-time.Minute)), expectErr: ErrCalendarFeedTokenInvalid},
+		{name: "Failure - Without Expiry", token: signFeedToken(1, time.Time{}), expectErr: ErrCalendarFeedTokenInvalid},
+		{
+			name:  "Failure - Revoked By Newer Version",
+			token: validToken,
+			setupMocks: func(m leaveCalendarMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, FeedVersion: 2}, nil).Times(1)
+			},
+			expectErr: ErrCalendarFeedTokenInvalid,
+		},
+		{
+			name:  "Failure - Viewer Account Deleted",
+			token: validToken,
+			setupMocks: func(m leaveCalendarMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+			},
+			expectErr: ErrCalendarFeedTokenInvalid,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+			if tc.setupMocks != nil {
+				tc.setupMocks(m)
+			}
+
+			token, err := service.VerifyFeedToken(ctx, tc.token)
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, uint(1), token.Version)
+		})
+	}
+}
+
+func TestLeaveCalendarServiceImpl_RevokeFeedTokens(t *testing.T) {
+	ctx := context.Background()
+	employeeID := uuid.New()
+
+	t.Run("Success - Increments Feed Version", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().IncrementFeedVersion(gomock.Any(), employeeID).Return(nil).Times(1)
+
+		assert.NoError(t, service.RevokeFeedTokens(ctx, employeeID.String()))
+	})
+
+	t.Run("Failure - Account Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().IncrementFeedVersion(gomock.Any(), employeeID).Return(gorm.ErrRecordNotFound).Times(1)
+
+		assert.ErrorIs(t, service.RevokeFeedTokens(ctx, employeeID.String()), ErrAccountNotFound)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveCalendarServiceWithMocks(t, ctrl)
+
+		m.accountRepo.EXPECT().IncrementFeedVersion(gomock.Any(), employeeID).Return(errors.New("db down")).Times(1)
+
+		err := service.RevokeFeedTokens(ctx, employeeID.String())
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrAccountNotFound)
+	})
+}
@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"gorm.io/gorm"
+)
+
+// leaveFollowUpServiceImpl 實現了 LeaveFollowUpService 介面
+type leaveFollowUpServiceImpl struct {
+	leaveRepo        interfaces.LeaveRequestRepository
+	accountRepo      interfaces.AccountRepository
+	approvalRepo     interfaces.LeaveApprovalRepository // 找出目前待審的步驟
+	eventRepo        interfaces.LeaveRequestEventRepository
+	leaveRequestSvc  interfaces.LeaveRequestService // 自動核准沿用人工核准的檢查
+	notifier         interfaces.Notifier
+	approvalNotifier interfaces.LeaveApprovalNotifier // 提醒目前步驟的審核人 (可附 Email 審核連結)
+	settings         models.LeaveFollowUpSettings
+}
+
+// NewLeaveFollowUpServiceImpl 構造函數
+func NewLeaveFollowUpServiceImpl(
+	leaveRepo interfaces.LeaveRequestRepository,
+	accountRepo interfaces.AccountRepository,
+	approvalRepo interfaces.LeaveApprovalRepository,
+	eventRepo interfaces.LeaveRequestEventRepository,
+	leaveRequestSvc interfaces.LeaveRequestService,
+	notifier interfaces.Notifier,
+	approvalNotifier interfaces.LeaveApprovalNotifier,
+	settings models.LeaveFollowUpSettings,
+) interfaces.LeaveFollowUpService {
+	return &leaveFollowUpServiceImpl{
+		leaveRepo:        leaveRepo,
+		accountRepo:      accountRepo,
+		approvalRepo:     approvalRepo,
+		eventRepo:        eventRepo,
+		leaveRequestSvc:  leaveRequestSvc,
+		notifier:         notifier,
+		approvalNotifier: approvalNotifier,
+		settings:         settings,
+	}
+}
+
+// ProcessStaleRequests 依序處理待審超過提醒門檻的假單
+func (s *leaveFollowUpServiceImpl) ProcessStaleRequests(ctx context.Context, now time.Time) (*models.LeaveFollowUpReport, error) {
+	report := &models.LeaveFollowUpReport{}
+	threshold := s.settings.ReminderAfter
+	if threshold <= 0 || (s.settings.EscalateAfter > 0 && s.settings.EscalateAfter < threshold) {
+		threshold = s.settings.EscalateAfter
+	}
+	if threshold <= 0 {
+		return report, nil // 提醒與升級皆停用
+	}
+
+	requests, err := s.leaveRepo.ListPendingRequestedBefore(ctx, now.Add(-threshold))
+	if err != nil {
+		log.Printf("Error fetching stale pending leave requests: %v", err)
+		return nil, fmt.Errorf("failed to retrieve pending leave requests")
+	}
+
+	for i := range requests {
+		request := &requests[i]
+		if err := s.followUp(ctx, request, now, threshold, report); err != nil {
+			log.Printf("Error following up stale leave request %s: %v", request.ID, err)
+			report.Failed++
+		}
+	}
+	log.Printf("Stale leave follow-up finished: checked=%d reminded=%d escalated=%d auto_approved=%d failed=%d",
+		report.Checked, report.Reminded, report.Escalated, report.AutoApproved, report.Failed)
+	return report, nil
+}
+
+// followUp 處理單張假單: 自動核准 (符合條件時)、提醒審核人、升級給 Super Admin
+func (s *leaveFollowUpServiceImpl) followUp(ctx context.Context, request *models.LeaveRequest, now time.Time, threshold time.Duration, report *models.LeaveFollowUpReport) error {
+	steps, err := s.approvalRepo.ListStepsByRequestID(ctx, request.ID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve approval steps: %w", err)
+	}
+
+	// 多段審核時，從上一步驟通過的時間開始計算目前審核人的等待時間
+	waitingSince := request.RequestedAt
+	for _, step := range steps {
+		if step.Status == models.ApprovalStepStatusApproved && step.DecidedAt != nil && step.DecidedAt.After(waitingSince) {
+			waitingSince = *step.DecidedAt
+		}
+	}
+	waited := now.Sub(waitingSince)
+	if waited < threshold {
+		return nil
+	}
+	report.Checked++
+
+	if s.eligibleForAutoApproval(request, waited) {
+		comment := fmt.Sprintf("auto-approved: sick leave of %s day(s) pending for %s", request.Days.String(), waited.Round(time.Hour))
+		err := s.leaveRequestSvc.AutoApproveRequest(ctx, request.ID.String(), comment)
+		if err == nil {
+			report.AutoApproved++
+			return nil
+		}
+		if errors.Is(err, ErrInvalidLeaveRequestState) || errors.Is(err, ErrLeaveRequestNotFound) {
+			return nil // 已被審核人處理
+		}
+		// 檢查未通過 (例如餘額不足) 時改為提醒審核人
+		log.Printf("Leave request %s not auto-approved, reminding approver instead: %v", request.ID, err)
+	}
+
+	changed := false
+	if s.settings.ReminderAfter > 0 && waited >= s.settings.ReminderAfter &&
+		(request.LastRemindedAt == nil || now.Sub(*request.LastRemindedAt) >= s.settings.ReminderAfter) {
+		kind := models.ApprovalStepManager
+		if current := currentApprovalStep(steps); current != nil {
+			kind = current.ApproverKind
+		}
+		subject := fmt.Sprintf("Reminder: leave request pending approval for %s", waited.Round(time.Hour))
+		approvers, err := s.approvalNotifier.NotifyApprovers(ctx, request, kind, subject)
+		if err != nil {
+			return err
+		}
+		s.recordFollowUpEvent(ctx, request, models.LeaveEventReminderSent, "reminded "+accountEmails(approvers))
+		request.LastRemindedAt = &now
+		report.Reminded++
+		changed = true
+	}
+
+	if s.settings.EscalateAfter > 0 && waited >= s.settings.EscalateAfter && request.EscalatedAt == nil {
+		superAdmins, err := s.accountRepo.ListAccountsByRoles(ctx, models.RoleSuperAdmin)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve super admin accounts: %w", err)
+		}
+		subject := fmt.Sprintf("Escalation: leave request pending approval for %s", waited.Round(time.Hour))
+		if err := s.notifyAll(ctx, superAdmins, subject, describeLeaveRequest(request)); err != nil {
+			return err
+		}
+		s.recordFollowUpEvent(ctx, request, models.LeaveEventEscalated, "escalated to "+accountEmails(superAdmins))
+		request.EscalatedAt = &now
+		report.Escalated++
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	if err := s.leaveRepo.UpdateFollowUp(ctx, request); err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to record follow-up time: %w", err)
+	}
+	return nil
+}
+
+// eligibleForAutoApproval 判斷假單是否為可由系統自動核准的短天數病假
+func (s *leaveFollowUpServiceImpl) eligibleForAutoApproval(request *models.LeaveRequest, waited time.Duration) bool {
+	maxDays := s.settings.AutoApproveSickMaxDays
+	if !maxDays.IsPositive() || request.LeaveType != models.LeaveTypeSick {
+		return false
+	}
+	if s.settings.ReminderAfter > 0 && waited < s.settings.ReminderAfter {
+		return false
+	}
+	return request.Days.IsPositive() && request.Days.LessThanOrEqual(maxDays)
+}
+
+// notifyAll 通知每個收件人; 任一通知失敗時返回錯誤，下次排程重試
+func (s *leaveFollowUpServiceImpl) notifyAll(ctx context.Context, recipients []models.Account, subject, body string) error {
+	if len(recipients) == 0 {
+		return errors.New("no recipient to notify")
+	}
+	for _, recipient := range recipients {
+		if err := s.notifier.Notify(ctx, recipient, subject, body); err != nil {
+			return fmt.Errorf("failed to notify %s: %w", recipient.Email, err)
+		}
+	}
+	return nil
+}
+
+// recordFollowUpEvent 寫入假單歷程 (由系統觸發，沒有 ActorID); 寫入失敗只記錄 log
+func (s *leaveFollowUpServiceImpl) recordFollowUpEvent(ctx context.Context, request *models.LeaveRequest, eventType, comment string) {
+	event := &models.LeaveRequestEvent{
+		LeaveRequestID: request.ID,
+		EventType:      eventType,
+		FromStatus:     request.Status,
+		ToStatus:       request.Status,
+		Comment:        comment,
+	}
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		log.Printf("Error recording %s event for leave request %s: %v", eventType, request.ID, err)
+	}
+}
+
+// describeLeaveRequest 組成通知內容: 申請人、假別、日期與天數
+func describeLeaveRequest(request *models.LeaveRequest) string {
+	return fmt.Sprintf("%s %s (%s) requested %s leave from %s to %s (%s day(s)), submitted at %s. Leave request ID: %s",
+		request.Account.FirstName, request.Account.LastName, request.Account.Email, request.LeaveType,
+		request.StartDate.Format("2006-01-02"), request.EndDate.Format("2006-01-02"), request.Days.String(),
+		request.RequestedAt.Format(time.RFC3339), request.ID)
+}
+
+// accountEmails 以逗號串接帳戶 Email，用於歷程記錄
+func accountEmails(accounts []models.Account) string {
+	emails := make([]string, 0, len(accounts))
+	for _, a := range accounts {
+		emails = append(emails, a.Email)
+	}
+	return strings.Join(emails, ", ")
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type leaveFollowUpMocks struct {
+	leaveRepo       *mocks.MockLeaveRequestRepository
+	accountRepo     *mocks.MockAccountRepository
+	employmentRepo  *mocks.MockEmploymentRepository
+	approvalRepo    *mocks.MockLeaveApprovalRepository
+	eventRepo       *mocks.MockLeaveRequestEventRepository
+	leaveRequestSvc *mocks.MockLeaveRequestService
+	delegationRepo  *mocks.MockApprovalDelegationRepository
+	notifier        *mocks.MockNotifier
+}
+
+func TestLeaveFollowUpServiceImpl_ProcessStaleRequests(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC)
+	settings := models.LeaveFollowUpSettings{
+		ReminderAfter:          48 * time.Hour,
+		EscalateAfter:          120 * time.Hour,
+		AutoApproveSickMaxDays: decimal.NewFromInt(2),
+	}
+	applicantID := uuid.New()
+	managerID := uuid.New()
+	manager := &models.Account{ID: managerID, Email: "manager@example.com", Role: models.RoleEmployee}
+	hrAccounts := []models.Account{{ID: uuid.New(), Email: "hr@example.com", Role: models.RoleHR}}
+	superAdmins := []models.Account{{ID: uuid.New(), Email: "admin@example.com", Role: models.RoleSuperAdmin}}
+
+	newRequest := func(leaveType string, days int64, pendingFor time.Duration) models.LeaveRequest {
+		return models.LeaveRequest{
+			ID: uuid.New(), AccountID: applicantID, LeaveType: leaveType, Days: decimal.NewFromInt(days),
+			Status: models.LeaveStatusPending, RequestedAt: now.Add(-pendingFor),
+		}
+	}
+	expectManager := func(m *leaveFollowUpMocks) {
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).
+			Return(&models.Employment{AccountID: applicantID, ManagerID: &managerID}, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerID).Return(manager, nil).Times(1)
+	}
+	expectNotified := func(m *leaveFollowUpMocks, email string) {
+		m.notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, recipient models.Account, subject, body string) error {
+				assert.Equal(t, email, recipient.Email)
+				return nil
+			}).Times(1)
+	}
+	expectFollowUpEvent := func(m *leaveFollowUpMocks, eventType string) {
+		m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+				assert.Equal(t, eventType, e.EventType)
+				assert.Nil(t, e.ActorID)
+				return nil
+			}).Times(1)
+	}
+
+	testCases := []struct {
+		name           string
+		requests       []models.LeaveRequest
+		setupMocks     func(m *leaveFollowUpMocks, requests []models.LeaveRequest)
+		expectedReport models.LeaveFollowUpReport
+	}{
+		{
+			name:     "Success - Reminds Direct Manager",
+			requests: []models.LeaveRequest{newRequest(models.LeaveTypeAnnual, 3, 50*time.Hour)},
+			setupMocks: func(m *leaveFollowUpMocks, requests []models.LeaveRequest) {
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requests[0].ID).
+					Return(pendingApprovalSteps(requests[0].ID, models.ApprovalStepManager), nil).Times(1)
+				expectManager(m)
+				expectNotified(m, manager.Email)
+				expectFollowUpEvent(m, models.LeaveEventReminderSent)
+				m.leaveRepo.EXPECT().UpdateFollowUp(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+						require.NotNil(t, req.LastRemindedAt)
+						assert.Equal(t, now, *req.LastRemindedAt)
+						assert.Nil(t, req.EscalatedAt)
+						return nil
+					}).Times(1)
+			},
+			expectedReport: models.LeaveFollowUpReport{Checked: 1, Reminded: 1},
+		},
+		{
+			name: "Success - Recently Reminded Is Not Reminded Again",
+			requests: func() []models.LeaveRequest {
+				r := newRequest(models.LeaveTypeAnnual, 3, 60*time.Hour)
+				remindedAt := now.Add(-10 * time.Hour)
+				r.LastRemindedAt = &remindedAt
+				return []models.LeaveRequest{r}
+			}(),
+			setupMocks: func(m *leaveFollowUpMocks, requests []models.LeaveRequest) {
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requests[0].ID).
+					Return(pendingApprovalSteps(requests[0].ID, models.ApprovalStepManager), nil).Times(1)
+				// Notify / UpdateFollowUp should NOT be called
+			},
+			expectedReport: models.LeaveFollowUpReport{Checked: 1},
+		},
+		{
+			name: "Success - HR Step Reminds HR And Escalates To Super Admin",
+			requests: func() []models.LeaveRequest {
+				r := newRequest(models.LeaveTypeAnnual, 5, 130*time.Hour)
+				remindedAt := now.Add(-80 * time.Hour)
+				r.LastRemindedAt = &remindedAt
+				return []models.LeaveRequest{r}
+			}(),
+			setupMocks: func(m *leaveFollowUpMocks, requests []models.LeaveRequest) {
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requests[0].ID).
+					Return(pendingApprovalSteps(requests[0].ID, models.ApprovalStepHR), nil).Times(1)
+				m.accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleHR, models.RoleSuperAdmin).Return(hrAccounts, nil).Times(1)
+				expectNotified(m, "hr@example.com")
+				expectFollowUpEvent(m, models.LeaveEventReminderSent)
+				m.accountRepo.EXPECT().ListAccountsByRoles(gomock.Any(), models.RoleSuperAdmin).Return(superAdmins, nil).Times(1)
+				expectNotified(m, "admin@example.com")
+				expectFollowUpEvent(m, models.LeaveEventEscalated)
+				m.leaveRepo.EXPECT().UpdateFollowUp(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+						require.NotNil(t, req.EscalatedAt)
+						assert.Equal(t, now, *req.EscalatedAt)
+						return nil
+					}).Times(1)
+			},
+			expectedReport: models.LeaveFollowUpReport{Checked: 1, Reminded: 1, Escalated: 1},
+		},
+		{
+			name:     "Success - Short Sick Leave Auto-Approved",
+			requests: []models.LeaveRequest{newRequest(models.LeaveTypeSick, 1, 49*time.Hour)},
+			setupMocks: func(m *leaveFollowUpMocks, requests []models.LeaveRequest) {
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requests[0].ID).
+					Return(pendingApprovalSteps(requests[0].ID, models.ApprovalStepManager), nil).Times(1)
+				m.leaveRequestSvc.EXPECT().AutoApproveRequest(gomock.Any(), requests[0].ID.String(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedReport: models.LeaveFollowUpReport{Checked: 1, AutoApproved: 1},
+		},
+		{
+			name:     "Success - Auto-Approval Failure Falls Back To Reminder",
+			requests: []models.LeaveRequest{newRequest(models.LeaveTypeSick, 2, 49*time.Hour)},
+			setupMocks: func(m *leaveFollowUpMocks, requests []models.LeaveRequest) {
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requests[0].ID).
+					Return(pendingApprovalSteps(requests[0].ID, models.ApprovalStepManager), nil).Times(1)
+				m.leaveRequestSvc.EXPECT().AutoApproveRequest(gomock.Any(), requests[0].ID.String(), gomock.Any()).
+					Return(ErrInsufficientLeaveBalance).Times(1)
+				expectManager(m)
+				expectNotified(m, manager.Email)
+				expectFollowUpEvent(m, models.LeaveEventReminderSent)
+				m.leaveRepo.EXPECT().UpdateFollowUp(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedReport: models.LeaveFollowUpReport{Checked: 1, Reminded: 1},
+		},
+		{
+			name:     "Success - Long Sick Leave Is Not Auto-Approved",
+			requests: []models.LeaveRequest{newRequest(models.LeaveTypeSick, 3, 49*time.Hour)},
+			setupMocks: func(m *leaveFollowUpMocks, requests []models.LeaveRequest) {
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requests[0].ID).
+					Return(pendingApprovalSteps(requests[0].ID, models.ApprovalStepManager), nil).Times(1)
+				expectManager(m)
+				expectNotified(m, manager.Email)
+				expectFollowUpEvent(m, models.LeaveEventReminderSent)
+				m.leaveRepo.EXPECT().UpdateFollowUp(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			expectedReport: models.LeaveFollowUpReport{Checked: 1, Reminded: 1},
+		},
+		{
+			name:     "Success - Waiting Time Starts From Previous Step Decision",
+			requests: []models.LeaveRequest{newRequest(models.LeaveTypeAnnual, 5, 100*time.Hour)},
+			setupMocks: func(m *leaveFollowUpMocks, requests []models.LeaveRequest) {
+				steps := pendingApprovalSteps(requests[0].ID, models.ApprovalStepManager, models.ApprovalStepHR)
+				decidedAt := now.Add(-5 * time.Hour)
+				steps[0].Status = models.ApprovalStepStatusApproved
+				steps[0].DecidedAt = &decidedAt
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requests[0].ID).Return(steps, nil).Times(1)
+			},
+			expectedReport: models.LeaveFollowUpReport{},
+		},
+		{
+			name:     "Failure - Notification Error Counted As Failed",
+			requests: []models.LeaveRequest{newRequest(models.LeaveTypeAnnual, 3, 50*time.Hour)},
+			setupMocks: func(m *leaveFollowUpMocks, requests []models.LeaveRequest) {
+				m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), requests[0].ID).
+					Return(pendingApprovalSteps(requests[0].ID, models.ApprovalStepManager), nil).Times(1)
+				expectManager(m)
+				m.notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("smtp down")).Times(1)
+			},
+			expectedReport: models.LeaveFollowUpReport{Checked: 1, Failed: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, m := newLeaveFollowUpServiceWithMocks(ctrl, settings)
+
+			m.leaveRepo.EXPECT().ListPendingRequestedBefore(gomock.Any(), now.Add(-48*time.Hour)).Return(tc.requests, nil).Times(1)
+			tc.setupMocks(m, tc.requests)
+
+			report, err := service.ProcessStaleRequests(ctx, now)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedReport, *report)
+		})
+	}
+
+	t.Run("Failure - List Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveFollowUpServiceWithMocks(ctrl, settings)
+
+		m.leaveRepo.EXPECT().ListPendingRequestedBefore(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error")).Times(1)
+
+		report, err := service.ProcessStaleRequests(ctx, now)
+
+		assert.Error(t, err)
+		assert.Nil(t, report)
+	})
+
+	t.Run("Success - Reminder Includes Action Links", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveFollowUpServiceWithMocks(ctrl, settings)
+		actionLinkSvc := mocks.NewMockLeaveActionLinkGenerator(ctrl)
+		approvalNotifier := NewLeaveApprovalNotifierImpl(m.accountRepo, m.employmentRepo, m.delegationRepo, m.notifier, actionLinkSvc)
+		service = NewLeaveFollowUpServiceImpl(m.leaveRepo, m.accountRepo, m.approvalRepo, m.eventRepo, m.leaveRequestSvc, m.notifier, approvalNotifier, settings)
+		request := newRequest(models.LeaveTypeAnnual, 3, 50*time.Hour)
+		expiresAt := now.Add(72 * time.Hour)
+		m.notifier.EXPECT().Private().Return(true).Times(1)
+
+		m.leaveRepo.EXPECT().ListPendingRequestedBefore(gomock.Any(), gomock.Any()).Return([]models.LeaveRequest{request}, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), request.ID).
+			Return(pendingApprovalSteps(request.ID, models.ApprovalStepManager), nil).Times(1)
+		expectManager(m)
+		actionLinkSvc.EXPECT().GenerateLinks(gomock.Any(), request.ID, managerID).
+			Return(&models.LeaveActionLinks{ApproveURL: "https://hr.example.com/a", RejectURL: "https://hr.example.com/r", ExpiresAt: expiresAt}, nil).Times(1)
+		m.notifier.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, recipient models.Account, subject, body string) error {
+				assert.Equal(t, manager.Email, recipient.Email)
+				assert.Contains(t, body, "Approve: https://hr.example.com/a")
+				assert.Contains(t, body, "Reject: https://hr.example.com/r")
+				assert.Contains(t, body, expiresAt.Format(time.RFC3339))
+				return nil
+			}).Times(1)
+		expectFollowUpEvent(m, models.LeaveEventReminderSent)
+		m.leaveRepo.EXPECT().UpdateFollowUp(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		report, err := service.ProcessStaleRequests(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveFollowUpReport{Checked: 1, Reminded: 1}, *report)
+	})
+
+	t.Run("Success - Disabled Does Nothing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveFollowUpServiceWithMocks(ctrl, models.LeaveFollowUpSettings{})
+		// ListPendingRequestedBefore should NOT be called
+
+		report, err := service.ProcessStaleRequests(ctx, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveFollowUpReport{}, *report)
+	})
+}
+
+// newLeaveFollowUpServiceWithMocks 建立一個所有依賴皆為 mock 的 LeaveFollowUpService
+func newLeaveFollowUpServiceWithMocks(ctrl *gomock.Controller, settings models.LeaveFollowUpSettings) (interfaces.LeaveFollowUpService, *leaveFollowUpMocks) {
+	m := &leaveFollowUpMocks{
+		leaveRepo:       mocks.NewMockLeaveRequestRepository(ctrl),
+		accountRepo:     mocks.NewMockAccountRepository(ctrl),
+		employmentRepo:  mocks.NewMockEmploymentRepository(ctrl),
+		approvalRepo:    mocks.NewMockLeaveApprovalRepository(ctrl),
+		eventRepo:       mocks.NewMockLeaveRequestEventRepository(ctrl),
+		leaveRequestSvc: mocks.NewMockLeaveRequestService(ctrl),
+		delegationRepo:  mocks.NewMockApprovalDelegationRepository(ctrl),
+		notifier:        mocks.NewMockNotifier(ctrl),
+	}
+	// 審核人都沒有設定代理
+	m.delegationRepo.EXPECT().ListActiveForDelegators(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	// 審核人通知使用真實實作 (不附連結), 其查詢與通知由上述 mock 驗證
+	approvalNotifier := NewLeaveApprovalNotifierImpl(m.accountRepo, m.employmentRepo, m.delegationRepo, m.notifier, nil)
+	return NewLeaveFollowUpServiceImpl(m.leaveRepo, m.accountRepo, m.approvalRepo, m.eventRepo, m.leaveRequestSvc, m.notifier, approvalNotifier, settings), m
+}
@@ -3,30 +3,66 @@ package services
 import (
 	"context"
 	"errors"
-	"fmt" 
-	"log" 
+	"fmt"
+	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/erinchen11/hr-system/internal/interfaces"
-	"github.com/erinchen11/hr-system/internal/models" 
+	"github.com/erinchen11/hr-system/internal/models"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
 // leaveRequestServiceImpl 實現了 LeaveRequestService 介面
 type leaveRequestServiceImpl struct {
-	leaveRepo   interfaces.LeaveRequestRepository
-	accountRepo interfaces.AccountRepository
+	leaveRepo      interfaces.LeaveRequestRepository
+	accountRepo    interfaces.AccountRepository
+	employmentRepo interfaces.EmploymentRepository    // 查詢申請人的直屬主管
+	approvalRepo   interfaces.LeaveApprovalRepository // 審核政策與多段審核步驟
+	delegationRepo interfaces.ApprovalDelegationRepository // 審核人不在時由代理人代為審核
+	eventRepo      interfaces.LeaveRequestEventRepository  // 假單狀態歷程
+	balanceSvc     interfaces.LeaveBalanceService  // 申請時檢查餘額、核准時扣除天數
+	holidaySvc     interfaces.HolidayService       // 計算假單實際占用的工作日數
+	attachmentSvc  interfaces.LeaveAttachmentService // 佐證文件的規則檢查與儲存
+	ruleSvc        interfaces.LeaveRuleService       // 封鎖期間與最少在班人數
+	leaveTypeSvc   interfaces.LeaveTypeService       // 假別是否存在、啟用與申請限制
+	approvalNotifier interfaces.LeaveApprovalNotifier // 提交及進入下一審核步驟時通知審核人
+	txManager      interfaces.TransactionManager     // 審核步驟、假單狀態與餘額扣除在同一事務中寫入
 }
 
 // NewLeaveRequestServiceImpl 構造函數
 func NewLeaveRequestServiceImpl(
 	leaveRepo interfaces.LeaveRequestRepository,
 	accountRepo interfaces.AccountRepository,
+	employmentRepo interfaces.EmploymentRepository,
+	approvalRepo interfaces.LeaveApprovalRepository,
+	delegationRepo interfaces.ApprovalDelegationRepository,
+	eventRepo interfaces.LeaveRequestEventRepository,
+	balanceSvc interfaces.LeaveBalanceService,
+	holidaySvc interfaces.HolidayService,
+	attachmentSvc interfaces.LeaveAttachmentService,
+	ruleSvc interfaces.LeaveRuleService,
+	leaveTypeSvc interfaces.LeaveTypeService,
+	approvalNotifier interfaces.LeaveApprovalNotifier,
+	txManager interfaces.TransactionManager,
 ) interfaces.LeaveRequestService { // 返回介面類型
 	return &leaveRequestServiceImpl{
-		leaveRepo:   leaveRepo,
-		accountRepo: accountRepo,
+		leaveRepo:      leaveRepo,
+		accountRepo:    accountRepo,
+		employmentRepo: employmentRepo,
+		approvalRepo:   approvalRepo,
+		delegationRepo: delegationRepo,
+		eventRepo:      eventRepo,
+		balanceSvc:     balanceSvc,
+		holidaySvc:     holidaySvc,
+		attachmentSvc:  attachmentSvc,
+		ruleSvc:        ruleSvc,
+		leaveTypeSvc:   leaveTypeSvc,
+		approvalNotifier: approvalNotifier,
+		txManager:      txManager,
 	}
 }
 
@@ -48,8 +84,108 @@ func (s *leaveRequestServiceImpl) ListAllRequests(ctx context.Context) ([]models
 	return requests, nil
 }
 
+// ListRequests 驗證查詢條件並補上分頁預設值後，查詢符合條件的假單
+func (s *leaveRequestServiceImpl) ListRequests(ctx context.Context, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error) {
+	if err := normalizeLeaveRequestFilter(&filter); err != nil {
+		return nil, 0, err
+	}
+
+	requests, total, err := s.leaveRepo.ListFiltered(ctx, filter)
+	if err != nil {
+		log.Printf("Service error fetching filtered leave requests: %v", err)
+		return nil, 0, fmt.Errorf("failed to retrieve leave requests from repository: %w", err)
+	}
+	for i := range requests {
+		requests[i].Account.Password = ""
+		if requests[i].Approver != nil {
+			requests[i].Approver.Password = ""
+		}
+	}
+	return requests, total, nil
+}
+
+// normalizeLeaveRequestFilter 檢查狀態、排序欄位與日期區間，並將分頁參數限制在合理範圍
+func normalizeLeaveRequestFilter(filter *models.LeaveRequestFilter) error {
+	for _, status := range filter.Statuses {
+		switch status {
+		case models.LeaveStatusPending, models.LeaveStatusApproved, models.LeaveStatusRejected,
+			models.LeaveStatusCancelled, models.LeaveStatusCancellationRequested:
+		default:
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidLeaveFilter, status)
+		}
+	}
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = models.LeaveSortRequestedAt
+	case models.LeaveSortRequestedAt, models.LeaveSortStartDate, models.LeaveSortDays, models.LeaveSortStatus:
+	default:
+		return fmt.Errorf("%w: unknown sort field %q", ErrInvalidLeaveFilter, filter.SortBy)
+	}
+	if filter.From != nil && filter.To != nil && filter.To.Before(*filter.From) {
+		return ErrInvalidDateRange
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = models.DefaultLeaveRequestPageSize
+	}
+	if filter.PageSize > models.MaxLeaveRequestPageSize {
+		filter.PageSize = models.MaxLeaveRequestPageSize
+	}
+	return nil
+}
+
+// ListManagedRequests 實現主管查看直屬部屬假單的業務邏輯
+func (s *leaveRequestServiceImpl) ListManagedRequests(ctx context.Context, managerAccountIDStr string) ([]models.LeaveRequest, error) {
+	managerUUID, err := uuid.Parse(managerAccountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+
+	reports, err := s.employmentRepo.ListEmploymentsByManagerID(ctx, managerUUID)
+	if err != nil {
+		log.Printf("Error fetching direct reports of manager %s: %v", managerUUID, err)
+		return nil, fmt.Errorf("failed to retrieve direct reports")
+	}
+	if len(reports) == 0 {
+		return []models.LeaveRequest{}, nil
+	}
+	accountIDs := make([]uuid.UUID, 0, len(reports))
+	for _, report := range reports {
+		accountIDs = append(accountIDs, report.AccountID)
+	}
+
+	requests, err := s.leaveRepo.ListByAccountIDs(ctx, accountIDs)
+	if err != nil {
+		log.Printf("Service error fetching leave requests for reports of manager %s: %v", managerUUID, err)
+		return nil, fmt.Errorf("failed to retrieve leave requests")
+	}
+	for i := range requests {
+		requests[i].Account.Password = ""
+		if requests[i].Approver != nil {
+			requests[i].Approver.Password = ""
+		}
+	}
+	return requests, nil
+}
+
 // ApproveRequest 實現批准請假單的業務邏輯
 func (s *leaveRequestServiceImpl) ApproveRequest(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string) error {
+	return s.approveRequest(ctx, leaveRequestIDStr, processorAccountIDStr, "")
+}
+
+// ApproveRequestWithOverride 批准請假單，違反封鎖期間或人力規則時以理由覆寫
+func (s *leaveRequestServiceImpl) ApproveRequestWithOverride(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string, justification string) error {
+	justification = strings.TrimSpace(justification)
+	if justification == "" {
+		return fmt.Errorf("%w: override justification is required", ErrInvalidInput)
+	}
+	return s.approveRequest(ctx, leaveRequestIDStr, processorAccountIDStr, justification)
+}
+
+// approveRequest 批准請假單; overrideJustification 不為空時允許覆寫假別規則 (僅 HR / Super Admin)
+func (s *leaveRequestServiceImpl) approveRequest(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string, overrideJustification string) error {
 	leaveRequestUUID, err := uuid.Parse(leaveRequestIDStr)
 	if err != nil {
 		return errors.New("invalid leave request identifier format")
@@ -67,9 +203,8 @@ func (s *leaveRequestServiceImpl) ApproveRequest(ctx context.Context, leaveReque
 		log.Printf("Error fetching processor account %s: %v", processorAccountUUID, err)
 		return fmt.Errorf("failed to verify processor account")
 	}
-	if processor.Role != models.RoleHR && processor.Role != models.RoleSuperAdmin {
-		log.Printf("Account %s (Role: %d) does not have permission to approve leave requests", processorAccountUUID, processor.Role)
-		return ErrInvalidProcessor
+	if overrideJustification != "" && !isHRAccount(processor) {
+		return ErrRuleOverrideNotAllowed
 	}
 
 	request, err := s.leaveRepo.GetByID(ctx, leaveRequestUUID)
@@ -80,26 +215,231 @@ func (s *leaveRequestServiceImpl) ApproveRequest(ctx context.Context, leaveReque
 		log.Printf("Error fetching leave request %s for approval: %v", leaveRequestUUID, err)
 		return fmt.Errorf("failed to retrieve leave request data")
 	}
+	if err := checkExpectedVersion(ctx, request.Version); err != nil {
+		return err
+	}
 
 	if request.Status != models.LeaveStatusPending {
 		log.Printf("Attempted to approve leave request %s with status %s", request.ID, request.Status)
 		return ErrInvalidLeaveRequestState
 	}
 
+	// 找出目前待審的步驟，並確認處理人可審核此步驟
+	steps, err := s.ensureApprovalSteps(ctx, request)
+	if err != nil {
+		return err
+	}
+	current := currentApprovalStep(steps)
+	if current == nil {
+		log.Printf("Leave request %s is pending but has no pending approval step", request.ID)
+		return ErrInvalidLeaveRequestState
+	}
+	onBehalfOf, err := s.resolveStepActor(ctx, current, processorAccountUUID, processor.Role, request)
+	if err != nil {
+		return err
+	}
+
+	violation, err := s.checkApprovalPreconditions(ctx, request, overrideJustification != "")
+	if err != nil {
+		return err
+	}
+	days := request.Days
+
 	now := time.Now()
-	request.Status = models.LeaveStatusApproved
-	request.ApproverID = &processorAccountUUID
-	request.ApprovedAt = &now
+	advanced := false // 通過的是中間步驟, 提交後通知下一步驟的審核人
+	// 重疊檢查、步驟、假單狀態與餘額扣除在同一事務中進行，任一步失敗即全部回滾
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.checkOverlapLocked(ctx, request); err != nil {
+			return err
+		}
+		current.Status = models.ApprovalStepStatusApproved
+		current.ActorID = &processorAccountUUID
+		current.OnBehalfOfID = onBehalfOf
+		current.DecidedAt = &now
+		if err := s.approvalRepo.UpdateStep(ctx, current); err != nil {
+			log.Printf("Error recording approval step %d of leave request %s: %v", current.StepOrder, request.ID, err)
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrLeaveRequestUpdateFailed
+		}
+		if violation != nil {
+			s.recordRuleOverride(ctx, request, violation, processorAccountUUID, overrideJustification)
+		}
+
+		// 尚有後續步驟: 假單維持 pending，等待下一位審核人
+		if current.StepOrder < steps[len(steps)-1].StepOrder {
+			log.Printf("Leave request %s passed approval step %d/%d", request.ID, current.StepOrder, len(steps))
+			s.recordEvent(ctx, request, models.LeaveEventStepApproved, models.LeaveStatusPending, &processorAccountUUID, onBehalfOf,
+				fmt.Sprintf("step %d (%s) approved", current.StepOrder, current.ApproverKind))
+			advanced = true
+			return nil
+		}
+
+		request.Status = models.LeaveStatusApproved
+		request.ApproverID = &processorAccountUUID
+		request.OnBehalfOfID = onBehalfOf
+		request.ApprovedAt = &now
+
+		err = s.leaveRepo.Update(ctx, request)
+		if err != nil {
+			log.Printf("Error updating leave request %s status to approved: %v", request.ID, err)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrLeaveRequestNotFound
+			}
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrLeaveRequestUpdateFailed
+		}
+
+		s.recordEvent(ctx, request, models.LeaveEventApproved, models.LeaveStatusPending, &processorAccountUUID, onBehalfOf, "")
+
+		if request.Unpaid {
+			return nil
+		}
+		if err := s.balanceSvc.DebitForLeave(ctx, request, days); err != nil {
+			log.Printf("Balance debit failed for leave request %s, approval rolled back: %v", request.ID, err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if advanced {
+		s.notifyApprovers(ctx, request, currentApprovalStep(steps), "Leave request pending your approval")
+	}
+	return nil
+}
 
-	err = s.leaveRepo.Update(ctx, request)
+// AutoApproveRequest 由排程以系統身份核准假單: 剩餘的待審步驟全部標記為通過 (沒有審核人)
+// 附件、重疊、規則與餘額檢查與人工核准相同，且不可覆寫規則
+func (s *leaveRequestServiceImpl) AutoApproveRequest(ctx context.Context, leaveRequestIDStr string, comment string) error {
+	leaveRequestUUID, err := uuid.Parse(leaveRequestIDStr)
+	if err != nil {
+		return errors.New("invalid leave request identifier format")
+	}
+
+	request, err := s.leaveRepo.GetByID(ctx, leaveRequestUUID)
 	if err != nil {
-		log.Printf("Error updating leave request %s status to approved: %v", request.ID, err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ErrLeaveRequestNotFound
 		}
-		return ErrLeaveRequestUpdateFailed
+		log.Printf("Error fetching leave request %s for auto-approval: %v", leaveRequestUUID, err)
+		return fmt.Errorf("failed to retrieve leave request data")
 	}
-	return nil
+	if request.Status != models.LeaveStatusPending {
+		return ErrInvalidLeaveRequestState
+	}
+
+	steps, err := s.ensureApprovalSteps(ctx, request)
+	if err != nil {
+		return err
+	}
+	if _, err := s.checkApprovalPreconditions(ctx, request, false); err != nil {
+		return err
+	}
+	return s.completeApproval(ctx, request, steps, nil, comment)
+}
+
+// completeApproval 將剩餘的待審步驟全部標記為通過並核准假單，最後扣除假期餘額
+// actorID 為 nil 表示由系統核准 (排程)，否則為核准人 (例如 HR 代為提交時預先核准)
+func (s *leaveRequestServiceImpl) completeApproval(ctx context.Context, request *models.LeaveRequest, steps []models.LeaveApprovalStep, actorID *uuid.UUID, comment string) error {
+	days := request.Days
+
+	now := time.Now()
+	// 重疊檢查、步驟、假單狀態與餘額扣除在同一事務中進行，任一步失敗即全部回滾
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.checkOverlapLocked(ctx, request); err != nil {
+			return err
+		}
+		for i := range steps {
+			step := &steps[i]
+			if step.Status != models.ApprovalStepStatusPending {
+				continue
+			}
+			step.Status = models.ApprovalStepStatusApproved
+			step.ActorID = actorID
+			step.DecidedAt = &now
+			if err := s.approvalRepo.UpdateStep(ctx, step); err != nil {
+				log.Printf("Error recording approval of step %d of leave request %s: %v", step.StepOrder, request.ID, err)
+				if errors.Is(err, ErrConcurrentModification) {
+					return ErrConcurrentModification
+				}
+				return ErrLeaveRequestUpdateFailed
+			}
+		}
+
+		request.Status = models.LeaveStatusApproved
+		request.ApproverID = actorID
+		request.ApprovedAt = &now
+		if err := s.leaveRepo.Update(ctx, request); err != nil {
+			log.Printf("Error updating leave request %s status to approved: %v", request.ID, err)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrLeaveRequestNotFound
+			}
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrLeaveRequestUpdateFailed
+		}
+		log.Printf("Leave request %s approved without review: %s", request.ID, comment)
+		s.recordEvent(ctx, request, models.LeaveEventApproved, models.LeaveStatusPending, actorID, nil, comment)
+
+		if request.Unpaid {
+			return nil
+		}
+		if err := s.balanceSvc.DebitForLeave(ctx, request, days); err != nil {
+			log.Printf("Balance debit failed for leave request %s, approval rolled back: %v", request.ID, err)
+			return err
+		}
+		return nil
+	})
+}
+
+// checkApprovalPreconditions 核准前重新確認假單仍可核准 (天數、附件、規則、餘額); 重疊檢查須鎖定假單，於核准事務中進行
+// 違反規則時: allowOverride 為 false 返回 LeaveRuleViolationError，否則返回違反的規則由呼叫端記錄覆寫
+func (s *leaveRequestServiceImpl) checkApprovalPreconditions(ctx context.Context, request *models.LeaveRequest, allowOverride bool) (*models.LeaveRuleViolation, error) {
+	// 舊資料沒有 Days 欄位，核准時補算
+	if request.Days.IsZero() {
+		days, err := s.holidaySvc.CountWorkingDays(ctx, request.StartDate, request.EndDate)
+		if err != nil {
+			log.Printf("Error calculating working days for request %s: %v", request.ID, err)
+			return nil, fmt.Errorf("failed to calculate working days: %w", err)
+		}
+		request.Days = days
+	}
+
+	// 規則要求附件的假單 (例如規則建立前提交的假單)，補上附件前不可核准
+	if err := s.checkRequiredAttachment(ctx, request); err != nil {
+		return nil, err
+	}
+
+	// 申請後可能新增了封鎖期間或同部門同事已被核准休假; 未附覆寫理由時擋下
+	violation, err := s.ruleSvc.CheckLeaveRules(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if violation != nil && request.OverriddenRuleID != nil && *request.OverriddenRuleID == violation.RuleID {
+		// HR 提交時已覆寫此規則並記錄理由
+		violation = nil
+	}
+	if violation != nil && !allowOverride {
+		return nil, &LeaveRuleViolationError{Violation: *violation}
+	}
+
+	// 申請後餘額可能已被其他假單用掉，核准前再確認一次 (無薪假不占用餘額)
+	if !request.Unpaid {
+		if err := s.balanceSvc.CheckSufficientBalance(ctx, request.AccountID, request.LeaveType, request.Days); err != nil {
+			if errors.Is(err, ErrInsufficientLeaveBalance) {
+				return nil, err
+			}
+			log.Printf("Error checking leave balance for request %s: %v", request.ID, err)
+			return nil, fmt.Errorf("failed to verify leave balance: %w", err)
+		}
+	}
+	return violation, nil
 }
 
 // RejectRequest 實現拒絕請假單的業務邏輯
@@ -121,10 +461,6 @@ func (s *leaveRequestServiceImpl) RejectRequest(ctx context.Context, leaveReques
 		log.Printf("Error fetching processor account %s: %v", processorAccountUUID, err)
 		return fmt.Errorf("failed to verify processor account")
 	}
-	if processor.Role != models.RoleHR && processor.Role != models.RoleSuperAdmin {
-		log.Printf("Account %s (Role: %d) does not have permission to reject leave requests", processorAccountUUID, processor.Role)
-		return ErrInvalidProcessor
-	}
 
 	request, err := s.leaveRepo.GetByID(ctx, leaveRequestUUID)
 	if err != nil {
@@ -134,41 +470,566 @@ func (s *leaveRequestServiceImpl) RejectRequest(ctx context.Context, leaveReques
 		log.Printf("Error fetching leave request %s for rejection: %v", leaveRequestUUID, err)
 		return fmt.Errorf("failed to retrieve leave request data")
 	}
+	if err := checkExpectedVersion(ctx, request.Version); err != nil {
+		return err
+	}
 
 	if request.Status != models.LeaveStatusPending {
 		log.Printf("Attempted to reject leave request %s with status %s", request.ID, request.Status)
 		return ErrInvalidLeaveRequestState
 	}
 
+	steps, err := s.ensureApprovalSteps(ctx, request)
+	if err != nil {
+		return err
+	}
+	current := currentApprovalStep(steps)
+	if current == nil {
+		log.Printf("Leave request %s is pending but has no pending approval step", request.ID)
+		return ErrInvalidLeaveRequestState
+	}
+	onBehalfOf, err := s.resolveStepActor(ctx, current, processorAccountUUID, processor.Role, request)
+	if err != nil {
+		return err
+	}
+
+	// 任一步驟被拒絕即結束流程: 記錄此步驟的決定，後續步驟標記為略過
 	now := time.Now()
-	request.Status = models.LeaveStatusRejected
-	request.ApproverID = &processorAccountUUID
-	request.ApprovedAt = &now
-	if reason != "" {
-		request.Reason = reason
-	} else {
-		request.Reason = ""
+	// 所有步驟與假單狀態在同一事務中寫入，任一步失敗即全部回滾
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		for i := range steps {
+			step := &steps[i]
+			switch {
+			case step.ID == current.ID:
+				step.Status = models.ApprovalStepStatusRejected
+				step.ActorID = &processorAccountUUID
+				step.OnBehalfOfID = onBehalfOf
+				step.DecidedAt = &now
+			case step.Status == models.ApprovalStepStatusPending:
+				step.Status = models.ApprovalStepStatusSkipped
+			default:
+				continue
+			}
+			if err := s.approvalRepo.UpdateStep(ctx, step); err != nil {
+				log.Printf("Error recording rejection on step %d of leave request %s: %v", step.StepOrder, request.ID, err)
+				if errors.Is(err, ErrConcurrentModification) {
+					return ErrConcurrentModification
+				}
+				return ErrLeaveRequestUpdateFailed
+			}
+		}
+
+		request.Status = models.LeaveStatusRejected
+		request.ApproverID = &processorAccountUUID
+		request.OnBehalfOfID = onBehalfOf
+		request.ApprovedAt = &now
+		request.DecisionNote = reason // 保留員工填寫的 Reason
+
+		err = s.leaveRepo.Update(ctx, request)
+		if err != nil {
+			log.Printf("Error updating leave request %s status to rejected: %v", request.ID, err)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrLeaveRequestNotFound
+			}
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrLeaveRequestUpdateFailed
+		}
+		s.recordEvent(ctx, request, models.LeaveEventRejected, models.LeaveStatusPending, &processorAccountUUID, onBehalfOf, reason)
+		return nil
+	})
+}
+
+// authorizeProcessor 確認處理人可審核此假單: HR / Super Admin 可覆核所有假單，其他人只能審核直屬部屬的假單
+func (s *leaveRequestServiceImpl) authorizeProcessor(ctx context.Context, processorID uuid.UUID, processorRole uint8, request *models.LeaveRequest) error {
+	if processorRole == models.RoleHR || processorRole == models.RoleSuperAdmin {
+		return nil
+	}
+
+	employment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, request.AccountID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvalidProcessor
+		}
+		log.Printf("Error fetching employment of applicant %s: %v", request.AccountID, err)
+		return fmt.Errorf("failed to verify reporting line")
+	}
+	if employment.ManagerID == nil || *employment.ManagerID != processorID {
+		log.Printf("Account %s (Role: %d) is not the manager of applicant %s", processorID, processorRole, request.AccountID)
+		return ErrInvalidProcessor
+	}
+	return nil
+}
+
+// authorizeStep 依審核步驟的類型確認處理人 (不可為申請人本人): manager 步驟由直屬主管審核 (HR / Super Admin 可覆核)，hr 步驟僅限 HR / Super Admin
+func (s *leaveRequestServiceImpl) authorizeStep(ctx context.Context, step *models.LeaveApprovalStep, processorID uuid.UUID, processorRole uint8, request *models.LeaveRequest) error {
+	// 任何人 (含 HR / Super Admin) 都不能審核自己的假單
+	if processorID == request.AccountID {
+		log.Printf("Account %s attempted to process their own leave request %s", processorID, request.ID)
+		return ErrInvalidProcessor
+	}
+	if step.ApproverKind == models.ApprovalStepHR {
+		if processorRole != models.RoleHR && processorRole != models.RoleSuperAdmin {
+			log.Printf("Account %s (Role: %d) attempted to process HR approval step of leave request %s", processorID, processorRole, request.ID)
+			return ErrInvalidProcessor
+		}
+		return nil
+	}
+	return s.authorizeProcessor(ctx, processorID, processorRole, request)
+}
+
+// resolveStepActor 確認處理人可審核目前步驟; 本身無權限時，檢查是否持有有權限者的有效代理
+// 代理審核時返回委託人 ID，本人審核時返回 nil
+func (s *leaveRequestServiceImpl) resolveStepActor(ctx context.Context, step *models.LeaveApprovalStep, processorID uuid.UUID, processorRole uint8, request *models.LeaveRequest) (*uuid.UUID, error) {
+	authErr := s.authorizeStep(ctx, step, processorID, processorRole, request)
+	if authErr == nil || !errors.Is(authErr, ErrInvalidProcessor) || processorID == request.AccountID {
+		return nil, authErr
+	}
+
+	delegations, err := s.delegationRepo.ListActiveForDelegate(ctx, processorID, time.Now())
+	if err != nil {
+		log.Printf("Error fetching active delegations for account %s: %v", processorID, err)
+		return nil, fmt.Errorf("failed to verify approval delegation")
+	}
+	for _, delegation := range delegations {
+		// 申請人不能透過代理審核自己的假單
+		if !delegation.Covers(step.ApproverKind) || delegation.DelegatorID == request.AccountID {
+			continue
+		}
+		delegator, err := s.accountRepo.GetAccountByID(ctx, delegation.DelegatorID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			log.Printf("Error fetching delegator account %s: %v", delegation.DelegatorID, err)
+			return nil, fmt.Errorf("failed to verify approval delegation")
+		}
+		if err := s.authorizeStep(ctx, step, delegator.ID, delegator.Role, request); err == nil {
+			log.Printf("Account %s acts on behalf of %s for leave request %s", processorID, delegator.ID, request.ID)
+			delegatorID := delegator.ID
+			return &delegatorID, nil
+		}
+	}
+	return nil, authErr
+}
+
+// ensureApprovalSteps 取得假單的審核步驟; 尚未建立步驟的舊假單依目前政策補建
+func (s *leaveRequestServiceImpl) ensureApprovalSteps(ctx context.Context, request *models.LeaveRequest) ([]models.LeaveApprovalStep, error) {
+	steps, err := s.approvalRepo.ListStepsByRequestID(ctx, request.ID)
+	if err != nil {
+		log.Printf("Error fetching approval steps of leave request %s: %v", request.ID, err)
+		return nil, fmt.Errorf("failed to retrieve approval steps")
+	}
+	if len(steps) > 0 {
+		return steps, nil
+	}
+	return s.createApprovalSteps(ctx, request)
+}
+
+// createApprovalSteps 依符合的審核政策為假單建立審核步驟
+func (s *leaveRequestServiceImpl) createApprovalSteps(ctx context.Context, request *models.LeaveRequest) ([]models.LeaveApprovalStep, error) {
+	policies, err := s.approvalRepo.ListActivePolicies(ctx)
+	if err != nil {
+		log.Printf("Error fetching approval policies for leave request %s: %v", request.ID, err)
+		return nil, fmt.Errorf("failed to retrieve approval policies")
+	}
+
+	kinds := resolveApprovalSteps(policies, request.LeaveType, request.Days)
+	steps := make([]models.LeaveApprovalStep, 0, len(kinds))
+	for i, kind := range kinds {
+		steps = append(steps, models.LeaveApprovalStep{
+			LeaveRequestID: request.ID,
+			StepOrder:      i + 1,
+			ApproverKind:   kind,
+			Status:         models.ApprovalStepStatusPending,
+		})
+	}
+	if err := s.approvalRepo.CreateSteps(ctx, steps); err != nil {
+		log.Printf("Error creating approval steps for leave request %s: %v", request.ID, err)
+		return nil, fmt.Errorf("failed to create approval steps")
+	}
+	return steps, nil
+}
+
+// resolveApprovalSteps 選出適用的審核政策並返回審核人類型列表
+// 指定假別的政策優先於通用政策，同一優先級取 MinDays 最大且不超過假單天數者; 沒有符合的政策時使用預設流程
+func resolveApprovalSteps(policies []models.LeaveApprovalPolicy, leaveType string, days decimal.Decimal) []string {
+	var best *models.LeaveApprovalPolicy
+	for i := range policies {
+		p := &policies[i]
+		if !p.Active || (p.LeaveType != "" && p.LeaveType != leaveType) || p.MinDays.GreaterThan(days) {
+			continue
+		}
+		if best == nil ||
+			(p.LeaveType != "" && best.LeaveType == "") ||
+			(p.LeaveType == best.LeaveType && p.MinDays.GreaterThan(best.MinDays)) {
+			best = p
+		}
+	}
+
+	if best != nil {
+		kinds := make([]string, 0, 2)
+		for _, kind := range best.StepList() {
+			if kind == models.ApprovalStepManager || kind == models.ApprovalStepHR {
+				kinds = append(kinds, kind)
+			}
+		}
+		if len(kinds) > 0 {
+			return kinds
+		}
+		log.Printf("Approval policy %s has no valid steps (%q), using default chain", best.ID, best.Steps)
+	}
+	return append([]string(nil), models.DefaultApprovalSteps...)
+}
+
+// notifyApprovers 通知步驟的審核人 (step 為 nil 時視為直屬主管步驟); 通知失敗只記錄 log，不影響假單
+func (s *leaveRequestServiceImpl) notifyApprovers(ctx context.Context, request *models.LeaveRequest, step *models.LeaveApprovalStep, subject string) {
+	kind := models.ApprovalStepManager
+	if step != nil {
+		kind = step.ApproverKind
+	}
+	if _, err := s.approvalNotifier.NotifyApprovers(ctx, request, kind, subject); err != nil {
+		log.Printf("Error notifying %s approvers of leave request %s: %v", kind, request.ID, err)
+	}
+}
+
+// currentApprovalStep 返回第一個待審的步驟; 前面若有步驟已被拒絕則返回 nil
+func currentApprovalStep(steps []models.LeaveApprovalStep) *models.LeaveApprovalStep {
+	for i := range steps {
+		switch steps[i].Status {
+		case models.ApprovalStepStatusPending:
+			return &steps[i]
+		case models.ApprovalStepStatusRejected:
+			return nil
+		}
+	}
+	return nil
+}
+
+// BulkProcessRequests 逐張套用單張審核的所有規則，並將錯誤歸類為每張假單的處理結果
+func (s *leaveRequestServiceImpl) BulkProcessRequests(ctx context.Context, leaveRequestIDs []string, processorAccountIDStr string, decision string, reason string) ([]models.BulkLeaveDecisionResult, error) {
+	if decision != models.LeaveDecisionApprove && decision != models.LeaveDecisionReject {
+		return nil, fmt.Errorf("%w: decision must be '%s' or '%s'", ErrInvalidInput, models.LeaveDecisionApprove, models.LeaveDecisionReject)
+	}
+	if len(leaveRequestIDs) == 0 || len(leaveRequestIDs) > models.MaxBulkLeaveDecisions {
+		return nil, fmt.Errorf("%w: between 1 and %d leave request IDs are required", ErrInvalidInput, models.MaxBulkLeaveDecisions)
+	}
+
+	results := make([]models.BulkLeaveDecisionResult, 0, len(leaveRequestIDs))
+	seen := make(map[string]bool, len(leaveRequestIDs))
+	for _, id := range leaveRequestIDs {
+		if seen[id] {
+			continue // 重複的 ID 只處理一次
+		}
+		seen[id] = true
+
+		result := models.BulkLeaveDecisionResult{LeaveRequestID: id}
+		if _, parseErr := uuid.Parse(id); parseErr != nil {
+			result.Result = models.BulkLeaveResultInvalidID
+			result.Message = "invalid leave request identifier format"
+			results = append(results, result)
+			continue
+		}
+
+		var err error
+		if decision == models.LeaveDecisionApprove {
+			err = s.ApproveRequest(ctx, id, processorAccountIDStr)
+		} else {
+			err = s.RejectRequest(ctx, id, processorAccountIDStr, reason)
+		}
+		switch {
+		case err == nil:
+			result.Result = models.BulkLeaveResultSucceeded
+		case errors.Is(err, ErrLeaveRequestNotFound):
+			result.Result = models.BulkLeaveResultNotFound
+			result.Message = err.Error()
+		case errors.Is(err, ErrInvalidLeaveRequestState):
+			result.Result = models.BulkLeaveResultInvalidState
+			result.Message = err.Error()
+		default:
+			result.Result = models.BulkLeaveResultFailed
+			result.Message = err.Error()
+		}
+		results = append(results, result)
+	}
+	log.Printf("Bulk %s of %d leave request(s) by %s finished", decision, len(results), processorAccountIDStr)
+	return results, nil
+}
+
+// CancelRequest 實現員工取消自己假單的業務邏輯
+func (s *leaveRequestServiceImpl) CancelRequest(ctx context.Context, leaveRequestIDStr string, accountIDStr string) (*models.LeaveRequest, error) {
+	leaveRequestUUID, err := uuid.Parse(leaveRequestIDStr)
+	if err != nil {
+		return nil, errors.New("invalid leave request identifier format")
+	}
+	accountUUID, err := uuid.Parse(accountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+
+	request, err := s.leaveRepo.GetByID(ctx, leaveRequestUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLeaveRequestNotFound
+		}
+		log.Printf("Error fetching leave request %s for cancellation: %v", leaveRequestUUID, err)
+		return nil, fmt.Errorf("failed to retrieve leave request data")
 	}
+	if err := checkExpectedVersion(ctx, request.Version); err != nil {
+		return nil, err
+	}
+	if request.AccountID != accountUUID {
+		log.Printf("Account %s attempted to cancel leave request %s owned by %s", accountUUID, request.ID, request.AccountID)
+		return nil, ErrNotLeaveRequestOwner
+	}
+
+	fromStatus := request.Status
+	eventType := models.LeaveEventCancelled
+	switch request.Status {
+	case models.LeaveStatusPending:
+		// 尚未審核的假單沒有扣除天數，直接撤回
+		now := time.Now()
+		request.Status = models.LeaveStatusCancelled
+		request.CancelledAt = &now
+	case models.LeaveStatusApproved:
+		// 已核准的假單只能在開始前申請取消，且需 HR 確認後才退回天數
+		if !request.StartDate.After(dateOf(time.Now())) {
+			return nil, ErrLeaveAlreadyStarted
+		}
+		request.Status = models.LeaveStatusCancellationRequested
+		eventType = models.LeaveEventCancellationRequested
+	default:
+		log.Printf("Attempted to cancel leave request %s with status %s", request.ID, request.Status)
+		return nil, ErrInvalidLeaveRequestState
+	}
+
+	if err := s.leaveRepo.Update(ctx, request); err != nil {
+		log.Printf("Error updating leave request %s status to %s: %v", request.ID, request.Status, err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLeaveRequestNotFound
+		}
+		if errors.Is(err, ErrConcurrentModification) {
+			return nil, ErrConcurrentModification
+		}
+		return nil, ErrLeaveRequestUpdateFailed
+	}
+	s.recordEvent(ctx, request, eventType, fromStatus, &accountUUID, nil, "")
+	return request, nil
+}
 
-	err = s.leaveRepo.Update(ctx, request)
+// ConfirmCancellation 實現 HR 確認取消已核准假單的業務邏輯
+func (s *leaveRequestServiceImpl) ConfirmCancellation(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string) error {
+	request, processorID, err := s.getCancellationRequest(ctx, leaveRequestIDStr, processorAccountIDStr)
 	if err != nil {
-		log.Printf("Error updating leave request %s status to rejected: %v", request.ID, err)
+		return err
+	}
+
+	now := time.Now()
+	// 假單狀態、歷程與餘額退回在同一事務中寫入; 退回失敗時假單維持 cancellation_requested，可重新確認
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		request.Status = models.LeaveStatusCancelled
+		request.CancelledAt = &now
+		if err := s.leaveRepo.Update(ctx, request); err != nil {
+			log.Printf("Error updating leave request %s status to cancelled: %v", request.ID, err)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrLeaveRequestNotFound
+			}
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrLeaveRequestUpdateFailed
+		}
+		s.recordEvent(ctx, request, models.LeaveEventCancelled, models.LeaveStatusCancellationRequested, &processorID, nil, "")
+
+		if request.Unpaid {
+			return nil
+		}
+		if err := s.balanceSvc.RestoreForLeave(ctx, request); err != nil {
+			log.Printf("Balance restore failed for leave request %s, cancellation rolled back: %v", request.ID, err)
+			return err
+		}
+		return nil
+	})
+}
+
+// DeclineCancellation 實現 HR 駁回取消申請的業務邏輯
+func (s *leaveRequestServiceImpl) DeclineCancellation(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string) error {
+	request, processorID, err := s.getCancellationRequest(ctx, leaveRequestIDStr, processorAccountIDStr)
+	if err != nil {
+		return err
+	}
+
+	request.Status = models.LeaveStatusApproved
+	if err := s.leaveRepo.Update(ctx, request); err != nil {
+		log.Printf("Error restoring leave request %s status to approved: %v", request.ID, err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return ErrLeaveRequestNotFound
 		}
+		if errors.Is(err, ErrConcurrentModification) {
+			return ErrConcurrentModification
+		}
 		return ErrLeaveRequestUpdateFailed
 	}
+	s.recordEvent(ctx, request, models.LeaveEventCancellationDeclined, models.LeaveStatusCancellationRequested, &processorID, nil, "")
 	return nil
 }
 
+// CancelForTermination 處理離職員工 pending / approved / cancellation_requested 的假單:
+// 開始日晚於離職日的假單取消並退回已扣除的天數; 跨越離職日的假單截短至離職日並退回離職日後的天數
+// 每張假單的退回與更新在各自的事務中進行，失敗時停止並返回錯誤; 重新執行即可處理剩下的假單 (已處理的假單不會重複處理)
+func (s *leaveRequestServiceImpl) CancelForTermination(ctx context.Context, accountID uuid.UUID, terminationDate time.Time, processorAccountID uuid.UUID) ([]models.LeaveRequest, []models.LeaveRequest, error) {
+	requests, err := s.leaveRepo.ListByAccountID(ctx, accountID)
+	if err != nil {
+		log.Printf("Error fetching leave requests for terminated account %s: %v", accountID, err)
+		return nil, nil, fmt.Errorf("failed to retrieve leave requests")
+	}
+
+	terminationDate = dateOf(terminationDate)
+	comment := fmt.Sprintf("employment terminated effective %s", terminationDate.Format("2006-01-02"))
+	cancelled := []models.LeaveRequest{}
+	shortened := []models.LeaveRequest{}
+	for i := range requests {
+		request := &requests[i]
+		switch request.Status {
+		case models.LeaveStatusPending, models.LeaveStatusApproved, models.LeaveStatusCancellationRequested:
+		default:
+			continue
+		}
+
+		switch {
+		case dateOf(request.StartDate).After(terminationDate):
+			if err := s.cancelForTermination(ctx, request, processorAccountID, comment); err != nil {
+				return cancelled, shortened, err
+			}
+			cancelled = append(cancelled, *request)
+		case dateOf(request.EndDate).After(terminationDate):
+			if err := s.shortenForTermination(ctx, request, terminationDate, processorAccountID, comment); err != nil {
+				return cancelled, shortened, err
+			}
+			shortened = append(shortened, *request)
+		}
+	}
+	return cancelled, shortened, nil
+}
+
+// cancelForTermination 在同一事務中退回已扣除的天數並取消假單
+func (s *leaveRequestServiceImpl) cancelForTermination(ctx context.Context, request *models.LeaveRequest, processorAccountID uuid.UUID, comment string) error {
+	fromStatus := request.Status
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if fromStatus != models.LeaveStatusPending && !request.Unpaid {
+			if err := s.balanceSvc.RestoreForLeave(ctx, request); err != nil {
+				log.Printf("Error restoring balance for leave request %s on termination: %v", request.ID, err)
+				return err
+			}
+		}
+
+		now := time.Now()
+		request.Status = models.LeaveStatusCancelled
+		request.CancelledAt = &now
+		if err := s.leaveRepo.Update(ctx, request); err != nil {
+			log.Printf("Error cancelling leave request %s on termination: %v", request.ID, err)
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrLeaveRequestUpdateFailed
+		}
+		s.recordEvent(ctx, request, models.LeaveEventCancelled, fromStatus, &processorAccountID, nil, comment)
+		return nil
+	})
+}
+
+// shortenForTermination 將跨越離職日的假單截短至離職日，並在同一事務中退回離職日後已扣除的天數
+// 只有整天的假單會跨越多日; 離職日前沒有工作日時整張假單取消
+func (s *leaveRequestServiceImpl) shortenForTermination(ctx context.Context, request *models.LeaveRequest, terminationDate time.Time, processorAccountID uuid.UUID, comment string) error {
+	days, err := s.holidaySvc.CountWorkingDays(ctx, request.StartDate, terminationDate)
+	if err != nil {
+		log.Printf("Error calculating working days of leave request %s up to termination: %v", request.ID, err)
+		return fmt.Errorf("failed to calculate working days: %w", err)
+	}
+	if days.IsZero() {
+		return s.cancelForTermination(ctx, request, processorAccountID, comment)
+	}
+
+	fromStatus := request.Status
+	restored := request.Days.Sub(days)
+	change := fmt.Sprintf("%s; end_date: %s -> %s; days: %s -> %s", comment,
+		request.EndDate.Format("2006-01-02"), terminationDate.Format("2006-01-02"), request.Days, days)
+	return s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		request.EndDate = terminationDate
+		request.Days = days
+		if fromStatus != models.LeaveStatusPending && !request.Unpaid && restored.IsPositive() {
+			if err := s.balanceSvc.RestoreForShortenedLeave(ctx, request, restored); err != nil {
+				log.Printf("Error restoring balance for leave request %s shortened on termination: %v", request.ID, err)
+				return err
+			}
+		}
+		if err := s.leaveRepo.Update(ctx, request); err != nil {
+			log.Printf("Error shortening leave request %s on termination: %v", request.ID, err)
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrLeaveRequestUpdateFailed
+		}
+		s.recordEvent(ctx, request, models.LeaveEventShortened, fromStatus, &processorAccountID, nil, change)
+		return nil
+	})
+}
+
+// getCancellationRequest 驗證處理人為 HR / Super Admin，並取得狀態為 cancellation_requested 的假單與處理人 ID
+func (s *leaveRequestServiceImpl) getCancellationRequest(ctx context.Context, leaveRequestIDStr string, processorAccountIDStr string) (*models.LeaveRequest, uuid.UUID, error) {
+	leaveRequestUUID, err := uuid.Parse(leaveRequestIDStr)
+	if err != nil {
+		return nil, uuid.Nil, errors.New("invalid leave request identifier format")
+	}
+	processorAccountUUID, err := uuid.Parse(processorAccountIDStr)
+	if err != nil {
+		return nil, uuid.Nil, errors.New("invalid processor account identifier format")
+	}
+
+	processor, err := s.accountRepo.GetAccountByID(ctx, processorAccountUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, uuid.Nil, ErrInvalidProcessor
+		}
+		log.Printf("Error fetching processor account %s: %v", processorAccountUUID, err)
+		return nil, uuid.Nil, fmt.Errorf("failed to verify processor account")
+	}
+	if processor.Role != models.RoleHR && processor.Role != models.RoleSuperAdmin {
+		log.Printf("Account %s (Role: %d) does not have permission to process leave cancellations", processorAccountUUID, processor.Role)
+		return nil, uuid.Nil, ErrInvalidProcessor
+	}
+
+	request, err := s.leaveRepo.GetByID(ctx, leaveRequestUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, uuid.Nil, ErrLeaveRequestNotFound
+		}
+		log.Printf("Error fetching leave request %s for cancellation review: %v", leaveRequestUUID, err)
+		return nil, uuid.Nil, fmt.Errorf("failed to retrieve leave request data")
+	}
+	if err := checkExpectedVersion(ctx, request.Version); err != nil {
+		return nil, uuid.Nil, err
+	}
+	if request.AccountID == processorAccountUUID {
+		log.Printf("Account %s attempted to process the cancellation of their own leave request %s", processorAccountUUID, request.ID)
+		return nil, uuid.Nil, ErrInvalidProcessor
+	}
+	if request.Status != models.LeaveStatusCancellationRequested {
+		log.Printf("Attempted to process cancellation of leave request %s with status %s", request.ID, request.Status)
+		return nil, uuid.Nil, ErrInvalidLeaveRequestState
+	}
+	return request, processorAccountUUID, nil
+}
+
 // ApplyForLeave 實現提交請假申請的業務邏輯
-func (s *leaveRequestServiceImpl) ApplyForLeave(ctx context.Context, accountIDStr, leaveType, reason string, startDate, endDate time.Time) (*models.LeaveRequest, error) {
+func (s *leaveRequestServiceImpl) ApplyForLeave(ctx context.Context, accountIDStr string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
 	accountUUID, err := uuid.Parse(accountIDStr)
 	if err != nil {
 		return nil, errors.New("invalid user identifier format")
 	}
 
-	_, err = s.accountRepo.GetAccountByID(ctx, accountUUID)
+	account, err := s.accountRepo.GetAccountByID(ctx, accountUUID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrAccountNotFound
@@ -176,28 +1037,569 @@ func (s *leaveRequestServiceImpl) ApplyForLeave(ctx context.Context, accountIDSt
 		log.Printf("Error verifying applying account %s: %v", accountUUID, err)
 		return nil, fmt.Errorf("failed to verify applicant account")
 	}
+	if err := authorizeRuleOverride(account, &input); err != nil {
+		return nil, err
+	}
 
-	if endDate.Before(startDate) {
-		return nil, ErrInvalidDateRange
+	return s.submitLeaveRequest(ctx, account, accountUUID, input, true)
+}
+
+// ApplyForLeaveOnBehalf HR / Super Admin 代員工提交假單 (例如員工來電請病假)
+// 檢查與員工自行申請相同，並記錄建立者; preApproved 為 true 時建立後直接由建立者核准 (不可用於自己的假單)
+func (s *leaveRequestServiceImpl) ApplyForLeaveOnBehalf(ctx context.Context, creatorAccountIDStr string, accountIDStr string, input models.LeaveRequestInput, preApproved bool) (*models.LeaveRequest, error) {
+	creatorUUID, err := uuid.Parse(creatorAccountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid creator account identifier format")
+	}
+	accountUUID, err := uuid.Parse(accountIDStr)
+	if err != nil {
+		return nil, ErrAccountNotFound
+	}
+
+	creator, err := s.accountRepo.GetAccountByID(ctx, creatorUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidProcessor
+		}
+		log.Printf("Error fetching creator account %s: %v", creatorUUID, err)
+		return nil, fmt.Errorf("failed to verify creator account")
+	}
+	if !isHRAccount(creator) {
+		log.Printf("Account %s (Role: %d) attempted to submit leave on behalf of %s", creatorUUID, creator.Role, accountUUID)
+		return nil, ErrInvalidProcessor
+	}
+	if err := authorizeRuleOverride(creator, &input); err != nil {
+		return nil, err
+	}
+	// 自己的假單需由其他人審核
+	if preApproved && creatorUUID == accountUUID {
+		log.Printf("Account %s attempted to pre-approve their own leave request", creatorUUID)
+		return nil, ErrInvalidProcessor
+	}
+
+	account, err := s.accountRepo.GetAccountByID(ctx, accountUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		log.Printf("Error verifying applicant account %s: %v", accountUUID, err)
+		return nil, fmt.Errorf("failed to verify applicant account")
+	}
+
+	// 預先核准的假單不需通知審核人
+	leaveRequest, err := s.submitLeaveRequest(ctx, account, creatorUUID, input, !preApproved)
+	if err != nil || !preApproved {
+		return leaveRequest, err
 	}
 
+	// 預先核准: 所有審核步驟記錄為建立者通過; 失敗時假單維持 pending 等待一般審核
+	steps, err := s.ensureApprovalSteps(ctx, leaveRequest)
+	var firstStep *models.LeaveApprovalStep
+	if err == nil {
+		if current := currentApprovalStep(steps); current != nil {
+			step := *current // completeApproval 會改動步驟狀態, 保留原本待審的步驟
+			firstStep = &step
+		}
+		err = s.completeApproval(ctx, leaveRequest, steps, &creatorUUID, "pre-approved on submission")
+	}
+	if err != nil {
+		log.Printf("Leave request %s submitted on behalf of %s but pre-approval failed: %v", leaveRequest.ID, accountUUID, err)
+		s.notifyApprovers(ctx, leaveRequest, firstStep, "Leave request pending your approval")
+		return leaveRequest, fmt.Errorf("%w: %v", ErrLeavePreApprovalFailed, err)
+	}
+	return leaveRequest, nil
+}
+
+// submitLeaveRequest 驗證並建立假單、記錄提交事件、建立審核流程並儲存附件 (前三者在同一事務中)
+// creatorID 與申請人不同時 (HR 代為提交) 記錄於 CreatedByID，提交事件的執行人也是建立者
+// notify 為 true 時於建立審核流程後通知第一步驟的審核人
+func (s *leaveRequestServiceImpl) submitLeaveRequest(ctx context.Context, account *models.Account, creatorID uuid.UUID, input models.LeaveRequestInput, notify bool) (*models.LeaveRequest, error) {
 	leaveRequest := &models.LeaveRequest{
-		AccountID: accountUUID,
-		LeaveType: leaveType,
-		StartDate: startDate,
-		EndDate:   endDate,
-		Reason:    reason,
+		AccountID: account.ID,
 		Status:    models.LeaveStatusPending,
 	}
+	if creatorID != account.ID {
+		leaveRequest.CreatedByID = &creatorID
+	}
+	violation, err := s.prepareLeaveRequest(ctx, account, input, leaveRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// 建立假單、提交事件與審核流程在同一事務中進行; 審核流程無法建立時假單不會留下 (沒有審核人可處理)
+	var steps []models.LeaveApprovalStep
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.leaveRepo.Create(ctx, leaveRequest); err != nil {
+			log.Printf("Error creating leave request for account %s: %v", account.ID, err)
+			return ErrLeaveApplyFailed
+		}
 
-	err = s.leaveRepo.Create(ctx, leaveRequest)
+		s.recordEvent(ctx, leaveRequest, models.LeaveEventSubmitted, "", &creatorID, nil, input.Reason)
+		if violation != nil {
+			s.recordRuleOverride(ctx, leaveRequest, violation, creatorID, input.OverrideJustification)
+		}
+
+		created, err := s.createApprovalSteps(ctx, leaveRequest)
+		if err != nil {
+			log.Printf("Error creating approval steps of leave request for account %s, submission rolled back: %v", account.ID, err)
+			return ErrLeaveApplyFailed
+		}
+		steps = created
+		return nil
+	})
 	if err != nil {
-		log.Printf("Error creating leave request for account %s: %v", accountUUID, err)
-		return nil, ErrLeaveApplyFailed
+		return nil, err
+	}
+	if notify {
+		// 通知內容需要申請人資料; 使用副本以免後續更新假單時一併寫入帳戶
+		notified := *leaveRequest
+		notified.Account = *account
+		s.notifyApprovers(ctx, &notified, currentApprovalStep(steps), "Leave request pending your approval")
+	}
+
+	// 附件在假單建立後才儲存 (需要假單 ID); 儲存失敗時假單仍已提交，一併返回假單讓呼叫端提示重新上傳
+	for _, upload := range input.Attachments {
+		if _, err := s.attachmentSvc.SaveAttachment(ctx, leaveRequest.ID, creatorID, upload); err != nil {
+			return leaveRequest, err
+		}
 	}
 	return leaveRequest, nil
 }
 
+// UpdatePendingRequest 申請人修改仍待審核的假單 (日期、時長、假別與原因)
+// 重新執行申請時的所有檢查; 有變更時記錄修改內容並依新的內容重建審核流程
+func (s *leaveRequestServiceImpl) UpdatePendingRequest(ctx context.Context, leaveRequestIDStr string, accountIDStr string, input models.LeaveRequestInput) (*models.LeaveRequest, error) {
+	leaveRequestUUID, err := uuid.Parse(leaveRequestIDStr)
+	if err != nil {
+		return nil, errors.New("invalid leave request identifier format")
+	}
+	accountUUID, err := uuid.Parse(accountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+
+	request, err := s.leaveRepo.GetByID(ctx, leaveRequestUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLeaveRequestNotFound
+		}
+		log.Printf("Error fetching leave request %s for update: %v", leaveRequestUUID, err)
+		return nil, fmt.Errorf("failed to retrieve leave request data")
+	}
+	if err := checkExpectedVersion(ctx, request.Version); err != nil {
+		return nil, err
+	}
+	if request.AccountID != accountUUID {
+		log.Printf("Account %s attempted to edit leave request %s owned by %s", accountUUID, request.ID, request.AccountID)
+		return nil, ErrNotLeaveRequestOwner
+	}
+	if request.Status != models.LeaveStatusPending {
+		log.Printf("Attempted to edit leave request %s with status %s", request.ID, request.Status)
+		return nil, ErrInvalidLeaveRequestState
+	}
+
+	account, err := s.accountRepo.GetAccountByID(ctx, accountUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		log.Printf("Error verifying account %s editing leave request: %v", accountUUID, err)
+		return nil, fmt.Errorf("failed to verify applicant account")
+	}
+	if err := authorizeRuleOverride(account, &input); err != nil {
+		return nil, err
+	}
+
+	before := *request
+	violation, err := s.prepareLeaveRequest(ctx, account, input, request)
+	if err != nil {
+		return nil, err
+	}
+	changes := describeLeaveChanges(&before, request)
+	if changes == "" {
+		return request, nil
+	}
+
+	// 修改後需重新審核: 先移除原有步驟 (含已通過的步驟)，再依新的假別與天數建立
+	// 移除步驟、更新假單與重建步驟在同一事務中進行; 版本衝突或任一步失敗時原有步驟保持不變
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.approvalRepo.DeleteStepsByRequestID(ctx, request.ID); err != nil {
+			log.Printf("Error deleting approval steps of edited leave request %s: %v", request.ID, err)
+			return ErrLeaveRequestUpdateFailed
+		}
+		request.LastRemindedAt = nil
+		request.EscalatedAt = nil
+		if err := s.leaveRepo.Update(ctx, request); err != nil {
+			log.Printf("Error updating edited leave request %s: %v", request.ID, err)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrLeaveRequestNotFound
+			}
+			if errors.Is(err, ErrConcurrentModification) {
+				return ErrConcurrentModification
+			}
+			return ErrLeaveRequestUpdateFailed
+		}
+		s.recordEvent(ctx, request, models.LeaveEventEdited, models.LeaveStatusPending, &accountUUID, nil, changes)
+		if violation != nil {
+			s.recordRuleOverride(ctx, request, violation, accountUUID, input.OverrideJustification)
+		}
+
+		if _, err := s.createApprovalSteps(ctx, request); err != nil {
+			log.Printf("Error recreating approval steps of edited leave request %s, edit rolled back: %v", request.ID, err)
+			return ErrLeaveRequestUpdateFailed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// prepareLeaveRequest 驗證申請內容並填入 request (假別、日期、天數、時長與原因)
+// 申請與修改共用: request.ID 不為空時 (修改)，已上傳的附件可滿足附件規則，重疊檢查也會略過假單本身
+// 違反規則且 input 附有覆寫理由時 (已由呼叫端確認權限) 記錄於 request 並返回違反的規則，由呼叫端記錄覆寫事件
+func (s *leaveRequestServiceImpl) prepareLeaveRequest(ctx context.Context, account *models.Account, input models.LeaveRequestInput, request *models.LeaveRequest) (*models.LeaveRuleViolation, error) {
+	leaveType, err := s.leaveTypeSvc.ResolveLeaveType(ctx, input.LeaveType, account.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.EndDate.Before(input.StartDate) {
+		return nil, ErrInvalidDateRange
+	}
+	// 連續天數以日曆天計算 (含週末與假日)
+	span := calendarDays(input.StartDate, input.EndDate)
+	if leaveType.MaxConsecutiveDays > 0 && span > leaveType.MaxConsecutiveDays {
+		return nil, fmt.Errorf("%w: %s leave allows at most %d consecutive day(s)", ErrLeaveTypeMaxDaysExceeded, leaveType.Code, leaveType.MaxConsecutiveDays)
+	}
+	unit, hours, err := validateLeaveDuration(input)
+	if err != nil {
+		return nil, err
+	}
+	for _, upload := range input.Attachments {
+		if err := s.attachmentSvc.ValidateUpload(upload); err != nil {
+			return nil, err
+		}
+	}
+
+	days, err := s.holidaySvc.CountWorkingDays(ctx, input.StartDate, input.EndDate)
+	if err != nil {
+		log.Printf("Error calculating working days for account %s: %v", request.AccountID, err)
+		return nil, fmt.Errorf("failed to calculate working days: %w", err)
+	}
+	if days.IsZero() {
+		return nil, ErrNoWorkingDaysInRange
+	}
+	// 非整天的假單只會落在單一工作日, 依單位換算天數
+	switch unit {
+	case models.LeaveDurationHalfDayAM, models.LeaveDurationHalfDayPM:
+		days = decimal.NewFromFloat(0.5)
+	case models.LeaveDurationHours:
+		days = hours.Div(decimal.NewFromInt(models.StandardWorkingHoursPerDay)).Round(2)
+	}
+
+	request.LeaveType = input.LeaveType
+	request.StartDate = input.StartDate
+	request.EndDate = input.EndDate
+	request.Days = days
+	request.DurationUnit = unit
+	request.Reason = input.Reason
+	request.Unpaid = !leaveType.Paid
+	request.StartTime, request.EndTime = "", ""
+	if unit == models.LeaveDurationHours {
+		request.StartTime = input.StartTime
+		request.EndTime = input.EndTime
+	}
+
+	if len(input.Attachments) == 0 {
+		required := leaveType.RequiresAttachment
+		if !required {
+			required, err = s.attachmentSvc.IsAttachmentRequired(ctx, input.LeaveType, days)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if required {
+			hasAttachments := false
+			if request.ID != uuid.Nil {
+				hasAttachments, err = s.attachmentSvc.HasAttachments(ctx, request.ID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if !hasAttachments {
+				return nil, ErrAttachmentRequired
+			}
+		}
+	}
+
+	if err := s.checkOverlap(ctx, request, activeLeaveStatuses); err != nil {
+		return nil, err
+	}
+
+	// 修改後的內容需重新覆寫, 先清除先前的覆寫記錄
+	request.OverriddenRuleID, request.RuleOverrideNote = nil, ""
+	violation, err := s.ruleSvc.CheckLeaveRules(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if violation != nil {
+		if input.OverrideJustification == "" {
+			return nil, &LeaveRuleViolationError{Violation: *violation}
+		}
+		ruleID := violation.RuleID
+		request.OverriddenRuleID = &ruleID
+		request.RuleOverrideNote = input.OverrideJustification
+	}
+
+	if leaveType.Paid {
+		if err := s.balanceSvc.CheckSufficientBalance(ctx, request.AccountID, input.LeaveType, days); err != nil {
+			if errors.Is(err, ErrInsufficientLeaveBalance) {
+				return nil, err
+			}
+			log.Printf("Error checking leave balance for account %s: %v", request.AccountID, err)
+			return nil, fmt.Errorf("failed to verify leave balance: %w", err)
+		}
+	}
+	return violation, nil
+}
+
+// authorizeRuleOverride 整理覆寫理由並確認提交者可覆寫假別規則 (僅 HR / Super Admin)
+func authorizeRuleOverride(submitter *models.Account, input *models.LeaveRequestInput) error {
+	input.OverrideJustification = strings.TrimSpace(input.OverrideJustification)
+	if input.OverrideJustification != "" && !isHRAccount(submitter) {
+		log.Printf("Account %s (Role: %d) attempted to override leave rules on submission", submitter.ID, submitter.Role)
+		return ErrRuleOverrideNotAllowed
+	}
+	return nil
+}
+
+// recordRuleOverride 在假單歷程記錄覆寫的規則與理由
+func (s *leaveRequestServiceImpl) recordRuleOverride(ctx context.Context, request *models.LeaveRequest, violation *models.LeaveRuleViolation, actorID uuid.UUID, justification string) {
+	log.Printf("Leave rule %s overridden for leave request %s by %s", violation.RuleID, request.ID, actorID)
+	s.recordEvent(ctx, request, models.LeaveEventRuleOverridden, models.LeaveStatusPending, &actorID, nil,
+		fmt.Sprintf("%s; justification: %s", violation.Message, justification))
+}
+
+// calendarDays 返回起訖日期之間的日曆天數 (含起訖兩日)
+func calendarDays(start, end time.Time) int {
+	return int(dateOf(end).Sub(dateOf(start)).Round(24*time.Hour).Hours()/24) + 1
+}
+
+// describeLeaveChanges 列出修改前後不同的欄位 (例如 "start_date: 2025-03-03 -> 2025-03-04")，沒有變更時返回空字串
+func describeLeaveChanges(before, after *models.LeaveRequest) string {
+	var changes []string
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, fmt.Sprintf("%s: %s -> %s", field, from, to))
+		}
+	}
+	add("leave_type", before.LeaveType, after.LeaveType)
+	add("start_date", before.StartDate.Format("2006-01-02"), after.StartDate.Format("2006-01-02"))
+	add("end_date", before.EndDate.Format("2006-01-02"), after.EndDate.Format("2006-01-02"))
+	add("duration_unit", before.DurationUnit, after.DurationUnit)
+	add("start_time", before.StartTime, after.StartTime)
+	add("end_time", before.EndTime, after.EndTime)
+	if !before.Days.Equal(after.Days) {
+		add("days", before.Days.String(), after.Days.String())
+	}
+	if before.Reason != after.Reason {
+		changes = append(changes, fmt.Sprintf("reason: %q -> %q", before.Reason, after.Reason))
+	}
+	return strings.Join(changes, "; ")
+}
+
+// checkRequiredAttachment 假別規則要求附件但假單尚無附件時返回 ErrAttachmentRequired
+func (s *leaveRequestServiceImpl) checkRequiredAttachment(ctx context.Context, request *models.LeaveRequest) error {
+	required, err := s.attachmentSvc.IsAttachmentRequired(ctx, request.LeaveType, request.Days)
+	if err != nil || !required {
+		return err
+	}
+	hasAttachments, err := s.attachmentSvc.HasAttachments(ctx, request.ID)
+	if err != nil {
+		return err
+	}
+	if !hasAttachments {
+		return ErrAttachmentRequired
+	}
+	return nil
+}
+
+// validateLeaveDuration 驗證時長單位並返回正規化後的單位，hours 單位另返回請假時數
+func validateLeaveDuration(input models.LeaveRequestInput) (string, decimal.Decimal, error) {
+	unit := input.DurationUnit
+	if unit == "" {
+		unit = models.LeaveDurationFullDay
+	}
+
+	switch unit {
+	case models.LeaveDurationFullDay:
+		return unit, decimal.Zero, nil
+	case models.LeaveDurationHalfDayAM, models.LeaveDurationHalfDayPM, models.LeaveDurationHours:
+		if !dateOf(input.StartDate).Equal(dateOf(input.EndDate)) {
+			return "", decimal.Zero, fmt.Errorf("%w: partial-day leave must start and end on the same date", ErrInvalidLeaveDuration)
+		}
+	default:
+		return "", decimal.Zero, fmt.Errorf("%w: unknown duration unit '%s'", ErrInvalidLeaveDuration, unit)
+	}
+	if unit != models.LeaveDurationHours {
+		return unit, decimal.Zero, nil
+	}
+
+	start, errStart := time.Parse("15:04", input.StartTime)
+	end, errEnd := time.Parse("15:04", input.EndTime)
+	if errStart != nil || errEnd != nil {
+		return "", decimal.Zero, fmt.Errorf("%w: start_time and end_time (HH:MM) are required for hourly leave", ErrInvalidLeaveDuration)
+	}
+	if !end.After(start) {
+		return "", decimal.Zero, fmt.Errorf("%w: end_time must be after start_time", ErrInvalidLeaveDuration)
+	}
+	hours := decimal.NewFromFloat(end.Sub(start).Hours())
+	if hours.GreaterThan(decimal.NewFromInt(models.StandardWorkingHoursPerDay)) {
+		return "", decimal.Zero, fmt.Errorf("%w: hourly leave cannot exceed %d hours", ErrInvalidLeaveDuration, models.StandardWorkingHoursPerDay)
+	}
+	return unit, hours, nil
+}
+
+// activeLeaveStatuses 仍占用請假時段的狀態 (申請時不可與之重疊)
+var activeLeaveStatuses = []string{models.LeaveStatusPending, models.LeaveStatusApproved, models.LeaveStatusCancellationRequested}
+
+// approvedLeaveStatuses 已核准並扣除天數的狀態 (核准時不可與之重疊)
+var approvedLeaveStatuses = []string{models.LeaveStatusApproved, models.LeaveStatusCancellationRequested}
+
+// checkOverlap 檢查同一帳戶在假單期間內是否已有狀態屬於 statuses 的其他假單
+func (s *leaveRequestServiceImpl) checkOverlap(ctx context.Context, candidate *models.LeaveRequest, statuses []string) error {
+	existing, err := s.leaveRepo.ListOverlapping(ctx, candidate.AccountID, candidate.StartDate, candidate.EndDate, statuses)
+	if err != nil {
+		log.Printf("Error checking overlapping leave for account %s: %v", candidate.AccountID, err)
+		return fmt.Errorf("failed to check overlapping leave requests: %w", err)
+	}
+	for i := range existing {
+		if existing[i].ID == candidate.ID {
+			continue
+		}
+		if leavesOverlap(candidate, &existing[i]) {
+			log.Printf("Leave request for account %s (%s ~ %s) overlaps with request %s", candidate.AccountID,
+				candidate.StartDate.Format("2006-01-02"), candidate.EndDate.Format("2006-01-02"), existing[i].ID)
+			return ErrOverlappingLeave
+		}
+	}
+	return nil
+}
+
+// checkOverlapLocked 在事務中鎖定申請人在假期區間內的所有假單，再確認沒有已核准的重疊假單
+// 同一員工兩張重疊的假單同時核准時，後者會等前者的事務提交後才讀到其狀態，因此不會兩張都被核准
+func (s *leaveRequestServiceImpl) checkOverlapLocked(ctx context.Context, candidate *models.LeaveRequest) error {
+	existing, err := s.leaveRepo.LockOverlapping(ctx, candidate.AccountID, candidate.StartDate, candidate.EndDate)
+	if err != nil {
+		log.Printf("Error locking overlapping leave for account %s: %v", candidate.AccountID, err)
+		return fmt.Errorf("failed to check overlapping leave requests: %w", err)
+	}
+	for i := range existing {
+		if existing[i].ID == candidate.ID || !slices.Contains(approvedLeaveStatuses, existing[i].Status) {
+			continue
+		}
+		if leavesOverlap(candidate, &existing[i]) {
+			log.Printf("Leave request %s overlaps with approved request %s", candidate.ID, existing[i].ID)
+			return ErrOverlappingLeave
+		}
+	}
+	return nil
+}
+
+// leavesOverlap 判斷兩張假單是否重疊; 同一天的非整天假單依時段判斷 (例如上午假與下午假不重疊)
+func leavesOverlap(a, b *models.LeaveRequest) bool {
+	if dateOf(a.StartDate).After(dateOf(b.EndDate)) || dateOf(b.StartDate).After(dateOf(a.EndDate)) {
+		return false
+	}
+	aStart, aEnd := dayWindow(a)
+	bStart, bEnd := dayWindow(b)
+	return aStart < bEnd && bStart < aEnd
+}
+
+// dayWindow 返回假單在當天占用的時段 (以分鐘計, 左閉右開), 整天假為 [0, 1440)
+func dayWindow(r *models.LeaveRequest) (int, int) {
+	const noon, endOfDay = 12 * 60, 24 * 60
+	switch r.DurationUnit {
+	case models.LeaveDurationHalfDayAM:
+		return 0, noon
+	case models.LeaveDurationHalfDayPM:
+		return noon, endOfDay
+	case models.LeaveDurationHours:
+		start, errStart := time.Parse("15:04", r.StartTime)
+		end, errEnd := time.Parse("15:04", r.EndTime)
+		if errStart == nil && errEnd == nil {
+			return start.Hour()*60 + start.Minute(), end.Hour()*60 + end.Minute()
+		}
+	}
+	return 0, endOfDay
+}
+
+// recordEvent 寫入假單歷程; 歷程為輔助資料，寫入失敗只記錄 log，不影響狀態變更
+func (s *leaveRequestServiceImpl) recordEvent(ctx context.Context, request *models.LeaveRequest, eventType, fromStatus string, actorID, onBehalfOfID *uuid.UUID, comment string) {
+	event := &models.LeaveRequestEvent{
+		LeaveRequestID: request.ID,
+		EventType:      eventType,
+		FromStatus:     fromStatus,
+		ToStatus:       request.Status,
+		ActorID:        actorID,
+		OnBehalfOfID:   onBehalfOfID,
+		Comment:        comment,
+	}
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		log.Printf("Error recording %s event for leave request %s: %v", eventType, request.ID, err)
+	}
+}
+
+// GetRequestHistory 實現查詢假單狀態歷程的業務邏輯
+func (s *leaveRequestServiceImpl) GetRequestHistory(ctx context.Context, leaveRequestIDStr string, viewerAccountIDStr string) ([]models.LeaveRequestEvent, error) {
+	leaveRequestUUID, err := uuid.Parse(leaveRequestIDStr)
+	if err != nil {
+		return nil, errors.New("invalid leave request identifier format")
+	}
+	viewerUUID, err := uuid.Parse(viewerAccountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+
+	request, err := s.leaveRepo.GetByID(ctx, leaveRequestUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLeaveRequestNotFound
+		}
+		log.Printf("Error fetching leave request %s for history: %v", leaveRequestUUID, err)
+		return nil, fmt.Errorf("failed to retrieve leave request data")
+	}
+
+	// 申請人本人可直接查看，其他人需為 HR / Super Admin
+	if request.AccountID != viewerUUID {
+		viewer, err := s.accountRepo.GetAccountByID(ctx, viewerUUID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrAccountNotFound
+			}
+			log.Printf("Error fetching viewer account %s: %v", viewerUUID, err)
+			return nil, fmt.Errorf("failed to verify viewer account")
+		}
+		if viewer.Role != models.RoleHR && viewer.Role != models.RoleSuperAdmin {
+			return nil, ErrNotLeaveRequestOwner
+		}
+	}
+
+	events, err := s.eventRepo.ListByRequestID(ctx, leaveRequestUUID)
+	if err != nil {
+		log.Printf("Error fetching history of leave request %s: %v", leaveRequestUUID, err)
+		return nil, fmt.Errorf("failed to retrieve leave request history")
+	}
+	for i := range events {
+		if events[i].Actor != nil {
+			events[i].Actor.Password = ""
+		}
+	}
+	return events, nil
+}
+
 // ListAccountRequests 實現獲取特定帳戶請假列表的業務邏輯
 func (s *leaveRequestServiceImpl) ListAccountRequests(ctx context.Context, accountIDStr string) ([]models.LeaveRequest, error) {
 	accountUUID, err := uuid.Parse(accountIDStr)
@@ -3,12 +3,15 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
-	"github.com/erinchen11/hr-system/internal/interfaces/mocks" 
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
 	"github.com/erinchen11/hr-system/internal/models"
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,9 +36,8 @@ func TestLeaveRequestServiceImpl_ListAllRequests(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl) // Needed for New
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo := m.leaveRepo
 
 		mockLeaveRepo.EXPECT().ListAllWithAccount(gomock.Any()).Return(mockRequests, nil).Times(1)
 
@@ -56,9 +58,8 @@ func TestLeaveRequestServiceImpl_ListAllRequests(t *testing.T) {
 	t.Run("Success - Empty List", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo := m.leaveRepo
 
 		emptyRequests := []models.LeaveRequest{}
 		mockLeaveRepo.EXPECT().ListAllWithAccount(gomock.Any()).Return(emptyRequests, nil).Times(1)
@@ -73,9 +74,8 @@ func TestLeaveRequestServiceImpl_ListAllRequests(t *testing.T) {
 	t.Run("Failure - Repository Error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo := m.leaveRepo
 
 		repoError := errors.New("db connection error")
 		mockLeaveRepo.EXPECT().ListAllWithAccount(gomock.Any()).Return(nil, repoError).Times(1)
@@ -89,6 +89,97 @@ func TestLeaveRequestServiceImpl_ListAllRequests(t *testing.T) {
 	})
 }
 
+func TestLeaveRequestServiceImpl_ListRequests(t *testing.T) {
+	ctx := context.Background()
+	approverID := uuid.New()
+	mockApprover := models.Account{ID: approverID, Password: "hash2"}
+	mockRequests := []models.LeaveRequest{
+		{ID: uuid.New(), Account: models.Account{Password: "hash1"}, Status: models.LeaveStatusApproved, ApproverID: &approverID, Approver: &mockApprover},
+	}
+
+	t.Run("Success - Applies defaults and clears passwords", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().ListFiltered(gomock.Any(), models.LeaveRequestFilter{
+			Statuses: []string{models.LeaveStatusApproved},
+			SortBy:   models.LeaveSortRequestedAt,
+			SortDesc: true,
+			Page:     1,
+			PageSize: models.DefaultLeaveRequestPageSize,
+		}).Return(mockRequests, int64(41), nil).Times(1)
+
+		requests, total, err := service.ListRequests(ctx, models.LeaveRequestFilter{Statuses: []string{models.LeaveStatusApproved}, SortDesc: true})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(41), total)
+		require.Len(t, requests, 1)
+		assert.Equal(t, "", requests[0].Account.Password)
+		assert.Equal(t, "", requests[0].Approver.Password)
+	})
+
+	t.Run("Success - Page size capped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().ListFiltered(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter models.LeaveRequestFilter) ([]models.LeaveRequest, int64, error) {
+				assert.Equal(t, 2, filter.Page)
+				assert.Equal(t, models.MaxLeaveRequestPageSize, filter.PageSize)
+				assert.Equal(t, models.LeaveSortDays, filter.SortBy)
+				return []models.LeaveRequest{}, 0, nil
+			}).Times(1)
+
+		_, _, err := service.ListRequests(ctx, models.LeaveRequestFilter{SortBy: models.LeaveSortDays, Page: 2, PageSize: 1000})
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Unknown status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveServiceWithMocks(ctrl)
+
+		_, _, err := service.ListRequests(ctx, models.LeaveRequestFilter{Statuses: []string{"archived"}})
+		assert.ErrorIs(t, err, ErrInvalidLeaveFilter)
+	})
+
+	t.Run("Failure - Unknown sort field", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveServiceWithMocks(ctrl)
+
+		_, _, err := service.ListRequests(ctx, models.LeaveRequestFilter{SortBy: "password"})
+		assert.ErrorIs(t, err, ErrInvalidLeaveFilter)
+	})
+
+	t.Run("Failure - End before start", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveServiceWithMocks(ctrl)
+
+		from := time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+		_, _, err := service.ListRequests(ctx, models.LeaveRequestFilter{From: &from, To: &to})
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		repoError := errors.New("db connection error")
+		m.leaveRepo.EXPECT().ListFiltered(gomock.Any(), gomock.Any()).Return(nil, int64(0), repoError).Times(1)
+
+		requests, total, err := service.ListRequests(ctx, models.LeaveRequestFilter{})
+		assert.ErrorIs(t, err, repoError)
+		assert.Nil(t, requests)
+		assert.Zero(t, total)
+	})
+}
+
 func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 	ctx := context.Background()
 	leaveRequestID := uuid.New()
@@ -98,6 +189,7 @@ func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 	pendingRequest := &models.LeaveRequest{
 		ID:        leaveRequestID,
 		AccountID: applicantAccountID,
+		Days:      decimal.NewFromInt(2),
 		Status:    models.LeaveStatusPending, // Correct initial state
 	}
 
@@ -112,16 +204,32 @@ func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo, mockAccountRepo := m.leaveRepo, m.accountRepo
 		localPendingRequest := *pendingRequest // Use copy
 
 		// 1. Expect processor validation
 		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
 		// 2. Expect fetching the leave request
 		mockLeaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
-		// 3. Expect updating the leave request
+		// 3. Expect loading the approval chain (single manager step)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		// 4. Expect overlap re-check against approved leave
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		// 5. Expect balance re-check with the stored working days
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, localPendingRequest.LeaveType, localPendingRequest.Days).Return(nil).Times(1)
+		// 6. Expect recording the decision on the last step
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				assert.Equal(t, models.ApprovalStepStatusApproved, step.Status)
+				require.NotNil(t, step.ActorID)
+				assert.Equal(t, processorAccountID, *step.ActorID)
+				assert.NotNil(t, step.DecidedAt)
+				return nil
+			}).Times(1)
+		// 7. Expect updating the leave request
 		mockLeaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
 			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
 				assert.Equal(t, leaveRequestID, req.ID)
@@ -132,6 +240,9 @@ func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 				assert.WithinDuration(t, time.Now(), *req.ApprovedAt, time.Second*2) // Check ApprovedAt is recent
 				return nil
 			}).Times(1)
+		// 8. Expect the balance debit
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), localPendingRequest.Days).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
 
 		// Execute
 		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
@@ -140,12 +251,147 @@ func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Failure - Debit Error Rolls Back Step And Status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
+		debitErr := errors.New("ledger write failed")
+		inTransaction := func(ctx context.Context) {
+			assert.Equal(t, true, ctx.Value(inTransactionKey{}), "write should run inside the transaction")
+		}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				inTransaction(ctx)
+				return nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				inTransaction(ctx)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), localPendingRequest.Days).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest, days decimal.Decimal) error {
+				inTransaction(ctx)
+				return debitErr
+			}).Times(1)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+
+		// 錯誤由事務返回，步驟與假單狀態一併回滾
+		assert.ErrorIs(t, err, debitErr)
+	})
+
+	t.Run("Success - Unpaid Leave Is Not Debited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		unpaidRequest := *pendingRequest
+		unpaidRequest.Unpaid = true
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&unpaidRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
+		// CheckSufficientBalance and DebitForLeave should NOT be called
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Legacy Request Without Days", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		legacyRequest := *pendingRequest
+		legacyRequest.Days = decimal.Zero
+		workingDays := decimal.NewFromInt(3)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&legacyRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), legacyRequest.StartDate, legacyRequest.EndDate).Return(workingDays, nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), workingDays).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.True(t, workingDays.Equal(req.Days), "days should be back-filled on approval")
+				return nil
+			}).Times(1)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), workingDays).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Insufficient Balance At Approval", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(ErrInsufficientLeaveBalance).Times(1)
+		// Update and DebitForLeave should NOT be called
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInsufficientLeaveBalance)
+	})
+
+	t.Run("Failure - Overlaps Already Approved Leave", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
+		pendingElsewhere := models.LeaveRequest{ID: uuid.New(), AccountID: applicantAccountID, Status: models.LeaveStatusPending}
+		approvedElsewhere := models.LeaveRequest{ID: uuid.New(), AccountID: applicantAccountID, Status: models.LeaveStatusApproved}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		// 重疊檢查在事務中鎖定申請人區間內的所有假單，只與已核准的假單比較
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, localPendingRequest.StartDate, localPendingRequest.EndDate).
+			DoAndReturn(func(ctx context.Context, accountID uuid.UUID, start, end time.Time) ([]models.LeaveRequest, error) {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "overlap rows should be locked inside the transaction")
+				return []models.LeaveRequest{localPendingRequest, pendingElsewhere, approvedElsewhere}, nil
+			}).Times(1)
+		// UpdateStep, Update and DebitForLeave should NOT be called
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+		assert.ErrorIs(t, err, ErrOverlappingLeave)
+	})
+
 	t.Run("Failure - Invalid Processor ID", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, _ := newLeaveServiceWithMocks(ctrl)
 
 		err := service.ApproveRequest(ctx, leaveRequestID.String(), "invalid-uuid")
 		require.Error(t, err)
@@ -155,9 +401,8 @@ func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 	t.Run("Failure - Processor Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockAccountRepo := m.accountRepo
 
 		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
 
@@ -169,23 +414,74 @@ func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 	t.Run("Failure - Processor Role Insufficient", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockAccountRepo := m.accountRepo
+		localPendingRequest := *pendingRequest
+		otherManagerID := uuid.New()
 
 		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(nonHrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		// 非 HR 的處理人必須是申請人的直屬主管
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantAccountID).
+			Return(&models.Employment{AccountID: applicantAccountID, ManagerID: &otherManagerID}, nil).Times(1)
+		// 處理人沒有任何有效代理
+		m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), processorAccountID, gomock.Any()).Return(nil, nil).Times(1)
 
 		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
 		require.Error(t, err)
 		assert.ErrorIs(t, err, ErrInvalidProcessor)
 	})
 
+	t.Run("Success - Direct Manager Approves", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(nonHrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantAccountID).
+			Return(&models.Employment{AccountID: applicantAccountID, ManagerID: &processorAccountID}, nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				require.NotNil(t, req.ApproverID)
+				assert.Equal(t, processorAccountID, *req.ApproverID)
+				return nil
+			}).Times(1)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Applicant Cannot Approve Own Request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		ownRequest := *pendingRequest
+		ownRequest.AccountID = processorAccountID
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(nonHrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&ownRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
+	})
+
 	t.Run("Failure - Leave Request Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo, mockAccountRepo := m.leaveRepo, m.accountRepo
 
 		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
 		mockLeaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
@@ -198,9 +494,8 @@ func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 	t.Run("Failure - Invalid State (Not Pending)", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo, mockAccountRepo := m.leaveRepo, m.accountRepo
 		localNonPendingRequest := *nonPendingRequest // Use copy
 
 		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
@@ -214,217 +509,1950 @@ func TestLeaveRequestServiceImpl_ApproveRequest(t *testing.T) {
 	t.Run("Failure - Update Error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo, mockAccountRepo := m.leaveRepo, m.accountRepo
 		localPendingRequest := *pendingRequest // Use copy
 		updateError := errors.New("db update failed")
 
 		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
 		mockLeaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 		mockLeaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(updateError).Times(1)
 
 		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
 		require.Error(t, err)
 		assert.ErrorIs(t, err, ErrLeaveRequestUpdateFailed)
 	})
-}
 
-// --- Test ApplyForLeave ---
-func TestLeaveRequestServiceImpl_ApplyForLeave(t *testing.T) {
-	ctx := context.Background()
-	accountID := uuid.New()
-	accountIDStr := accountID.String()
-	leaveType := models.LeaveTypePersonal
-	startDate := time.Now().AddDate(0, 0, 5)
-	endDate := time.Now().AddDate(0, 0, 7)
-	reason := "Family matter"
+	t.Run("Failure - Step Already Decided By Another Approver", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		// 步驟已不是待審 (另一位審核人先處理)，status 條件更新沒有寫入
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(ErrConcurrentModification).Times(1)
+		// Update and DebitForLeave should NOT be called
 
-	mockAccount := &models.Account{ID: accountID, Role: models.RoleEmployee} // Applicant account
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+	})
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Failure - Required Attachment Missing", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
 
-		// 1. Expect Account check
-		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
-		// 2. Expect Create call
-		mockLeaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
-			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
-				assert.Equal(t, accountID, req.AccountID)
-				assert.Equal(t, leaveType, req.LeaveType) // 檢查假單類型 "personal"
-				assert.Equal(t, startDate, req.StartDate)
-				assert.Equal(t, endDate, req.EndDate)
-				assert.Equal(t, reason, req.Reason) // 檢查請假原因 "Family matter"
-				assert.Equal(t, models.LeaveStatusPending, req.Status)
-				req.ID = uuid.New()
-				req.RequestedAt = time.Now()
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		m.attachmentSvc.EXPECT().IsAttachmentRequired(gomock.Any(), localPendingRequest.LeaveType, localPendingRequest.Days).Return(true, nil).Times(1)
+		m.attachmentSvc.EXPECT().HasAttachments(gomock.Any(), leaveRequestID).Return(false, nil).Times(1)
+		// Overlap check, UpdateStep and Update should NOT be called
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+
+		assert.ErrorIs(t, err, ErrAttachmentRequired)
+	})
+
+	staffingViolation := &models.LeaveRuleViolation{Rule: models.LeaveRuleMinStaffing, RuleID: uuid.New(), RuleName: "Two engineers on duty",
+		Department: "Engineering", MinOnDuty: 2, Message: "approving this leave would leave Engineering below 2 people on duty"}
+
+	t.Run("Failure - Leave Rule Violated Without Override", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.ruleSvc.EXPECT().CheckLeaveRules(gomock.Any(), &localPendingRequest).Return(staffingViolation, nil).Times(1)
+		// Balance check, UpdateStep and Update should NOT be called
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
+
+		assert.ErrorIs(t, err, ErrLeaveRuleViolation)
+		var violationErr *LeaveRuleViolationError
+		require.ErrorAs(t, err, &violationErr)
+		assert.Equal(t, *staffingViolation, violationErr.Violation)
+	})
+
+	t.Run("Success - HR Overrides Leave Rule With Justification", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		m.ruleSvc.EXPECT().CheckLeaveRules(gomock.Any(), gomock.Any()).Return(staffingViolation, nil).Times(1)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+				assert.Equal(t, models.LeaveEventRuleOverridden, e.EventType)
+				assert.Contains(t, e.Comment, staffingViolation.Message)
+				assert.Contains(t, e.Comment, "release deadline cover arranged")
+				require.NotNil(t, e.ActorID)
+				assert.Equal(t, processorAccountID, *e.ActorID)
 				return nil
 			}).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
 
-		// Execute
-		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, leaveType, reason, startDate, endDate)
+		err := service.ApproveRequestWithOverride(ctx, leaveRequestID.String(), processorAccountID.String(), " release deadline cover arranged ")
 
-		// Assert
 		require.NoError(t, err)
-		require.NotNil(t, createdRequest)
-		assert.NotEqual(t, uuid.Nil, createdRequest.ID)
-		assert.Equal(t, accountID, createdRequest.AccountID)
-		assert.Equal(t, leaveType, createdRequest.LeaveType)
-		assert.Equal(t, models.LeaveStatusPending, createdRequest.Status)
 	})
 
-	t.Run("Failure - Invalid Account ID Format", func(t *testing.T) {
+	t.Run("Failure - Manager Cannot Override Leave Rule", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
 
-		createdRequest, err := service.ApplyForLeave(ctx, "invalid-uuid", leaveType, reason, startDate, endDate)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(nonHrAccount, nil).Times(1)
+		// Leave request should NOT be fetched
 
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "invalid user identifier format")
-		assert.Nil(t, createdRequest)
+		err := service.ApproveRequestWithOverride(ctx, leaveRequestID.String(), processorAccountID.String(), "team agreed")
+
+		assert.ErrorIs(t, err, ErrRuleOverrideNotAllowed)
 	})
 
-	t.Run("Failure - Account Not Found", func(t *testing.T) {
+	t.Run("Failure - Override Without Justification", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, _ := newLeaveServiceWithMocks(ctrl)
 
-		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
-
-		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, leaveType, reason, startDate, endDate)
+		err := service.ApproveRequestWithOverride(ctx, leaveRequestID.String(), processorAccountID.String(), "   ")
 
-		require.Error(t, err)
-		assert.ErrorIs(t, err, ErrAccountNotFound) // Expect service level error
-		assert.Nil(t, createdRequest)
+		assert.ErrorIs(t, err, ErrInvalidInput)
 	})
 
-	t.Run("Failure - Invalid Date Range", func(t *testing.T) {
+	t.Run("Success - Rule Overridden On Submission Does Not Block Approval", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
-		invalidEndDate := startDate.AddDate(0, 0, -1) // End date before start date
-
-		// Account check should still happen before date validation
-		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
-		// Create should NOT be called
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localPendingRequest := *pendingRequest
+		overriddenRuleID := staffingViolation.RuleID
+		localPendingRequest.OverriddenRuleID = &overriddenRuleID
+		localPendingRequest.RuleOverrideNote = "release deadline cover arranged"
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&localPendingRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.ruleSvc.EXPECT().CheckLeaveRules(gomock.Any(), gomock.Any()).Return(staffingViolation, nil).Times(1)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		// 覆寫已於提交時記錄, 核准時不再記錄 rule_overridden
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
 
-		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, leaveType, reason, startDate, invalidEndDate)
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
 
-		require.Error(t, err)
-		assert.ErrorIs(t, err, ErrInvalidDateRange)
-		assert.Nil(t, createdRequest)
+		require.NoError(t, err)
 	})
 
-	t.Run("Failure - Create Repo Error", func(t *testing.T) {
+	t.Run("Failure - HR Cannot Approve Own Request", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
-		repoError := errors.New("db create failed")
+		service, m := newLeaveServiceWithMocks(ctrl)
+		ownRequest := *pendingRequest
+		ownRequest.AccountID = processorAccountID
 
-		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
-		mockLeaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(repoError).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(processorAccountID)).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(&ownRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepHR), nil).Times(1)
+		// Delegations, UpdateStep and Update should NOT be called
 
-		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, leaveType, reason, startDate, endDate)
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), processorAccountID.String())
 
-		require.Error(t, err)
-		assert.ErrorIs(t, err, ErrLeaveApplyFailed)
-		assert.Nil(t, createdRequest)
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
 	})
 }
 
-// --- Test ListAccountRequests ---
-func TestLeaveRequestServiceImpl_ListAccountRequests(t *testing.T) {
+func TestLeaveRequestServiceImpl_RejectRequest(t *testing.T) {
 	ctx := context.Background()
-	accountID := uuid.New()
-	accountIDStr := accountID.String()
-
-	mockAccount := &models.Account{ID: accountID, Role: models.RoleEmployee}
-	mockRequests := []models.LeaveRequest{
-		{ID: uuid.New(), AccountID: accountID, Status: models.LeaveStatusPending, Account: models.Account{Password: "hash1"}},
-		{ID: uuid.New(), AccountID: accountID, Status: models.LeaveStatusApproved, Account: models.Account{Password: "hash2"}, Approver: &models.Account{Password: "hash3"}},
-	}
+	leaveRequestID := uuid.New()
+	processorAccountID := uuid.New()
+	applicantAccountID := uuid.New()
+	managerAccount := &models.Account{ID: processorAccountID, Role: models.RoleEmployee}
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Success - Direct Manager Rejects", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
-
-		// 1. Expect account validation
-		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
-		// 2. Expect list call
-		mockLeaveRepo.EXPECT().ListByAccountID(gomock.Any(), gomock.Eq(accountID)).Return(mockRequests, nil).Times(1)
-
-		// Execute
-		requests, err := service.ListAccountRequests(ctx, accountIDStr)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantAccountID, Reason: "Visiting family", Status: models.LeaveStatusPending}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorAccountID).Return(managerAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantAccountID).
+			Return(&models.Employment{AccountID: applicantAccountID, ManagerID: &processorAccountID}, nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				assert.Equal(t, models.ApprovalStepStatusRejected, step.Status)
+				return nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusRejected, req.Status)
+				assert.Equal(t, "Team is short-staffed", req.DecisionNote)
+				assert.Equal(t, "Visiting family", req.Reason, "employee's reason must be preserved")
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventRejected)
 
-		// Assert
+		err := service.RejectRequest(ctx, leaveRequestID.String(), processorAccountID.String(), "Team is short-staffed")
 		require.NoError(t, err)
-		require.Len(t, requests, 2)
-		assert.Equal(t, mockRequests[0].ID, requests[0].ID)
-		// Assert passwords cleared
-		assert.Equal(t, "", requests[0].Account.Password)
-		assert.NotNil(t, requests[1].Approver)
-		assert.Equal(t, "", requests[1].Approver.Password)
 	})
 
-	t.Run("Failure - Account Not Found", func(t *testing.T) {
+	t.Run("Failure - Applicant Has No Manager", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantAccountID, Status: models.LeaveStatusPending}
 
-		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
-		// ListByAccountID should NOT be called
-
-		requests, err := service.ListAccountRequests(ctx, accountIDStr)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorAccountID).Return(managerAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantAccountID).
+			Return(&models.Employment{AccountID: applicantAccountID}, nil).Times(1)
+		m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), processorAccountID, gomock.Any()).Return(nil, nil).Times(1)
 
-		require.Error(t, err)
-		assert.ErrorIs(t, err, ErrAccountNotFound)
-		assert.Nil(t, requests)
+		err := service.RejectRequest(ctx, leaveRequestID.String(), processorAccountID.String(), "")
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
 	})
 
-	t.Run("Failure - List Repo Error", func(t *testing.T) {
+	t.Run("Failure - If-Match Version Is Stale", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
-		repoError := errors.New("db list error")
-
-		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
-		mockLeaveRepo.EXPECT().ListByAccountID(gomock.Any(), gomock.Eq(accountID)).Return(nil, repoError).Times(1)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantAccountID, Status: models.LeaveStatusPending, Version: 3}
 
-		requests, err := service.ListAccountRequests(ctx, accountIDStr)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorAccountID).Return(managerAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		// 客戶端看到的是版本 2, 不應寫入任何資料
 
-		require.Error(t, err)
-		assert.Contains(t, err.Error(), "failed to retrieve leave requests")
-		assert.Nil(t, requests)
+		err := service.RejectRequest(models.WithExpectedVersion(ctx, 2), leaveRequestID.String(), processorAccountID.String(), "")
+		assert.ErrorIs(t, err, ErrConcurrentModification)
 	})
 
+	t.Run("Failure - Concurrent Update Loses Race", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantAccountID, Status: models.LeaveStatusPending, Version: 3}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorAccountID).Return(managerAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantAccountID).
+			Return(&models.Employment{AccountID: applicantAccountID, ManagerID: &processorAccountID}, nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(ErrConcurrentModification).Times(1)
+
+		err := service.RejectRequest(models.WithExpectedVersion(ctx, 3), leaveRequestID.String(), processorAccountID.String(), "")
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+	})
 }
 
-// --- Test GetLeaveRequestByID ---
-func TestLeaveRequestServiceImpl_GetLeaveRequestByID(t *testing.T) {
+func TestLeaveRequestServiceImpl_AutoApproveRequest(t *testing.T) {
 	ctx := context.Background()
 	leaveRequestID := uuid.New()
-	leaveRequestIDStr := leaveRequestID.String()
-	accountID := uuid.New()
+	applicantAccountID := uuid.New()
+
+	t.Run("Success - All Pending Steps Approved Without Actor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantAccountID, LeaveType: models.LeaveTypeSick,
+			Days: decimal.NewFromInt(1), Status: models.LeaveStatusPending}
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).
+			Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager, models.ApprovalStepHR), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, models.LeaveTypeSick, decimal.NewFromInt(1)).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				assert.Equal(t, models.ApprovalStepStatusApproved, step.Status)
+				assert.Nil(t, step.ActorID)
+				assert.NotNil(t, step.DecidedAt)
+				return nil
+			}).Times(2)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusApproved, req.Status)
+				assert.Nil(t, req.ApproverID)
+				assert.NotNil(t, req.ApprovedAt)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), request, decimal.NewFromInt(1)).Return(nil).Times(1)
+
+		err := service.AutoApproveRequest(ctx, leaveRequestID.String(), "auto-approved")
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Rule Violation Cannot Be Overridden", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantAccountID, LeaveType: models.LeaveTypeSick,
+			Days: decimal.NewFromInt(1), Status: models.LeaveStatusPending}
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).
+			Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.ruleSvc.EXPECT().CheckLeaveRules(gomock.Any(), request).
+			Return(&models.LeaveRuleViolation{Rule: models.LeaveRuleBlackout, Message: "year-end freeze"}, nil).Times(1)
+		// UpdateStep / Update should NOT be called
+
+		err := service.AutoApproveRequest(ctx, leaveRequestID.String(), "auto-approved")
+		assert.ErrorIs(t, err, ErrLeaveRuleViolation)
+	})
+
+	t.Run("Failure - Not Pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).
+			Return(&models.LeaveRequest{ID: leaveRequestID, Status: models.LeaveStatusApproved}, nil).Times(1)
+
+		err := service.AutoApproveRequest(ctx, leaveRequestID.String(), "auto-approved")
+		assert.ErrorIs(t, err, ErrInvalidLeaveRequestState)
+	})
+}
+
+func TestLeaveRequestServiceImpl_BulkProcessRequests(t *testing.T) {
+	ctx := context.Background()
+	hrAccountID := uuid.New()
+	hrAccount := &models.Account{ID: hrAccountID, Role: models.RoleHR}
+	pendingID := uuid.New()
+	approvedID := uuid.New()
+	missingID := uuid.New()
+
+	t.Run("Success - Per-Item Results For Mixed Batch", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrAccountID).Return(hrAccount, nil).Times(3)
+		// 待審假單: 正常拒絕
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), pendingID).
+			Return(&models.LeaveRequest{ID: pendingID, AccountID: uuid.New(), Status: models.LeaveStatusPending}, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), pendingID).Return(pendingApprovalSteps(pendingID, models.ApprovalStepHR), nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, pendingID, req.ID)
+				assert.Equal(t, "Year-end freeze", req.DecisionNote)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventRejected)
+		// 已核准的假單: 狀態不符
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), approvedID).
+			Return(&models.LeaveRequest{ID: approvedID, Status: models.LeaveStatusApproved}, nil).Times(1)
+		// 不存在的假單
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), missingID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		ids := []string{pendingID.String(), approvedID.String(), missingID.String(), "not-a-uuid", pendingID.String()}
+		results, err := service.BulkProcessRequests(ctx, ids, hrAccountID.String(), models.LeaveDecisionReject, "Year-end freeze")
+
+		require.NoError(t, err)
+		require.Len(t, results, 4, "duplicate IDs must be processed once")
+		expected := []struct{ id, result string }{
+			{pendingID.String(), models.BulkLeaveResultSucceeded},
+			{approvedID.String(), models.BulkLeaveResultInvalidState},
+			{missingID.String(), models.BulkLeaveResultNotFound},
+			{"not-a-uuid", models.BulkLeaveResultInvalidID},
+		}
+		for i, e := range expected {
+			assert.Equal(t, e.id, results[i].LeaveRequestID)
+			assert.Equal(t, e.result, results[i].Result)
+		}
+		assert.Empty(t, results[0].Message)
+	})
+
+	t.Run("Success - Other Errors Reported As Failed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrAccountID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		results, err := service.BulkProcessRequests(ctx, []string{pendingID.String()}, hrAccountID.String(), models.LeaveDecisionApprove, "")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, models.BulkLeaveResultFailed, results[0].Result)
+		assert.Equal(t, ErrInvalidProcessor.Error(), results[0].Message)
+	})
+
+	invalidCases := []struct {
+		name     string
+		ids      []string
+		decision string
+	}{
+		{"Unknown Decision", []string{pendingID.String()}, "defer"},
+		{"No IDs", nil, models.LeaveDecisionApprove},
+		{"Too Many IDs", make([]string, models.MaxBulkLeaveDecisions+1), models.LeaveDecisionApprove},
+	}
+	for _, ic := range invalidCases {
+		t.Run("Failure - "+ic.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, _ := newLeaveServiceWithMocks(ctrl)
+
+			results, err := service.BulkProcessRequests(ctx, ic.ids, hrAccountID.String(), ic.decision, "")
+
+			assert.ErrorIs(t, err, ErrInvalidInput)
+			assert.Nil(t, results)
+		})
+	}
+}
+
+func TestLeaveRequestServiceImpl_MultiStepApproval(t *testing.T) {
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	applicantAccountID := uuid.New()
+	managerAccountID := uuid.New()
+	hrAccountID := uuid.New()
+	managerAccount := &models.Account{ID: managerAccountID, Role: models.RoleEmployee}
+	hrAccount := &models.Account{ID: hrAccountID, Role: models.RoleHR}
+	managerEmployment := &models.Employment{AccountID: applicantAccountID, ManagerID: &managerAccountID}
+	newPendingRequest := func() *models.LeaveRequest {
+		return &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantAccountID, LeaveType: models.LeaveTypeAnnual, Days: decimal.NewFromInt(7), Status: models.LeaveStatusPending}
+	}
+
+	t.Run("Success - Manager Step Advances Without Approving Request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		steps := pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager, models.ApprovalStepHR)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerAccountID).Return(managerAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(newPendingRequest(), nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(steps, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantAccountID).Return(managerEmployment, nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				assert.Equal(t, 1, step.StepOrder)
+				assert.Equal(t, models.ApprovalStepStatusApproved, step.Status)
+				assert.Equal(t, managerAccountID, *step.ActorID)
+				return nil
+			}).Times(1)
+		// 尚有 HR 步驟: 假單不更新、不扣餘額
+		expectLeaveEvent(t, m, models.LeaveEventStepApproved)
+		// 事務提交後通知 HR 步驟的審核人
+		m.notifier.EXPECT().NotifyApprovers(gomock.Any(), gomock.Any(), models.ApprovalStepHR, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest, kind, subject string) ([]models.Account, error) {
+				assert.Nil(t, ctx.Value(inTransactionKey{}), "approvers are notified after the transaction commits")
+				assert.Equal(t, leaveRequestID, req.ID)
+				return []models.Account{{ID: uuid.New(), Role: models.RoleHR}}, nil
+			}).Times(1)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), managerAccountID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Manager Cannot Sign Off HR Step", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		steps := pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager, models.ApprovalStepHR)
+		steps[0].Status = models.ApprovalStepStatusApproved
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerAccountID).Return(managerAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(newPendingRequest(), nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(steps, nil).Times(1)
+		m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), managerAccountID, gomock.Any()).Return(nil, nil).Times(1)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), managerAccountID.String())
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
+	})
+
+	t.Run("Success - HR Signs Off Last Step", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		steps := pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager, models.ApprovalStepHR)
+		steps[0].Status = models.ApprovalStepStatusApproved
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrAccountID).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(newPendingRequest(), nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(steps, nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				assert.Equal(t, 2, step.StepOrder)
+				assert.Equal(t, models.ApprovalStepStatusApproved, step.Status)
+				return nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusApproved, req.Status)
+				assert.Equal(t, hrAccountID, *req.ApproverID)
+				return nil
+			}).Times(1)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), hrAccountID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Success - Rejection Ends Chain And Skips Later Steps", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		steps := pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager, models.ApprovalStepHR)
+		var recorded []string
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerAccountID).Return(managerAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(newPendingRequest(), nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(steps, nil).Times(1)
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantAccountID).Return(managerEmployment, nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				recorded = append(recorded, step.Status)
+				return nil
+			}).Times(2)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusRejected, req.Status)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventRejected)
+
+		err := service.RejectRequest(ctx, leaveRequestID.String(), managerAccountID.String(), "Peak season")
+		require.NoError(t, err)
+		assert.Equal(t, []string{models.ApprovalStepStatusRejected, models.ApprovalStepStatusSkipped}, recorded)
+	})
+
+	t.Run("Success - Legacy Request Gets Steps From Policy", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		policies := []models.LeaveApprovalPolicy{{ID: uuid.New(), LeaveType: models.LeaveTypeAnnual, MinDays: decimal.NewFromInt(5), Steps: "manager,hr", Active: true}}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrAccountID).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(newPendingRequest(), nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(policies, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, steps []models.LeaveApprovalStep) error {
+				require.Len(t, steps, 2)
+				assert.Equal(t, models.ApprovalStepManager, steps[0].ApproverKind)
+				assert.Equal(t, models.ApprovalStepHR, steps[1].ApproverKind)
+				return nil
+			}).Times(1)
+		expectNoAttachmentRule(m)
+		// HR 覆核主管步驟後, 仍需等待 HR 步驟
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventStepApproved)
+		expectApproversNotified(m, models.ApprovalStepHR)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), hrAccountID.String())
+		require.NoError(t, err)
+	})
+}
+
+func TestLeaveRequestServiceImpl_DelegatedApproval(t *testing.T) {
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	applicantAccountID := uuid.New()
+	managerAccountID := uuid.New()
+	hrLeadAccountID := uuid.New()
+	delegateAccountID := uuid.New()
+	delegateAccount := &models.Account{ID: delegateAccountID, Role: models.RoleEmployee}
+	hrLeadAccount := &models.Account{ID: hrLeadAccountID, Role: models.RoleHR}
+	managerAccount := &models.Account{ID: managerAccountID, Role: models.RoleEmployee}
+	newPendingRequest := func() *models.LeaveRequest {
+		return &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantAccountID, LeaveType: models.LeaveTypeAnnual, Days: decimal.NewFromInt(7), Status: models.LeaveStatusPending}
+	}
+	hrStepPending := func() []models.LeaveApprovalStep {
+		steps := pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager, models.ApprovalStepHR)
+		steps[0].Status = models.ApprovalStepStatusApproved
+		return steps
+	}
+
+	t.Run("Success - Delegate Signs Off HR Step On Behalf Of HR Lead", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		delegation := models.ApprovalDelegation{ID: uuid.New(), DelegatorID: hrLeadAccountID, DelegateID: delegateAccountID, Scope: models.DelegationScopeAll}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateAccountID).Return(delegateAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(newPendingRequest(), nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(hrStepPending(), nil).Times(1)
+		m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), delegateAccountID, gomock.Any()).Return([]models.ApprovalDelegation{delegation}, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrLeadAccountID).Return(hrLeadAccount, nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), applicantAccountID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				assert.Equal(t, delegateAccountID, *step.ActorID)
+				require.NotNil(t, step.OnBehalfOfID)
+				assert.Equal(t, hrLeadAccountID, *step.OnBehalfOfID)
+				return nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusApproved, req.Status)
+				assert.Equal(t, delegateAccountID, *req.ApproverID)
+				require.NotNil(t, req.OnBehalfOfID)
+				assert.Equal(t, hrLeadAccountID, *req.OnBehalfOfID)
+				return nil
+			}).Times(1)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), delegateAccountID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Delegation Scope Does Not Cover HR Step", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		delegation := models.ApprovalDelegation{ID: uuid.New(), DelegatorID: hrLeadAccountID, DelegateID: delegateAccountID, Scope: models.DelegationScopeManager}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateAccountID).Return(delegateAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(newPendingRequest(), nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(hrStepPending(), nil).Times(1)
+		m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), delegateAccountID, gomock.Any()).Return([]models.ApprovalDelegation{delegation}, nil).Times(1)
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), delegateAccountID.String())
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
+	})
+
+	t.Run("Failure - Applicant Cannot Use Delegation On Own Request", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		ownRequest := newPendingRequest()
+		ownRequest.AccountID = delegateAccountID
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateAccountID).Return(delegateAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(ownRequest, nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(hrStepPending(), nil).Times(1)
+		// 申請人本人: 不查詢代理
+
+		err := service.ApproveRequest(ctx, leaveRequestID.String(), delegateAccountID.String())
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
+	})
+
+	t.Run("Success - Delegate Rejects On Behalf Of Manager", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		delegation := models.ApprovalDelegation{ID: uuid.New(), DelegatorID: managerAccountID, DelegateID: delegateAccountID, Scope: models.DelegationScopeManager}
+		employment := &models.Employment{AccountID: applicantAccountID, ManagerID: &managerAccountID}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), delegateAccountID).Return(delegateAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(newPendingRequest(), nil).Times(1)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), leaveRequestID).Return(pendingApprovalSteps(leaveRequestID, models.ApprovalStepManager), nil).Times(1)
+		// 代理人本身不是主管, 委託人是
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantAccountID).Return(employment, nil).Times(2)
+		m.delegationRepo.EXPECT().ListActiveForDelegate(gomock.Any(), delegateAccountID, gomock.Any()).Return([]models.ApprovalDelegation{delegation}, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), managerAccountID).Return(managerAccount, nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusRejected, req.Status)
+				assert.Equal(t, delegateAccountID, *req.ApproverID)
+				require.NotNil(t, req.OnBehalfOfID)
+				assert.Equal(t, managerAccountID, *req.OnBehalfOfID)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventRejected)
+
+		err := service.RejectRequest(ctx, leaveRequestID.String(), delegateAccountID.String(), "")
+		require.NoError(t, err)
+	})
+}
+
+func TestResolveApprovalSteps(t *testing.T) {
+	policies := []models.LeaveApprovalPolicy{
+		{LeaveType: "", MinDays: decimal.NewFromInt(10), Steps: "manager,hr", Active: true},
+		{LeaveType: models.LeaveTypeAnnual, MinDays: decimal.NewFromInt(3), Steps: "manager", Active: true},
+		{LeaveType: models.LeaveTypeAnnual, MinDays: decimal.NewFromInt(5), Steps: "manager, hr", Active: true},
+		{LeaveType: models.LeaveTypeSick, MinDays: decimal.Zero, Steps: "hr", Active: false},
+		{LeaveType: models.LeaveTypePersonal, MinDays: decimal.Zero, Steps: "unknown", Active: true},
+	}
+
+	testCases := []struct {
+		name      string
+		leaveType string
+		days      decimal.Decimal
+		expected  []string
+	}{
+		{"No Policy Matches Uses Default", models.LeaveTypeVacation, decimal.NewFromInt(1), []string{models.ApprovalStepManager}},
+		{"Type Specific Below Threshold", models.LeaveTypeAnnual, decimal.NewFromInt(4), []string{models.ApprovalStepManager}},
+		{"Type Specific Highest Threshold", models.LeaveTypeAnnual, decimal.NewFromInt(5), []string{models.ApprovalStepManager, models.ApprovalStepHR}},
+		{"Type Specific Beats Wildcard", models.LeaveTypeAnnual, decimal.NewFromInt(12), []string{models.ApprovalStepManager, models.ApprovalStepHR}},
+		{"Wildcard Applies To Other Types", models.LeaveTypeVacation, decimal.NewFromInt(10), []string{models.ApprovalStepManager, models.ApprovalStepHR}},
+		{"Inactive Policy Ignored", models.LeaveTypeSick, decimal.NewFromInt(2), []string{models.ApprovalStepManager}},
+		{"Policy Without Valid Steps Uses Default", models.LeaveTypePersonal, decimal.NewFromInt(1), []string{models.ApprovalStepManager}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, resolveApprovalSteps(policies, tc.leaveType, tc.days))
+		})
+	}
+}
+
+func TestLeaveRequestServiceImpl_ListManagedRequests(t *testing.T) {
+	ctx := context.Background()
+	managerID := uuid.New()
+
+	t.Run("Success - Lists Requests Of Direct Reports", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		reportA, reportB := uuid.New(), uuid.New()
+
+		m.employmentRepo.EXPECT().ListEmploymentsByManagerID(gomock.Any(), managerID).
+			Return([]models.Employment{{AccountID: reportA}, {AccountID: reportB}}, nil).Times(1)
+		m.leaveRepo.EXPECT().ListByAccountIDs(gomock.Any(), []uuid.UUID{reportA, reportB}).
+			Return([]models.LeaveRequest{{AccountID: reportA, Account: models.Account{Password: "hashed"}}}, nil).Times(1)
+
+		requests, err := service.ListManagedRequests(ctx, managerID.String())
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		assert.Empty(t, requests[0].Account.Password, "password should be cleared")
+	})
+
+	t.Run("Success - No Direct Reports", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.employmentRepo.EXPECT().ListEmploymentsByManagerID(gomock.Any(), managerID).Return(nil, nil).Times(1)
+		// ListByAccountIDs should NOT be called
+
+		requests, err := service.ListManagedRequests(ctx, managerID.String())
+		require.NoError(t, err)
+		assert.Empty(t, requests)
+	})
+}
+
+// --- Test ApplyForLeave ---
+func TestLeaveRequestServiceImpl_ApplyForLeave(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	accountIDStr := accountID.String()
+	leaveType := models.LeaveTypePersonal
+	startDate := time.Now().AddDate(0, 0, 5)
+	endDate := time.Now().AddDate(0, 0, 7)
+	reason := "Family matter"
+	input := models.LeaveRequestInput{LeaveType: leaveType, Reason: reason, StartDate: startDate, EndDate: endDate}
+
+	mockAccount := &models.Account{ID: accountID, Role: models.RoleEmployee} // Applicant account
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo, mockAccountRepo := m.leaveRepo, m.accountRepo
+
+		// 1. Expect Account check
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		// 2. Expect working-day calculation (e.g. 3 calendar days spanning a weekend day)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		// 3. Expect overlap check against pending/approved leave
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		// 4. Expect balance check for the working days only
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uuid.UUID, lt string, days decimal.Decimal) error {
+				assert.True(t, decimal.NewFromInt(2).Equal(days))
+				return nil
+			}).Times(1)
+		// 5. Expect Create call
+		mockLeaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "request should be created inside the transaction")
+				assert.Equal(t, accountID, req.AccountID)
+				assert.Equal(t, leaveType, req.LeaveType) // 檢查假單類型 "personal"
+				assert.Equal(t, startDate, req.StartDate)
+				assert.Equal(t, endDate, req.EndDate)
+				assert.True(t, decimal.NewFromInt(2).Equal(req.Days), "working days should be persisted")
+				assert.Equal(t, models.LeaveDurationFullDay, req.DurationUnit)
+				assert.Equal(t, reason, req.Reason) // 檢查請假原因 "Family matter"
+				assert.Equal(t, models.LeaveStatusPending, req.Status)
+				req.ID = uuid.New()
+				req.RequestedAt = time.Now()
+				return nil
+			}).Times(1)
+		// 6. Expect the approval chain to be created (no policy matches -> default single manager step)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, steps []models.LeaveApprovalStep) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "approval steps should be created inside the transaction")
+				require.Len(t, steps, 1)
+				assert.Equal(t, 1, steps[0].StepOrder)
+				assert.Equal(t, models.ApprovalStepManager, steps[0].ApproverKind)
+				assert.Equal(t, models.ApprovalStepStatusPending, steps[0].Status)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+		// 7. Expect the manager step's approvers to be notified with the applicant's details
+		m.notifier.EXPECT().NotifyApprovers(gomock.Any(), gomock.Any(), models.ApprovalStepManager, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest, kind, subject string) ([]models.Account, error) {
+				assert.Equal(t, accountID, req.Account.ID)
+				assert.NotEqual(t, uuid.Nil, req.ID)
+				return []models.Account{{ID: uuid.New()}}, nil
+			}).Times(1)
+
+		// Execute
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		// Assert
+		require.NoError(t, err)
+		require.NotNil(t, createdRequest)
+		assert.NotEqual(t, uuid.Nil, createdRequest.ID)
+		assert.Equal(t, accountID, createdRequest.AccountID)
+		assert.Equal(t, leaveType, createdRequest.LeaveType)
+		assert.Equal(t, models.LeaveStatusPending, createdRequest.Status)
+	})
+
+	t.Run("Failure - Invalid Account ID Format", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveServiceWithMocks(ctrl)
+
+		createdRequest, err := service.ApplyForLeave(ctx, "invalid-uuid", input)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid user identifier format")
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Account Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockAccountRepo := m.accountRepo
+
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrAccountNotFound) // Expect service level error
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Invalid Date Range", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockAccountRepo := m.accountRepo
+		invalidEndDate := startDate.AddDate(0, 0, -1) // End date before start date
+
+		// Account check should still happen before date validation
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		// Create should NOT be called
+
+		invalidInput := input
+		invalidInput.EndDate = invalidEndDate
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, invalidInput)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Create Repo Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo, mockAccountRepo := m.leaveRepo, m.accountRepo
+		repoError := errors.New("db create failed")
+
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, gomock.Any()).Return(nil).Times(1)
+		mockLeaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(repoError).Times(1)
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrLeaveApplyFailed)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Approval Steps Error Rolls Back Submission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "request should be created inside the transaction")
+				req.ID = uuid.New()
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+		// 審核流程建立失敗: 事務回滾, 不通知審核人
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrLeaveApplyFailed)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Insufficient Balance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, gomock.Any()).Return(ErrInsufficientLeaveBalance).Times(1)
+		// Create should NOT be called
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrInsufficientLeaveBalance)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Overlaps Existing Leave", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		existing := models.LeaveRequest{ID: uuid.New(), AccountID: accountID, StartDate: endDate, EndDate: endDate.AddDate(0, 0, 2), Status: models.LeaveStatusApproved}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, startDate, endDate, activeLeaveStatuses).
+			Return([]models.LeaveRequest{existing}, nil).Times(1)
+		// Balance check and Create should NOT be called
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		assert.ErrorIs(t, err, ErrOverlappingLeave)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Blackout Period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		violation := &models.LeaveRuleViolation{Rule: models.LeaveRuleBlackout, RuleID: uuid.New(), RuleName: "Q2 close",
+			StartDate: &startDate, EndDate: &endDate, Message: "leave is not allowed during blackout period Q2 close"}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		m.ruleSvc.EXPECT().CheckLeaveRules(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) (*models.LeaveRuleViolation, error) {
+				assert.Equal(t, accountID, req.AccountID)
+				assert.Equal(t, leaveType, req.LeaveType)
+				return violation, nil
+			}).Times(1)
+		// Balance check and Create should NOT be called
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		var violationErr *LeaveRuleViolationError
+		require.ErrorAs(t, err, &violationErr)
+		assert.Equal(t, models.LeaveRuleBlackout, violationErr.Violation.Rule)
+		assert.ErrorIs(t, err, ErrLeaveRuleViolation)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Employee Cannot Override Leave Rule", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		overrideInput := input
+		overrideInput.OverrideJustification = "my manager agreed"
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		// Rule check and Create should NOT be called
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, overrideInput)
+
+		assert.ErrorIs(t, err, ErrRuleOverrideNotAllowed)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Success - Half Day PM Alongside Existing Half Day AM", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		existing := models.LeaveRequest{ID: uuid.New(), AccountID: accountID, StartDate: startDate, EndDate: startDate,
+			DurationUnit: models.LeaveDurationHalfDayAM, Status: models.LeaveStatusPending}
+		pmInput := models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: startDate, DurationUnit: models.LeaveDurationHalfDayPM}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, startDate).Return(decimal.NewFromInt(1), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, startDate, startDate, gomock.Any()).
+			Return([]models.LeaveRequest{existing}, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+		expectApproversNotified(m, models.ApprovalStepManager)
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, pmInput)
+		require.NoError(t, err)
+		require.NotNil(t, createdRequest)
+	})
+
+	t.Run("Failure - No Working Days", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.Zero, nil).Times(1)
+		// Balance check and Create should NOT be called
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrNoWorkingDaysInRange)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Success - Partial Day Units", func(t *testing.T) {
+		partialCases := []struct {
+			name         string
+			input        models.LeaveRequestInput
+			expectedDays decimal.Decimal
+		}{
+			{"Half Day AM", models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: startDate, DurationUnit: models.LeaveDurationHalfDayAM}, decimal.NewFromFloat(0.5)},
+			{"Half Day PM", models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: startDate, DurationUnit: models.LeaveDurationHalfDayPM}, decimal.NewFromFloat(0.5)},
+			{"Hours", models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: startDate, DurationUnit: models.LeaveDurationHours, StartTime: "09:00", EndTime: "11:00"}, decimal.NewFromFloat(0.25)},
+		}
+		for _, pc := range partialCases {
+			t.Run(pc.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+				service, m := newLeaveServiceWithMocks(ctrl)
+
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+				expectLeaveType(m, paidLeaveType)
+				m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, startDate).Return(decimal.NewFromInt(1), nil).Times(1)
+				expectNoAttachmentRule(m)
+				m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+				expectNoRuleViolation(m)
+				m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, pc.expectedDays).Return(nil).Times(1)
+				m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+						assert.Equal(t, pc.input.DurationUnit, req.DurationUnit)
+						assert.True(t, pc.expectedDays.Equal(req.Days), "expected %s days, got %s", pc.expectedDays, req.Days)
+						assert.Equal(t, pc.input.StartTime, req.StartTime)
+						assert.Equal(t, pc.input.EndTime, req.EndTime)
+						return nil
+					}).Times(1)
+				m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+				m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+				expectApproversNotified(m, models.ApprovalStepManager)
+
+				createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, pc.input)
+				require.NoError(t, err)
+				require.NotNil(t, createdRequest)
+			})
+		}
+	})
+
+	t.Run("Failure - Invalid Duration", func(t *testing.T) {
+		invalidCases := []struct {
+			name  string
+			input models.LeaveRequestInput
+		}{
+			{"Unknown Unit", models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: startDate, DurationUnit: "quarter_day"}},
+			{"Half Day Spanning Multiple Dates", models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: endDate, DurationUnit: models.LeaveDurationHalfDayAM}},
+			{"Hours Missing Times", models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: startDate, DurationUnit: models.LeaveDurationHours}},
+			{"Hours End Before Start", models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: startDate, DurationUnit: models.LeaveDurationHours, StartTime: "14:00", EndTime: "10:00"}},
+			{"Hours Exceed Working Day", models.LeaveRequestInput{LeaveType: leaveType, StartDate: startDate, EndDate: startDate, DurationUnit: models.LeaveDurationHours, StartTime: "08:00", EndTime: "18:00"}},
+		}
+		for _, ic := range invalidCases {
+			t.Run(ic.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+				service, m := newLeaveServiceWithMocks(ctrl)
+
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+				expectLeaveType(m, paidLeaveType)
+				// Working-day calculation, balance check and Create should NOT be called
+
+				createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, ic.input)
+				require.Error(t, err)
+				assert.ErrorIs(t, err, ErrInvalidLeaveDuration)
+				assert.Nil(t, createdRequest)
+			})
+		}
+	})
+
+	t.Run("Failure - Required Attachment Missing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		sickInput := input
+		sickInput.LeaveType = models.LeaveTypeSick
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(3), nil).Times(1)
+		m.attachmentSvc.EXPECT().IsAttachmentRequired(gomock.Any(), models.LeaveTypeSick, gomock.Any()).Return(true, nil).Times(1)
+		// Overlap check, balance check and Create should NOT be called
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, sickInput)
+
+		assert.ErrorIs(t, err, ErrAttachmentRequired)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Invalid Attachment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		upload := models.AttachmentUpload{FileName: "note.exe", Data: []byte("MZ")}
+		withAttachment := input
+		withAttachment.Attachments = []models.AttachmentUpload{upload}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.attachmentSvc.EXPECT().ValidateUpload(upload).Return(ErrUnsupportedAttachmentType).Times(1)
+		// Create should NOT be called
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, withAttachment)
+
+		assert.ErrorIs(t, err, ErrUnsupportedAttachmentType)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Success - With Attachment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		upload := models.AttachmentUpload{FileName: "certificate.pdf", Data: []byte("%PDF-1.4")}
+		withAttachment := input
+		withAttachment.Attachments = []models.AttachmentUpload{upload}
+		createdID := uuid.New()
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.attachmentSvc.EXPECT().ValidateUpload(upload).Return(nil).Times(1)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		// Rule lookup is skipped when attachments are supplied
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				req.ID = createdID
+				return nil
+			}).Times(1)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+		expectApproversNotified(m, models.ApprovalStepManager)
+		m.attachmentSvc.EXPECT().SaveAttachment(gomock.Any(), createdID, accountID, upload).
+			Return(&models.LeaveAttachment{ID: uuid.New(), LeaveRequestID: createdID}, nil).Times(1)
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, withAttachment)
+
+		require.NoError(t, err)
+		require.NotNil(t, createdRequest)
+		assert.Equal(t, createdID, createdRequest.ID)
+	})
+
+	t.Run("Failure - Attachment Save Failed After Submit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		upload := models.AttachmentUpload{FileName: "certificate.pdf", Data: []byte("%PDF-1.4")}
+		withAttachment := input
+		withAttachment.Attachments = []models.AttachmentUpload{upload}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.attachmentSvc.EXPECT().ValidateUpload(upload).Return(nil).Times(1)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+		expectApproversNotified(m, models.ApprovalStepManager)
+		m.attachmentSvc.EXPECT().SaveAttachment(gomock.Any(), gomock.Any(), accountID, upload).Return(nil, ErrAttachmentSaveFailed).Times(1)
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, withAttachment)
+
+		assert.ErrorIs(t, err, ErrAttachmentSaveFailed)
+		require.NotNil(t, createdRequest, "the request is still submitted")
+	})
+
+	t.Run("Failure - Unknown Leave Type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		unknownInput := input
+		unknownInput.LeaveType = "sabbatical"
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		m.leaveTypeSvc.EXPECT().ResolveLeaveType(gomock.Any(), "sabbatical", models.RoleEmployee).
+			Return(nil, fmt.Errorf("%w: 'sabbatical'", ErrUnknownLeaveType)).Times(1)
+		// Working days, balance check and Create should NOT be called
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, unknownInput)
+
+		assert.ErrorIs(t, err, ErrUnknownLeaveType)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Exceeds Max Consecutive Days", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		limited := paidLeaveType
+		limited.MaxConsecutiveDays = 2 // 申請跨 3 個日曆天
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, limited)
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		assert.ErrorIs(t, err, ErrLeaveTypeMaxDaysExceeded)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Failure - Leave Type Always Requires Attachment", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		documented := paidLeaveType
+		documented.RequiresAttachment = true
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, documented)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		// The day-based attachment rule is not consulted
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		assert.ErrorIs(t, err, ErrAttachmentRequired)
+		assert.Nil(t, createdRequest)
+	})
+
+	t.Run("Success - Unpaid Leave Skips Balance Check", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		unpaid := paidLeaveType
+		unpaid.Paid = false
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, unpaid)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		// CheckSufficientBalance should NOT be called
+		m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.True(t, req.Unpaid, "unpaid flag is recorded on the request")
+				return nil
+			}).Times(1)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+		expectApproversNotified(m, models.ApprovalStepManager)
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		require.NoError(t, err)
+		assert.True(t, createdRequest.Unpaid)
+	})
+
+	t.Run("Success - Notification Failure Does Not Fail Submission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, leaveType, gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+		m.notifier.EXPECT().NotifyApprovers(gomock.Any(), gomock.Any(), models.ApprovalStepManager, gomock.Any()).
+			Return(nil, errors.New("smtp down")).Times(1)
+
+		createdRequest, err := service.ApplyForLeave(ctx, accountIDStr, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveStatusPending, createdRequest.Status)
+	})
+}
+
+// 透過 HR 假別 CRUD 新增的有薪假別沒有累積規則, 仍必須可以申請
+func TestLeaveRequestServiceImpl_ApplyForLeave_HRCreatedPaidType(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	accountID := uuid.New()
+	startDate := time.Now().AddDate(0, 0, 5)
+	endDate := startDate.AddDate(0, 0, 1)
+
+	// 使用真實的假別與額度服務, 僅模擬其 repository
+	mockLeaveTypeRepo := mocks.NewMockLeaveTypeRepository(ctrl)
+	mockBalanceRepo := mocks.NewMockLeaveBalanceRepository(ctrl)
+	leaveTypeSvc := NewLeaveTypeServiceImpl(mockLeaveTypeRepo)
+	_, m := newLeaveServiceWithMocks(ctrl)
+	service := NewLeaveRequestServiceImpl(m.leaveRepo, m.accountRepo, m.employmentRepo, m.approvalRepo, m.delegationRepo, m.eventRepo,
+		NewLeaveBalanceServiceImpl(mockBalanceRepo, m.employmentRepo), m.holidaySvc, m.attachmentSvc, m.ruleSvc, leaveTypeSvc, m.notifier, m.txManager)
+
+	// 1. HR 新增有薪假別 "bereavement"
+	mockLeaveTypeRepo.EXPECT().GetByCode(gomock.Any(), "bereavement").Return(nil, gorm.ErrRecordNotFound).Times(1)
+	mockLeaveTypeRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	created, err := leaveTypeSvc.CreateLeaveType(ctx, &models.LeaveType{Code: "bereavement", Name: "Bereavement leave", Paid: true})
+	require.NoError(t, err)
+
+	// 2. 員工申請該假別; 累積規則只涵蓋年假
+	m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), accountID).Return(&models.Account{ID: accountID, Role: models.RoleEmployee}, nil).Times(1)
+	mockLeaveTypeRepo.EXPECT().GetByCode(gomock.Any(), "bereavement").Return(created, nil).Times(1)
+	m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+	expectNoAttachmentRule(m)
+	m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	expectNoRuleViolation(m)
+	mockBalanceRepo.EXPECT().ListActiveAccrualRules(gomock.Any()).Return([]models.LeaveAccrualRule{
+		{LeaveType: models.LeaveTypeAnnual, Frequency: models.AccrualFrequencyMonthly, Amount: decimal.NewFromInt(1), Active: true},
+	}, nil).Times(1)
+	// 沒有累積規則的假別不加總帳本
+	mockBalanceRepo.EXPECT().SumByAccount(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+	m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	expectLeaveEvent(t, m, models.LeaveEventSubmitted)
+	expectApproversNotified(m, models.ApprovalStepManager)
+
+	createdRequest, err := service.ApplyForLeave(ctx, accountID.String(), models.LeaveRequestInput{
+		LeaveType: "bereavement", Reason: "Funeral", StartDate: startDate, EndDate: endDate,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "bereavement", createdRequest.LeaveType)
+	assert.False(t, createdRequest.Unpaid)
+}
+
+func TestLeaveRequestServiceImpl_ApplyForLeaveOnBehalf(t *testing.T) {
+	ctx := context.Background()
+	hrID := uuid.New()
+	employeeID := uuid.New()
+	hrAccount := &models.Account{ID: hrID, Role: models.RoleHR}
+	employeeAccount := &models.Account{ID: employeeID, Role: models.RoleEmployee}
+	startDate := time.Now().AddDate(0, 0, 1)
+	input := models.LeaveRequestInput{LeaveType: models.LeaveTypeSick, Reason: "Called in sick", StartDate: startDate, EndDate: startDate}
+
+	// expectSubmission 預期一次完整的申請流程 (驗證、建立假單、提交事件與審核流程)
+	expectSubmission := func(t *testing.T, m *leaveServiceMocks) {
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, startDate).Return(decimal.NewFromInt(1), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), employeeID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), employeeID, models.LeaveTypeSick, gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, employeeID, req.AccountID)
+				require.NotNil(t, req.CreatedByID)
+				assert.Equal(t, hrID, *req.CreatedByID)
+				assert.Equal(t, models.LeaveStatusPending, req.Status)
+				req.ID = uuid.New()
+				return nil
+			}).Times(1)
+		m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+				assert.Equal(t, models.LeaveEventSubmitted, e.EventType)
+				require.NotNil(t, e.ActorID)
+				assert.Equal(t, hrID, *e.ActorID, "the creator is recorded as the submitter")
+				return nil
+			}).Times(1)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	}
+
+	t.Run("Success - HR Overrides Leave Rule On Submission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		violation := &models.LeaveRuleViolation{Rule: models.LeaveRuleMinStaffing, RuleID: uuid.New(), RuleName: "Support cover",
+			Message: "approving this leave would leave 1 on duty, below the minimum of 2"}
+		overrideInput := input
+		overrideInput.OverrideJustification = " contractor covers the shift "
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hrAccount, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(employeeAccount, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), startDate, startDate).Return(decimal.NewFromInt(1), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), employeeID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		m.ruleSvc.EXPECT().CheckLeaveRules(gomock.Any(), gomock.Any()).Return(violation, nil).Times(1)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), employeeID, models.LeaveTypeSick, gomock.Any()).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				require.NotNil(t, req.OverriddenRuleID)
+				assert.Equal(t, violation.RuleID, *req.OverriddenRuleID)
+				assert.Equal(t, "contractor covers the shift", req.RuleOverrideNote)
+				req.ID = uuid.New()
+				return nil
+			}).Times(1)
+		gomock.InOrder(
+			m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+					assert.Equal(t, models.LeaveEventSubmitted, e.EventType)
+					return nil
+				}),
+			m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+					assert.Equal(t, models.LeaveEventRuleOverridden, e.EventType)
+					assert.Contains(t, e.Comment, violation.Message)
+					assert.Contains(t, e.Comment, "justification: contractor covers the shift")
+					require.NotNil(t, e.ActorID)
+					assert.Equal(t, hrID, *e.ActorID)
+					return nil
+				}),
+		)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		expectApproversNotified(m, models.ApprovalStepManager)
+
+		request, err := service.ApplyForLeaveOnBehalf(ctx, hrID.String(), employeeID.String(), overrideInput, false)
+
+		require.NoError(t, err)
+		require.NotNil(t, request)
+		assert.Equal(t, models.LeaveStatusPending, request.Status)
+	})
+
+	t.Run("Success - Pending For Normal Approval", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hrAccount, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(employeeAccount, nil).Times(1)
+		expectSubmission(t, m)
+		expectApproversNotified(m, models.ApprovalStepManager)
+
+		request, err := service.ApplyForLeaveOnBehalf(ctx, hrID.String(), employeeID.String(), input, false)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveStatusPending, request.Status)
+	})
+
+	t.Run("Success - Pre-approved By Creator", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hrAccount, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(employeeAccount, nil).Times(1)
+		expectSubmission(t, m)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uuid.UUID) ([]models.LeaveApprovalStep, error) {
+				return pendingApprovalSteps(id, models.ApprovalStepManager), nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), employeeID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, step *models.LeaveApprovalStep) error {
+				assert.Equal(t, models.ApprovalStepStatusApproved, step.Status)
+				require.NotNil(t, step.ActorID)
+				assert.Equal(t, hrID, *step.ActorID)
+				return nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusApproved, req.Status)
+				require.NotNil(t, req.ApproverID)
+				assert.Equal(t, hrID, *req.ApproverID)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventApproved)
+		m.balanceSvc.EXPECT().DebitForLeave(gomock.Any(), gomock.Any(), decimal.NewFromInt(1)).Return(nil).Times(1)
+		// 預先核准的假單不通知審核人 (NotifyApprovers should NOT be called)
+
+		request, err := service.ApplyForLeaveOnBehalf(ctx, hrID.String(), employeeID.String(), input, true)
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveStatusApproved, request.Status)
+	})
+
+	t.Run("Failure - Pre-approval Fails After Submission", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hrAccount, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(employeeAccount, nil).Times(1)
+		expectSubmission(t, m)
+		m.approvalRepo.EXPECT().ListStepsByRequestID(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, id uuid.UUID) ([]models.LeaveApprovalStep, error) {
+				return pendingApprovalSteps(id, models.ApprovalStepManager), nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().LockOverlapping(gomock.Any(), employeeID, gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().UpdateStep(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+		// 預先核准失敗時假單等待一般審核，改為通知審核人
+		expectApproversNotified(m, models.ApprovalStepManager)
+
+		request, err := service.ApplyForLeaveOnBehalf(ctx, hrID.String(), employeeID.String(), input, true)
+
+		assert.ErrorIs(t, err, ErrLeavePreApprovalFailed)
+		require.NotNil(t, request, "the submitted request is returned so the caller can follow up")
+		assert.Equal(t, models.LeaveStatusPending, request.Status)
+	})
+
+	t.Run("Failure - Creator Is Not HR", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(&models.Account{ID: hrID, Role: models.RoleEmployee}, nil).Times(1)
+
+		request, err := service.ApplyForLeaveOnBehalf(ctx, hrID.String(), employeeID.String(), input, false)
+
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
+		assert.Nil(t, request)
+	})
+
+	t.Run("Failure - Cannot Pre-approve Own Leave", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hrAccount, nil).Times(1)
+
+		request, err := service.ApplyForLeaveOnBehalf(ctx, hrID.String(), hrID.String(), input, true)
+
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
+		assert.Nil(t, request)
+	})
+
+	t.Run("Failure - Applicant Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hrAccount, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		request, err := service.ApplyForLeaveOnBehalf(ctx, hrID.String(), employeeID.String(), input, false)
+
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+		assert.Nil(t, request)
+	})
+}
+
+func TestLeaveRequestServiceImpl_UpdatePendingRequest(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	leaveRequestID := uuid.New()
+	account := &models.Account{ID: accountID, Role: models.RoleEmployee}
+	oldStart := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	newStart := time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+	input := models.LeaveRequestInput{LeaveType: models.LeaveTypeAnnual, Reason: "Family trip", StartDate: newStart, EndDate: endDate}
+	storedRequest := func(status string) *models.LeaveRequest {
+		remindedAt := time.Now().Add(-time.Hour)
+		return &models.LeaveRequest{ID: leaveRequestID, AccountID: accountID, LeaveType: models.LeaveTypeAnnual, Reason: "Family trip",
+			StartDate: oldStart, EndDate: endDate, Days: decimal.NewFromInt(3), DurationUnit: models.LeaveDurationFullDay,
+			Status: status, LastRemindedAt: &remindedAt}
+	}
+	expectValidations := func(m *leaveServiceMocks, days int64) {
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), newStart, endDate).Return(decimal.NewFromInt(days), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]models.LeaveRequest{*storedRequest(models.LeaveStatusPending)}, nil).Times(1) // 假單本身不算重疊
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, models.LeaveTypeAnnual, decimal.NewFromInt(days)).Return(nil).Times(1)
+	}
+
+	t.Run("Success - Records Changes And Restarts Approval", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(storedRequest(models.LeaveStatusPending), nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), accountID).Return(account, nil).Times(1)
+		expectValidations(m, 2)
+		m.approvalRepo.EXPECT().DeleteStepsByRequestID(gomock.Any(), leaveRequestID).Return(nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, newStart, req.StartDate)
+				assert.True(t, decimal.NewFromInt(2).Equal(req.Days))
+				assert.Equal(t, models.LeaveStatusPending, req.Status)
+				assert.Nil(t, req.LastRemindedAt, "reminder timer restarts with the new approval chain")
+				return nil
+			}).Times(1)
+		m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+				assert.Equal(t, models.LeaveEventEdited, e.EventType)
+				assert.Equal(t, "start_date: 2025-03-03 -> 2025-03-04; days: 3 -> 2", e.Comment)
+				assert.Equal(t, &accountID, e.ActorID)
+				return nil
+			}).Times(1)
+		m.approvalRepo.EXPECT().ListActivePolicies(gomock.Any()).Return(nil, nil).Times(1)
+		m.approvalRepo.EXPECT().CreateSteps(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		updated, err := service.UpdatePendingRequest(ctx, leaveRequestID.String(), accountID.String(), input)
+
+		require.NoError(t, err)
+		assert.Equal(t, newStart, updated.StartDate)
+	})
+
+	t.Run("Failure - Version Conflict Keeps Approval Steps", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		stepsDeleted := false // 模擬資料庫中的步驟，只在事務提交後才真正刪除
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(storedRequest(models.LeaveStatusPending), nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), accountID).Return(account, nil).Times(1)
+		expectValidations(m, 2)
+		m.approvalRepo.EXPECT().DeleteStepsByRequestID(gomock.Any(), leaveRequestID).
+			DoAndReturn(func(ctx context.Context, id uuid.UUID) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "step deletion must run inside the transaction")
+				m.afterCommit = append(m.afterCommit, func() { stepsDeleted = true })
+				return nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "request update must run inside the transaction")
+				return ErrConcurrentModification
+			}).Times(1)
+		// 事件與重建步驟 should NOT be called
+
+		updated, err := service.UpdatePendingRequest(ctx, leaveRequestID.String(), accountID.String(), input)
+
+		assert.ErrorIs(t, err, ErrConcurrentModification)
+		assert.Nil(t, updated)
+		assert.False(t, stepsDeleted, "approval steps must survive a version conflict")
+	})
+
+	t.Run("Success - No Changes Is A No-Op", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		stored := storedRequest(models.LeaveStatusPending)
+		stored.StartDate = newStart
+		stored.Days = decimal.NewFromInt(2)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(stored, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), accountID).Return(account, nil).Times(1)
+		expectValidations(m, 2)
+		// DeleteStepsByRequestID / Update / event should NOT be called
+
+		updated, err := service.UpdatePendingRequest(ctx, leaveRequestID.String(), accountID.String(), input)
+
+		require.NoError(t, err)
+		assert.Equal(t, stored, updated)
+	})
+
+	t.Run("Failure - Validation Error Leaves Request Unchanged", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(storedRequest(models.LeaveStatusPending), nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), accountID).Return(account, nil).Times(1)
+		expectLeaveType(m, paidLeaveType)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), newStart, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		expectNoAttachmentRule(m)
+		m.leaveRepo.EXPECT().ListOverlapping(gomock.Any(), accountID, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		expectNoRuleViolation(m)
+		m.balanceSvc.EXPECT().CheckSufficientBalance(gomock.Any(), accountID, models.LeaveTypeAnnual, gomock.Any()).Return(ErrInsufficientLeaveBalance).Times(1)
+
+		updated, err := service.UpdatePendingRequest(ctx, leaveRequestID.String(), accountID.String(), input)
+
+		assert.ErrorIs(t, err, ErrInsufficientLeaveBalance)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("Failure - Attachment Required And None Uploaded", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(storedRequest(models.LeaveStatusPending), nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), accountID).Return(account, nil).Times(1)
+		expectLeaveType(m, models.LeaveType{Name: "Sick", Paid: true, Active: true, RequiresAttachment: true})
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), newStart, endDate).Return(decimal.NewFromInt(2), nil).Times(1)
+		m.attachmentSvc.EXPECT().HasAttachments(gomock.Any(), leaveRequestID).Return(false, nil).Times(1)
+
+		updated, err := service.UpdatePendingRequest(ctx, leaveRequestID.String(), accountID.String(), input)
+
+		assert.ErrorIs(t, err, ErrAttachmentRequired)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("Failure - Not Owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(storedRequest(models.LeaveStatusPending), nil).Times(1)
+
+		updated, err := service.UpdatePendingRequest(ctx, leaveRequestID.String(), uuid.New().String(), input)
+
+		assert.ErrorIs(t, err, ErrNotLeaveRequestOwner)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("Failure - Not Pending", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(storedRequest(models.LeaveStatusApproved), nil).Times(1)
+
+		updated, err := service.UpdatePendingRequest(ctx, leaveRequestID.String(), accountID.String(), input)
+
+		assert.ErrorIs(t, err, ErrInvalidLeaveRequestState)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("Failure - Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		updated, err := service.UpdatePendingRequest(ctx, leaveRequestID.String(), accountID.String(), input)
+
+		assert.ErrorIs(t, err, ErrLeaveRequestNotFound)
+		assert.Nil(t, updated)
+	})
+}
+
+func TestLeaveRequestServiceImpl_GetRequestHistory(t *testing.T) {
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	applicantID := uuid.New()
+	otherID := uuid.New()
+	request := &models.LeaveRequest{ID: leaveRequestID, AccountID: applicantID, Status: models.LeaveStatusRejected}
+	events := []models.LeaveRequestEvent{
+		{ID: uuid.New(), LeaveRequestID: leaveRequestID, EventType: models.LeaveEventSubmitted, ToStatus: models.LeaveStatusPending, Actor: &models.Account{Password: "hash1"}},
+		{ID: uuid.New(), LeaveRequestID: leaveRequestID, EventType: models.LeaveEventRejected, FromStatus: models.LeaveStatusPending, ToStatus: models.LeaveStatusRejected, Comment: "Peak season"},
+	}
+
+	t.Run("Success - Applicant Views Own History", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		localEvents := append([]models.LeaveRequestEvent(nil), events...)
+		localEvents[0].Actor = &models.Account{Password: "hash1"}
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.eventRepo.EXPECT().ListByRequestID(gomock.Any(), leaveRequestID).Return(localEvents, nil).Times(1)
+
+		history, err := service.GetRequestHistory(ctx, leaveRequestID.String(), applicantID.String())
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, models.LeaveEventSubmitted, history[0].EventType)
+		assert.Equal(t, "", history[0].Actor.Password)
+	})
+
+	t.Run("Success - HR Views Any History", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), otherID).Return(&models.Account{ID: otherID, Role: models.RoleHR}, nil).Times(1)
+		m.eventRepo.EXPECT().ListByRequestID(gomock.Any(), leaveRequestID).Return(nil, nil).Times(1)
+
+		_, err := service.GetRequestHistory(ctx, leaveRequestID.String(), otherID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Other Employee", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), otherID).Return(&models.Account{ID: otherID, Role: models.RoleEmployee}, nil).Times(1)
+
+		_, err := service.GetRequestHistory(ctx, leaveRequestID.String(), otherID.String())
+		assert.ErrorIs(t, err, ErrNotLeaveRequestOwner)
+	})
+
+	t.Run("Failure - Leave Request Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		_, err := service.GetRequestHistory(ctx, leaveRequestID.String(), applicantID.String())
+		assert.ErrorIs(t, err, ErrLeaveRequestNotFound)
+	})
+}
+
+// --- Test ListAccountRequests ---
+func TestLeaveRequestServiceImpl_ListAccountRequests(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	accountIDStr := accountID.String()
+
+	mockAccount := &models.Account{ID: accountID, Role: models.RoleEmployee}
+	mockRequests := []models.LeaveRequest{
+		{ID: uuid.New(), AccountID: accountID, Status: models.LeaveStatusPending, Account: models.Account{Password: "hash1"}},
+		{ID: uuid.New(), AccountID: accountID, Status: models.LeaveStatusApproved, Account: models.Account{Password: "hash2"}, Approver: &models.Account{Password: "hash3"}},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo, mockAccountRepo := m.leaveRepo, m.accountRepo
+
+		// 1. Expect account validation
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		// 2. Expect list call
+		mockLeaveRepo.EXPECT().ListByAccountID(gomock.Any(), gomock.Eq(accountID)).Return(mockRequests, nil).Times(1)
+
+		// Execute
+		requests, err := service.ListAccountRequests(ctx, accountIDStr)
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, requests, 2)
+		assert.Equal(t, mockRequests[0].ID, requests[0].ID)
+		// Assert passwords cleared
+		assert.Equal(t, "", requests[0].Account.Password)
+		assert.NotNil(t, requests[1].Approver)
+		assert.Equal(t, "", requests[1].Approver.Password)
+	})
+
+	t.Run("Failure - Account Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockAccountRepo := m.accountRepo
+
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		// ListByAccountID should NOT be called
+
+		requests, err := service.ListAccountRequests(ctx, accountIDStr)
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrAccountNotFound)
+		assert.Nil(t, requests)
+	})
+
+	t.Run("Failure - List Repo Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo, mockAccountRepo := m.leaveRepo, m.accountRepo
+		repoError := errors.New("db list error")
+
+		mockAccountRepo.EXPECT().GetAccountByID(gomock.Any(), gomock.Eq(accountID)).Return(mockAccount, nil).Times(1)
+		mockLeaveRepo.EXPECT().ListByAccountID(gomock.Any(), gomock.Eq(accountID)).Return(nil, repoError).Times(1)
+
+		requests, err := service.ListAccountRequests(ctx, accountIDStr)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to retrieve leave requests")
+		assert.Nil(t, requests)
+	})
+
+}
+
+// --- Test GetLeaveRequestByID ---
+func TestLeaveRequestServiceImpl_GetLeaveRequestByID(t *testing.T) {
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	leaveRequestIDStr := leaveRequestID.String()
+	accountID := uuid.New()
 	approverID := uuid.New()
 
 	mockRequest := &models.LeaveRequest{
@@ -439,9 +2467,8 @@ func TestLeaveRequestServiceImpl_GetLeaveRequestByID(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo := m.leaveRepo
 
 		mockLeaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(mockRequest, nil).Times(1)
 
@@ -459,9 +2486,7 @@ func TestLeaveRequestServiceImpl_GetLeaveRequestByID(t *testing.T) {
 	t.Run("Failure - Invalid ID Format", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, _ := newLeaveServiceWithMocks(ctrl)
 
 		request, err := service.GetLeaveRequestByID(ctx, "not-a-uuid")
 
@@ -473,9 +2498,8 @@ func TestLeaveRequestServiceImpl_GetLeaveRequestByID(t *testing.T) {
 	t.Run("Failure - Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo := m.leaveRepo
 
 		mockLeaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(nil, gorm.ErrRecordNotFound).Times(1)
 
@@ -489,9 +2513,8 @@ func TestLeaveRequestServiceImpl_GetLeaveRequestByID(t *testing.T) {
 	t.Run("Failure - DB Error", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
-		mockLeaveRepo := mocks.NewMockLeaveRequestRepository(ctrl)
-		mockAccountRepo := mocks.NewMockAccountRepository(ctrl)
-		service := NewLeaveRequestServiceImpl(mockLeaveRepo, mockAccountRepo)
+		service, m := newLeaveServiceWithMocks(ctrl)
+		mockLeaveRepo := m.leaveRepo
 		dbError := errors.New("get by id db error")
 
 		mockLeaveRepo.EXPECT().GetByID(gomock.Any(), gomock.Eq(leaveRequestID)).Return(nil, dbError).Times(1)
@@ -503,3 +2526,491 @@ func TestLeaveRequestServiceImpl_GetLeaveRequestByID(t *testing.T) {
 		assert.Nil(t, request)
 	})
 }
+
+func TestLeavesOverlap(t *testing.T) {
+	day := time.Date(2025, time.July, 7, 0, 0, 0, 0, time.Local)
+	fullDay := func(start, end time.Time) *models.LeaveRequest {
+		return &models.LeaveRequest{StartDate: start, EndDate: end, DurationUnit: models.LeaveDurationFullDay}
+	}
+	partial := func(unit, from, to string) *models.LeaveRequest {
+		return &models.LeaveRequest{StartDate: day, EndDate: day, DurationUnit: unit, StartTime: from, EndTime: to}
+	}
+
+	testCases := []struct {
+		name     string
+		a, b     *models.LeaveRequest
+		expected bool
+	}{
+		{"Full days sharing an end date", fullDay(day, day.AddDate(0, 0, 2)), fullDay(day.AddDate(0, 0, 2), day.AddDate(0, 0, 4)), true},
+		{"Adjacent full days", fullDay(day, day.AddDate(0, 0, 1)), fullDay(day.AddDate(0, 0, 2), day.AddDate(0, 0, 3)), false},
+		{"Half day inside full-day range", fullDay(day.AddDate(0, 0, -1), day.AddDate(0, 0, 1)), partial(models.LeaveDurationHalfDayPM, "", ""), true},
+		{"Half day AM and PM on same date", partial(models.LeaveDurationHalfDayAM, "", ""), partial(models.LeaveDurationHalfDayPM, "", ""), false},
+		{"Two AM half days", partial(models.LeaveDurationHalfDayAM, "", ""), partial(models.LeaveDurationHalfDayAM, "", ""), true},
+		{"Morning hours and PM half day", partial(models.LeaveDurationHours, "09:00", "11:00"), partial(models.LeaveDurationHalfDayPM, "", ""), false},
+		{"Intersecting hours", partial(models.LeaveDurationHours, "09:00", "11:00"), partial(models.LeaveDurationHours, "10:30", "12:00"), true},
+		{"Back-to-back hours", partial(models.LeaveDurationHours, "09:00", "11:00"), partial(models.LeaveDurationHours, "11:00", "12:00"), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, leavesOverlap(tc.a, tc.b))
+			assert.Equal(t, tc.expected, leavesOverlap(tc.b, tc.a), "overlap should be symmetric")
+		})
+	}
+}
+
+func TestLeaveRequestServiceImpl_CancelRequest(t *testing.T) {
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	ownerID := uuid.New()
+	today := dateOf(time.Now())
+
+	t.Run("Success - Pending Request Cancelled Immediately", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: ownerID, Status: models.LeaveStatusPending, StartDate: today}
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusCancelled, req.Status)
+				require.NotNil(t, req.CancelledAt)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventCancelled)
+
+		result, err := service.CancelRequest(ctx, leaveRequestID.String(), ownerID.String())
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveStatusCancelled, result.Status)
+	})
+
+	t.Run("Success - Approved Future Request Awaits HR Confirmation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: ownerID, Status: models.LeaveStatusApproved, StartDate: today.AddDate(0, 0, 3)}
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusCancellationRequested, req.Status)
+				assert.Nil(t, req.CancelledAt, "cancelled_at is only set once HR confirms")
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventCancellationRequested)
+
+		result, err := service.CancelRequest(ctx, leaveRequestID.String(), ownerID.String())
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveStatusCancellationRequested, result.Status)
+	})
+
+	t.Run("Failure - Approved Leave Already Started", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: ownerID, Status: models.LeaveStatusApproved, StartDate: today}
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+
+		result, err := service.CancelRequest(ctx, leaveRequestID.String(), ownerID.String())
+		assert.ErrorIs(t, err, ErrLeaveAlreadyStarted)
+		assert.Nil(t, result)
+	})
+
+	t.Run("Failure - Not Owner", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: uuid.New(), Status: models.LeaveStatusPending}
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+
+		_, err := service.CancelRequest(ctx, leaveRequestID.String(), ownerID.String())
+		assert.ErrorIs(t, err, ErrNotLeaveRequestOwner)
+	})
+
+	t.Run("Failure - Rejected Request Cannot Be Cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, AccountID: ownerID, Status: models.LeaveStatusRejected}
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+
+		_, err := service.CancelRequest(ctx, leaveRequestID.String(), ownerID.String())
+		assert.ErrorIs(t, err, ErrInvalidLeaveRequestState)
+	})
+
+	t.Run("Failure - Leave Request Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		_, err := service.CancelRequest(ctx, leaveRequestID.String(), ownerID.String())
+		assert.ErrorIs(t, err, ErrLeaveRequestNotFound)
+	})
+}
+
+func TestLeaveRequestServiceImpl_ConfirmCancellation(t *testing.T) {
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	processorID := uuid.New()
+	hrAccount := &models.Account{ID: processorID, Role: models.RoleHR}
+
+	t.Run("Success - Cancels And Restores Balance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, Status: models.LeaveStatusCancellationRequested, Days: decimal.NewFromInt(2)}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorID).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusCancelled, req.Status)
+				require.NotNil(t, req.CancelledAt)
+				return nil
+			}).Times(1)
+		m.balanceSvc.EXPECT().RestoreForLeave(gomock.Any(), request).Return(nil).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventCancelled)
+
+		err := service.ConfirmCancellation(ctx, leaveRequestID.String(), processorID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - No Pending Cancellation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, Status: models.LeaveStatusApproved}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorID).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+
+		err := service.ConfirmCancellation(ctx, leaveRequestID.String(), processorID.String())
+		assert.ErrorIs(t, err, ErrInvalidLeaveRequestState)
+	})
+
+	t.Run("Failure - Processor Not HR", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorID).
+			Return(&models.Account{ID: processorID, Role: models.RoleEmployee}, nil).Times(1)
+
+		err := service.ConfirmCancellation(ctx, leaveRequestID.String(), processorID.String())
+		assert.ErrorIs(t, err, ErrInvalidProcessor)
+	})
+
+	t.Run("Failure - Balance Restore Error Rolls Back Cancellation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, Status: models.LeaveStatusCancellationRequested, Days: decimal.NewFromInt(2)}
+		storedStatus := request.Status // 模擬資料庫中的狀態，只在事務提交後更新
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorID).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "status update must run inside the transaction")
+				status := req.Status
+				m.afterCommit = append(m.afterCommit, func() { storedStatus = status })
+				return nil
+			}).Times(1)
+		m.balanceSvc.EXPECT().RestoreForLeave(gomock.Any(), request).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "balance restore must run inside the transaction")
+				return ErrLeaveBalanceUpdateFailed
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventCancelled)
+
+		err := service.ConfirmCancellation(ctx, leaveRequestID.String(), processorID.String())
+		assert.ErrorIs(t, err, ErrLeaveBalanceUpdateFailed)
+		assert.Equal(t, models.LeaveStatusCancellationRequested, storedStatus)
+	})
+}
+
+func TestLeaveRequestServiceImpl_DeclineCancellation(t *testing.T) {
+	ctx := context.Background()
+	leaveRequestID := uuid.New()
+	processorID := uuid.New()
+	hrAccount := &models.Account{ID: processorID, Role: models.RoleHR}
+
+	t.Run("Success - Request Returns To Approved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, Status: models.LeaveStatusCancellationRequested}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorID).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusApproved, req.Status)
+				return nil
+			}).Times(1)
+		expectLeaveEvent(t, m, models.LeaveEventCancellationDeclined)
+
+		err := service.DeclineCancellation(ctx, leaveRequestID.String(), processorID.String())
+		require.NoError(t, err)
+	})
+
+	t.Run("Failure - Update Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		request := &models.LeaveRequest{ID: leaveRequestID, Status: models.LeaveStatusCancellationRequested}
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), processorID).Return(hrAccount, nil).Times(1)
+		m.leaveRepo.EXPECT().GetByID(gomock.Any(), leaveRequestID).Return(request, nil).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		err := service.DeclineCancellation(ctx, leaveRequestID.String(), processorID.String())
+		assert.ErrorIs(t, err, ErrLeaveRequestUpdateFailed)
+	})
+}
+
+func TestLeaveRequestServiceImpl_CancelForTermination(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	processorID := uuid.New()
+	terminationDate := time.Date(2025, 3, 14, 0, 0, 0, 0, time.Local)
+	day := func(d int) time.Time { return time.Date(2025, 3, d, 0, 0, 0, 0, time.Local) }
+	leave := func(status string, start int, unpaid bool) models.LeaveRequest {
+		return models.LeaveRequest{ID: uuid.New(), AccountID: accountID, LeaveType: models.LeaveTypeAnnual, Status: status,
+			StartDate: day(start), EndDate: day(start + 1), Days: decimal.NewFromInt(2), Unpaid: unpaid}
+	}
+
+	t.Run("Success - Cancels Only Active Leave After Termination Date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		lastDay := leave(models.LeaveStatusApproved, 14, false)
+		lastDay.EndDate, lastDay.Days = day(14), decimal.NewFromInt(1)
+		stored := []models.LeaveRequest{
+			leave(models.LeaveStatusApproved, 10, false),              // 離職日前開始並結束, 保留
+			lastDay,                                                   // 離職當天開始並結束, 保留
+			leave(models.LeaveStatusPending, 17, false),               // 取消, 無需退回
+			leave(models.LeaveStatusApproved, 20, false),              // 取消並退回
+			leave(models.LeaveStatusCancellationRequested, 24, false), // 取消並退回
+			leave(models.LeaveStatusApproved, 26, true),               // 無薪假, 取消但不退回
+			leave(models.LeaveStatusRejected, 28, false),              // 已結束的狀態, 保留
+		}
+
+		m.leaveRepo.EXPECT().ListByAccountID(gomock.Any(), accountID).Return(stored, nil).Times(1)
+		m.balanceSvc.EXPECT().RestoreForLeave(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Contains(t, []uuid.UUID{stored[3].ID, stored[4].ID}, req.ID)
+				return nil
+			}).Times(2)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, models.LeaveStatusCancelled, req.Status)
+				require.NotNil(t, req.CancelledAt)
+				return nil
+			}).Times(4)
+		m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+				assert.Equal(t, models.LeaveEventCancelled, e.EventType)
+				assert.Equal(t, "employment terminated effective 2025-03-14", e.Comment)
+				require.NotNil(t, e.ActorID)
+				assert.Equal(t, processorID, *e.ActorID)
+				return nil
+			}).Times(4)
+
+		cancelled, shortened, err := service.CancelForTermination(ctx, accountID, terminationDate, processorID)
+
+		require.NoError(t, err)
+		assert.Empty(t, shortened)
+		require.Len(t, cancelled, 4)
+		for i, req := range cancelled {
+			assert.Equal(t, stored[i+2].ID, req.ID)
+		}
+	})
+
+	t.Run("Success - Shortens Approved Leave Spanning Termination Date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+		// 3/12 (三) 至 3/18 (二) 共 5 個工作日; 離職日 3/14 (五) 之後的 3/17、3/18 退回
+		spanning := leave(models.LeaveStatusApproved, 12, false)
+		spanning.EndDate, spanning.Days = day(18), decimal.NewFromInt(5)
+
+		m.leaveRepo.EXPECT().ListByAccountID(gomock.Any(), accountID).Return([]models.LeaveRequest{spanning}, nil).Times(1)
+		m.holidaySvc.EXPECT().CountWorkingDays(gomock.Any(), day(12), terminationDate).Return(decimal.NewFromInt(3), nil).Times(1)
+		m.balanceSvc.EXPECT().RestoreForShortenedLeave(gomock.Any(), gomock.Any(), decimal.NewFromInt(2)).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest, days decimal.Decimal) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "balance restore must run inside the transaction")
+				assert.Equal(t, spanning.ID, req.ID)
+				return nil
+			}).Times(1)
+		m.leaveRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "request update must run inside the transaction")
+				assert.Equal(t, models.LeaveStatusApproved, req.Status)
+				assert.Equal(t, terminationDate, req.EndDate)
+				assert.True(t, decimal.NewFromInt(3).Equal(req.Days))
+				return nil
+			}).Times(1)
+		m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+				assert.Equal(t, models.LeaveEventShortened, e.EventType)
+				assert.Equal(t, "employment terminated effective 2025-03-14; end_date: 2025-03-18 -> 2025-03-14; days: 5 -> 3", e.Comment)
+				return nil
+			}).Times(1)
+
+		cancelled, shortened, err := service.CancelForTermination(ctx, accountID, terminationDate, processorID)
+
+		require.NoError(t, err)
+		assert.Empty(t, cancelled)
+		require.Len(t, shortened, 1)
+		assert.Equal(t, terminationDate, shortened[0].EndDate)
+		assert.True(t, decimal.NewFromInt(3).Equal(shortened[0].Days))
+	})
+
+	t.Run("Failure - Restore Error Rolls Back Cancellation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().ListByAccountID(gomock.Any(), accountID).
+			Return([]models.LeaveRequest{leave(models.LeaveStatusApproved, 20, false)}, nil).Times(1)
+		m.balanceSvc.EXPECT().RestoreForLeave(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, req *models.LeaveRequest) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}), "balance restore must run inside the transaction")
+				return ErrLeaveBalanceUpdateFailed
+			}).Times(1)
+		// Update should NOT be called so that a retry can restore the balance
+
+		cancelled, shortened, err := service.CancelForTermination(ctx, accountID, terminationDate, processorID)
+
+		assert.ErrorIs(t, err, ErrLeaveBalanceUpdateFailed)
+		assert.Empty(t, cancelled)
+		assert.Empty(t, shortened)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveServiceWithMocks(ctrl)
+
+		m.leaveRepo.EXPECT().ListByAccountID(gomock.Any(), accountID).Return(nil, errors.New("db error")).Times(1)
+
+		cancelled, shortened, err := service.CancelForTermination(ctx, accountID, terminationDate, processorID)
+
+		require.Error(t, err)
+		assert.Nil(t, cancelled)
+		assert.Nil(t, shortened)
+	})
+}
+
+// leaveServiceMocks 集中管理 LeaveRequestService 的所有依賴 mock
+type leaveServiceMocks struct {
+	leaveRepo      *mocks.MockLeaveRequestRepository
+	accountRepo    *mocks.MockAccountRepository
+	employmentRepo *mocks.MockEmploymentRepository
+	approvalRepo   *mocks.MockLeaveApprovalRepository
+	delegationRepo *mocks.MockApprovalDelegationRepository
+	eventRepo      *mocks.MockLeaveRequestEventRepository
+	balanceSvc     *mocks.MockLeaveBalanceService
+	holidaySvc     *mocks.MockHolidayService
+	attachmentSvc  *mocks.MockLeaveAttachmentService
+	ruleSvc        *mocks.MockLeaveRuleService
+	leaveTypeSvc   *mocks.MockLeaveTypeService
+	notifier       *mocks.MockLeaveApprovalNotifier
+	txManager      *mocks.MockTransactionManager
+	afterCommit    []func() // 事務成功提交後才執行，用於模擬回滾時寫入不生效
+}
+
+// inTransactionKey 標記 ctx 來自 mock 事務，用於確認寫入發生在事務中
+type inTransactionKey struct{}
+
+// newLeaveServiceWithMocks 建立一個所有依賴皆為 mock 的 LeaveRequestService
+func newLeaveServiceWithMocks(ctrl *gomock.Controller) (interfaces.LeaveRequestService, *leaveServiceMocks) {
+	m := &leaveServiceMocks{
+		leaveRepo:      mocks.NewMockLeaveRequestRepository(ctrl),
+		accountRepo:    mocks.NewMockAccountRepository(ctrl),
+		employmentRepo: mocks.NewMockEmploymentRepository(ctrl),
+		approvalRepo:   mocks.NewMockLeaveApprovalRepository(ctrl),
+		delegationRepo: mocks.NewMockApprovalDelegationRepository(ctrl),
+		eventRepo:      mocks.NewMockLeaveRequestEventRepository(ctrl),
+		balanceSvc:     mocks.NewMockLeaveBalanceService(ctrl),
+		holidaySvc:     mocks.NewMockHolidayService(ctrl),
+		attachmentSvc:  mocks.NewMockLeaveAttachmentService(ctrl),
+		ruleSvc:        mocks.NewMockLeaveRuleService(ctrl),
+		leaveTypeSvc:   mocks.NewMockLeaveTypeService(ctrl),
+		notifier:       mocks.NewMockLeaveApprovalNotifier(ctrl),
+		txManager:      mocks.NewMockTransactionManager(ctrl),
+	}
+	// 事務直接執行 fn，並在 ctx 中標記事務; fn 成功時才執行 afterCommit，失敗時視為回滾
+	m.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			err := fn(context.WithValue(ctx, inTransactionKey{}, true))
+			hooks := m.afterCommit
+			m.afterCommit = nil
+			if err != nil {
+				return err
+			}
+			for _, hook := range hooks {
+				hook()
+			}
+			return nil
+		}).AnyTimes()
+	return NewLeaveRequestServiceImpl(m.leaveRepo, m.accountRepo, m.employmentRepo, m.approvalRepo, m.delegationRepo, m.eventRepo, m.balanceSvc, m.holidaySvc, m.attachmentSvc, m.ruleSvc, m.leaveTypeSvc, m.notifier, m.txManager), m
+}
+
+// expectLeaveType 預期查詢一次假別，返回指定設定 (代碼沿用申請的假別)
+func expectLeaveType(m *leaveServiceMocks, leaveType models.LeaveType) {
+	m.leaveTypeSvc.EXPECT().ResolveLeaveType(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, code string, role uint8) (*models.LeaveType, error) {
+			leaveType.Code = code
+			return &leaveType, nil
+		}).Times(1)
+}
+
+// paidLeaveType 啟用中、無其他限制的有薪假別
+var paidLeaveType = models.LeaveType{Name: "Paid leave", Paid: true, Active: true}
+
+// expectApproversNotified 預期通知一次指定步驟類型的審核人
+func expectApproversNotified(m *leaveServiceMocks, kind string) {
+	m.notifier.EXPECT().NotifyApprovers(gomock.Any(), gomock.Any(), kind, gomock.Any()).Return(nil, nil).Times(1)
+}
+
+// expectNoAttachmentRule 預期檢查一次附件規則，且該假別不需附件
+func expectNoAttachmentRule(m *leaveServiceMocks) {
+	m.attachmentSvc.EXPECT().IsAttachmentRequired(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil).Times(1)
+}
+
+// expectNoRuleViolation 預期檢查一次封鎖期間與人力規則，且沒有違反
+func expectNoRuleViolation(m *leaveServiceMocks) {
+	m.ruleSvc.EXPECT().CheckLeaveRules(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+}
+
+// pendingApprovalSteps 建立一組全部待審的審核步驟 (依給定的審核人類型順序)
+func pendingApprovalSteps(leaveRequestID uuid.UUID, kinds ...string) []models.LeaveApprovalStep {
+	steps := make([]models.LeaveApprovalStep, 0, len(kinds))
+	for i, kind := range kinds {
+		steps = append(steps, models.LeaveApprovalStep{
+			ID:             uuid.New(),
+			LeaveRequestID: leaveRequestID,
+			StepOrder:      i + 1,
+			ApproverKind:   kind,
+			Status:         models.ApprovalStepStatusPending,
+		})
+	}
+	return steps
+}
+
+// expectLeaveEvent 預期寫入一筆指定類型的假單歷程
+func expectLeaveEvent(t *testing.T, m *leaveServiceMocks, eventType string) {
+	m.eventRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, e *models.LeaveRequestEvent) error {
+			assert.Equal(t, eventType, e.EventType)
+			return nil
+		}).Times(1)
+}
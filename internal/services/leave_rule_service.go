@@ -0,0 +1,250 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// leaveRuleServiceImpl 實現了 LeaveRuleService 介面
+type leaveRuleServiceImpl struct {
+	ruleRepo       interfaces.LeaveRuleRepository
+	employmentRepo interfaces.EmploymentRepository   // 申請人所屬部門與部門成員
+	leaveRepo      interfaces.LeaveRequestRepository // 部門成員已核准的假單
+	holidaySvc     interfaces.HolidayService         // 只檢查工作日的在班人數
+}
+
+// NewLeaveRuleServiceImpl 構造函數
+func NewLeaveRuleServiceImpl(
+	ruleRepo interfaces.LeaveRuleRepository,
+	employmentRepo interfaces.EmploymentRepository,
+	leaveRepo interfaces.LeaveRequestRepository,
+	holidaySvc interfaces.HolidayService,
+) interfaces.LeaveRuleService {
+	return &leaveRuleServiceImpl{
+		ruleRepo:       ruleRepo,
+		employmentRepo: employmentRepo,
+		leaveRepo:      leaveRepo,
+		holidaySvc:     holidaySvc,
+	}
+}
+
+// CheckLeaveRules 先檢查封鎖期間，再檢查申請人部門的最少在班人數
+func (s *leaveRuleServiceImpl) CheckLeaveRules(ctx context.Context, request *models.LeaveRequest) (*models.LeaveRuleViolation, error) {
+	department := ""
+	employment, err := s.employmentRepo.GetEmploymentByAccountID(ctx, request.AccountID)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("Error fetching employment of account %s for leave rules: %v", request.AccountID, err)
+			return nil, fmt.Errorf("failed to verify leave rules")
+		}
+	} else {
+		department = employment.Department
+	}
+
+	start, end := dateOf(request.StartDate), dateOf(request.EndDate)
+	blackouts, err := s.ruleRepo.ListActiveBlackoutsBetween(ctx, start, end)
+	if err != nil {
+		log.Printf("Error fetching blackout periods for leave request %s: %v", request.ID, err)
+		return nil, fmt.Errorf("failed to verify leave rules")
+	}
+	for _, blackout := range blackouts {
+		if blackout.Blocks(department, request.LeaveType, start, end) {
+			return blackoutViolation(blackout), nil
+		}
+	}
+
+	// 半天與小時假當天仍有部分時間在班，不影響在班人數
+	if department == "" || !isFullDayLeave(request) {
+		return nil, nil
+	}
+	return s.checkStaffing(ctx, request, department, start, end)
+}
+
+// checkStaffing 逐一檢查區間內的工作日，核准後部門在班人數不可低於規則下限
+func (s *leaveRuleServiceImpl) checkStaffing(ctx context.Context, request *models.LeaveRequest, department string, start, end time.Time) (*models.LeaveRuleViolation, error) {
+	rules, err := s.ruleRepo.ListActiveStaffingRulesByDepartment(ctx, department)
+	if err != nil {
+		log.Printf("Error fetching staffing rules of department %s: %v", department, err)
+		return nil, fmt.Errorf("failed to verify leave rules")
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	rule := rules[0] // 依 MinOnDuty 由大到小排序，只需檢查最嚴格的規則
+
+	members, err := s.employmentRepo.ListActiveEmploymentsByDepartment(ctx, department)
+	if err != nil {
+		log.Printf("Error fetching members of department %s: %v", department, err)
+		return nil, fmt.Errorf("failed to verify leave rules")
+	}
+	colleagueIDs := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		if member.AccountID != request.AccountID {
+			colleagueIDs = append(colleagueIDs, member.AccountID)
+		}
+	}
+
+	var colleagueLeaves []models.LeaveRequest
+	if len(colleagueIDs) > 0 {
+		colleagueLeaves, err = s.leaveRepo.ListInRange(ctx, colleagueIDs, start, end, approvedLeaveStatuses)
+		if err != nil {
+			log.Printf("Error fetching approved leave of department %s: %v", department, err)
+			return nil, fmt.Errorf("failed to verify leave rules")
+		}
+	}
+
+	workingDays, err := s.holidaySvc.ListWorkingDays(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for _, day := range workingDays {
+		absent := make(map[uuid.UUID]bool)
+		for _, leave := range colleagueLeaves {
+			if leave.ID != request.ID && isFullDayLeave(&leave) &&
+				!day.Before(dateOf(leave.StartDate)) && !day.After(dateOf(leave.EndDate)) {
+				absent[leave.AccountID] = true
+			}
+		}
+		onDuty := len(colleagueIDs) - len(absent)
+		if onDuty < rule.MinOnDuty {
+			return staffingViolation(rule, day, onDuty), nil
+		}
+	}
+	return nil, nil
+}
+
+// isFullDayLeave 判斷假單是否整天不在班 (舊資料未設定時長單位時視為整天)
+func isFullDayLeave(request *models.LeaveRequest) bool {
+	return request.DurationUnit == "" || request.DurationUnit == models.LeaveDurationFullDay
+}
+
+// blackoutViolation 建立封鎖期間的違規說明
+func blackoutViolation(blackout models.LeaveBlackoutPeriod) *models.LeaveRuleViolation {
+	start, end := blackout.StartDate, blackout.EndDate
+	scope := "all departments"
+	if blackout.Department != "" {
+		scope = "department " + blackout.Department
+	}
+	leaveType := "any leave"
+	if blackout.LeaveType != "" {
+		leaveType = blackout.LeaveType + " leave"
+	}
+	return &models.LeaveRuleViolation{
+		Rule:       models.LeaveRuleBlackout,
+		RuleID:     blackout.ID,
+		RuleName:   blackout.Name,
+		Department: blackout.Department,
+		LeaveType:  blackout.LeaveType,
+		StartDate:  &start,
+		EndDate:    &end,
+		Message: fmt.Sprintf("%s cannot be taken by %s between %s and %s (%s)",
+			leaveType, scope, start.Format("2006-01-02"), end.Format("2006-01-02"), blackout.Name),
+	}
+}
+
+// staffingViolation 建立人力不足的違規說明
+func staffingViolation(rule models.LeaveStaffingRule, day time.Time, onDuty int) *models.LeaveRuleViolation {
+	return &models.LeaveRuleViolation{
+		Rule:       models.LeaveRuleMinStaffing,
+		RuleID:     rule.ID,
+		RuleName:   rule.Name,
+		Department: rule.Department,
+		Date:       &day,
+		MinOnDuty:  rule.MinOnDuty,
+		OnDuty:     &onDuty,
+		Message: fmt.Sprintf("department %s needs at least %d on duty but would only have %d on %s (%s)",
+			rule.Department, rule.MinOnDuty, onDuty, day.Format("2006-01-02"), rule.Name),
+	}
+}
+
+// ListBlackouts 列出所有封鎖期間
+func (s *leaveRuleServiceImpl) ListBlackouts(ctx context.Context) ([]models.LeaveBlackoutPeriod, error) {
+	blackouts, err := s.ruleRepo.ListBlackouts(ctx)
+	if err != nil {
+		log.Printf("Error fetching blackout periods: %v", err)
+		return nil, fmt.Errorf("failed to retrieve blackout periods")
+	}
+	return blackouts, nil
+}
+
+// CreateBlackout 驗證名稱與日期區間後新增封鎖期間
+func (s *leaveRuleServiceImpl) CreateBlackout(ctx context.Context, blackout *models.LeaveBlackoutPeriod) (*models.LeaveBlackoutPeriod, error) {
+	blackout.Name = strings.TrimSpace(blackout.Name)
+	blackout.Department = strings.TrimSpace(blackout.Department)
+	if blackout.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidLeaveRule)
+	}
+	blackout.StartDate, blackout.EndDate = dateOf(blackout.StartDate), dateOf(blackout.EndDate)
+	if blackout.EndDate.Before(blackout.StartDate) {
+		return nil, ErrInvalidDateRange
+	}
+	blackout.Active = true
+
+	if err := s.ruleRepo.CreateBlackout(ctx, blackout); err != nil {
+		log.Printf("Error creating blackout period %q: %v", blackout.Name, err)
+		return nil, ErrLeaveRuleCreateFailed
+	}
+	return blackout, nil
+}
+
+// DeleteBlackout 刪除封鎖期間
+func (s *leaveRuleServiceImpl) DeleteBlackout(ctx context.Context, id uuid.UUID) error {
+	if err := s.ruleRepo.DeleteBlackout(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrLeaveRuleNotFound
+		}
+		log.Printf("Error deleting blackout period %s: %v", id, err)
+		return fmt.Errorf("failed to delete blackout period")
+	}
+	return nil
+}
+
+// ListStaffingRules 列出所有最少在班人數規則
+func (s *leaveRuleServiceImpl) ListStaffingRules(ctx context.Context) ([]models.LeaveStaffingRule, error) {
+	rules, err := s.ruleRepo.ListStaffingRules(ctx)
+	if err != nil {
+		log.Printf("Error fetching staffing rules: %v", err)
+		return nil, fmt.Errorf("failed to retrieve staffing rules")
+	}
+	return rules, nil
+}
+
+// CreateStaffingRule 驗證部門與人數後新增最少在班人數規則
+func (s *leaveRuleServiceImpl) CreateStaffingRule(ctx context.Context, rule *models.LeaveStaffingRule) (*models.LeaveStaffingRule, error) {
+	rule.Name = strings.TrimSpace(rule.Name)
+	rule.Department = strings.TrimSpace(rule.Department)
+	if rule.Name == "" || rule.Department == "" {
+		return nil, fmt.Errorf("%w: name and department are required", ErrInvalidLeaveRule)
+	}
+	if rule.MinOnDuty < 1 {
+		return nil, fmt.Errorf("%w: min_on_duty must be at least 1", ErrInvalidLeaveRule)
+	}
+	rule.Active = true
+
+	if err := s.ruleRepo.CreateStaffingRule(ctx, rule); err != nil {
+		log.Printf("Error creating staffing rule %q: %v", rule.Name, err)
+		return nil, ErrLeaveRuleCreateFailed
+	}
+	return rule, nil
+}
+
+// DeleteStaffingRule 刪除最少在班人數規則
+func (s *leaveRuleServiceImpl) DeleteStaffingRule(ctx context.Context, id uuid.UUID) error {
+	if err := s.ruleRepo.DeleteStaffingRule(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrLeaveRuleNotFound
+		}
+		log.Printf("Error deleting staffing rule %s: %v", id, err)
+		return fmt.Errorf("failed to delete staffing rule")
+	}
+	return nil
+}
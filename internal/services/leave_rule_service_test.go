@@ -0,0 +1,293 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// leaveRuleMocks 集中 LeaveRuleService 測試用的 mock 依賴
+type leaveRuleMocks struct {
+	ruleRepo       *mocks.MockLeaveRuleRepository
+	employmentRepo *mocks.MockEmploymentRepository
+	leaveRepo      *mocks.MockLeaveRequestRepository
+	holidaySvc     *mocks.MockHolidayService
+}
+
+func newLeaveRuleServiceWithMocks(ctrl *gomock.Controller) (interfaces.LeaveRuleService, leaveRuleMocks) {
+	m := leaveRuleMocks{
+		ruleRepo:       mocks.NewMockLeaveRuleRepository(ctrl),
+		employmentRepo: mocks.NewMockEmploymentRepository(ctrl),
+		leaveRepo:      mocks.NewMockLeaveRequestRepository(ctrl),
+		holidaySvc:     mocks.NewMockHolidayService(ctrl),
+	}
+	return NewLeaveRuleServiceImpl(m.ruleRepo, m.employmentRepo, m.leaveRepo, m.holidaySvc), m
+}
+
+func TestLeaveRuleServiceImpl_CheckLeaveRules(t *testing.T) {
+	ctx := context.Background()
+	date := func(m time.Month, d int) time.Time { return time.Date(2025, m, d, 0, 0, 0, 0, time.UTC) }
+
+	applicantID := uuid.New()
+	colleagueA, colleagueB := uuid.New(), uuid.New()
+	request := &models.LeaveRequest{
+		ID: uuid.New(), AccountID: applicantID, LeaveType: models.LeaveTypeAnnual,
+		StartDate: date(time.March, 27), EndDate: date(time.March, 28), DurationUnit: models.LeaveDurationFullDay,
+	}
+	engineering := &models.Employment{AccountID: applicantID, Department: "Engineering"}
+	members := []models.Employment{{AccountID: applicantID}, {AccountID: colleagueA}, {AccountID: colleagueB}}
+	staffingRule := models.LeaveStaffingRule{ID: uuid.New(), Name: "Two engineers on duty", Department: "Engineering", MinOnDuty: 2}
+
+	t.Run("Violation - Department Blackout For Leave Type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+		blackout := models.LeaveBlackoutPeriod{ID: uuid.New(), Name: "Q1 close", Department: "Finance", LeaveType: models.LeaveTypeAnnual,
+			StartDate: date(time.March, 25), EndDate: date(time.March, 31), Active: true}
+
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).
+			Return(&models.Employment{AccountID: applicantID, Department: "Finance"}, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveBlackoutsBetween(gomock.Any(), request.StartDate, request.EndDate).
+			Return([]models.LeaveBlackoutPeriod{blackout}, nil).Times(1)
+
+		violation, err := service.CheckLeaveRules(ctx, request)
+
+		require.NoError(t, err)
+		require.NotNil(t, violation)
+		assert.Equal(t, models.LeaveRuleBlackout, violation.Rule)
+		assert.Equal(t, blackout.ID, violation.RuleID)
+		assert.Equal(t, "Finance", violation.Department)
+		assert.Contains(t, violation.Message, "Q1 close")
+	})
+
+	t.Run("Success - Blackout For Other Department And Type Ignored", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+		blackouts := []models.LeaveBlackoutPeriod{
+			{Name: "Q1 close", Department: "Finance", StartDate: date(time.March, 25), EndDate: date(time.March, 31), Active: true},
+			{Name: "No sick leave", LeaveType: models.LeaveTypeSick, StartDate: date(time.March, 25), EndDate: date(time.March, 31), Active: true},
+		}
+
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(engineering, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(blackouts, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveStaffingRulesByDepartment(gomock.Any(), "Engineering").Return(nil, nil).Times(1)
+
+		violation, err := service.CheckLeaveRules(ctx, request)
+
+		require.NoError(t, err)
+		assert.Nil(t, violation)
+	})
+
+	t.Run("Violation - Below Minimum Staffing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+		// colleagueA 已核准 3/28 整天休假; 3/27 還有 2 人在班, 3/28 只剩 1 人
+		colleagueLeave := models.LeaveRequest{ID: uuid.New(), AccountID: colleagueA, StartDate: date(time.March, 28), EndDate: date(time.March, 28)}
+
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(engineering, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveStaffingRulesByDepartment(gomock.Any(), "Engineering").Return([]models.LeaveStaffingRule{staffingRule}, nil).Times(1)
+		m.employmentRepo.EXPECT().ListActiveEmploymentsByDepartment(gomock.Any(), "Engineering").Return(members, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), []uuid.UUID{colleagueA, colleagueB}, request.StartDate, request.EndDate, approvedLeaveStatuses).
+			Return([]models.LeaveRequest{colleagueLeave}, nil).Times(1)
+		m.holidaySvc.EXPECT().ListWorkingDays(gomock.Any(), request.StartDate, request.EndDate).
+			Return([]time.Time{date(time.March, 27), date(time.March, 28)}, nil).Times(1)
+
+		violation, err := service.CheckLeaveRules(ctx, request)
+
+		require.NoError(t, err)
+		require.NotNil(t, violation)
+		assert.Equal(t, models.LeaveRuleMinStaffing, violation.Rule)
+		assert.Equal(t, staffingRule.ID, violation.RuleID)
+		require.NotNil(t, violation.Date)
+		assert.Equal(t, date(time.March, 28), *violation.Date)
+		require.NotNil(t, violation.OnDuty)
+		assert.Equal(t, 1, *violation.OnDuty)
+		assert.Equal(t, 2, violation.MinOnDuty)
+	})
+
+	t.Run("Success - Partial Day Colleague Leave Still On Duty", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+		halfDay := models.LeaveRequest{ID: uuid.New(), AccountID: colleagueA, StartDate: date(time.March, 28), EndDate: date(time.March, 28),
+			DurationUnit: models.LeaveDurationHalfDayAM}
+
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(engineering, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveStaffingRulesByDepartment(gomock.Any(), "Engineering").Return([]models.LeaveStaffingRule{staffingRule}, nil).Times(1)
+		m.employmentRepo.EXPECT().ListActiveEmploymentsByDepartment(gomock.Any(), "Engineering").Return(members, nil).Times(1)
+		m.leaveRepo.EXPECT().ListInRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]models.LeaveRequest{halfDay}, nil).Times(1)
+		m.holidaySvc.EXPECT().ListWorkingDays(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]time.Time{date(time.March, 27), date(time.March, 28)}, nil).Times(1)
+
+		violation, err := service.CheckLeaveRules(ctx, request)
+
+		require.NoError(t, err)
+		assert.Nil(t, violation)
+	})
+
+	t.Run("Success - Partial Day Request Skips Staffing", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+		hourly := *request
+		hourly.DurationUnit = models.LeaveDurationHours
+
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(engineering, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		// Staffing rules should NOT be loaded
+
+		violation, err := service.CheckLeaveRules(ctx, &hourly)
+
+		require.NoError(t, err)
+		assert.Nil(t, violation)
+	})
+
+	t.Run("Success - No Employment Only Company-wide Blackouts Apply", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+		m.ruleRepo.EXPECT().ListActiveBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		violation, err := service.CheckLeaveRules(ctx, request)
+
+		require.NoError(t, err)
+		assert.Nil(t, violation)
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+
+		m.employmentRepo.EXPECT().GetEmploymentByAccountID(gomock.Any(), applicantID).Return(engineering, nil).Times(1)
+		m.ruleRepo.EXPECT().ListActiveBlackoutsBetween(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("db down")).Times(1)
+
+		violation, err := service.CheckLeaveRules(ctx, request)
+
+		require.Error(t, err)
+		assert.Nil(t, violation)
+	})
+}
+
+func TestLeaveRuleServiceImpl_CreateBlackout(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, time.June, 25, 15, 0, 0, 0, time.UTC)
+	end := time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+
+		m.ruleRepo.EXPECT().CreateBlackout(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, b *models.LeaveBlackoutPeriod) error {
+				assert.Equal(t, "Q2 close", b.Name)
+				assert.Equal(t, time.Date(2025, time.June, 25, 0, 0, 0, 0, time.UTC), b.StartDate, "time of day is dropped")
+				assert.True(t, b.Active)
+				return nil
+			}).Times(1)
+
+		blackout, err := service.CreateBlackout(ctx, &models.LeaveBlackoutPeriod{Name: " Q2 close ", Department: "Finance", StartDate: start, EndDate: end})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Finance", blackout.Department)
+	})
+
+	t.Run("Failure - Missing Name", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveRuleServiceWithMocks(ctrl)
+
+		_, err := service.CreateBlackout(ctx, &models.LeaveBlackoutPeriod{StartDate: start, EndDate: end})
+
+		assert.ErrorIs(t, err, ErrInvalidLeaveRule)
+	})
+
+	t.Run("Failure - End Before Start", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveRuleServiceWithMocks(ctrl)
+
+		_, err := service.CreateBlackout(ctx, &models.LeaveBlackoutPeriod{Name: "Q2 close", StartDate: end, EndDate: start})
+
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+	})
+}
+
+func TestLeaveRuleServiceImpl_CreateStaffingRule(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+
+		m.ruleRepo.EXPECT().CreateStaffingRule(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		rule, err := service.CreateStaffingRule(ctx, &models.LeaveStaffingRule{Name: "Two engineers", Department: "Engineering", MinOnDuty: 2})
+
+		require.NoError(t, err)
+		assert.True(t, rule.Active)
+	})
+
+	t.Run("Failure - Invalid Minimum", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveRuleServiceWithMocks(ctrl)
+
+		_, err := service.CreateStaffingRule(ctx, &models.LeaveStaffingRule{Name: "Nobody", Department: "Engineering", MinOnDuty: 0})
+
+		assert.ErrorIs(t, err, ErrInvalidLeaveRule)
+	})
+
+	t.Run("Failure - Missing Department", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, _ := newLeaveRuleServiceWithMocks(ctrl)
+
+		_, err := service.CreateStaffingRule(ctx, &models.LeaveStaffingRule{Name: "Two engineers", MinOnDuty: 2})
+
+		assert.ErrorIs(t, err, ErrInvalidLeaveRule)
+	})
+}
+
+func TestLeaveRuleServiceImpl_DeleteRules(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+
+	t.Run("Blackout Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+
+		m.ruleRepo.EXPECT().DeleteBlackout(gomock.Any(), id).Return(gorm.ErrRecordNotFound).Times(1)
+
+		assert.ErrorIs(t, service.DeleteBlackout(ctx, id), ErrLeaveRuleNotFound)
+	})
+
+	t.Run("Staffing Rule Deleted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		service, m := newLeaveRuleServiceWithMocks(ctrl)
+
+		m.ruleRepo.EXPECT().DeleteStaffingRule(gomock.Any(), id).Return(nil).Times(1)
+
+		assert.NoError(t, service.DeleteStaffingRule(ctx, id))
+	})
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// leaveTypeCodePattern 假別代碼只允許小寫英文、數字與底線 (例如 "comp_off")
+var leaveTypeCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// leaveTypeServiceImpl 實現了 LeaveTypeService 介面
+type leaveTypeServiceImpl struct {
+	leaveTypeRepo interfaces.LeaveTypeRepository
+}
+
+// NewLeaveTypeServiceImpl 構造函數
+func NewLeaveTypeServiceImpl(leaveTypeRepo interfaces.LeaveTypeRepository) interfaces.LeaveTypeService {
+	return &leaveTypeServiceImpl{leaveTypeRepo: leaveTypeRepo}
+}
+
+// ResolveLeaveType 查詢假別並確認可以申請: 不存在、已停用或角色不符時返回對應錯誤
+func (s *leaveTypeServiceImpl) ResolveLeaveType(ctx context.Context, code string, role uint8) (*models.LeaveType, error) {
+	leaveType, err := s.leaveTypeRepo.GetByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: '%s'", ErrUnknownLeaveType, code)
+		}
+		log.Printf("Error fetching leave type %s: %v", code, err)
+		return nil, fmt.Errorf("failed to verify leave type")
+	}
+	if !leaveType.Active {
+		return nil, fmt.Errorf("%w: '%s'", ErrLeaveTypeInactive, code)
+	}
+	if !leaveType.AllowsRole(role) {
+		return nil, fmt.Errorf("%w: '%s'", ErrLeaveTypeNotEligible, code)
+	}
+	return leaveType, nil
+}
+
+// ListLeaveTypes 列出所有假別
+func (s *leaveTypeServiceImpl) ListLeaveTypes(ctx context.Context) ([]models.LeaveType, error) {
+	leaveTypes, err := s.leaveTypeRepo.List(ctx)
+	if err != nil {
+		log.Printf("Error fetching leave types: %v", err)
+		return nil, fmt.Errorf("failed to retrieve leave types")
+	}
+	return leaveTypes, nil
+}
+
+// CreateLeaveType 驗證代碼與設定後新增假別
+func (s *leaveTypeServiceImpl) CreateLeaveType(ctx context.Context, leaveType *models.LeaveType) (*models.LeaveType, error) {
+	leaveType.Code = strings.ToLower(strings.TrimSpace(leaveType.Code))
+	if !leaveTypeCodePattern.MatchString(leaveType.Code) {
+		return nil, fmt.Errorf("%w: code must start with a letter and contain only lowercase letters, digits and underscores", ErrInvalidLeaveType)
+	}
+	if err := validateLeaveTypeSettings(leaveType); err != nil {
+		return nil, err
+	}
+
+	_, err := s.leaveTypeRepo.GetByCode(ctx, leaveType.Code)
+	if err == nil {
+		return nil, ErrLeaveTypeExists
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Printf("Error checking existing leave type %s: %v", leaveType.Code, err)
+		return nil, ErrLeaveTypeSaveFailed
+	}
+
+	leaveType.Active = true
+	if err := s.leaveTypeRepo.Create(ctx, leaveType); err != nil {
+		log.Printf("Error creating leave type %s: %v", leaveType.Code, err)
+		return nil, ErrLeaveTypeSaveFailed
+	}
+	return leaveType, nil
+}
+
+// UpdateLeaveType 更新假別的名稱與設定，代碼維持不變以免既有假單失去對應
+func (s *leaveTypeServiceImpl) UpdateLeaveType(ctx context.Context, id uuid.UUID, update *models.LeaveType) (*models.LeaveType, error) {
+	if err := validateLeaveTypeSettings(update); err != nil {
+		return nil, err
+	}
+
+	leaveType, err := s.leaveTypeRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrLeaveTypeNotFound
+		}
+		log.Printf("Error fetching leave type %s for update: %v", id, err)
+		return nil, ErrLeaveTypeSaveFailed
+	}
+
+	leaveType.Name = update.Name
+	leaveType.Paid = update.Paid
+	leaveType.RequiresAttachment = update.RequiresAttachment
+	leaveType.MaxConsecutiveDays = update.MaxConsecutiveDays
+	leaveType.EligibleRoles = update.EligibleRoles
+	leaveType.Active = update.Active
+	if err := s.leaveTypeRepo.Update(ctx, leaveType); err != nil {
+		log.Printf("Error updating leave type %s: %v", leaveType.Code, err)
+		return nil, ErrLeaveTypeSaveFailed
+	}
+	return leaveType, nil
+}
+
+// DeleteLeaveType 刪除假別; 已有假單使用的假別只能停用，避免歷史假單失去對應
+func (s *leaveTypeServiceImpl) DeleteLeaveType(ctx context.Context, id uuid.UUID) error {
+	leaveType, err := s.leaveTypeRepo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrLeaveTypeNotFound
+		}
+		log.Printf("Error fetching leave type %s for deletion: %v", id, err)
+		return fmt.Errorf("failed to delete leave type")
+	}
+
+	count, err := s.leaveTypeRepo.CountLeaveRequests(ctx, leaveType.Code)
+	if err != nil {
+		log.Printf("Error counting leave requests of type %s: %v", leaveType.Code, err)
+		return fmt.Errorf("failed to delete leave type")
+	}
+	if count > 0 {
+		return ErrLeaveTypeInUse
+	}
+
+	if err := s.leaveTypeRepo.Delete(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrLeaveTypeNotFound
+		}
+		log.Printf("Error deleting leave type %s: %v", leaveType.Code, err)
+		return fmt.Errorf("failed to delete leave type")
+	}
+	return nil
+}
+
+// validateLeaveTypeSettings 驗證名稱、連續天數上限與可申請角色 (並將角色正規化)
+func validateLeaveTypeSettings(leaveType *models.LeaveType) error {
+	leaveType.Name = strings.TrimSpace(leaveType.Name)
+	if leaveType.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidLeaveType)
+	}
+	if leaveType.MaxConsecutiveDays < 0 {
+		return fmt.Errorf("%w: max_consecutive_days cannot be negative", ErrInvalidLeaveType)
+	}
+	roles, err := models.ParseEligibleRoles(leaveType.EligibleRoles)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidLeaveType, err)
+	}
+	leaveType.EligibleRoles = models.FormatEligibleRoles(roles)
+	return nil
+}
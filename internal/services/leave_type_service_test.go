@@ -0,0 +1,255 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestLeaveTypeServiceImpl_ResolveLeaveType(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		code          string
+		stored        *models.LeaveType
+		repoErr       error
+		role          uint8
+		expectedError error
+	}{
+		{
+			name:   "Success - Open To All Roles",
+			code:   models.LeaveTypeAnnual,
+			stored: &models.LeaveType{Code: models.LeaveTypeAnnual, Paid: true, Active: true},
+			role:   models.RoleEmployee,
+		},
+		{
+			name:   "Success - Role Is Eligible",
+			code:   "parental",
+			stored: &models.LeaveType{Code: "parental", Active: true, EligibleRoles: "1,2"},
+			role:   models.RoleHR,
+		},
+		{
+			name:          "Failure - Unknown Code",
+			code:          "sabbatical",
+			repoErr:       gorm.ErrRecordNotFound,
+			role:          models.RoleEmployee,
+			expectedError: ErrUnknownLeaveType,
+		},
+		{
+			name:          "Failure - Inactive",
+			code:          models.LeaveTypeVacation,
+			stored:        &models.LeaveType{Code: models.LeaveTypeVacation, Active: false},
+			role:          models.RoleEmployee,
+			expectedError: ErrLeaveTypeInactive,
+		},
+		{
+			name:          "Failure - Role Not Eligible",
+			code:          "parental",
+			stored:        &models.LeaveType{Code: "parental", Active: true, EligibleRoles: "2"},
+			role:          models.RoleHR,
+			expectedError: ErrLeaveTypeNotEligible,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRepo := mocks.NewMockLeaveTypeRepository(ctrl)
+			service := NewLeaveTypeServiceImpl(mockRepo)
+
+			mockRepo.EXPECT().GetByCode(gomock.Any(), tc.code).Return(tc.stored, tc.repoErr).Times(1)
+
+			leaveType, err := service.ResolveLeaveType(ctx, tc.code, tc.role)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				assert.Nil(t, leaveType)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.stored, leaveType)
+		})
+	}
+}
+
+func TestLeaveTypeServiceImpl_CreateLeaveType(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Normalizes Code And Roles", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveTypeRepository(ctrl)
+		service := NewLeaveTypeServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetByCode(gomock.Any(), "comp_off").Return(nil, gorm.ErrRecordNotFound).Times(1)
+		mockRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		created, err := service.CreateLeaveType(ctx, &models.LeaveType{Code: " Comp_Off ", Name: " Compensatory leave ", Paid: true, EligibleRoles: "2,1,2"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "comp_off", created.Code)
+		assert.Equal(t, "Compensatory leave", created.Name)
+		assert.Equal(t, "1,2", created.EligibleRoles)
+		assert.True(t, created.Active)
+	})
+
+	t.Run("Failure - Duplicate Code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveTypeRepository(ctrl)
+		service := NewLeaveTypeServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetByCode(gomock.Any(), models.LeaveTypeSick).Return(&models.LeaveType{Code: models.LeaveTypeSick}, nil).Times(1)
+		// Create should NOT be called
+
+		created, err := service.CreateLeaveType(ctx, &models.LeaveType{Code: models.LeaveTypeSick, Name: "Sick"})
+
+		assert.ErrorIs(t, err, ErrLeaveTypeExists)
+		assert.Nil(t, created)
+	})
+
+	invalidCases := []struct {
+		name      string
+		leaveType models.LeaveType
+	}{
+		{"Invalid Code", models.LeaveType{Code: "sick leave", Name: "Sick"}},
+		{"Missing Name", models.LeaveType{Code: "sick", Name: "  "}},
+		{"Negative Max Days", models.LeaveType{Code: "sick", Name: "Sick", MaxConsecutiveDays: -1}},
+		{"Unknown Role", models.LeaveType{Code: "sick", Name: "Sick", EligibleRoles: "2,9"}},
+	}
+	for _, ic := range invalidCases {
+		t.Run("Failure - "+ic.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service := NewLeaveTypeServiceImpl(mocks.NewMockLeaveTypeRepository(ctrl))
+			leaveType := ic.leaveType
+
+			created, err := service.CreateLeaveType(ctx, &leaveType)
+
+			assert.ErrorIs(t, err, ErrInvalidLeaveType)
+			assert.Nil(t, created)
+		})
+	}
+}
+
+func TestLeaveTypeServiceImpl_UpdateLeaveType(t *testing.T) {
+	ctx := context.Background()
+	leaveTypeID := uuid.New()
+
+	t.Run("Success - Keeps Code", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveTypeRepository(ctrl)
+		service := NewLeaveTypeServiceImpl(mockRepo)
+		stored := &models.LeaveType{ID: leaveTypeID, Code: models.LeaveTypeVacation, Name: "Vacation", Paid: true, Active: true}
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), leaveTypeID).Return(stored, nil).Times(1)
+		mockRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, lt *models.LeaveType) error {
+				assert.Equal(t, models.LeaveTypeVacation, lt.Code)
+				assert.Equal(t, "Unpaid vacation", lt.Name)
+				assert.False(t, lt.Paid)
+				assert.Equal(t, 10, lt.MaxConsecutiveDays)
+				assert.False(t, lt.Active)
+				return nil
+			}).Times(1)
+
+		updated, err := service.UpdateLeaveType(ctx, leaveTypeID, &models.LeaveType{Code: "ignored", Name: "Unpaid vacation", MaxConsecutiveDays: 10})
+
+		require.NoError(t, err)
+		assert.Equal(t, models.LeaveTypeVacation, updated.Code)
+	})
+
+	t.Run("Failure - Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveTypeRepository(ctrl)
+		service := NewLeaveTypeServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), leaveTypeID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+
+		updated, err := service.UpdateLeaveType(ctx, leaveTypeID, &models.LeaveType{Name: "Vacation"})
+
+		assert.ErrorIs(t, err, ErrLeaveTypeNotFound)
+		assert.Nil(t, updated)
+	})
+}
+
+func TestLeaveTypeServiceImpl_DeleteLeaveType(t *testing.T) {
+	ctx := context.Background()
+	leaveTypeID := uuid.New()
+	stored := &models.LeaveType{ID: leaveTypeID, Code: "sabbatical"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(mockRepo *mocks.MockLeaveTypeRepository)
+		expectedError error
+	}{
+		{
+			name: "Success - Unused Type",
+			setupMocks: func(mockRepo *mocks.MockLeaveTypeRepository) {
+				mockRepo.EXPECT().GetByID(gomock.Any(), leaveTypeID).Return(stored, nil).Times(1)
+				mockRepo.EXPECT().CountLeaveRequests(gomock.Any(), "sabbatical").Return(int64(0), nil).Times(1)
+				mockRepo.EXPECT().Delete(gomock.Any(), leaveTypeID).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Failure - Used By Leave Requests",
+			setupMocks: func(mockRepo *mocks.MockLeaveTypeRepository) {
+				mockRepo.EXPECT().GetByID(gomock.Any(), leaveTypeID).Return(stored, nil).Times(1)
+				mockRepo.EXPECT().CountLeaveRequests(gomock.Any(), "sabbatical").Return(int64(3), nil).Times(1)
+			},
+			expectedError: ErrLeaveTypeInUse,
+		},
+		{
+			name: "Failure - Not Found",
+			setupMocks: func(mockRepo *mocks.MockLeaveTypeRepository) {
+				mockRepo.EXPECT().GetByID(gomock.Any(), leaveTypeID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+			},
+			expectedError: ErrLeaveTypeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRepo := mocks.NewMockLeaveTypeRepository(ctrl)
+			service := NewLeaveTypeServiceImpl(mockRepo)
+			tc.setupMocks(mockRepo)
+
+			err := service.DeleteLeaveType(ctx, leaveTypeID)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("Failure - Count Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockRepo := mocks.NewMockLeaveTypeRepository(ctrl)
+		service := NewLeaveTypeServiceImpl(mockRepo)
+
+		mockRepo.EXPECT().GetByID(gomock.Any(), leaveTypeID).Return(stored, nil).Times(1)
+		mockRepo.EXPECT().CountLeaveRequests(gomock.Any(), "sabbatical").Return(int64(0), errors.New("db error")).Times(1)
+
+		err := service.DeleteLeaveType(ctx, leaveTypeID)
+
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrLeaveTypeInUse)
+	})
+}
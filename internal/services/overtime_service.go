@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// overtimeServiceImpl 實現了 OvertimeService 介面
+type overtimeServiceImpl struct {
+	claimRepo   interfaces.OvertimeClaimRepository
+	accountRepo interfaces.AccountRepository
+	balanceSvc  interfaces.LeaveBalanceService
+	txManager   interfaces.TransactionManager // 核准狀態與補休存入在同一事務中寫入
+	expiryDays  int                           // 補休自加班日起的有效天數
+}
+
+// NewOvertimeServiceImpl 構造函數; expiryDays 不大於 0 時使用預設值
+func NewOvertimeServiceImpl(
+	claimRepo interfaces.OvertimeClaimRepository,
+	accountRepo interfaces.AccountRepository,
+	balanceSvc interfaces.LeaveBalanceService,
+	txManager interfaces.TransactionManager,
+	expiryDays int,
+) interfaces.OvertimeService {
+	if expiryDays <= 0 {
+		expiryDays = models.DefaultCompOffExpiryDays
+	}
+	return &overtimeServiceImpl{
+		claimRepo:   claimRepo,
+		accountRepo: accountRepo,
+		balanceSvc:  balanceSvc,
+		txManager:   txManager,
+		expiryDays:  expiryDays,
+	}
+}
+
+// SubmitClaim 驗證後建立待審的加班申請
+func (s *overtimeServiceImpl) SubmitClaim(ctx context.Context, accountIDStr string, input models.OvertimeClaimInput) (*models.OvertimeClaim, error) {
+	accountUUID, err := uuid.Parse(accountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+
+	workDate := dateOf(input.WorkDate)
+	today := dateOf(time.Now())
+	if workDate.After(today) {
+		return nil, fmt.Errorf("%w: work date cannot be in the future", ErrInvalidOvertimeClaim)
+	}
+	// 換得的補休在核准前就已失效時不受理
+	if today.After(s.compOffExpiresOn(workDate)) {
+		return nil, fmt.Errorf("%w: work date is more than %d days ago", ErrOvertimeClaimExpired, s.expiryDays)
+	}
+	if !input.Hours.IsPositive() || input.Hours.GreaterThan(decimal.NewFromInt(models.MaxOvertimeHoursPerClaim)) {
+		return nil, fmt.Errorf("%w: hours must be greater than 0 and at most %d", ErrInvalidOvertimeClaim, models.MaxOvertimeHoursPerClaim)
+	}
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is required", ErrInvalidOvertimeClaim)
+	}
+
+	claim := &models.OvertimeClaim{
+		AccountID: accountUUID,
+		WorkDate:  workDate,
+		Hours:     input.Hours,
+		Reason:    reason,
+		Status:    models.OvertimeStatusPending,
+	}
+	// 同一加班日只能有一筆待審或已核准的申請, 避免重複換得補休
+	// 鎖定申請人帳戶後再檢查與新增, 同時送出的兩筆申請不會都通過檢查
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.claimRepo.LockAccount(ctx, accountUUID); err != nil {
+			log.Printf("Error locking overtime claims of account %s: %v", accountUUID, err)
+			return ErrOvertimeClaimSaveFailed
+		}
+		count, err := s.claimRepo.CountActiveByWorkDate(ctx, accountUUID, workDate)
+		if err != nil {
+			log.Printf("Error checking existing overtime claims for account %s on %s: %v", accountUUID, workDate.Format("2006-01-02"), err)
+			return ErrOvertimeClaimSaveFailed
+		}
+		if count > 0 {
+			return ErrOvertimeClaimExists
+		}
+		if err := s.claimRepo.Create(ctx, claim); err != nil {
+			log.Printf("Error creating overtime claim for account %s: %v", accountUUID, err)
+			return ErrOvertimeClaimSaveFailed
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Overtime claim %s submitted by account %s (%s hours on %s)", claim.ID, accountUUID, claim.Hours, workDate.Format("2006-01-02"))
+	return claim, nil
+}
+
+// ListMyClaims 列出員工本人的加班申請
+func (s *overtimeServiceImpl) ListMyClaims(ctx context.Context, accountIDStr string) ([]models.OvertimeClaim, error) {
+	accountUUID, err := uuid.Parse(accountIDStr)
+	if err != nil {
+		return nil, errors.New("invalid user identifier format")
+	}
+	claims, err := s.claimRepo.ListByAccountID(ctx, accountUUID)
+	if err != nil {
+		log.Printf("Error fetching overtime claims for account %s: %v", accountUUID, err)
+		return nil, fmt.Errorf("failed to retrieve overtime claims")
+	}
+	return claims, nil
+}
+
+// ListClaims 列出加班申請
+func (s *overtimeServiceImpl) ListClaims(ctx context.Context, status string) ([]models.OvertimeClaim, error) {
+	switch status {
+	case "", models.OvertimeStatusPending, models.OvertimeStatusApproved, models.OvertimeStatusRejected:
+	default:
+		return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidOvertimeClaim, status)
+	}
+	claims, err := s.claimRepo.List(ctx, status)
+	if err != nil {
+		log.Printf("Error fetching overtime claims (status %q): %v", status, err)
+		return nil, fmt.Errorf("failed to retrieve overtime claims")
+	}
+	return claims, nil
+}
+
+// ApproveClaim 核准加班申請: 時數依標準工時換算為補休天數，自加班日起 expiryDays 天內有效
+// 補休在核准時已失效的申請不能核准 (只能拒絕)
+// 申請狀態與補休存入在同一事務中寫入; 補休存入失敗時全部回滾，申請維持待審，HR 可以重新核准
+func (s *overtimeServiceImpl) ApproveClaim(ctx context.Context, claimIDStr string, processorAccountIDStr string) (*models.OvertimeClaim, error) {
+	claim, processorID, err := s.getPendingClaimForProcessor(ctx, claimIDStr, processorAccountIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresOn := s.compOffExpiresOn(claim.WorkDate)
+	if dateOf(now).After(expiresOn) {
+		log.Printf("Overtime claim %s cannot be approved: comp-off would have expired on %s", claim.ID, expiresOn.Format("2006-01-02"))
+		return nil, ErrOvertimeClaimExpired
+	}
+	err = s.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		claim.Status = models.OvertimeStatusApproved
+		claim.ProcessedByID = &processorID
+		claim.ProcessedAt = &now
+		claim.CompOffDays = claim.Hours.Div(decimal.NewFromInt(models.StandardWorkingHoursPerDay)).Round(2)
+		claim.CompOffExpiresOn = &expiresOn
+		if err := s.claimRepo.Update(ctx, claim); err != nil {
+			log.Printf("Error updating overtime claim %s status to approved: %v", claim.ID, err)
+			return claimUpdateError(err)
+		}
+		if err := s.balanceSvc.CreditCompOff(ctx, claim); err != nil {
+			log.Printf("Comp-off credit failed for overtime claim %s, approval rolled back: %v", claim.ID, err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Overtime claim %s approved by %s: %s day(s) of comp-off until %s", claim.ID, processorID, claim.CompOffDays, expiresOn.Format("2006-01-02"))
+	return claim, nil
+}
+
+// RejectClaim 拒絕加班申請
+func (s *overtimeServiceImpl) RejectClaim(ctx context.Context, claimIDStr string, processorAccountIDStr string, reason string) (*models.OvertimeClaim, error) {
+	claim, processorID, err := s.getPendingClaimForProcessor(ctx, claimIDStr, processorAccountIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	claim.Status = models.OvertimeStatusRejected
+	claim.ProcessedByID = &processorID
+	claim.ProcessedAt = &now
+	claim.DecisionNote = strings.TrimSpace(reason)
+	if err := s.claimRepo.Update(ctx, claim); err != nil {
+		log.Printf("Error updating overtime claim %s status to rejected: %v", claim.ID, err)
+		return nil, claimUpdateError(err)
+	}
+	log.Printf("Overtime claim %s rejected by %s", claim.ID, processorID)
+	return claim, nil
+}
+
+// claimUpdateError 將更新加班申請的錯誤轉為 Service 錯誤: 申請已被其他請求處理時視為狀態不符
+func claimUpdateError(err error) error {
+	switch {
+	case errors.Is(err, ErrConcurrentModification):
+		return ErrInvalidOvertimeClaimState
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ErrOvertimeClaimNotFound
+	default:
+		return ErrOvertimeClaimSaveFailed
+	}
+}
+
+// compOffExpiresOn 返回加班日換得的補休的最後可用日
+func (s *overtimeServiceImpl) compOffExpiresOn(workDate time.Time) time.Time {
+	return dateOf(workDate).AddDate(0, 0, s.expiryDays)
+}
+
+// getPendingClaimForProcessor 確認處理人是 HR / Super Admin、申請存在且仍待審，且不是處理人自己的申請
+func (s *overtimeServiceImpl) getPendingClaimForProcessor(ctx context.Context, claimIDStr, processorAccountIDStr string) (*models.OvertimeClaim, uuid.UUID, error) {
+	claimUUID, err := uuid.Parse(claimIDStr)
+	if err != nil {
+		return nil, uuid.Nil, errors.New("invalid overtime claim identifier format")
+	}
+	processorUUID, err := uuid.Parse(processorAccountIDStr)
+	if err != nil {
+		return nil, uuid.Nil, errors.New("invalid processor account identifier format")
+	}
+
+	processor, err := s.accountRepo.GetAccountByID(ctx, processorUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, uuid.Nil, ErrInvalidProcessor
+		}
+		log.Printf("Error fetching processor account %s: %v", processorUUID, err)
+		return nil, uuid.Nil, fmt.Errorf("failed to verify processor account")
+	}
+	if processor.Role != models.RoleHR && processor.Role != models.RoleSuperAdmin {
+		return nil, uuid.Nil, ErrInvalidProcessor
+	}
+
+	claim, err := s.claimRepo.GetByID(ctx, claimUUID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, uuid.Nil, ErrOvertimeClaimNotFound
+		}
+		log.Printf("Error fetching overtime claim %s: %v", claimUUID, err)
+		return nil, uuid.Nil, fmt.Errorf("failed to retrieve overtime claim")
+	}
+	if claim.AccountID == processorUUID {
+		log.Printf("Account %s attempted to process its own overtime claim %s", processorUUID, claim.ID)
+		return nil, uuid.Nil, ErrInvalidProcessor
+	}
+	if claim.Status != models.OvertimeStatusPending {
+		return nil, uuid.Nil, ErrInvalidOvertimeClaimState
+	}
+	return claim, processorUUID, nil
+}
@@ -0,0 +1,357 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/erinchen11/hr-system/internal/interfaces/mocks"
+	"github.com/erinchen11/hr-system/internal/models"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type overtimeMocks struct {
+	claimRepo   *mocks.MockOvertimeClaimRepository
+	accountRepo *mocks.MockAccountRepository
+	balanceSvc  *mocks.MockLeaveBalanceService
+	txManager   *mocks.MockTransactionManager
+}
+
+func newOvertimeTestService(ctrl *gomock.Controller) (*overtimeMocks, *overtimeServiceImpl) {
+	m := &overtimeMocks{
+		claimRepo:   mocks.NewMockOvertimeClaimRepository(ctrl),
+		accountRepo: mocks.NewMockAccountRepository(ctrl),
+		balanceSvc:  mocks.NewMockLeaveBalanceService(ctrl),
+		txManager:   mocks.NewMockTransactionManager(ctrl),
+	}
+	// 事務直接執行 fn，並在 ctx 中標記事務
+	m.txManager.EXPECT().WithinTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(context.WithValue(ctx, inTransactionKey{}, true))
+		}).AnyTimes()
+	return m, NewOvertimeServiceImpl(m.claimRepo, m.accountRepo, m.balanceSvc, m.txManager, 90).(*overtimeServiceImpl)
+}
+
+func TestOvertimeServiceImpl_SubmitClaim(t *testing.T) {
+	ctx := context.Background()
+	accountID := uuid.New()
+	yesterday := dateOf(time.Now()).AddDate(0, 0, -1)
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m, service := newOvertimeTestService(ctrl)
+
+		// 鎖定帳戶、重複檢查與新增在同一事務中依序執行
+		gomock.InOrder(
+			m.claimRepo.EXPECT().LockAccount(gomock.Any(), accountID).
+				DoAndReturn(func(ctx context.Context, id uuid.UUID) error {
+					assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+					return nil
+				}).Times(1),
+			m.claimRepo.EXPECT().CountActiveByWorkDate(gomock.Any(), accountID, yesterday).Return(int64(0), nil).Times(1),
+		)
+		m.claimRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, claim *models.OvertimeClaim) error {
+				assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+				assert.Equal(t, accountID, claim.AccountID)
+				assert.Equal(t, yesterday, claim.WorkDate)
+				assert.Equal(t, models.OvertimeStatusPending, claim.Status)
+				assert.Equal(t, "Weekend database incident", claim.Reason)
+				return nil
+			}).Times(1)
+
+		claim, err := service.SubmitClaim(ctx, accountID.String(), models.OvertimeClaimInput{
+			WorkDate: yesterday.Add(15 * time.Hour), Hours: decimal.NewFromInt(6), Reason: "  Weekend database incident ",
+		})
+
+		require.NoError(t, err)
+		assert.True(t, decimal.NewFromInt(6).Equal(claim.Hours))
+	})
+
+	t.Run("Failure - Invalid Input", func(t *testing.T) {
+		invalidCases := []struct {
+			name  string
+			input models.OvertimeClaimInput
+		}{
+			{"Future Work Date", models.OvertimeClaimInput{WorkDate: time.Now().AddDate(0, 0, 1), Hours: decimal.NewFromInt(4), Reason: "incident"}},
+			{"Zero Hours", models.OvertimeClaimInput{WorkDate: yesterday, Hours: decimal.Zero, Reason: "incident"}},
+			{"Too Many Hours", models.OvertimeClaimInput{WorkDate: yesterday, Hours: decimal.NewFromInt(25), Reason: "incident"}},
+			{"Missing Reason", models.OvertimeClaimInput{WorkDate: yesterday, Hours: decimal.NewFromInt(4), Reason: " "}},
+		}
+		for _, ic := range invalidCases {
+			t.Run(ic.name, func(t *testing.T) {
+				ctrl := gomock.NewController(t)
+				defer ctrl.Finish()
+				_, service := newOvertimeTestService(ctrl)
+
+				_, err := service.SubmitClaim(ctx, accountID.String(), ic.input)
+				assert.ErrorIs(t, err, ErrInvalidOvertimeClaim)
+			})
+		}
+	})
+
+	t.Run("Failure - Repository Error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m, service := newOvertimeTestService(ctrl)
+
+		m.claimRepo.EXPECT().LockAccount(gomock.Any(), accountID).Return(nil).Times(1)
+		m.claimRepo.EXPECT().CountActiveByWorkDate(gomock.Any(), accountID, yesterday).Return(int64(0), nil).Times(1)
+		m.claimRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db error")).Times(1)
+
+		_, err := service.SubmitClaim(ctx, accountID.String(), models.OvertimeClaimInput{WorkDate: yesterday, Hours: decimal.NewFromInt(4), Reason: "incident"})
+		assert.ErrorIs(t, err, ErrOvertimeClaimSaveFailed)
+	})
+
+	t.Run("Failure - Work Date Older Than Comp-Off Expiry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		_, service := newOvertimeTestService(ctrl)
+
+		// 有效期 90 天: 91 天前的加班在提交時已失效, 不應查詢或新增
+		_, err := service.SubmitClaim(ctx, accountID.String(), models.OvertimeClaimInput{
+			WorkDate: dateOf(time.Now()).AddDate(0, 0, -91), Hours: decimal.NewFromInt(4), Reason: "incident",
+		})
+		assert.ErrorIs(t, err, ErrOvertimeClaimExpired)
+	})
+
+	t.Run("Success - Work Date On Last Day Of Expiry", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m, service := newOvertimeTestService(ctrl)
+		workDate := dateOf(time.Now()).AddDate(0, 0, -90)
+
+		m.claimRepo.EXPECT().LockAccount(gomock.Any(), accountID).Return(nil).Times(1)
+		m.claimRepo.EXPECT().CountActiveByWorkDate(gomock.Any(), accountID, workDate).Return(int64(0), nil).Times(1)
+		m.claimRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+		_, err := service.SubmitClaim(ctx, accountID.String(), models.OvertimeClaimInput{WorkDate: workDate, Hours: decimal.NewFromInt(4), Reason: "incident"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Failure - Duplicate Claim For Work Date", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m, service := newOvertimeTestService(ctrl)
+
+		m.claimRepo.EXPECT().LockAccount(gomock.Any(), accountID).Return(nil).Times(1)
+		m.claimRepo.EXPECT().CountActiveByWorkDate(gomock.Any(), accountID, yesterday).Return(int64(1), nil).Times(1)
+		// Create should NOT be called
+
+		_, err := service.SubmitClaim(ctx, accountID.String(), models.OvertimeClaimInput{WorkDate: yesterday, Hours: decimal.NewFromInt(4), Reason: "incident"})
+		assert.ErrorIs(t, err, ErrOvertimeClaimExists)
+	})
+}
+
+func TestOvertimeServiceImpl_ListClaims(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success - Filter By Status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m, service := newOvertimeTestService(ctrl)
+		stored := []models.OvertimeClaim{{ID: uuid.New(), Status: models.OvertimeStatusPending}}
+
+		m.claimRepo.EXPECT().List(gomock.Any(), models.OvertimeStatusPending).Return(stored, nil).Times(1)
+
+		claims, err := service.ListClaims(ctx, models.OvertimeStatusPending)
+		require.NoError(t, err)
+		assert.Equal(t, stored, claims)
+	})
+
+	t.Run("Failure - Unknown Status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		_, service := newOvertimeTestService(ctrl)
+
+		_, err := service.ListClaims(ctx, "cancelled")
+		assert.ErrorIs(t, err, ErrInvalidOvertimeClaim)
+	})
+}
+
+func TestOvertimeServiceImpl_ApproveClaim(t *testing.T) {
+	ctx := context.Background()
+	hrID := uuid.New()
+	employeeID := uuid.New()
+	claimID := uuid.New()
+	workDate := dateOf(time.Now()).AddDate(0, 0, -10)
+	pendingClaim := func() *models.OvertimeClaim {
+		return &models.OvertimeClaim{ID: claimID, AccountID: employeeID, WorkDate: workDate, Hours: decimal.NewFromInt(12), Status: models.OvertimeStatusPending}
+	}
+	hr := &models.Account{ID: hrID, Role: models.RoleHR}
+
+	testCases := []struct {
+		name        string
+		processorID uuid.UUID
+		setupMocks  func(m *overtimeMocks)
+		expectedErr error
+		checkResult func(t *testing.T, claim *models.OvertimeClaim)
+	}{
+		{
+			name:        "Success - Converts Hours To Comp-Off Days",
+			processorID: hrID,
+			setupMocks: func(m *overtimeMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hr, nil).Times(1)
+				m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).Return(pendingClaim(), nil).Times(1)
+				m.claimRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				m.balanceSvc.EXPECT().CreditCompOff(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			},
+			checkResult: func(t *testing.T, claim *models.OvertimeClaim) {
+				assert.Equal(t, models.OvertimeStatusApproved, claim.Status)
+				assert.True(t, decimal.RequireFromString("1.5").Equal(claim.CompOffDays), "12 hours = 1.5 days")
+				require.NotNil(t, claim.CompOffExpiresOn)
+				assert.Equal(t, workDate.AddDate(0, 0, 90), *claim.CompOffExpiresOn)
+				require.NotNil(t, claim.ProcessedByID)
+				assert.Equal(t, hrID, *claim.ProcessedByID)
+			},
+		},
+		{
+			name:        "Failure - Employee Cannot Approve",
+			processorID: employeeID,
+			setupMocks: func(m *overtimeMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), employeeID).Return(&models.Account{ID: employeeID, Role: models.RoleEmployee}, nil).Times(1)
+			},
+			expectedErr: ErrInvalidProcessor,
+		},
+		{
+			name:        "Failure - HR Cannot Approve Own Claim",
+			processorID: hrID,
+			setupMocks: func(m *overtimeMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hr, nil).Times(1)
+				own := pendingClaim()
+				own.AccountID = hrID
+				m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).Return(own, nil).Times(1)
+			},
+			expectedErr: ErrInvalidProcessor,
+		},
+		{
+			name:        "Failure - Claim Not Found",
+			processorID: hrID,
+			setupMocks: func(m *overtimeMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hr, nil).Times(1)
+				m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).Return(nil, gorm.ErrRecordNotFound).Times(1)
+			},
+			expectedErr: ErrOvertimeClaimNotFound,
+		},
+		{
+			name:        "Failure - Already Processed",
+			processorID: hrID,
+			setupMocks: func(m *overtimeMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hr, nil).Times(1)
+				processed := pendingClaim()
+				processed.Status = models.OvertimeStatusRejected
+				m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).Return(processed, nil).Times(1)
+			},
+			expectedErr: ErrInvalidOvertimeClaimState,
+		},
+		{
+			name:        "Failure - Processed Concurrently",
+			processorID: hrID,
+			setupMocks: func(m *overtimeMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hr, nil).Times(1)
+				m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).Return(pendingClaim(), nil).Times(1)
+				// 讀取後已被其他請求拒絕: 條件更新沒有寫入，不得存入補休
+				m.claimRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(ErrConcurrentModification).Times(1)
+			},
+			expectedErr: ErrInvalidOvertimeClaimState,
+		},
+		{
+			name:        "Failure - Comp-Off Already Expired",
+			processorID: hrID,
+			setupMocks: func(m *overtimeMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hr, nil).Times(1)
+				stale := pendingClaim()
+				stale.WorkDate = dateOf(time.Now()).AddDate(0, 0, -91)
+				m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).Return(stale, nil).Times(1)
+				// 不得更新申請或存入補休
+			},
+			expectedErr: ErrOvertimeClaimExpired,
+		},
+		{
+			name:        "Failure - Credit Error Rolls Back Approval",
+			processorID: hrID,
+			setupMocks: func(m *overtimeMocks) {
+				m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(hr, nil).Times(1)
+				m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).Return(pendingClaim(), nil).Times(1)
+				// 兩筆寫入都在同一事務中; 存入失敗由事務回滾，不再另外寫回待審
+				gomock.InOrder(
+					m.claimRepo.EXPECT().Update(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, claim *models.OvertimeClaim) error {
+							assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+							return nil
+						}).Times(1),
+					m.balanceSvc.EXPECT().CreditCompOff(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, claim *models.OvertimeClaim) error {
+							assert.Equal(t, true, ctx.Value(inTransactionKey{}))
+							return ErrLeaveBalanceUpdateFailed
+						}).Times(1),
+				)
+			},
+			expectedErr: ErrLeaveBalanceUpdateFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			m, service := newOvertimeTestService(ctrl)
+			tc.setupMocks(m)
+
+			claim, err := service.ApproveClaim(ctx, claimID.String(), tc.processorID.String())
+
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				assert.Nil(t, claim)
+				return
+			}
+			require.NoError(t, err)
+			tc.checkResult(t, claim)
+		})
+	}
+}
+
+func TestOvertimeServiceImpl_RejectClaim(t *testing.T) {
+	ctx := context.Background()
+	hrID := uuid.New()
+	claimID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m, service := newOvertimeTestService(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(&models.Account{ID: hrID, Role: models.RoleSuperAdmin}, nil).Times(1)
+		m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).
+			Return(&models.OvertimeClaim{ID: claimID, AccountID: uuid.New(), Status: models.OvertimeStatusPending}, nil).Times(1)
+		m.claimRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		// CreditCompOff should NOT be called
+
+		claim, err := service.RejectClaim(ctx, claimID.String(), hrID.String(), "Not an approved incident")
+
+		require.NoError(t, err)
+		assert.Equal(t, models.OvertimeStatusRejected, claim.Status)
+		assert.Equal(t, "Not an approved incident", claim.DecisionNote)
+		assert.True(t, claim.CompOffDays.IsZero())
+	})
+	t.Run("Failure - Processed Concurrently", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		m, service := newOvertimeTestService(ctrl)
+
+		m.accountRepo.EXPECT().GetAccountByID(gomock.Any(), hrID).Return(&models.Account{ID: hrID, Role: models.RoleHR}, nil).Times(1)
+		m.claimRepo.EXPECT().GetByID(gomock.Any(), claimID).
+			Return(&models.OvertimeClaim{ID: claimID, AccountID: uuid.New(), Status: models.OvertimeStatusPending}, nil).Times(1)
+		m.claimRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(ErrConcurrentModification).Times(1)
+
+		_, err := service.RejectClaim(ctx, claimID.String(), hrID.String(), "Duplicate")
+		assert.ErrorIs(t, err, ErrInvalidOvertimeClaimState)
+	})
+}
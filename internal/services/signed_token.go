@@ -0,0 +1,36 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/erinchen11/hr-system/internal/interfaces"
+)
+
+// encodeSignedToken 將 payload 編碼為 base64url(JSON) + "." + base64url(簽章)，用於不需登入的簽署連結
+func encodeSignedToken(signer interfaces.MessageSigner, payload interface{}) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	signature := signer.Sign(data)
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// decodeSignedToken 驗證 encodeSignedToken 產生的 token 並將內容解入 dest; 格式或簽章不符時返回 false
+func decodeSignedToken(signer interfaces.MessageSigner, token string, dest interface{}) bool {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return false
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !signer.Verify(data, signature) {
+		return false
+	}
+	return json.Unmarshal(data, dest) == nil
+}
@@ -0,0 +1,18 @@
+package services
+
+import (
+	"context"
+	"log"
+
+	"github.com/erinchen11/hr-system/internal/models"
+)
+
+// checkExpectedVersion 在 ctx 帶有預期版本號 (models.WithExpectedVersion) 且與記錄目前的版本號 current 不同時返回 ErrConcurrentModification
+func checkExpectedVersion(ctx context.Context, current int) error {
+	expected, ok := models.ExpectedVersionFromContext(ctx)
+	if !ok || expected == current {
+		return nil
+	}
+	log.Printf("Version precondition failed: client expected version %d, current version is %d", expected, current)
+	return ErrConcurrentModification
+}
@@ -2,6 +2,8 @@
 package utils
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"log" // 用於記錄警告或錯誤
@@ -106,6 +108,28 @@ func (j *jwtHelper) ParseJWT(tokenString string, opts ...jwt.ParserOption) (*mod
 	return parsedClaims, nil
 }
 
+// actionLinkKeyPurpose 用於從 JWT secret 衍生簽署金鑰, 避免簽章可與 JWT 互換使用
+const actionLinkKeyPurpose = "hr-system/signed-action-link"
+
+// Sign 以 JWT secret 衍生的金鑰計算訊息的 HMAC-SHA256 簽章 (例如 Email 中的一次性審核連結)
+func (j *jwtHelper) Sign(message []byte) []byte {
+	mac := hmac.New(sha256.New, j.signingKey())
+	mac.Write(message)
+	return mac.Sum(nil)
+}
+
+// Verify 以固定時間比較驗證訊息的簽章
+func (j *jwtHelper) Verify(message, signature []byte) bool {
+	return hmac.Equal(j.Sign(message), signature)
+}
+
+// signingKey 衍生 Sign / Verify 專用的金鑰
+func (j *jwtHelper) signingKey() []byte {
+	mac := hmac.New(sha256.New, j.secretKey)
+	mac.Write([]byte(actionLinkKeyPurpose))
+	return mac.Sum(nil)
+}
+
 // GenerateTestContext 創建一個模擬的 Gin Context，
 // 其中包含模擬 AuthMiddleware 設置的用戶 Claims 和其他相關鍵。
 func GenerateTestContext(req *http.Request, userID, email string, role uint8) *gin.Context {
@@ -148,5 +172,6 @@ func GenerateTestContext(req *http.Request, userID, email string, role uint8) *g
 // --- 介面符合性檢查 (可選) ---
 var _ interfaces.TokenGenerator = (*jwtHelper)(nil)
 var _ interfaces.TokenParser = (*jwtHelper)(nil)
+var _ interfaces.MessageSigner = (*jwtHelper)(nil)
 
 // var _ interfaces.TokenGeneratorParser = (*jwtHelper)(nil) // 如果你沒有組合介面，就不需要這行
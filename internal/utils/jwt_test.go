@@ -247,6 +247,23 @@ func TestJWTGenerateParseCycle(t *testing.T) {
 	})
 }
 
+// --- 測試 Sign / Verify (一次性連結簽章) ---
+func TestJwtHelperSignVerify(t *testing.T) {
+	helper, err := utils.NewJwtUtils("test-secret-key", "test-issuer", "1")
+	require.NoError(t, err)
+	otherHelper, err := utils.NewJwtUtils("another-secret-key", "test-issuer", "1")
+	require.NoError(t, err)
+	message := []byte(`{"action":"approve"}`)
+
+	signature := helper.Sign(message)
+
+	assert.Len(t, signature, 32, "HMAC-SHA256 signature")
+	assert.True(t, helper.Verify(message, signature))
+	assert.False(t, helper.Verify([]byte(`{"action":"reject"}`), signature), "tampered message")
+	assert.False(t, otherHelper.Verify(message, signature), "different secret")
+	assert.False(t, helper.Verify(message, signature[:16]), "truncated signature")
+}
+
 // --- GenerateTestContext 的測試 (可選) ---
 // 通常這個輔助函數不需要單獨的單元測試，它的正確性會在 Handler 測試中體現
 // 如果要測試，可以驗證它返回的 Context 中是否包含了預期的值